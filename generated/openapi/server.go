@@ -14,6 +14,21 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List canned responses
+	// (GET /canned-responses)
+	GetCannedResponses(ctx echo.Context, params GetCannedResponsesParams) error
+	// Create a canned response
+	// (POST /canned-responses)
+	PostCannedResponses(ctx echo.Context) error
+	// Delete a canned response
+	// (DELETE /canned-responses/{id})
+	DeleteCannedResponsesID(ctx echo.Context, id openapi_types.UUID) error
+	// Get a canned response
+	// (GET /canned-responses/{id})
+	GetCannedResponsesID(ctx echo.Context, id openapi_types.UUID) error
+	// Update a canned response
+	// (PUT /canned-responses/{id})
+	PutCannedResponsesID(ctx echo.Context, id openapi_types.UUID) error
 	// Get categories tree
 	// (GET /categories)
 	GetCategories(ctx echo.Context, params GetCategoriesParams) error
@@ -28,13 +43,34 @@ type ServerInterface interface {
 	GetCategoriesID(ctx echo.Context, id openapi_types.UUID) error
 	// Update a category
 	// (PUT /categories/{id})
-	PutCategoriesID(ctx echo.Context, id openapi_types.UUID) error
+	PutCategoriesID(ctx echo.Context, id openapi_types.UUID, params PutCategoriesIDParams) error
 	// Get tickets in a category
 	// (GET /categories/{id}/tickets)
 	GetCategoriesIDTickets(ctx echo.Context, id openapi_types.UUID, params GetCategoriesIDTicketsParams) error
 	// Authenticate user and receive JWT token
 	// (POST /login)
 	PostLogin(ctx echo.Context) error
+	// List macros
+	// (GET /macros)
+	GetMacros(ctx echo.Context, params GetMacrosParams) error
+	// Create a macro
+	// (POST /macros)
+	PostMacros(ctx echo.Context) error
+	// Delete a macro
+	// (DELETE /macros/{id})
+	DeleteMacrosID(ctx echo.Context, id openapi_types.UUID) error
+	// Get a macro
+	// (GET /macros/{id})
+	GetMacrosID(ctx echo.Context, id openapi_types.UUID) error
+	// Update a macro
+	// (PUT /macros/{id})
+	PutMacrosID(ctx echo.Context, id openapi_types.UUID) error
+	// List own notifications
+	// (GET /notifications)
+	GetNotifications(ctx echo.Context, params GetNotificationsParams) error
+	// Mark a notification as read
+	// (POST /notifications/{id}/read)
+	PostNotificationsIDRead(ctx echo.Context, id openapi_types.UUID) error
 	// List organizations with pagination
 	// (GET /organizations)
 	GetOrganizations(ctx echo.Context, params GetOrganizationsParams) error
@@ -49,40 +85,196 @@ type ServerInterface interface {
 	GetOrganizationsID(ctx echo.Context, id openapi_types.UUID) error
 	// Update an organization
 	// (PUT /organizations/{id})
-	PutOrganizationsID(ctx echo.Context, id openapi_types.UUID) error
+	PutOrganizationsID(ctx echo.Context, id openapi_types.UUID, params PutOrganizationsIDParams) error
+	// Disable automatic assignment for an organization
+	// (DELETE /organizations/{id}/assignment)
+	DeleteOrganizationsIDAssignment(ctx echo.Context, id openapi_types.UUID) error
+	// Get the automatic assignment configuration of an organization
+	// (GET /organizations/{id}/assignment)
+	GetOrganizationsIDAssignment(ctx echo.Context, id openapi_types.UUID) error
+	// Configure automatic assignment for an organization
+	// (PUT /organizations/{id}/assignment)
+	PutOrganizationsIDAssignment(ctx echo.Context, id openapi_types.UUID) error
+	// Reset the priority matrix of an organization
+	// (DELETE /organizations/{id}/priority-matrix)
+	DeleteOrganizationsIDPriorityMatrix(ctx echo.Context, id openapi_types.UUID) error
+	// Get the priority matrix of an organization
+	// (GET /organizations/{id}/priority-matrix)
+	GetOrganizationsIDPriorityMatrix(ctx echo.Context, id openapi_types.UUID) error
+	// Configure the priority matrix of an organization
+	// (PUT /organizations/{id}/priority-matrix)
+	PutOrganizationsIDPriorityMatrix(ctx echo.Context, id openapi_types.UUID) error
+	// Get the settings of an organization
+	// (GET /organizations/{id}/settings)
+	GetOrganizationsIDSettings(ctx echo.Context, id openapi_types.UUID) error
+	// Update the settings of an organization
+	// (PUT /organizations/{id}/settings)
+	PutOrganizationsIDSettings(ctx echo.Context, id openapi_types.UUID) error
+	// Reset the SLA configuration of an organization
+	// (DELETE /organizations/{id}/sla)
+	DeleteOrganizationsIDSla(ctx echo.Context, id openapi_types.UUID) error
+	// Get the SLA configuration of an organization
+	// (GET /organizations/{id}/sla)
+	GetOrganizationsIDSla(ctx echo.Context, id openapi_types.UUID) error
+	// Configure the SLA policies of an organization
+	// (PUT /organizations/{id}/sla)
+	PutOrganizationsIDSla(ctx echo.Context, id openapi_types.UUID) error
+	// List tag definitions of an organization
+	// (GET /organizations/{id}/tags)
+	GetOrganizationsIDTags(ctx echo.Context, id openapi_types.UUID) error
+	// Define a ticket tag for an organization
+	// (POST /organizations/{id}/tags)
+	PostOrganizationsIDTags(ctx echo.Context, id openapi_types.UUID) error
+	// Delete a tag definition
+	// (DELETE /organizations/{id}/tags/{name})
+	DeleteOrganizationsIDTagsName(ctx echo.Context, id openapi_types.UUID, name string) error
+	// Update a tag definition
+	// (PUT /organizations/{id}/tags/{name})
+	PutOrganizationsIDTagsName(ctx echo.Context, id openapi_types.UUID, name string) error
 	// Get tickets in an organization
 	// (GET /organizations/{id}/tickets)
 	GetOrganizationsIDTickets(ctx echo.Context, id openapi_types.UUID, params GetOrganizationsIDTicketsParams) error
 	// Get users in an organization
 	// (GET /organizations/{id}/users)
 	GetOrganizationsIDUsers(ctx echo.Context, id openapi_types.UUID, params GetOrganizationsIDUsersParams) error
+	// Reset the ticket workflow of an organization
+	// (DELETE /organizations/{id}/workflow)
+	DeleteOrganizationsIDWorkflow(ctx echo.Context, id openapi_types.UUID) error
+	// Get the ticket workflow of an organization
+	// (GET /organizations/{id}/workflow)
+	GetOrganizationsIDWorkflow(ctx echo.Context, id openapi_types.UUID) error
+	// Configure the ticket workflow of an organization
+	// (PUT /organizations/{id}/workflow)
+	PutOrganizationsIDWorkflow(ctx echo.Context, id openapi_types.UUID) error
+	// List recurring ticket templates
+	// (GET /recurring-templates)
+	GetRecurringTemplates(ctx echo.Context, params GetRecurringTemplatesParams) error
+	// Create a recurring ticket template
+	// (POST /recurring-templates)
+	PostRecurringTemplates(ctx echo.Context) error
+	// Delete a recurring ticket template
+	// (DELETE /recurring-templates/{id})
+	DeleteRecurringTemplatesID(ctx echo.Context, id openapi_types.UUID) error
+	// Get a recurring ticket template
+	// (GET /recurring-templates/{id})
+	GetRecurringTemplatesID(ctx echo.Context, id openapi_types.UUID) error
+	// Update a recurring ticket template
+	// (PUT /recurring-templates/{id})
+	PutRecurringTemplatesID(ctx echo.Context, id openapi_types.UUID) error
+	// Customer satisfaction report
+	// (GET /reports/satisfaction)
+	GetReportsSatisfaction(ctx echo.Context, params GetReportsSatisfactionParams) error
+	// Time tracking report
+	// (GET /reports/time)
+	GetReportsTime(ctx echo.Context, params GetReportsTimeParams) error
 	// List tickets with filtering and pagination
 	// (GET /tickets)
 	GetTickets(ctx echo.Context, params GetTicketsParams) error
 	// Create a new ticket
 	// (POST /tickets)
 	PostTickets(ctx echo.Context) error
+	// Apply an operation to many tickets
+	// (POST /tickets/bulk)
+	PostTicketsBulk(ctx echo.Context) error
 	// Delete a ticket
 	// (DELETE /tickets/{id})
 	DeleteTicketsID(ctx echo.Context, id openapi_types.UUID) error
-	// Get a ticket by ID
+	// Get a ticket by ID or key
 	// (GET /tickets/{id})
-	GetTicketsID(ctx echo.Context, id openapi_types.UUID) error
+	GetTicketsID(ctx echo.Context, id string) error
 	// Update a ticket
 	// (PUT /tickets/{id})
-	PutTicketsID(ctx echo.Context, id openapi_types.UUID) error
+	PutTicketsID(ctx echo.Context, id openapi_types.UUID, params PutTicketsIDParams) error
 	// Assign or unassign ticket
 	// (PATCH /tickets/{id}/assign)
-	PatchTicketsIDAssign(ctx echo.Context, id openapi_types.UUID) error
+	PatchTicketsIDAssign(ctx echo.Context, id openapi_types.UUID, params PatchTicketsIDAssignParams) error
+	// Get ticket attachments
+	// (GET /tickets/{id}/attachments)
+	GetTicketsIDAttachments(ctx echo.Context, id openapi_types.UUID) error
+	// Upload a ticket attachment
+	// (POST /tickets/{id}/attachments)
+	PostTicketsIDAttachments(ctx echo.Context, id openapi_types.UUID) error
+	// Delete a ticket attachment
+	// (DELETE /tickets/{id}/attachments/{attachmentId})
+	DeleteTicketsIDAttachmentsAttachmentID(ctx echo.Context, id openapi_types.UUID, attachmentId openapi_types.UUID) error
+	// Download a ticket attachment
+	// (GET /tickets/{id}/attachments/{attachmentId})
+	GetTicketsIDAttachmentsAttachmentID(ctx echo.Context, id openapi_types.UUID, attachmentId openapi_types.UUID) error
+	// Render a canned response for a ticket
+	// (GET /tickets/{id}/canned-responses/{responseId})
+	GetTicketsIDCannedResponsesResponseID(ctx echo.Context, id openapi_types.UUID, responseId openapi_types.UUID) error
 	// Get ticket comments
 	// (GET /tickets/{id}/comments)
 	GetTicketsIDComments(ctx echo.Context, id openapi_types.UUID, params GetTicketsIDCommentsParams) error
 	// Add a comment to a ticket
 	// (POST /tickets/{id}/comments)
 	PostTicketsIDComments(ctx echo.Context, id openapi_types.UUID) error
+	// Delete a ticket comment
+	// (DELETE /tickets/{id}/comments/{commentId})
+	DeleteTicketsIDCommentsCommentID(ctx echo.Context, id openapi_types.UUID, commentId openapi_types.UUID) error
+	// Edit a ticket comment
+	// (PUT /tickets/{id}/comments/{commentId})
+	PutTicketsIDCommentsCommentID(ctx echo.Context, id openapi_types.UUID, commentId openapi_types.UUID) error
+	// Get ticket history
+	// (GET /tickets/{id}/history)
+	GetTicketsIDHistory(ctx echo.Context, id openapi_types.UUID, params GetTicketsIDHistoryParams) error
+	// Apply a macro to a ticket
+	// (POST /tickets/{id}/macros/{macroId}/apply)
+	PostTicketsIDMacrosMacroIDApply(ctx echo.Context, id openapi_types.UUID, macroId openapi_types.UUID) error
+	// Merge tickets into this ticket
+	// (POST /tickets/{id}/merge)
+	PostTicketsIDMerge(ctx echo.Context, id openapi_types.UUID) error
+	// List ticket relations
+	// (GET /tickets/{id}/relations)
+	GetTicketsIDRelations(ctx echo.Context, id openapi_types.UUID) error
+	// Link a ticket to another ticket
+	// (POST /tickets/{id}/relations)
+	PostTicketsIDRelations(ctx echo.Context, id openapi_types.UUID) error
+	// Remove a ticket relation
+	// (DELETE /tickets/{id}/relations/{type}/{relatedId})
+	DeleteTicketsIDRelationsTypeRelatedID(ctx echo.Context, id openapi_types.UUID, pType TicketRelationType, relatedId openapi_types.UUID) error
+	// Split comments into a new ticket
+	// (POST /tickets/{id}/split)
+	PostTicketsIDSplit(ctx echo.Context, id openapi_types.UUID) error
 	// Update ticket status
 	// (PATCH /tickets/{id}/status)
-	PatchTicketsIDStatus(ctx echo.Context, id openapi_types.UUID) error
+	PatchTicketsIDStatus(ctx echo.Context, id openapi_types.UUID, params PatchTicketsIDStatusParams) error
+	// Get the satisfaction survey of a ticket
+	// (GET /tickets/{id}/survey)
+	GetTicketsIDSurvey(ctx echo.Context, id openapi_types.UUID) error
+	// Submit a satisfaction rating
+	// (POST /tickets/{id}/survey)
+	PostTicketsIDSurvey(ctx echo.Context, id openapi_types.UUID) error
+	// List ticket watchers
+	// (GET /tickets/{id}/watchers)
+	GetTicketsIDWatchers(ctx echo.Context, id openapi_types.UUID) error
+	// Subscribe a user to a ticket
+	// (POST /tickets/{id}/watchers)
+	PostTicketsIDWatchers(ctx echo.Context, id openapi_types.UUID) error
+	// Unsubscribe a user from a ticket
+	// (DELETE /tickets/{id}/watchers/{userId})
+	DeleteTicketsIDWatchersUserID(ctx echo.Context, id openapi_types.UUID, userId openapi_types.UUID) error
+	// List ticket work log entries
+	// (GET /tickets/{id}/worklogs)
+	GetTicketsIDWorklogs(ctx echo.Context, id openapi_types.UUID) error
+	// Log time spent on a ticket
+	// (POST /tickets/{id}/worklogs)
+	PostTicketsIDWorklogs(ctx echo.Context, id openapi_types.UUID) error
+	// Delete a work log entry
+	// (DELETE /tickets/{id}/worklogs/{worklogId})
+	DeleteTicketsIDWorklogsWorklogID(ctx echo.Context, id openapi_types.UUID, worklogId openapi_types.UUID) error
+	// Edit a work log entry
+	// (PUT /tickets/{id}/worklogs/{worklogId})
+	PutTicketsIDWorklogsWorklogID(ctx echo.Context, id openapi_types.UUID, worklogId openapi_types.UUID) error
+	// List trashed items
+	// (GET /trash)
+	GetTrash(ctx echo.Context, params GetTrashParams) error
+	// Purge a trashed item
+	// (DELETE /trash/{type}/{id})
+	DeleteTrashTypeID(ctx echo.Context, pType TrashItemType, id openapi_types.UUID) error
+	// Restore a trashed item
+	// (POST /trash/{type}/{id}/restore)
+	PostTrashTypeIDRestore(ctx echo.Context, pType TrashItemType, id openapi_types.UUID) error
 	// List users with filtering and pagination
 	// (GET /users)
 	GetUsers(ctx echo.Context, params GetUsersParams) error
@@ -97,13 +289,34 @@ type ServerInterface interface {
 	GetUsersID(ctx echo.Context, id openapi_types.UUID) error
 	// Update a user
 	// (PUT /users/{id})
-	PutUsersID(ctx echo.Context, id openapi_types.UUID) error
+	PutUsersID(ctx echo.Context, id openapi_types.UUID, params PutUsersIDParams) error
 	// Update user role
 	// (PATCH /users/{id}/role)
-	PatchUsersIDRole(ctx echo.Context, id openapi_types.UUID) error
+	PatchUsersIDRole(ctx echo.Context, id openapi_types.UUID, params PatchUsersIDRoleParams) error
 	// Get user tickets
 	// (GET /users/{id}/tickets)
 	GetUsersIDTickets(ctx echo.Context, id openapi_types.UUID, params GetUsersIDTicketsParams) error
+	// List saved ticket views
+	// (GET /views)
+	GetViews(ctx echo.Context) error
+	// Save a ticket view
+	// (POST /views)
+	PostViews(ctx echo.Context) error
+	// Count tickets of every view
+	// (GET /views/counts)
+	GetViewsCounts(ctx echo.Context) error
+	// Delete a saved ticket view
+	// (DELETE /views/{id})
+	DeleteViewsID(ctx echo.Context, id openapi_types.UUID) error
+	// Get a saved ticket view
+	// (GET /views/{id})
+	GetViewsID(ctx echo.Context, id openapi_types.UUID) error
+	// Update a saved ticket view
+	// (PUT /views/{id})
+	PutViewsID(ctx echo.Context, id openapi_types.UUID) error
+	// Run a saved ticket view
+	// (GET /views/{id}/tickets)
+	GetViewsIDTickets(ctx echo.Context, id openapi_types.UUID, params GetViewsIDTicketsParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -111,6 +324,91 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetCannedResponses converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCannedResponses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCannedResponsesParams
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCannedResponses(ctx, params)
+	return err
+}
+
+// PostCannedResponses converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCannedResponses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCannedResponses(ctx)
+	return err
+}
+
+// DeleteCannedResponsesID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCannedResponsesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCannedResponsesID(ctx, id)
+	return err
+}
+
+// GetCannedResponsesID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCannedResponsesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCannedResponsesID(ctx, id)
+	return err
+}
+
+// PutCannedResponsesID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCannedResponsesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCannedResponsesID(ctx, id)
+	return err
+}
+
 // GetCategories converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCategories(ctx echo.Context) error {
 	var err error
@@ -212,8 +510,28 @@ func (w *ServerInterfaceWrapper) PutCategoriesID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutCategoriesIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutCategoriesID(ctx, id)
+	err = w.Handler.PutCategoriesID(ctx, id, params)
 	return err
 }
 
@@ -281,74 +599,39 @@ func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
 	return err
 }
 
-// GetOrganizations converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrganizations(ctx echo.Context) error {
+// GetMacros converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMacros(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOrganizationsParams
-	// ------------- Optional query parameter "name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
-
-	// ------------- Optional query parameter "domain" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "domain", ctx.QueryParams(), &params.Domain)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter domain: %s", err))
-	}
-
-	// ------------- Optional query parameter "is_active" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
-	}
-
-	// ------------- Optional query parameter "parent_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "parent_id", ctx.QueryParams(), &params.ParentId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_id: %s", err))
-	}
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	var params GetMacrosParams
+	// ------------- Optional query parameter "organization_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrganizations(ctx, params)
+	err = w.Handler.GetMacros(ctx, params)
 	return err
 }
 
-// PostOrganizations converts echo context to params.
-func (w *ServerInterfaceWrapper) PostOrganizations(ctx echo.Context) error {
+// PostMacros converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMacros(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostOrganizations(ctx)
+	err = w.Handler.PostMacros(ctx)
 	return err
 }
 
-// DeleteOrganizationsID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteOrganizationsID(ctx echo.Context) error {
+// DeleteMacrosID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMacrosID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -361,12 +644,12 @@ func (w *ServerInterfaceWrapper) DeleteOrganizationsID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteOrganizationsID(ctx, id)
+	err = w.Handler.DeleteMacrosID(ctx, id)
 	return err
 }
 
-// GetOrganizationsID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrganizationsID(ctx echo.Context) error {
+// GetMacrosID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMacrosID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -379,12 +662,12 @@ func (w *ServerInterfaceWrapper) GetOrganizationsID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrganizationsID(ctx, id)
+	err = w.Handler.GetMacrosID(ctx, id)
 	return err
 }
 
-// PutOrganizationsID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutOrganizationsID(ctx echo.Context) error {
+// PutMacrosID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutMacrosID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -397,37 +680,23 @@ func (w *ServerInterfaceWrapper) PutOrganizationsID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutOrganizationsID(ctx, id)
+	err = w.Handler.PutMacrosID(ctx, id)
 	return err
 }
 
-// GetOrganizationsIDTickets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrganizationsIDTickets(ctx echo.Context) error {
+// GetNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotifications(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOrganizationsIDTicketsParams
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
-
-	// ------------- Optional query parameter "priority" -------------
+	var params GetNotificationsParams
+	// ------------- Optional query parameter "unread" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	err = runtime.BindQueryParameter("form", true, false, "unread", ctx.QueryParams(), &params.Unread)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unread: %s", err))
 	}
 
 	// ------------- Optional query parameter "page" -------------
@@ -445,12 +714,12 @@ func (w *ServerInterfaceWrapper) GetOrganizationsIDTickets(ctx echo.Context) err
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrganizationsIDTickets(ctx, id, params)
+	err = w.Handler.GetNotifications(ctx, params)
 	return err
 }
 
-// GetOrganizationsIDUsers converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrganizationsIDUsers(ctx echo.Context) error {
+// PostNotificationsIDRead converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNotificationsIDRead(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -462,75 +731,45 @@ func (w *ServerInterfaceWrapper) GetOrganizationsIDUsers(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOrganizationsIDUsersParams
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrganizationsIDUsers(ctx, id, params)
+	err = w.Handler.PostNotificationsIDRead(ctx, id)
 	return err
 }
 
-// GetTickets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTickets(ctx echo.Context) error {
+// GetOrganizations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizations(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTicketsParams
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
-
-	// ------------- Optional query parameter "priority" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
-	}
-
-	// ------------- Optional query parameter "category_id" -------------
+	var params GetOrganizationsParams
+	// ------------- Optional query parameter "name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "assignee_id" -------------
+	// ------------- Optional query parameter "domain" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "assignee_id", ctx.QueryParams(), &params.AssigneeId)
+	err = runtime.BindQueryParameter("form", true, false, "domain", ctx.QueryParams(), &params.Domain)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignee_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter domain: %s", err))
 	}
 
-	// ------------- Optional query parameter "organization_id" -------------
+	// ------------- Optional query parameter "is_active" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
 	}
 
-	// ------------- Optional query parameter "author_id" -------------
+	// ------------- Optional query parameter "parent_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "author_id", ctx.QueryParams(), &params.AuthorId)
+	err = runtime.BindQueryParameter("form", true, false, "parent_id", ctx.QueryParams(), &params.ParentId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_id: %s", err))
 	}
 
 	// ------------- Optional query parameter "page" -------------
@@ -548,23 +787,23 @@ func (w *ServerInterfaceWrapper) GetTickets(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTickets(ctx, params)
+	err = w.Handler.GetOrganizations(ctx, params)
 	return err
 }
 
-// PostTickets converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTickets(ctx echo.Context) error {
+// PostOrganizations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostOrganizations(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTickets(ctx)
+	err = w.Handler.PostOrganizations(ctx)
 	return err
 }
 
-// DeleteTicketsID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTicketsID(ctx echo.Context) error {
+// DeleteOrganizationsID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -577,12 +816,12 @@ func (w *ServerInterfaceWrapper) DeleteTicketsID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTicketsID(ctx, id)
+	err = w.Handler.DeleteOrganizationsID(ctx, id)
 	return err
 }
 
-// GetTicketsID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTicketsID(ctx echo.Context) error {
+// GetOrganizationsID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -595,12 +834,12 @@ func (w *ServerInterfaceWrapper) GetTicketsID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTicketsID(ctx, id)
+	err = w.Handler.GetOrganizationsID(ctx, id)
 	return err
 }
 
-// PutTicketsID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutTicketsID(ctx echo.Context) error {
+// PutOrganizationsID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -612,13 +851,33 @@ func (w *ServerInterfaceWrapper) PutTicketsID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutOrganizationsIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutTicketsID(ctx, id)
+	err = w.Handler.PutOrganizationsID(ctx, id, params)
 	return err
 }
 
-// PatchTicketsIDAssign converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTicketsIDAssign(ctx echo.Context) error {
+// DeleteOrganizationsIDAssignment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsIDAssignment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -631,12 +890,12 @@ func (w *ServerInterfaceWrapper) PatchTicketsIDAssign(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTicketsIDAssign(ctx, id)
+	err = w.Handler.DeleteOrganizationsIDAssignment(ctx, id)
 	return err
 }
 
-// GetTicketsIDComments converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTicketsIDComments(ctx echo.Context) error {
+// GetOrganizationsIDAssignment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDAssignment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -648,22 +907,1312 @@ func (w *ServerInterfaceWrapper) GetTicketsIDComments(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTicketsIDCommentsParams
-	// ------------- Optional query parameter "include_internal" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDAssignment(ctx, id)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "include_internal", ctx.QueryParams(), &params.IncludeInternal)
+// PutOrganizationsIDAssignment converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDAssignment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDAssignment(ctx, id)
+	return err
+}
+
+// DeleteOrganizationsIDPriorityMatrix converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsIDPriorityMatrix(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrganizationsIDPriorityMatrix(ctx, id)
+	return err
+}
+
+// GetOrganizationsIDPriorityMatrix converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDPriorityMatrix(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDPriorityMatrix(ctx, id)
+	return err
+}
+
+// PutOrganizationsIDPriorityMatrix converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDPriorityMatrix(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDPriorityMatrix(ctx, id)
+	return err
+}
+
+// GetOrganizationsIDSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDSettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDSettings(ctx, id)
+	return err
+}
+
+// PutOrganizationsIDSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDSettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDSettings(ctx, id)
+	return err
+}
+
+// DeleteOrganizationsIDSla converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsIDSla(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrganizationsIDSla(ctx, id)
+	return err
+}
+
+// GetOrganizationsIDSla converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDSla(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDSla(ctx, id)
+	return err
+}
+
+// PutOrganizationsIDSla converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDSla(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDSla(ctx, id)
+	return err
+}
+
+// GetOrganizationsIDTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDTags(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDTags(ctx, id)
+	return err
+}
+
+// PostOrganizationsIDTags converts echo context to params.
+func (w *ServerInterfaceWrapper) PostOrganizationsIDTags(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostOrganizationsIDTags(ctx, id)
+	return err
+}
+
+// DeleteOrganizationsIDTagsName converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsIDTagsName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrganizationsIDTagsName(ctx, id, name)
+	return err
+}
+
+// PutOrganizationsIDTagsName converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDTagsName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDTagsName(ctx, id, name)
+	return err
+}
+
+// GetOrganizationsIDTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDTickets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationsIDTicketsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDTickets(ctx, id, params)
+	return err
+}
+
+// GetOrganizationsIDUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDUsers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationsIDUsersParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDUsers(ctx, id, params)
+	return err
+}
+
+// DeleteOrganizationsIDWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganizationsIDWorkflow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrganizationsIDWorkflow(ctx, id)
+	return err
+}
+
+// GetOrganizationsIDWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationsIDWorkflow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationsIDWorkflow(ctx, id)
+	return err
+}
+
+// PutOrganizationsIDWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) PutOrganizationsIDWorkflow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutOrganizationsIDWorkflow(ctx, id)
+	return err
+}
+
+// GetRecurringTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRecurringTemplatesParams
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurringTemplates(ctx, params)
+	return err
+}
+
+// PostRecurringTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRecurringTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRecurringTemplates(ctx)
+	return err
+}
+
+// DeleteRecurringTemplatesID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurringTemplatesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRecurringTemplatesID(ctx, id)
+	return err
+}
+
+// GetRecurringTemplatesID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringTemplatesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurringTemplatesID(ctx, id)
+	return err
+}
+
+// PutRecurringTemplatesID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRecurringTemplatesID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRecurringTemplatesID(ctx, id)
+	return err
+}
+
+// GetReportsSatisfaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsSatisfaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsSatisfactionParams
+	// ------------- Required query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsSatisfaction(ctx, params)
+	return err
+}
+
+// GetReportsTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsTime(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsTimeParams
+	// ------------- Required query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "billable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "billable", ctx.QueryParams(), &params.Billable)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter billable: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsTime(ctx, params)
+	return err
+}
+
+// GetTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTickets(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTicketsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "assignee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assignee_id", ctx.QueryParams(), &params.AssigneeId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignee_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", ctx.QueryParams(), &params.AuthorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "watcher_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watcher_id", ctx.QueryParams(), &params.WatcherId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter watcher_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags_any" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags_any", ctx.QueryParams(), &params.TagsAny)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags_any: %s", err))
+	}
+
+	// ------------- Optional query parameter "custom_fields" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "custom_fields", ctx.QueryParams(), &params.CustomFields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter custom_fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "key" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "key", ctx.QueryParams(), &params.Key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTickets(ctx, params)
+	return err
+}
+
+// PostTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTickets(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTickets(ctx)
+	return err
+}
+
+// PostTicketsBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsBulk(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsBulk(ctx)
+	return err
+}
+
+// DeleteTicketsID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTicketsID(ctx, id)
+	return err
+}
+
+// GetTicketsID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsID(ctx, id)
+	return err
+}
+
+// PutTicketsID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTicketsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTicketsIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTicketsID(ctx, id, params)
+	return err
+}
+
+// PatchTicketsIDAssign converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTicketsIDAssign(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTicketsIDAssignParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTicketsIDAssign(ctx, id, params)
+	return err
+}
+
+// GetTicketsIDAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDAttachments(ctx, id)
+	return err
+}
+
+// PostTicketsIDAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDAttachments(ctx, id)
+	return err
+}
+
+// DeleteTicketsIDAttachmentsAttachmentID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsIDAttachmentsAttachmentID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTicketsIDAttachmentsAttachmentID(ctx, id, attachmentId)
+	return err
+}
+
+// GetTicketsIDAttachmentsAttachmentID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDAttachmentsAttachmentID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDAttachmentsAttachmentID(ctx, id, attachmentId)
+	return err
+}
+
+// GetTicketsIDCannedResponsesResponseID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDCannedResponsesResponseID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "responseId" -------------
+	var responseId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "responseId", runtime.ParamLocationPath, ctx.Param("responseId"), &responseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter responseId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDCannedResponsesResponseID(ctx, id, responseId)
+	return err
+}
+
+// GetTicketsIDComments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTicketsIDCommentsParams
+	// ------------- Optional query parameter "include_internal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_internal", ctx.QueryParams(), &params.IncludeInternal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_internal: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDComments(ctx, id, params)
+	return err
+}
+
+// PostTicketsIDComments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDComments(ctx, id)
+	return err
+}
+
+// DeleteTicketsIDCommentsCommentID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsIDCommentsCommentID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "commentId", runtime.ParamLocationPath, ctx.Param("commentId"), &commentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTicketsIDCommentsCommentID(ctx, id, commentId)
+	return err
+}
+
+// PutTicketsIDCommentsCommentID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTicketsIDCommentsCommentID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "commentId", runtime.ParamLocationPath, ctx.Param("commentId"), &commentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTicketsIDCommentsCommentID(ctx, id, commentId)
+	return err
+}
+
+// GetTicketsIDHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTicketsIDHistoryParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDHistory(ctx, id, params)
+	return err
+}
+
+// PostTicketsIDMacrosMacroIDApply converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDMacrosMacroIDApply(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "macroId" -------------
+	var macroId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "macroId", runtime.ParamLocationPath, ctx.Param("macroId"), &macroId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter macroId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDMacrosMacroIDApply(ctx, id, macroId)
+	return err
+}
+
+// PostTicketsIDMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDMerge(ctx, id)
+	return err
+}
+
+// GetTicketsIDRelations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDRelations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDRelations(ctx, id)
+	return err
+}
+
+// PostTicketsIDRelations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDRelations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDRelations(ctx, id)
+	return err
+}
+
+// DeleteTicketsIDRelationsTypeRelatedID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsIDRelationsTypeRelatedID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "type" -------------
+	var pType TicketRelationType
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "type", runtime.ParamLocationPath, ctx.Param("type"), &pType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Path parameter "relatedId" -------------
+	var relatedId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relatedId", runtime.ParamLocationPath, ctx.Param("relatedId"), &relatedId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter relatedId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTicketsIDRelationsTypeRelatedID(ctx, id, pType, relatedId)
+	return err
+}
+
+// PostTicketsIDSplit converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDSplit(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDSplit(ctx, id)
+	return err
+}
+
+// PatchTicketsIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTicketsIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTicketsIDStatusParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTicketsIDStatus(ctx, id, params)
+	return err
+}
+
+// GetTicketsIDSurvey converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDSurvey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDSurvey(ctx, id)
+	return err
+}
+
+// PostTicketsIDSurvey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDSurvey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDSurvey(ctx, id)
+	return err
+}
+
+// GetTicketsIDWatchers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDWatchers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDWatchers(ctx, id)
+	return err
+}
+
+// PostTicketsIDWatchers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDWatchers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_internal: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTicketsIDComments(ctx, id, params)
+	err = w.Handler.PostTicketsIDWatchers(ctx, id)
 	return err
 }
 
-// PostTicketsIDComments converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTicketsIDComments(ctx echo.Context) error {
+// DeleteTicketsIDWatchersUserID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsIDWatchersUserID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -673,16 +2222,187 @@ func (w *ServerInterfaceWrapper) PostTicketsIDComments(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTicketsIDComments(ctx, id)
+	err = w.Handler.DeleteTicketsIDWatchersUserID(ctx, id, userId)
 	return err
 }
 
-// PatchTicketsIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTicketsIDStatus(ctx echo.Context) error {
+// GetTicketsIDWorklogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketsIDWorklogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketsIDWorklogs(ctx, id)
+	return err
+}
+
+// PostTicketsIDWorklogs converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTicketsIDWorklogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTicketsIDWorklogs(ctx, id)
+	return err
+}
+
+// DeleteTicketsIDWorklogsWorklogID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTicketsIDWorklogsWorklogID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "worklogId" -------------
+	var worklogId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worklogId", runtime.ParamLocationPath, ctx.Param("worklogId"), &worklogId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worklogId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTicketsIDWorklogsWorklogID(ctx, id, worklogId)
+	return err
+}
+
+// PutTicketsIDWorklogsWorklogID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTicketsIDWorklogsWorklogID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "worklogId" -------------
+	var worklogId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worklogId", runtime.ParamLocationPath, ctx.Param("worklogId"), &worklogId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worklogId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTicketsIDWorklogsWorklogID(ctx, id, worklogId)
+	return err
+}
+
+// GetTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrashParams
+	// ------------- Required query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", ctx.QueryParams(), &params.OrganizationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTrash(ctx, params)
+	return err
+}
+
+// DeleteTrashTypeID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTrashTypeID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "type" -------------
+	var pType TrashItemType
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "type", runtime.ParamLocationPath, ctx.Param("type"), &pType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTrashTypeID(ctx, pType, id)
+	return err
+}
+
+// PostTrashTypeIDRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTrashTypeIDRestore(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "type" -------------
+	var pType TrashItemType
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "type", runtime.ParamLocationPath, ctx.Param("type"), &pType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -694,7 +2414,7 @@ func (w *ServerInterfaceWrapper) PatchTicketsIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTicketsIDStatus(ctx, id)
+	err = w.Handler.PostTrashTypeIDRestore(ctx, pType, id)
 	return err
 }
 
@@ -820,8 +2540,28 @@ func (w *ServerInterfaceWrapper) PutUsersID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUsersIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutUsersID(ctx, id)
+	err = w.Handler.PutUsersID(ctx, id, params)
 	return err
 }
 
@@ -838,8 +2578,28 @@ func (w *ServerInterfaceWrapper) PatchUsersIDRole(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchUsersIDRoleParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchUsersIDRole(ctx, id)
+	err = w.Handler.PatchUsersIDRole(ctx, id, params)
 	return err
 }
 
@@ -898,6 +2658,127 @@ func (w *ServerInterfaceWrapper) GetUsersIDTickets(ctx echo.Context) error {
 	return err
 }
 
+// GetViews converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViews(ctx)
+	return err
+}
+
+// PostViews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostViews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostViews(ctx)
+	return err
+}
+
+// GetViewsCounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViewsCounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViewsCounts(ctx)
+	return err
+}
+
+// DeleteViewsID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteViewsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteViewsID(ctx, id)
+	return err
+}
+
+// GetViewsID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViewsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViewsID(ctx, id)
+	return err
+}
+
+// PutViewsID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutViewsID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutViewsID(ctx, id)
+	return err
+}
+
+// GetViewsIDTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViewsIDTickets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewsIDTicketsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViewsIDTickets(ctx, id, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -926,6 +2807,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/canned-responses", wrapper.GetCannedResponses)
+	router.POST(baseURL+"/canned-responses", wrapper.PostCannedResponses)
+	router.DELETE(baseURL+"/canned-responses/:id", wrapper.DeleteCannedResponsesID)
+	router.GET(baseURL+"/canned-responses/:id", wrapper.GetCannedResponsesID)
+	router.PUT(baseURL+"/canned-responses/:id", wrapper.PutCannedResponsesID)
 	router.GET(baseURL+"/categories", wrapper.GetCategories)
 	router.POST(baseURL+"/categories", wrapper.PostCategories)
 	router.DELETE(baseURL+"/categories/:id", wrapper.DeleteCategoriesID)
@@ -933,22 +2819,81 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/categories/:id", wrapper.PutCategoriesID)
 	router.GET(baseURL+"/categories/:id/tickets", wrapper.GetCategoriesIDTickets)
 	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.GET(baseURL+"/macros", wrapper.GetMacros)
+	router.POST(baseURL+"/macros", wrapper.PostMacros)
+	router.DELETE(baseURL+"/macros/:id", wrapper.DeleteMacrosID)
+	router.GET(baseURL+"/macros/:id", wrapper.GetMacrosID)
+	router.PUT(baseURL+"/macros/:id", wrapper.PutMacrosID)
+	router.GET(baseURL+"/notifications", wrapper.GetNotifications)
+	router.POST(baseURL+"/notifications/:id/read", wrapper.PostNotificationsIDRead)
 	router.GET(baseURL+"/organizations", wrapper.GetOrganizations)
 	router.POST(baseURL+"/organizations", wrapper.PostOrganizations)
 	router.DELETE(baseURL+"/organizations/:id", wrapper.DeleteOrganizationsID)
 	router.GET(baseURL+"/organizations/:id", wrapper.GetOrganizationsID)
 	router.PUT(baseURL+"/organizations/:id", wrapper.PutOrganizationsID)
+	router.DELETE(baseURL+"/organizations/:id/assignment", wrapper.DeleteOrganizationsIDAssignment)
+	router.GET(baseURL+"/organizations/:id/assignment", wrapper.GetOrganizationsIDAssignment)
+	router.PUT(baseURL+"/organizations/:id/assignment", wrapper.PutOrganizationsIDAssignment)
+	router.DELETE(baseURL+"/organizations/:id/priority-matrix", wrapper.DeleteOrganizationsIDPriorityMatrix)
+	router.GET(baseURL+"/organizations/:id/priority-matrix", wrapper.GetOrganizationsIDPriorityMatrix)
+	router.PUT(baseURL+"/organizations/:id/priority-matrix", wrapper.PutOrganizationsIDPriorityMatrix)
+	router.GET(baseURL+"/organizations/:id/settings", wrapper.GetOrganizationsIDSettings)
+	router.PUT(baseURL+"/organizations/:id/settings", wrapper.PutOrganizationsIDSettings)
+	router.DELETE(baseURL+"/organizations/:id/sla", wrapper.DeleteOrganizationsIDSla)
+	router.GET(baseURL+"/organizations/:id/sla", wrapper.GetOrganizationsIDSla)
+	router.PUT(baseURL+"/organizations/:id/sla", wrapper.PutOrganizationsIDSla)
+	router.GET(baseURL+"/organizations/:id/tags", wrapper.GetOrganizationsIDTags)
+	router.POST(baseURL+"/organizations/:id/tags", wrapper.PostOrganizationsIDTags)
+	router.DELETE(baseURL+"/organizations/:id/tags/:name", wrapper.DeleteOrganizationsIDTagsName)
+	router.PUT(baseURL+"/organizations/:id/tags/:name", wrapper.PutOrganizationsIDTagsName)
 	router.GET(baseURL+"/organizations/:id/tickets", wrapper.GetOrganizationsIDTickets)
 	router.GET(baseURL+"/organizations/:id/users", wrapper.GetOrganizationsIDUsers)
+	router.DELETE(baseURL+"/organizations/:id/workflow", wrapper.DeleteOrganizationsIDWorkflow)
+	router.GET(baseURL+"/organizations/:id/workflow", wrapper.GetOrganizationsIDWorkflow)
+	router.PUT(baseURL+"/organizations/:id/workflow", wrapper.PutOrganizationsIDWorkflow)
+	router.GET(baseURL+"/recurring-templates", wrapper.GetRecurringTemplates)
+	router.POST(baseURL+"/recurring-templates", wrapper.PostRecurringTemplates)
+	router.DELETE(baseURL+"/recurring-templates/:id", wrapper.DeleteRecurringTemplatesID)
+	router.GET(baseURL+"/recurring-templates/:id", wrapper.GetRecurringTemplatesID)
+	router.PUT(baseURL+"/recurring-templates/:id", wrapper.PutRecurringTemplatesID)
+	router.GET(baseURL+"/reports/satisfaction", wrapper.GetReportsSatisfaction)
+	router.GET(baseURL+"/reports/time", wrapper.GetReportsTime)
 	router.GET(baseURL+"/tickets", wrapper.GetTickets)
 	router.POST(baseURL+"/tickets", wrapper.PostTickets)
+	router.POST(baseURL+"/tickets/bulk", wrapper.PostTicketsBulk)
 	router.DELETE(baseURL+"/tickets/:id", wrapper.DeleteTicketsID)
 	router.GET(baseURL+"/tickets/:id", wrapper.GetTicketsID)
 	router.PUT(baseURL+"/tickets/:id", wrapper.PutTicketsID)
 	router.PATCH(baseURL+"/tickets/:id/assign", wrapper.PatchTicketsIDAssign)
+	router.GET(baseURL+"/tickets/:id/attachments", wrapper.GetTicketsIDAttachments)
+	router.POST(baseURL+"/tickets/:id/attachments", wrapper.PostTicketsIDAttachments)
+	router.DELETE(baseURL+"/tickets/:id/attachments/:attachmentId", wrapper.DeleteTicketsIDAttachmentsAttachmentID)
+	router.GET(baseURL+"/tickets/:id/attachments/:attachmentId", wrapper.GetTicketsIDAttachmentsAttachmentID)
+	router.GET(baseURL+"/tickets/:id/canned-responses/:responseId", wrapper.GetTicketsIDCannedResponsesResponseID)
 	router.GET(baseURL+"/tickets/:id/comments", wrapper.GetTicketsIDComments)
 	router.POST(baseURL+"/tickets/:id/comments", wrapper.PostTicketsIDComments)
+	router.DELETE(baseURL+"/tickets/:id/comments/:commentId", wrapper.DeleteTicketsIDCommentsCommentID)
+	router.PUT(baseURL+"/tickets/:id/comments/:commentId", wrapper.PutTicketsIDCommentsCommentID)
+	router.GET(baseURL+"/tickets/:id/history", wrapper.GetTicketsIDHistory)
+	router.POST(baseURL+"/tickets/:id/macros/:macroId/apply", wrapper.PostTicketsIDMacrosMacroIDApply)
+	router.POST(baseURL+"/tickets/:id/merge", wrapper.PostTicketsIDMerge)
+	router.GET(baseURL+"/tickets/:id/relations", wrapper.GetTicketsIDRelations)
+	router.POST(baseURL+"/tickets/:id/relations", wrapper.PostTicketsIDRelations)
+	router.DELETE(baseURL+"/tickets/:id/relations/:type/:relatedId", wrapper.DeleteTicketsIDRelationsTypeRelatedID)
+	router.POST(baseURL+"/tickets/:id/split", wrapper.PostTicketsIDSplit)
 	router.PATCH(baseURL+"/tickets/:id/status", wrapper.PatchTicketsIDStatus)
+	router.GET(baseURL+"/tickets/:id/survey", wrapper.GetTicketsIDSurvey)
+	router.POST(baseURL+"/tickets/:id/survey", wrapper.PostTicketsIDSurvey)
+	router.GET(baseURL+"/tickets/:id/watchers", wrapper.GetTicketsIDWatchers)
+	router.POST(baseURL+"/tickets/:id/watchers", wrapper.PostTicketsIDWatchers)
+	router.DELETE(baseURL+"/tickets/:id/watchers/:userId", wrapper.DeleteTicketsIDWatchersUserID)
+	router.GET(baseURL+"/tickets/:id/worklogs", wrapper.GetTicketsIDWorklogs)
+	router.POST(baseURL+"/tickets/:id/worklogs", wrapper.PostTicketsIDWorklogs)
+	router.DELETE(baseURL+"/tickets/:id/worklogs/:worklogId", wrapper.DeleteTicketsIDWorklogsWorklogID)
+	router.PUT(baseURL+"/tickets/:id/worklogs/:worklogId", wrapper.PutTicketsIDWorklogsWorklogID)
+	router.GET(baseURL+"/trash", wrapper.GetTrash)
+	router.DELETE(baseURL+"/trash/:type/:id", wrapper.DeleteTrashTypeID)
+	router.POST(baseURL+"/trash/:type/:id/restore", wrapper.PostTrashTypeIDRestore)
 	router.GET(baseURL+"/users", wrapper.GetUsers)
 	router.POST(baseURL+"/users", wrapper.PostUsers)
 	router.DELETE(baseURL+"/users/:id", wrapper.DeleteUsersID)
@@ -956,5 +2901,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/users/:id", wrapper.PutUsersID)
 	router.PATCH(baseURL+"/users/:id/role", wrapper.PatchUsersIDRole)
 	router.GET(baseURL+"/users/:id/tickets", wrapper.GetUsersIDTickets)
+	router.GET(baseURL+"/views", wrapper.GetViews)
+	router.POST(baseURL+"/views", wrapper.PostViews)
+	router.GET(baseURL+"/views/counts", wrapper.GetViewsCounts)
+	router.DELETE(baseURL+"/views/:id", wrapper.DeleteViewsID)
+	router.GET(baseURL+"/views/:id", wrapper.GetViewsID)
+	router.PUT(baseURL+"/views/:id", wrapper.PutViewsID)
+	router.GET(baseURL+"/views/:id/tickets", wrapper.GetViewsIDTickets)
 
 }
@@ -18,67 +18,322 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd32/cNvL/Vwh9vw8JsBfbaXNA/eZLrgcXxTVo2utDYBi0NLvLViK3JOV0r/D/fuAP",
-	"SaRE/VrvrrT1vgTxSiKp4XxmPpwZkX9GMcs2jAKVIrr+MxLxGjKs/3sjBFnRn0j8G8gf4fcchFQ/bzjb",
-	"AJcE9E1Y3wRwTxL1ZwIi5mQjCaPRtW0BAN1+QK9onqZIMpRT88zraBEtGc+wjK6jPCdJtIjkdgPRdSQk",
-	"J3QVPT2Vv7CHXyGW0dMies8BS3iPJawY37aOyxtIfVzF08j9eRFl+I/vga7kOrp+d3nZGM0iojiDjtb0",
-	"Za+ZK9VMRmjx99tAo4yvMCX/xaq1oBR/cG5Atx/6xbaINpgDlcHWPupLKC4GraaG6Ys4HTYlHH7PCYck",
-	"uv4c2Veuv8PdgIkTG0YFNGcuNGjzbOKO+lnaw7IMaIdS53LNeFB89lFkbhk4HTGjEqhsb624wVOet5c1",
-	"7bkKNE3EPaESOMWpaX6J81RG10ucCljUuru1d6LY9rtM8aoa8ANjKWDamORqdJVg2mfYVdd2eLIME9qj",
-	"6+YmXz0HYtJrZzdc9mOI+cB8No6GynQX5LCxRqQdPXvzCCPEtejC5I3B4ituhgT89VBcWnMSRvqOFnLR",
-	"7XuM9Do9z+WxvAQnjBO5VY39P4dldB3930VFBy4sF7gwQ/5Y3K00g8gUWt/NXK3bMg9wX/UBomjDl1M5",
-	"4qZAhhmnnwXwVs2FDBNtRUvJmV86rE7AagjxhXE9Qc77/n2gIy06LJvpe5VuW7ADxv/JOetoNwMh8Cr0",
-	"7qHG/gWy3+HHxkTdY+kNOcES/iaJFksfxJpuccjra++JY0ke3fcpvWDHLAfQOI6T9d6db5KRQmmR/zDX",
-	"sdMclD782OKfSJSF42sTYs3zjXNpY51V//0pEyNndEIk7oSonf0XB8HSx5FvKiSWuRjW2Sdzr+sqD6eX",
-	"3Z5gl0kd4QlnaGk5MxLvmiYtM3Xf3ibieyIKZ0dAdMxHeY/20xKyXp0KudFqCJhzvG0fk+sAOoblCnrU",
-	"yIIOpjE6ZbRXhOLCTnQ1+rG8s2qv7e0M3Dre63ndKvzqDsZIpOYnBs+U0skDvkmumh/zHp5dGfYWbEXo",
-	"Pvi1S6O7ifMgxmzH1SZZyX4D2t+VuS3UfkDgjU7WWNxT+CMQBfplDXINHKl/AGEOKGMc0AavQASiM4so",
-	"JRkJtHOr5hVtgOtHqycJlbACbkEYimDmXAc01FVE8+wBePBpyWQRZvKWfepn+xxiS2T0q9lASGMMVmwc",
-	"rCcSNybO1oij7YXsDHd2flCuMwj3ijKJHokgDykgyVCcC8ky4OJ1cPKNQbrfeYFXY0Nti/iCXKEUHkEB",
-	"DGieKRSk7Eu0iKjqWP28Jqt1pKRJJIlx6sCjkofHido6tPSq6oiC6ojQ+w1nKw5CXfuCiVSNVhQuKphu",
-	"sOeftWufJlvgUZ6WJs0N1bu3M6O95hxG5Qd2ixUaye87GByNk7PXwq6yniKW/ByZ98RnhwY9p4p0zi0q",
-	"2SllY9NaZb3LkrFGOmwTd60DGRbS9EWinkEFc2qwMVdC794NSUG14U/3syvu9MNNvA0ZUm+0XLe9R8wV",
-	"y8nWiRi3Jq3pgH44qAHFE+EXVM8hQhWxRGIrJGSOey1oRrSI8Mqm95KM0IAffVpEAuJc4eyTGqt5pQfA",
-	"HPhNriah+OvbQoDf/fJTtDDVBHqy9dVKomspN9GTapjQJdPU24A3+kSyTQqfgD+SGD6A+A3dfLyNFtEj",
-	"cGFe7fLN5ZsrPcsboHhDouvoqzdXb670IkCu9dgu/FX2CgJ0+UeQnMAjCC2fNQGOebxWJAZJDkpheR7L",
-	"nIPitE57umOuteY2ia6dILe5usEcZyD1YutzvdNvSSqBo4dtQPuUD4x+z4FvowITgTSH0ZZB5K+9802g",
-	"CkAXaCyZUhsmnRd+3TK0yqntaVB1QxHqtDI2bqfNBHaTdcdpngCK1yRNnJcr4MGLdVtLt+bxe/04B+r1",
-	"XibdJc8hkE2/02xVN6+18e3lpXbE1WoFbzYpifUkX/wqjDOt2u+yGS1RJw2toGtX7+yrt4LS13sckp9B",
-	"Cozklj7ilCRIyxg5cHlaRO+OOxC7FBPAH5VHVA8Yc5dnGeZbg25XXZTolFbjldBGtAL+neIuTMi2HDzC",
-	"iMKXCnJfiFxr1dtw9kgSSFACEpO0aWA+MuFbGJvo/gdLtnsTVriu6sl3RUrBnxrafHWwQfRq8xaJPI5B",
-	"iGWepltk1/STaTShm1yiBEtshvD18YbgrVIYb1h4yiRaspxa4XxzvJG9r2k8EZrVIZxywMkWwR9EyNIQ",
-	"uw5vlgYhiOY2k/C0cKnIxZ8keTL2IQUZYG0f9O+GkDQNhdhATJYEEkMXfCNhHq3MhL6lk4r4az3t9RR/",
-	"cpxeEtXBP8bTN93e1x0LTg/HRj7T4djlRYxXf66xQAlsgCZAYzXDx0b5+yCcZ4cRo4wI9+Jj0UfN4yr4",
-	"pp0jygWhKx8gtx8qH2qtiFXkDqI+R3zsbxaDGcMOdSqk66GQ21mYBQ5fItS+xSSFBEmGVhUL3QYIagU0",
-	"tY7S+trGUPMA3EwMo83t9PPTfH642j9BDqcSBhHk6XDt4dnWOcyAHWunSnicp5gjDkvgQGNQCgZxOcLJ",
-	"sT5DljxLb2+QMcDbB9jwhVNf0UMFcJoiezd6gJTRlWYCrMaNnUF0un9bOTK1teqIh8laYjQUmCovDpvv",
-	"eqqht3On4joYA6wujxlAlbVpj9QVU73kLEMif/AisF0xuvq9gUCd/TqmP2z4saqI0KFRpwanLSi6gnCf",
-	"VzprQbI8c3MWTnFEve9/+wUVbmFHqGdTFBLs+u2lzqDYvm3Sq30kh45X1mu2AmZH3abevFACS+srCjSP",
-	"hWEognleD4aDqM5UDvMUKVuZqoBwVPU/akI0bc0FcBRzSIBKglOBME3U4iXnVCBdkp2g7375CZn6rVB0",
-	"VdeHHSiw6tXEHZku+nVvgcm7yeVaSc207tDFyRBmpwBt8DZl2PKwqwmQXunTnCBl07DR9ec7F2DOPILB",
-	"g8FADOQRPOUv4IZzubZAa5T+9hExlFrz7D1pmGvxpRxaaiqhKJoaiuc4G7zMq1HeMYWq2fKrDeZqzlCG",
-	"Zbxuy1zaooJG/nAIKWPBoqRQL+XFnfo5ZDK0kQceloveU8L3zKkOwKnCVf4dzMoH/TkF3MFejMiaps5T",
-	"3MKu+nIdmBT28DcuMVw3nYfLDYdqOSfJD4e/OOlJic46T/zNRHniWqyJ8WLPhROIOrUCqAOMDbYzKiMb",
-	"RumArKyH0f4A+Q9hb3z85Gw7gKZO0Na33mC1as7JE7V+0fhJJGvpUBT1Jm19jtxI3NYnb2jy9iRgtNdc",
-	"z06ebqa53Poa44Ui0s/p+tVOzbyuj8lGcjdANvvyu7tSzXy28DtUqndnvju9CZhp2ndGmD9z7udkeunz",
-	"CPf+k7614fRRh4G532OYsHP+9xwenHPKtV6PPZfl3uSp1xNa4dXTr8813+VOIgOMt753j6Zbb5IyS8N9",
-	"NlwHMFz+njgdZsvo2YyN1tlAdRuocgLHmacRVLJMWxe2sC1h/bC1pGtR8p9FWbKyQMU+fwud2e6zXwO5",
-	"5kunfdUQ4mbFZa17dwuLfX17XG1M3NKpu7vjnjqd9BNwdwfz4PuWWy+dveLp0/lzhr83w+95hY7qpcIb",
-	"FZIdmOO3lnVcdr9yHofL6/v7BR05ox/YLrE5hcUOYedPvf2i33rWc94kL4CGIJocVjcqPV8H2IDEvIVX",
-	"fzLDauCUyfgQCKZOw8tSLMfGgJXGiXwG3aHtvcl0WWyjVk+jl8IfnECfrbpfTuJPZpoof8Gg8pPjFjWh",
-	"z52tjOr5cI+S9WXCxxOyfFYIOlTeewc+eDk9H3zBWe6T8IXlR8IjmN+FibmYzc9lvG47T0pUFkEyhCnS",
-	"GxoqelwcNiiQXtnX8KzaLBFtmvpL4jp0kuOMcW2mTO+NPSuIOzFCpVvTI57xakzzRr/RQBeRo+yA3Sp9",
-	"aM6xuF0HHP2lYNlrOzl+X3Q2qSlo/S6e1PaPF+iV3rf1who9mm5f93whX25OP+rj+OfS9kHHTvjnATQP",
-	"neiJc5ZCObP3uZciVFM1JqR6kySi2HvPnp/QqChowbgTT50LyO8Oupenf8rtkQO7NSAHoph2+s5R3ROC",
-	"702SIOwibxyfr/bDb+Hz7vrc3Kxte221brZWR5JjKoiOfD+azSBCKXif5X8qEud/7dW7fy7BnNfwZpJn",
-	"xfPtmBz1mgXbP4n1fb14JWwUBhcQliU7pjpo9x0mBpUNVsUReXH2xBF2lMjLwzgGdlac07F7b/pAiXDj",
-	"9tJAk+OcWDHTMpc8dATJPjfTOBe4TF72eS5u6S1ucezngNIWI9WBhS0aYc0sitm9kiblOUPN1VhhlA+3",
-	"BnIPR5pkrwr/DM3mBOpzes57U7jHRZ3Y1hNK/QPQKWnOqBoWH0sDKlg0hPrTkVqyU1avNPV86tqV3IhE",
-	"x6TVfyffMsKcd3YaBS0tSt9bzKIl3SxlKeZiaCHLLLV+rwvoQX5jpgUsdjZfGoD84hWNkFDpipZOvXDF",
-	"IVx9ZSstdKujaGUuaDlUyGs0yTs+Us+lKm0QPT7BrDZgMEGXU9phfxDZvCgOGx0QZNdHhNoQe0U3bTeB",
-	"QLq1JT+aKNFf2J44h7nO1KboqZuVYTHKxF8qARiBZDcK2wPm4R+4Fh8wYSFYTJQmhJaSuutXNspgwrNF",
-	"FZEiL687+PbAT1kPCfzzdimtQ+CQmi+j12SjWKg14sEgv3NrOB4c4TR1Dos2H4NGi/IrWPXfNA2eFX0O",
-	"kM/rC1AN+Kk/A3WiLZPv3HICbqLYEKGct4Cb8I8D8M9j/3z3dPf0vwAAAP//+C5XKNydAAA=",
+	"H4sIAAAAAAAC/+y9e3PbtrY4+lUw2numyRnZcdJ231/jOTPHjZPW5yRNruPuTm+c64FESMIxCWgDoB3t",
+	"XH/3O1h4ECTBlyxbcuN/EosE8VxrYb3X19GUZ0vOCFNy9PLrSE4XJMPw51GSnNHpJVF/YDVdEHFK/pUT",
+	"qfSrpeBLIhQl0DCXRFzQRP+ZEDkVdKkoZ6OXo98lEUhxJPOJfjwh6ElCZjhPldSP1YKgKU5TIp6OxqMZ",
+	"FxlWo5ejPKfJaDxSqyUZvRxJJSibj25u/BM++V8yVaOb8ehISjpnZpKNs8PQiJDoDI/sS3RyjJ6wPE31",
+	"vHJmvrnVrDLC1EclsCLzVX3cX/k1woiRa6Rg9ohKZCeavDxngucsuRB8QhkSXGFFJFILwfP5AnYNzwlT",
+	"aMl5Oj5nKcFSXfAlYWhJp5cyaHFNlflgRq6JVAgamRHl+JxN9ey4WAXfQWco5Tghie2Ez+CNnaj/RuQp",
+	"2T9no/GIsDwbvfw0CmY9Go+KaY3GI/fV6HNtC8ejn/P00hziG5oqIurb9ZGkZKqBxkwdpfSSwKT+lRM9",
+	"fSxwRhQRUk/2l9dn6JltORq3Q0PH8Y5HOFcLLvq2dsvs3T6XimcXM0rSxEwvSaheM04/lKZd+7K8P6+g",
+	"HwT9oCuc5gQQLNOIO4qA6CVZyfoumzNA+iWS+XSBsERHr9693nt+8MOLQ8RZuvInAJDFGXHQAR9hQZCE",
+	"oyJ6uVSRLD77DH85MS+fHxz4+WEh8Eq/5mKOGf031vPqu5VLQbmgCnDt74LMRi9Hf3tW0LZnlrA9M4v8",
+	"4FrfjEdSYZXLft99NG012uN54xZKpPB8ThKzSzhNPQ7pj9o2proT+oMLzFY9R1IWf4ODGTzktaH2/fY9",
+	"Rv8KdH6/JAKb+Q6iyr+Ra0cNYR2Y2Z+Iux4PEc+oCqn1aDwYP+ujugYwavDLL6TPIJwpwlR9gFc807cC",
+	"UuSLsgPYJ2H/Gf7ylrC5WoxevjjQ2JFR5h48jwxH5QVligiG0/qQfyyIWhBhrlo7GJXIfYCeAIl/hpOM",
+	"MkDwp8WKJpynBLMtIVcE4vHcAUOSXCj9SyBBMn5FzK9gExugvH0WEcA9059pKBfkXzkVJNH3HPT1uR/k",
+	"n9lx3R3pYdVuTLC3wS05HtkljsajYoW6hTnDkb4AUqJI/T4dj77s6cH2rrBgONPY9qlpYkduMg3vP7o5",
+	"Nrz/UEy9ocWrYkVNc0iSMzxvfn8Ky29t8spvSkODY7tXpSNqZBhnngnpByyWadH3VkjvBkIaACk8uqBJ",
+	"283C0XSB2ZwcIvIFT1W68tTef40wS5BZBspyqdBE38sqvAY6qVhxRf9oiZC7satXRgU7ik1oR5FTIpec",
+	"SRI5AExTkgSXFWWKzM0WCyK1+KBf+rX02+lT+DJ248l8OiUkiQ9507UI3WltCUQILqLXrcH7iylPSEQs",
+	"ODv7gEwLINmSsnlK9izrTViy5FSz9TxPE7TAVwQJonLBSIJmXCCM9NblgozGtXXYZcqQCQgovAeefpd+",
+	"iRz6T4sx4kcvKSNSvsIpYQkW9V1b8JQmeFU+XT+ZBCvSDqnf/+Mf9dNVNCP/5iyy2ydHvx0h/Rrp90hT",
+	"y7KA+vvZK30fki84W6a609e5nu+zd1xO+XVsLtdcXFI2v1jwXPQH0j/MV7/CRzdDkK08XmzXX2HGSNKM",
+	"bI3cypnmUoCtvMKC4klKCqng61dz6PuKqpTc3BwiQVhChGZEF4RpqBSUXHmwNK2jzJIgWJHkAqvaWe/p",
+	"o4l901Mi0OcZRcGIeFFe+vuggdVS6E1Ewu6iZqHkAgvLdx8iPJGEKVjskgip5TdNlWUfdpFfszb1yfWC",
+	"I7tJsan0GSFfJgP3+CYKSOYqL9QbEa5ec5Pu/lrnrnnRcdcMFbEr6BJ+PA5mG0Ucw1WckisqoyJMJ59v",
+	"G6AJmXFh9BUkoVE00M8HYoH9ZLJaU0x7BUBVJg+NPNEQKvGyIA80ubkZ16hF8MTcdaVHjh++uRmfs69f",
+	"jQJmXyOzaWYfkAzT1DwJ8dk2BA7o61c4YfvoPCpcNVKNoOXzaMNOKvJRU4hCBQdzkUYspxKFn6MnIIA9",
+	"tUKtoZmekAzG+ArQw4oKsfRzCywY7GiGgqq6qlkLJQuOVCLqqKgXBnpdi6a/N7q7YzKjjDo+OeRN6xSi",
+	"NKnaHJ1IHz4uwcWPrWDR0Jvd4yrQBKL7i/FtL6KT4z7EfomFoWr13j7Aq0KroXXefGnUjU/XBavqGvqA",
+	"VxMvEpv0K3v3BbNeTy1vJ+GoepO1INT3xsm6adLzOPpeEptQ/ADjOno5w6kk4yqva1t6LdAsBZG6KglU",
+	"L0w/u2Jjmk/4HZ4K3ry1Uz2X/lwx9HY0rSP9iy4eoUIBeiP37tD8TK99XZR0G918UCFdaTyvhGeYsg6i",
+	"ZBqV6UhP4lnqZz0C2k3seJmC3prg9d3TdUgcH0rtb3pN5gzPW/i6lEfsbcdULlO8QvBaX99/Oz395Zef",
+	"f0Z2QnrnlSJCt/1///bpYO+no703eG/2+es/bv4eg4B1MbKuATZSekr08HKMEjqnSo7Rd3vfAc/33cV3",
+	"h0gqrmUzylDKr4lAUyzJ0+otX1rDp6O9/wfv/ftg76fPxZ8Xe5//4+/92Su9k83gcUqmudAdnJFsmcKD",
+	"tQzWxiQrkFHZA1vlpEPLbWkKZPtIkOK3tou8CmwierwQTPt03nr2mybHd2knnC5IkqedZgR/0h/dB6CF",
+	"Uml1US8qFO77nqBm+ipZDqo7Ecy2GSSdCjNtvwdKqsEyaMC3HvT6AEMfO0x5Xs0mmFBr2b3KW7uHDLg5",
+	"xm185BG8Qk+EmRIRT/vykr2wdPBUa1JdT5nsn+Bn0Clxmf1vlbdiuE2zJZ6qfqByYtrelUQVUAycpu9n",
+	"o5efhtGOz+Mqc2JfIUkUmqzApgmEnTKpCE48qWUEJURQ0KQKniGzK3DT5WJO2HR1eM7onMF9p3lIc5hE",
+	"SFB3fNmb8z29oD15SZd7Diz2wIxAxOilEjkJqVP06By9GUS6xiM7wX5H+LttXDcumLHL4FMnd33EEzPS",
+	"Pym5buOH8ozFdBvmhbYKJIY5OkQlXzY9z7G13YyRg5lx4ccAZ1ZoYnvqQIo5mxlUvGde1IWffubLot/C",
+	"fLk5MaisQOdIerHoELnTLaSksDukta0TmpprrRM3Be++j7Ua/ZSba1hyoazKtN/ufORC/bzyn3KREDHs",
+	"6/fwyc14FKysdwf/LL4ZKI7AqpvAHJSnJXbJPBliRVliKa+5gNMPKME/ejIxbkDfTddS2iWqdSSlqHqx",
+	"1v8liXhf/Q/x7DC424GK0zpIhtfpGJH9+b4mAkRZF44WIQTv/fvzJyt7REWP8SjFE5L2Q9KlV7dU+I9U",
+	"S0XeT9C41BCWW83tbRz3inNucl+wm+a1eeCeAJZsbCYU9T/qwzUGxxllGfU5uv0bN/vwVLupr2S1JNZ7",
+	"q+Z1+dL4dlGJMDKbNkYszyZEK3f+++P73+yvMdL3AMLozz///HPv3bu942PX/pzBUQQefKZ/e5zmGpHQ",
+	"Ifx3clzywdXjj8YjM8xo7Izm8HoMntpRF9w6W9fojKpZhj7+p5dkRRLN3Jinl2Q11q8o3IAIzzFlUsEC",
+	"zQk2+BnH3FdfC8FbKEJGpMTzGNWKkYHXcoqtBJSnkd7MBX6heEz8MS/DeYNfO5WGnxsDT2b9B7QdnOBM",
+	"u2gmfe42Yza8yCjLFYng8RsKRkUqUYbZCk2sewWyH4RmR4XF3DiZJzlBTw5AgWBt1lRoIsCmBFFoMREE",
+	"a9mxJDtQpv7xwwhYPpppSDuIeZg477S6p0VV+4yTRCu8GJqQBU5n7uzlSiqS9bLTRcXRPNWi2j76Y0EY",
+	"6FUVScbQs/ZWR5eELI1jzcmxp0S5AEUhNPAsidRaJn1Tjc8ZVwsirqkk1mNfoyaVaE4YERqWDxEOvjZt",
+	"To7txgKcAxR4Y5hCOBUEJytEDOiRxPjR9/Zo6KT9AlNJLkLBpbJR+n0Ita6pxle9vpRckTROigGUuojx",
+	"x7dHZ6Zhkw7DvI1R4F+I6jYVreM3UhN0b2eF7LA6rOu2QuUFnip6ReKOWkO8WoYZC+/Jj+QXovqpytc5",
+	"4sJmcd/bv6Wt7PKlHBzv4r17LmQQvdSGIJF4p7sPnEm5HIr9m6AYt1WN1UZY0PkipfOFil3wAs/1tkoT",
+	"yEPZ3PCDeZruAZ8pCRbThQmCsvE5Ul/zzL1x3rKDlA0f4dtf3cRipK4vLq2lw4sKXL/mGWZ7+trUPkaa",
+	"m4yFKFmeBnwQa5qFBZaIcfh0KciMfolNOSNiThJtWOdRzYZTiWm2y96d11gi8xnSn/Xy+btXw4X78oJf",
+	"ESFokhDWHCniVZGSGO7cfb2GbjLKQAir1+9/A5ftATF4FETy9GogckusqJwZY3lPzAi/0D2kuOeHb4+g",
+	"/TKl6kJv1hDYgq9gi/uA1noBN84r3QldAz73IR5BUNyAADeakQu5tFJD95hnNCMfoXmott7A/bquvtoH",
+	"zA2FZxtVXd+Uhpu+XQu2zu02QAu4g7zrUNXvZliut1Q66YQS2XIevk1vsIjJPb2AQ8/pN67ojE4NZW2e",
+	"lp9IrxmFncaQd4nnlPUKePrgW4ZLy5m+0C+mPI8pDX4zijM+Q6YdYuEa3TUEKq2IpiISxxOKoWb9lSl8",
+	"btjcUF5p2dwQigcde1Qe2vh+N4GOVc02r+u2x+xi8QfsSEWs6Y0GZwLLxabAHzrT6u77OwtNsO7wJDSy",
+	"DDqH0qXT7xT4nLJNGJ5C+1K7RamXKcnOq2lnFb8krHso0yzWP3irbtzptRb9so5OZDN6qQ2GU4F36zaD",
+	"qHq6126KaQgPtU6TGoP33+FLIoMsAWBATok2MTjHYpau1jaYBbMyBrPxyFjgWhMX1H0cwJgQOEolZElY",
+	"orUV9rgNDiDns9WOYE12uepsa5MMTAvhpl0vuCQwc5ThlWYXatF8PnLJrQ3pkHNZsergc6ajLWcpv65v",
+	"gYTQ7FBa1mKwtQ6Z/0r9PcEMkWypVtZ87JJYSETV05JBr4j4j2UMMF9FTXrviJgTf7c30GPJczElFz0D",
+	"z0HLAUqO0Krknf/WC/gbEu5an24MVEpsa4wi83ZSIT3ITnEuLckImc9+gR9wbq2RJI5/tSokpl+DFqnX",
+	"AHcXOQssMY5F+9GM1HYDZq8/QU+8/o2mxHLtJTNi6+SGhKD3o3AhIER9AoquxwVcjEsR7cE2d8FaNdeH",
+	"PdGeOTrCnt65L2/Gpduzd+xtzGlcpwtDTwzVyQhmEpErIlbISOzlZF/hnf50EGo3xutqE+kAq1s92jjS",
+	"OWGabifN2swiw1rFLx7nimdYUZ1/Ln6F3sb8EWMCwnN02tl3WAn6pX6WU5Km/feq3NsrkqaxvaLywoeI",
+	"taUJKunMc2nvQftpcb9lZurRCLLWtWtdaH3BQU6I9nQelRwSGgi8A0f/Las4fdz9dpm7UkYhbclTOh2i",
+	"Kfr49uiD/mbVTyQr7T5RirK5rB8B1m5hF8t8ktLpRSCy1+ercecCLHAXeKaIKLJdVJMc5kKzYk47Xyi1",
+	"qZI24k2QZbpyrirGAQV6rmDoITpACZUmC4V+swetonlO7N47LmF9uwnIlRclrVN8QzL85WJGU3Ih6b9J",
+	"jI//op1mEFYKTxdw8+uGiDI0WSki+6iv/KV0SVYX1nxVj3mD50XkdT2Vnx4zsJeZy8CYyyyV1BydsZLJ",
+	"TmY9CjLNBxDf0er2NcJXbAs+d4D7GZ7XIX3toLfBonV7GNsY5Yz+KzeOQ5TFgqt6CJfhcv+wAkp9zZsh",
+	"Z04AargwtXxChqW90b0Fed+q1iGBmaTDSLvr9cx/249KRjRmtV1cYHnByJf2PdRshiAo0xRtieckTvRT",
+	"mtGYp5xeIloSAZ9GCcHS+jZWXTCNH5t+i7z7Z/1rxVVM13CmH9vvNAFx+vE+6bAi/Ecd/tZyBVifdm8k",
+	"+MROuugtmFGM8tQj/2r7QL4sBZEynptCcIaKBia9ofFPnBoPRutOfj46QD+h/0D/gd69/23vzenJ+Ug3",
+	"Ph+9OX39f//nu/e/nf369s/Dn/88PvrzP/eevzk9H/VJcSEVFioq9v3GS0y006R5B1OwV2fkEM3qU5b2",
+	"Vsep5Oj47OPZ0enZWEuI0wU6+e3s9ek/j96eM7C/SONHoDUn5r3Ml8uUEolyJpdkSmcUmIjMBNMkeLWP",
+	"jqv5pPXc9ObpZhW3yg7ps2eaMOexrM9XL4xoDQ7sB2XlmKDfz16tK7nWIKktCjKAqV5Q2aA/09CnDwrN",
+	"6BXZM17bATQ+MX7FY6RvY+3ADhEQGWdqMXb/2YfXhFw+BbWgVfVaFuSc/VeCqebmhLC7hxk6ffMK/fjj",
+	"Dz9WIcf402qQRsdHJ2//HKM/Xr/+H/2/hXA9wp+vj07f/lnWmAm4OmGIqGasFoo9MCrUwlwpRW0kEHuN",
+	"kM47Ufq4byarJs1AVT2u7L4cGqzVj2zSk/VX2hojelz8ah5iI04J1SQRRr9n12uiK4pfRhfokV07kBEG",
+	"idEFQZTZXqO3O5bqQuQsSk4dHlpaZhesP6msujfxguGG6c8azTmavxk4df2JnXLJjOO2qNjS3it6iKH9",
+	"VV2pSskAaM6XU57pXI4ij0Vt/ab3WPrt143GKF/qe2ZmoDCeMbP5wqvw2psxdRWqiTpNNfUB1IJo4W56",
+	"GdOL50w5VQky7c326fahA2IunTesD3vxKqQYPvYLndf9gTJmhbDlPBT3Wm2jM9WhNq63XuH1Myo0LbAy",
+	"RRjO0xxd8zzGuUez3JjZ6ph/H4+CroPol6cbjStZH8NAB5QDNq+7A/FAkobtjY74OQ6wZz6kpULs3h45",
+	"G5fx8UtsrH6hcQRGJeA9yrMpzSLKivjRP3q3zTLSuGisuOJJb31KCqxtsNb4RUiUEeUB2phprLG2v50m",
+	"yUl0uOOcmLDGcv8B/kq0NBduNDQtFnfWOhFBMkyZJpmSTDmLWT5OXZOCTsDFBS7y8M0YMTLHcEt5t/Fi",
+	"Tn77+6no4LvmiL0z2y+rBev19GCrQ2/gkfyL4Pny51Up2/zcmI6DYMqSgqmfZSoc5OfVke2z/DRI9F5+",
+	"8b40XmXKp2TJRcSWNddL6RGnH1v+zdh8Htl/aCKLKL+MS4UEBoU4AtTtGykRDvxxykXUhKDd7XGartFX",
+	"hdT57Sj69Iv83AEVpsf6Zaz7mZMLs/ryjc/zSRognFUmNdxARybOtSiVIUo6xBI/GBaP4bkq6npoGUqE",
+	"Ib89TNSGxMo2v1GZT8wt6A65HxqbwABKkra+HdjwGfoBxIYfe3buzqPCg3w8OkPwzsSrmowZeg1uMn5E",
+	"ytCSiKnBws5Tq4BSsW/hOsdVeHDzjELXMqWqI6tS4f0gG90fjEsJvwo8Sgpz7ZruJM8PbpUbcrM8z0Zy",
+	"fplOxqUdjZ4KwLoNzMjFFVl1HU59ZvFQYkcgMmPGGr38sYtT9c6UFT9AZu5yJGF+yDQb93K59BOJrd2s",
+	"+sib1zYTHgEmqUYxucXe90azPWtY+HqKuZkOmXFKxFt60eRLU+pt/TTiZu9f8Sy+8QPDQItsud2QeYcO",
+	"UK01lWrJDJ4wXnLO9Dm44sWUrFuQjDuglR3BSoWbsET/BRZUfVn+Vy4JsGiHSLOoVtGQAZNvDKzlGmyD",
+	"HXeETXwvo3bmK8pzia6IkGEwhp3pGPE0IVIN46mqCfej0WLDgDvUaERko1DzRhJaSEa0tByzp9pZClr1",
+	"F1Ga8aXwP6mjjM8KMQiytSDad2OgbSNlWyfDQ9Myr+JEodMFE2U4saIjFDtCTwI20mQJsT6a8umWnSVb",
+	"aQVsgBYk677b4CCcZJS1uHEzcn3hfbJrI/M0aXm7eXfK4Ey9Oart4NvzJ5n5oQWVSssN5MowtD6RkeZ7",
+	"LszpJ+UEgMFTH9oceRREO7tQ9KCZNekGT1wMrH/gnAV1axb88MrEoqnjziCvTfDb0IvggSnbBr17dsV/",
+	"FTwq2nlyMHLR6aNSmPrIxhWPwvjiURFn7Xv3D0xFOf3I1Xp0TdzvooXCc/9W/128AQNhuH3A1F14mWtk",
+	"qiGlvOjA/baEOXjilhvTkRmI+tXASbNvBgDQ0CBcQ6DuJbyr5NsQLQh8TROSWi204JNU07jZzBS+XRDP",
+	"VgRIYlxxMpJQSO+lk0n01OmEs3kLvYRP3rkew4e/Qu9+JR8aswudVXIKuWRC5VkzTZJSN2tNoik4+7XA",
+	"gE8BsJl0QCW7aDeF3JE0yA2AVWrXTHQ1u0PZpeYlJSHMeFwUgSnewWSiNbbyfGStHkULZN7AE1Fa9znT",
+	"V9q5zYQT+5SaX0ubmn8W7SQAlCRfplRT2ws+G42Ln4nRhZmZuD/cU9O7BqgFTT3dI/JC8RbQinolr+NZ",
+	"XOPvbmIpWktWgr6cVuEnZ9To7Z582npR1bsXGvmSKy5nVQLT4KQct58dga2sUr/AtA9sAYQ1ekWDPV1P",
+	"sBcvZ2fSyL8GJpehO9uMXh8ryUIaNSoxBcpQVn6wyqWq5HNKPNEWuhLP9tOiymsvYmPtLCa8Uk0XJveh",
+	"Lzfjchn1M5mSNAntGPEs0G7Xe1oxYKEmsaftLnh0XOo5ePHKDXIzHklGl8uYpfDXs3dv9zS1WJLEL7Wc",
+	"v7MwOrhcTjpUWaJrgZdLI+uf5wcH308zLC7hL1+7u10/5pK2usm1HLW3MUbvaxv7eElW++jnnKZqjzL7",
+	"kBgUZeR6jCi7WAo+F0TKMdAPyHLqffz1HWA8+A9LBEFC+GVCpqnuqUgtWoxgtB1UhF7Gt0uWG01TU1t+",
+	"Za1ILbDSpIoyItGCXyOMKoGfkLXyCtR6DkIZ0dMN9ga4aerw0O7OyGUua7uJgI9uju9qcuLyoU2FGbPI",
+	"IRRMoG/k4taI2XjkKiMMG6nIfOQOxZq2QThxUklPYgGHYCDng+8lfPqx6HGgxvuwKNoLKdsUD0/LOtoV",
+	"N7X9VF/ifiLtFMEHCpf2sZkuFDmV4m7hspKaV2MDSvlcHqKUXppZ+mf6VyZJekXkuFhom9qj4vBA01SH",
+	"+TRb0X+2LYwlP+XzOexkOEPKhhjWxyPXOL7+jQ1UOafiy9qimw/r98KxvS5BSl5h5RAjJPExWLm93jYl",
+	"Ptq5hPKjfVQRIO3TigSpE9y3lmBYu0LCJtJmrF9H4f4TalxRcl3Jp3EHWTP0KH+1ogzrJIrbQCGHBuQO",
+	"oDi4xswuW541lgUicJxw/hS+JErV5ccm6TPJC0uYUdqMfmSgmC+U0il+Og67ePLRzbt49KFYQfEwcCQq",
+	"Hh4VqwoeuvUVj96XVxrMx6w5mM7bo/KwZh+OVOnp72ZDjlSFar1yGcuqpCtnZThqvnI0Mq1Vw9p9OB41",
+	"Jy2rUaUmnt8QOVPOQGLNv+vuD10NXyjMAEILwSDdWUbgl9dn6Jnz5oH0s1qpgzOiiJC1K91xpheKX2Sk",
+	"OcNIULSuSI0hcublSkuAIhHJQ7Mc321y4mrW4HghhebsmMVB3q8b/L8ivgzRZMPtxaCe31FaUkpa0tPY",
+	"WA0nn6WczcHBKCikUXTjyrKkKeJLUki5AxMl1/OfVu3Vw/Oh4rm8wGw17KvAPNW8QXWnuyguORvQ2nV1",
+	"avd0cJU1XTfxe83cef2vIjNelZSbpwUpD5+WbiXzqHIrmYfmPvtcW917x3q4BWI5tXqqgfOGno7g69Kj",
+	"Y+iqNPA/S/xHrbLcFVaGVBqlDaj5JyurWwGWDySvQ8ctckakdWwvaXBNfgZe8m9fmu7rTAW067/iYgkf",
+	"fI/l55GrvHgJnGOxKS6Vbl1nkiQGvnrzduaLniahXN4eT3S4+ls+H67v0dw5JE628iisp3PKTtJstx0Y",
+	"H3mJdOviPm61D2w6MtFLIFbu1hMgTPXzDk5sUGdlhVpHkRhgK03wFt4fzZoDvYXmfEpKgjonyLjaVBLr",
+	"WHorD0e+GFRd7zAqnUVn0iu9tibXffA+qE43tnN9ffyLwdb18A/VN4M80ipD38LHP9YT77FNzYEA1ssD",
+	"/D96BQVUp1APNVpP8zZU16aRmLb77TtlInJtB7jPbjA6YVBAQjshWGuvKodfbMZgxWEVgzYeIqSHKEKD",
+	"zK9AkjcPaqFARYLlGjBah6CBiW7NN63XiW0E1EETgT5nO1Ch11xEN4xyAU14ieFypbFupw8EWd6qpwcG",
+	"1Sy1U1zUXfYPZ1PSWwa2iBwKnlTT6A0JPe6ZfLszcaPdtgBigpVE8aHUdcnMbP13bokMvn/Xn38S4oR7",
+	"VkMLI6q8giy1zqesJZhjgMt8z0DfeKStGym2pW7KrnxB02SrmpGqH9UyxVMSurtZ67mBikPk0+amVCpk",
+	"nBElov19zJuKuRUhRD8edAYNNYSPt5U4H5aXwndpGiAvHDfX1GjowJ5d9cADbc2Lrrpw1ZQZAjw+inLz",
+	"3ZTlphlinM9/N3RX0yFce98S1yhSNrJVK1UB8274hhzUjXO9Zar33jmRO2LYNo32blnN2xLPUNu8T2Gi",
+	"2vVyRh/0yTNbpS1mZYbVC0sQu0Svh4GCMGACdX+QNlx/hH0m3d4UJ5rItqOO8i1zz5adAeyLfudXzuXW",
+	"jJcbSFBb7MFPIbz/1JED3AzdbzWN8y/qRLZwUrbRMNJd6mFd8v0+whZunISXeM/bkfFKft9msNl6mt/2",
+	"e/52mXhbkboCxX6kfoDs0vY2E9WHmb3X+4T9n//rHwdd5aX/Eol9OyKn10zyO0Yv9p4foJQoRYRxskro",
+	"XB8UZHUEd1NTndo0GSOpuDAaiXy5JFpOlGQf/VbkSz9n4PIJShlX4hEjqQGQTb1NzczyEJEvVMIw9muo",
+	"tW1tDnqC++g18O1S8aVEVMpcN4Y356xK3dakPGd43sJIrpnzN3A5/dung72fjvbe4L3Z56//uPl7jzR3",
+	"HaxZjQPVk+xHEVya28YVbyAdb0HQvu9iSe8wU2/dtdEo0oMRm7esluuxmYK2pXw0JhcujOtiLKVbubxA",
+	"vzKw/bKSRUoyrJFwsTvRRVeeRGkS0so8c7VxfJrInNk4wFLusVaGpzPX2EPMAHibRB9O+2c9pwIzeHUn",
+	"gtk2A35HghacJBfOO6GWnRwcJ5a5qji7PslyqdCEWOf4xFqV7evvZHPVkI4CQI2yXCte9GFZezvjKJGT",
+	"cXWkUAMFgdawMQbUDxFGLE9T88KronzAx/45M1XANf6a0X2KGy96JpxAtga7o0BDEsGXS4dJJUHVDGwv",
+	"zNqxt+qorBK6TUN1sLEC3iEW4jR9Pxu9/DQMHz+PY/RXWr8FG9hNKhW3uopRA77pU+oAfNOoGhl5C3AW",
+	"RFbY1ZbF6eOvLMyt17B1sMpKi4YlIzwvCbABGW5MWloYK0bjYfTsFknXW2mY4UdaZEo5xYldi61wMMOp",
+	"JOMmG0YRjWqElbFJcww/EMRDLglDED5aT9haK3gwzKctHqrRRca190sba3trh/lAdH0Ri1dd1xW+922/",
+	"PR/w2DXcfB6mIGpXddOI7dG8G9cLn4Y49uOPnY6ULVwajLOuygk+rqua+kyp0z4JfW9Q3eSCBxoPYlgE",
+	"QgUCKq5s4eA6WVaRZqxxeK0yaEgLVtgq/OonlGGx6hHn2TQtt5D4vuvluExSJnNOmCu/cOlyjgAg4UTj",
+	"E62rWqdiv7/HGnqSVOs34DQl4tDKWRAfp1VNXC1sPq2BHm4dF8JtPN424GQ22HvM68ue//DDQZcyyTmW",
+	"ddmFK4BW8RKLgVxFVdBU/X61rh/1JVkNdRWPUzWn32E4BmwTF/mrXz+N6NjbN0rPshBWWjcqUHHEVbck",
+	"udCIGjNccdCamkYgmBGhAcuAre+3XNkRsxUg/tO+ZqowHqt2/VuXviHHofiwL6rEznu3Ne0qZfNfneK6",
+	"cgszoEDkC86WmgyMnv+flwcHZVXekyefDp5/1vq8z//fi08He99/fvry08Hej+7RDy8PDp7+fdRUJafc",
+	"/8FP9f7buo/2q2umJDgiHRxjrwLSbdCTA02nPuYswaunIUn4R7v+vLLDbjy3ojHsW3239ZK1zoOqlVZ5",
+	"ZNZXkGBBhI7AKn69cdTuv/84szqKDEgmvC3WvFBqObrRHVM2AzCxwsjoI9U7+pGIKzolx0ReoqMPJ6Px",
+	"yKYZ1Fu9f7D/HHiOJWF4SUcvR9/vP99/bnZ/AXN7Zuo775VSBEcTvJ9CeLKVpVzJ8Up1aFnkqYFrT6ut",
+	"ITAJu9oElfbnLIjCLKdAGCPJhTKKE01yjCSvYRfenyTag5WosucPxEQUwVUvP1VX8V5fkpRN0zwhzqc/",
+	"vggqK96PpTrrRoOZXuOVdN0lI31Io5cjF/VjqGyDRirDfTzhP1eSN784OKh4ekDtBWOXefa/0pDMov+e",
+	"VvdwCyNq5arCZPSWSsjYUd05/e2PA6fYapIUgotiYvWJ+PSikghIOak/MHiYZ5nmFO1kazN18UafRlB1",
+	"SYIz2ZLLWFz/wrOiviy6L4PuK0Z+/WrE331A0JubMfr61YTO7WtAuLnRGuGvX0NosC/2z9kf1oOiAisx",
+	"nDHJDQwoHp4z4PpsjgCjZa/Dc4hjlcCVGFZ94DKCVhalf+bJamPna6KP4s57N2UirERObmrY8HxzU6kg",
+	"QR3WXlXOwdo0NMz/cL8wf4VTWiNbZh7fx/Jq1CCkgfDtJPoaGEG4OtsYBt+M6xfas680uSm8pPVfZXA/",
+	"hucVgD857rpJquBwcuzov75cC/JPk1EVjm93A/wwetk1F5chsg9ImLZtIPHDwQ/3BxLVpTCuAw5yluwk",
+	"cBrY6QecY8dXdfEwOwx7B1ukt4IoQckVSR5hshUmfyGqL0Auc9XlWF7u5rCF9Ydqp9ayXQ6hHdeiZ4H0",
+	"1BmPfLdRYfMMUFv0Qi8GaJsI6TITPygGCAD08bZbi7IYaB3CioWpKJq0CkDUDbVZUCKwmC60JyRSghAk",
+	"lcinKjflfYL+opqA4G0rzTAmN02I6naWTUvw4+bBl7XoEPQEvCSgMjTnKljw04apFZ7LG5pU1SQWG7Qw",
+	"q4WDVpX+9VFOrN7FWIuLxTmLSwBP0WHN5xfwuSCsNLq3XhjKXpvMXfIyWsNQQF87vvs1l8F7a0S0lpBo",
+	"V1maaXnrAppTvGlR4ngxsuRT5GPfl4Jf0YQkKCEK01Q2KEUCCnOX+pByZOB9a0Iqk+iE5pXWgU2JlLM8",
+	"TVdb14tQpr3xEqzwvV/n7yspYaoUvnS9/3Dw030yGmWIp9KY+XAqCE5WxiXdE+LwwtttpVCIzU0kocyK",
+	"RPRBlUAF75tYJxNySaZQLUpf1i4XrcBysY8gRJmUrjUtES2gqIqNCEjTcwbWeURYsuSUKYlypmgKiabA",
+	"ii8IBBtIU6DKhN+toCcTRR5T2DoNlhu4j9QU+oVuTWsVIx8l3dVWpIpia+5fHNgdQrHA2rYxCTBpxxVw",
+	"7URg3CV/TIvIdOAAgrLpIYfuGQVLKi3atEgju4iNmzvFYqn9WAW3uyWcL1R749GC4IQYV4XXZ3geIRu5",
+	"gFvVGrorCQt1qjbCEkQVmmCog49OZnvvsJouNME2OovSBzEhxm/dzSMZ2oo64g2m1rVsXvD+qwZNp53t",
+	"xCJQk1wQU3YalUbTdd8tFeQ7heg1iVtjUFgZUDvtYQma2EN4bvFhBjgJ6/7h+Qtf0jG8C5xqV1I29RK6",
+	"QdZi/g7VWnHqjnWpawhP2yOHJTJYlHp7JIIVUQ6yM1ExzVMskCAzIiCwNiGKTNVWTEIPRKSDaT1/cX/T",
+	"OitRUixRxhMjMgHlMLmpLdTO6RVhIXTuutp7sKD5LEgv0MGA6gTLtrXNyQz8J68InsEkWpnOMx+Ms1tX",
+	"UqFqVmEppQadr3/Z77yrfrKdgweRo7Hhg9dDJlAE5zUrwd1Rg2agLGi1q7+rbSM6cOvB362R/4DnLm0B",
+	"WB2CSp5N9oY5iY/5vKvqWnMKS/BSREsikO0+NnJKM6riQ784CDx7Xb6X5pnctSnAYl4b2XEOlA4IaDl6",
+	"dReUH/rGjRkHvjVJpK99IjjKfjdFyucmq1LcYPFPfSAgm4AH9VSQhDBFcWoylgjrjm1zKPz3H2emYFbU",
+	"cPEWhrobthv63hK3bcduPjztdK93zfQecNtbwzB7BGiJVzpAzszj+RYwvYCnXUIpGz0xevnpc4hgwTmS",
+	"IqJAkCnRxuoQ+B26addri2jWJ2FQYIP5piucwbZqjmE4Z72CGN6ZGa4RuxDO89uJWID9GhKoYEFgZ8MT",
+	"MgcAvYMSjsw3iErj0p9A4qnUrtfmoXyJcJIg7JJ/jr37EzDIYyTDyuMaqE1Ono6ABD9wexhCBDl6Bh94",
+	"dLg7G3spO+k9G9gt9NbBBV5s3X6eOeQaGE2wwzjmzcVmbQ3uauZHz3gBA6PdKl9zpts0s5oZrB8SUJzr",
+	"vbL+ZtoPxP2/Ea6anf53FIAO7ovMbc+d/yFAljFttYBVt+s+ND6ssbQbcdPfHeC9KzvScAbh3jBn2373",
+	"AxiEkrf940XSbWJoZVBqSXij8uxb68m3h5dLVPrEWwmt8VDLsmOXaI9IZQo+7Z+zo9J3kPzLJvCERHuS",
+	"Z4QzgjLCTL++ACkov6xq3QodDSLvb6W19JF8cyYITtATjX1PoTgRA9cR/QwU3k/Lq20Qbk03Az3XH/Xk",
+	"d6AnL4FAGwb91gXEj97znRoGfs1q6OGITPl5hNYYUybgTaO++h0Wl7JUQTY4oO9khRJhCZh7iDIM6WkQ",
+	"VSYBI+TCdqlBBdStwQlknNqP6gpKsHFyfGpwu0JNHhbjHC6pCx1g/0ji9vPeL9fSXHb7jtUAinD5ZnPb",
+	"1o4LJZ1uH0u+18OVvrQKYmiPU1tOXMO+1ryVbpTabfm+NIP1wttMWq8lFlrpjzKspoumqDL4r80jaNxz",
+	"TF8VJDaKf7nWOHcZqFaL0esXJ7ihYLxHZuMOmI0SBvUxzZeR/pHB6GQw6qSuBLiOwJba9Q3YK+HfsKC9",
+	"Kum8O5tCrJjSVmL3yhPpGa620zF8P20phq/iWsmFvdEiTpa7HSNXKYjRhIw1bmdAtFwcR3tEzJUpRxE0",
+	"d85c1By6s6C5EnXo1me+j/MB92/UaUbdbcfPVVmlrcbgbs87uzQNEz9B0/Lu7HZEHetLMzoj68oSQS26",
+	"rgIwvSPsHgTqbjSyZK17/c4C7sq+Rj3jTSowtYMxJ7tLv7YYgFc6toilskwsapF4EZ6/KxhvXY4/30W6",
+	"sOG4PF6/Wx5EbN7aAtL2qeiG4/T+orRzV1Kv7AjbN0B6vPcQvTJ9/SuF6bHbCbvPcFF8vEXuDYq/xpAa",
+	"SaUzhTNf5LKQWKG8i3luBNZ+omlQE/0vzunGS+Q3hHeYwsmoODRXPPlRAn4ANrljc1YIxw4SymZvQAyF",
+	"6I4Fv0asA2crVWkNqsoesucjbkZwszjIKWczOs9FhJWqeIE+YuvOhzkuGrC1fMh8Ngh1o+Lga1YUwa8N",
+	"x2cldPYSouFVpBJYkflq/5wZf0RjLDYIDXhOUjqnk1SXpmXIVIOCt2jJeWoLQ5ErIsrfxshGNIolfwAk",
+	"4j7EvGLZWxL4Nk2wtu1824Rxj3SrxRJmN2ojfEaD1ODC6PYyrAT90iY6nAblr03RRGQ+QpLXmRIoJq+f",
+	"Ws+KImDPfuTqFveQIVxeindmjt8Qr1JZeQSQPlS2VRCprzvu9v2RQdl9RD81ZxZW+7anyWebFSUq6WMQ",
+	"lV0l1cfWLu3x2M6MzhDjDFTAjqCTpIbOdZnjEZf74/KjyPHQRY5b4nN3tFzpTCqjHVpJYMqziXVo0xOo",
+	"4zjKcqnQxEkhnE11xgebDwg82228nR/AqAXNQ9sfF747Y9eR/SSMHSUI9yFllJe+A5LGLUnUtoWMCvw/",
+	"Eqg+ssWtyVSDZCGJUpTNeySwMTyJK9w9RjnU4EfgpC190hotA+1NUy4Jmgs8haQ3lCcx1UYPNuSjm903",
+	"xID4NUdgxr175DkePM/hEG8jzMYrc5WboNVS70tBJGGqKIJkbq/uC3/nUO8+rnq36B245IeTgW3f6/4q",
+	"+Ua9Mwwnji7JSmPdTEvfkF4RMmFgW5pl54vf/F64BK1HopoYjRT3VVt+fHuEljylU0rkQK2lwmJOlByi",
+	"tvyY4m+KvXh7FCUpb48qholHFeVDVlHWz3OTSsoaRpZQVssCk1xSRqREU5wSlmBR01DWcNbpKiGH0BBl",
+	"5SMGxzH4UUh46ELCrbF4oGqyG433zxk4J7p7drnUEgf3rhIulQ+eKSKusUhMP0GL6wWXgS6DiyIv+CCN",
+	"5C5h/b3IJm+PdkEsWYv4bFs0qU3vkfj0VTqWSMImBAGF+2gbFwTphkgtsEJTzLTdQ7v/c9bmZNmDWTjD",
+	"fw11Yq9U1uFCdARJj6TWOnYoITPKqH7Qrml8xJ/GhBWqso1D7+14Wu5EX6doJgjZ0/CFUjwhqT6iBcIS",
+	"nY+u6PJ8pK/Uc5/s/nxkcMh6J2pU6sYjbVfEcwgsMQ6NKb8mYm8KUWT6PnemyJzRf+UEHCQj1X2bUnDv",
+	"MkreR/6OMzzfUgqPGkFoIQBbvK8Vnu8QhblfLSKeP4T8H8cAIUU+Tk3vNuLqqJs/+6oR+qavvhDGFjyL",
+	"xElxRA37wDjSRc903i2i6xaQUCYJ3CgMrbT7P8VCrPwQ4GFBlfWqoApRiQRMI/HDZ72TgWii95vJhLZ7",
+	"EcSO+MdHs2+ax1sr6YihO0F6kS2W1tfH/UCSw5cZjfVNh+AvzFNu6s8EbYB10cOY2HF9+Bqj9PZMfLLv",
+	"HvL6Nwfw96EUGMpJHNw3J7FtyX/rnMSDoCY+Q3hvatJ0e2+6IOlQ6b5fXdKt0JhvvTbpY+bRnSoHWvVE",
+	"2AWb0E6UBX1odqLiWG8tfOWSiL7EG9pukHT/DmPvIuF+JFx3QLjguPuQLQNnO0y0HglUO4HyB3hr8nTN",
+	"xeUs5dcD41/dZ/18yXzrAT5kf7iJfUNuKH7NEdBw7x6dyB60E5kVFTxG3KkLWWWwmr+Yn8W6Ma2PSNqA",
+	"pI9+Yg/dT+yWiDrQS6w6Wj2BqlFeWB8yJTCT1FVI6FIT7xyW3oca1y16B3S5wynGtlW8Hg4DxeUjzejp",
+	"3nUryqHZdEGmudD4sqdItkyxIt3eXf4bN7z/FEkulImYAUOT4nMCcTOOxlCBGPmikMiZ3I9d+qeu7zM/",
+	"nT71H4sZgAdrMTcrelJZ3ZSY+F0paH+7Sk1hbjFXkHKJIaBIBWu7RWmqe/FBqx1IHy80J/w3Q8ruun61",
+	"zLlAJd+oxd1LZ9J13xqwhPJvDmU1RlAlkaIqJePQZDv22v1x4VxtzLqGl7Z5KMk5M7knFM1syNd0QZI8",
+	"hfKIRO4j5xaBTXqrBRe+sLPNWXe94N7pWwUT3j9npzmTKKNSQmlXmtohzPbprMCJrhupu57ifL5QKF+a",
+	"VWEkKZunjjo1uZFFUf3uPLhqw23JhyuCUHVoPW0Cwq1XX/IT4cKD226XOGpE6AZ8brgWO6sdeWRz7kcW",
+	"rSy++Y3TGHNJlmq/QTlVx4vuQgUBvLhhtlmBqBl8t+UiFNmgB+IhNBR8veKmB2+124B1sCs0d3t+8w8O",
+	"bKHiyxow263D8JwJOipqmjh+R+FLIhGZzchUGW9Oxq8PkSAyz/Q0cJX1PmcJJ1B42tJpLy/MrOJS1Ngf",
+	"qoDtMT01RLk9ABy7K43I7RisnUH2rbu8NTBYO0V37tenPuSeSuVIppzZSvLparc989ZjQpdc6BlhReUM",
+	"T824DcqZo/lckLlRwuSTjCrNeoZfIoFtTiEijPAXSJe8nNpkH52BNMkF2GlA7ltgQRCfnTPXD5+hH0As",
+	"/RFRpnudEqb20al9rZtjpQSd5Mq663up0xdSuF4QVlJkQdF1ydOrOIUFLgZ25WO4KR0E9phmhEG1GsXR",
+	"XPB86Tdj0uRBB80u4HUzvW2Dn3CCv+jOfl41qonaYyLvR1XldqSAHgz5JiEU3KjQFM2aPFf0nRufhEaA",
+	"PfvlujOZkBkXpHMSig+fwl2ynSEIGLCNBl+XkNQ2eywp3iLZg18IEUhGdi4kp/qBrBBTAIMeRFS3Qymf",
+	"z6HwnsfQHsTTBFIhAcX7+AyU80iDoA5Ues2UsKHYFYdOKHwMVBOYUp4z1UUDz2hG1qB9dlWwwrujf3py",
+	"Zp4t1O8NFVIVO+QIIOxdO6XpwQQnWMUwvjaJt7hxDmNE2TTNJb1qmo7im53MztwHE5qmUH/JGS8YZ3v+",
+	"ITFw3DAb1+xu7Rf9oC+e6y0DhJteao7skeZ209zojjXR2gFhJCituLeDBYFDS5yiGcQ96CEnK+uWELOO",
+	"jL1JZAwcaZfvcs84k2895KOYgr/rTo4bhnctbk18ikHdmTYP6lpscFAed0y5S3IbrBhsdC3rhfcbHPAa",
+	"q6m2z58coydTy1ZJlOGVs1dLac322tZ3cvy0YWK2n1vP7BXPMrwniUZLkBrxXB6aufgUVViIFej00tRe",
+	"kBmwTQLcE8CXkHxZpjwho5cznErScHGbtBURc3hlllVz93gk1SrVD/TyRn0XUZ+/QinBmvoxctuFXGC2",
+	"uqPFGCfsGSVpgq5wmhOpOclMH/gYkf35vvXTvoAm8hOWkqgLheef//Pt2d7zgx9ehOsw/FGUgIS9lNaC",
+	"k4Sa++CD0KRcUdK6ND75XzJV4doSQpbv3dPO4/KJYKXPzXL06t1rWEsFGM1t5c/PfLTGGV6SvseX4S8n",
+	"5iUEgQw8zTd5mu4p7YEjCRbTBeJXRFgFTNUNAW7SKc8ywpQ0uvTU+hJwkRDrSiBISq4wm5JzBu01dKMF",
+	"nS9SOl8ouY/+4CKRBl6sKZQKJPUSxuh89K+c6z1fLgSWRJ6P7OYZdhpmsHcNHZAv0zRPSJDzwbMtbo7w",
+	"rVkXCG3pyohitrAfSxBOMsqktiAUh1w/YzSjLAG6d85Cbw1ILqG/oEwqgq1wFjvOf5UOM8Nf3hI2Vwsd",
+	"xWMCd9zv54/xSw8l8PJRPujOmhVSxYJ115hXAlonNdj2LT5U3omjqOcZ8VdOiMI0lVEvo4LVvzvXIjPG",
+	"lvyJvDjTI7l5yfF3255ElC1zpVUu+N7NW6+aVHc77vtbx4YoNgUy+LNJnl7qyTWkpNMLgsoTBHnccbYa",
+	"LZp7fkjqvVIcOY9D/czc6mDUWhCL8OgJVijjUqEfDw7ct0+15hNPF+47Kr0Jn7KELAlLwIZX4LbEGRSf",
+	"yagE5eV0QaaXUl/T8Bb8C/dsb4QlS06ZkmPDgyiolGG22qoqfCJEvYoZpmkuoPsOF0VLPH7We3g3BER3",
+	"vQb5OLiTCTRD5wci3G47PtDpJt2mbS/gyM3Ax5xZSAToBHFFyytsFd7kO4fbR5CKGbMyGpam3YXqXQ6K",
+	"74qUa5WbtIj6Pzl22A/2iH10pv8LqAAWBC1okhBm/Gxwmp7b4hgeD22ONcwMw61BRnFhxs7sIa2gp6Wu",
+	"Zpc0Z12zCNjtQ2MabtXpMXbHltwdt4Abym/LfV+xdjceShq25st03KXD9qXVgAlFuXQXot98DfJabnxS",
+	"EzWfFkysNQNauG1SWQ/ABDvqWghxn1byIQys2+SGmNfxaEFwYpOgvNZ5zeoShfEXQldESJserzirQyQJ",
+	"SxBVaIKnl/pITmZ770B1obj1DAuaj9q27Oa+cf43QoEM4wDwGA9+a2D45sjAG0xT434099l2oj6rdpMm",
+	"qxLqREXVmK+q8fSKX6/dgmquduSmq2mANBbBIgzDrln3CeSR5sxklLRYMQO8hCX/8PyFjvIPdmKBC4Zf",
+	"Ujb1+iODrsXsHbqNtpaecavM+NqyvHNa/aYJ4A4oFmLk7ofnL+7ZV7bwpfSesoB1cHLu3E0Z6uCEdzy1",
+	"ZT91ByQaMmZhPb8lLKyu9oAGQTSm4iCtgHMqFyhn2LagkWKbuk9Prk1Xj0R7S0TbbP+DIdoGrDLCHul3",
+	"M/0O/D40Mm6fnHNRzOmRtG9M4wVbGpLbYUReKTxdgOG3h6NZRhTWIKTRRTuOBF87DCqUYH4azVL4UTD6",
+	"jqmm7iAJhFlCseYhOSAsyIbH9aiLak0GW9qrIdbS36GmP9QYoilBmkjkqaJLLPTCRQZE1MT2QANJ/w28",
+	"AZjti0j1kkUsw18udOMLaGxrCu+3WUt2DTmamA6/N890N3twwQwQFvVWFwvdkvG3jph14CveVnkOvYRv",
+	"0wAcl9O+v0e1lMY/8mVKSBJJ0Qa4Bli5o0KZhhyE6/Rq8NX97Gvx46TdfGWU9RIENf/NPjIBEyDTADwL",
+	"xEVhetKurKZDRBszbsQIV/HnzqnkAoRuGjLc1Lu3fDVRmG0l+wjY9mJmD8oS1YVSDUapj0oQnLlqQLA2",
+	"x92WOuzF1z5iwBoMNp8qovYknEMZhPw4E8qwWEVGaru53XCPqNSNSvya3e5+mmLGSLLnj/3ZV/envaI6",
+	"UyObHgovJJ/t7QoLqiPjJBImuYnnuq1vfUmdM4ZvTNTH+JyVeITC0wk4T3C0nMONeDQHsXbJpU0vq/29",
+	"sRYLrKf02AdYpSsb300SCl1Q1RBy6inEK1iaOyTvQbtzNOJV5Qiaxi2OdmfTF5W3PJ6vgxmP/ArgbZFe",
+	"VFFg17Om6w1EuDbtWWBBD6hHhqeCx4mHwbG+dVhcc593aJAa6pUbbLdw74SZYA1aC9F4Amz5M2tpYemq",
+	"KaKMmi4uXBfxqAIbUrOd1KhmU+0RrKES85Dy6B226xq5aYFn/dVxtt63dta239erLDXgeEmdthtIfqfl",
+	"te0St6pF84gciRfgWV26/YbjJx4E+h4lCcIh5g1zIrAfymdf7V991VNuyEA15SZh47vXV1A5UmD/3z2m",
+	"2y60aTy/lXevlIri7PY1Ug4UHpY6ys56XYfIQBdVYKQWYC8JWUrrI0muKM+lb0sZCL/6MRi1F1QqLlb9",
+	"sUqLtFGcCr0tHxHqzj0q17nbD7Z8t287CehO+Lw8DFL1WqN5L0JVu+ItSekhqYMGXZfkoMqUW0hpkfSh",
+	"ys1r0jVdCM54yud0ilOTL6FVkP/VTmW3qM9j6oE7oUb2sHtgoYVQHXW7E4qCnajw/NCUBwuP2z1oklEs",
+	"PvsK/59oY7kODG2O4IYqLSYo2ybCtFQJOtCkCIgPWCAoq9ZmsRfdGEmOXPhQmtqe5DmDJL56I6EgNBRu",
+	"3EfuxtR2BYkW+IrYrC4R4waG/JYVparsiLc+OX4HuwD/nhxDaOyOUUaYWuNo9vB21qDQy2XZLNGe/r2T",
+	"niPm4FkDD1doUkCii1C2wVx6Zt/frx9uUbTOVRHAqf6pp2XyADrXe6fWzyUR30kkeLpNo4whCltO5W45",
+	"NX+sLuG3LbMwSbnJMhmGnPMlYbsct293Nq5lajYWZUTMSTNxNxH7RX4rlkQdl3kupiTIJ+zwA4si5nEM",
+	"srYNTabCCs2mT0UzIhXOlpowfyz3pjd/mnKogmGKewkyI4KAz3k4zj46SlP/WZZLfbYpZ3PXDFJ6lLLM",
+	"d94CsDt/Ra03rMznoNr1sJVKvDUA7fZkYxjeuT3cPy01SMVFGe12nBsFeKtSCCoHKcQFSWH+3QVCU8ou",
+	"K54smgiYLCFFNpNmafjUD/WtxFa4FfcxI5+5RDxukx4FsPaMdMFWrWG/1ceRAEyjCVHXhDCkrnk1R3vs",
+	"dtPcDmU6KouY759obpB8wdnS8TkkuZiYNJXwUz5FVCKpoFAnZ9bBKw3yknZemruEO3edcM+sdKtW4wJx",
+	"Y15Z5t1u2Y1FQGq2H3Rxn9KHPw9XopN8oVLtakJNdlmJTw/vz4FX9rOvGj9vnn21xKTDln1KMp8rDAgX",
+	"hqBiWaZmUfrUZcH25OlstSSndja7ZnXzgAIto0PaN+vWRglJh96JxlmQ0E2rwYHVHul91HWNETQB0LJN",
+	"o7qD8513MtUbVWC1m3Y/ZJbLlKpmXcErX2E8yJVLWZw5Mbn74BVJyVSRItO35VtKSbXhfMMi/nHtgckt",
+	"EQzvVQWyrqjoZGQ+wnr/ikwMrOxhJg02QLgt1gVG357o/xDEHoCtAntB1B+UMNjQGlOWpjmDTujdYxpX",
+	"5H0gFkuo14CUwExS/SWCc4zX5Snn1fnoquk85tXZdjI0cxQPISWaAcTHzDqN9NNsUICPO5FfZ4v2qAIl",
+	"+ZIwq5OxjE3dQvWY82cz6dyq5dT63Ei5uCKrXsGXpbKg5jundGkvOAyZUTgztWKR4pd6RyWSC37Nzpkp",
+	"/BOanp3nqQYWeGvyXsMczLA0qGK7j46uMDXlE2035vtxpERNRxDmR7MbD1o73qM4nlllV81cCxqFH0BF",
+	"jWsS/yeE0e0JqZ7EGTcaVRi9V0TtrgNTAzaBH3drzuy4jPrRp4G3oP+dtNWejUD6XCPGjwYPWFEF0jmi",
+	"8hB1rZxqZ2SQdYqZJte5dPWfOJuSbkFzV5DpDiRNID4hNm3VDbsFoc0xemK5Pe24AcfC/fne/Yte4TQl",
+	"Ql8dmk4UuOKqW/h7iZop7yxJu1dOzgKQ0+yXAGn3hHSYHcJl4mpArx87ZAtudtvic0kEMCH65aTmPNfG",
+	"ZPzhxvhWjPB2wQNs8P4YHnVRHSb46wKYBvALDmwNz2BkHA3RvtTP1BDL64V9cUETTRq5Z7mrnDWEhnl0",
+	"QJitOCOH56zO1euGOElQURe3pBD4TpY16havXr2Culn75+yV/c4vPeYUF+2rk2PZIcy8g5TOSVJCx4Bj",
+	"uXtzvicBdYj/XRIRENJ751BgfKuTuDaKp4oT9FZsbxrrtqjBgV1xt/61q/hV2phdvP4d+THbNzg43ZGU",
+	"Z1/19x3W/N+lK+qds4LyqQXJJEmviDys6x+gtbDmSuY2VnQZ9x1d0iPunFHfTs7seNOgZjvv3pIOYBsc",
+	"xzat5/Zwd5yZ+J3JKtqYAnTDEIeLy5TPZS9FIqgBUz6fF854zpmepwmRCuJAkDH5UCE72Gk39DfDTnNx",
+	"+ZbP+7DTuqneaUSYEpQ88tPd/HR1x4bw1adkCkXVAcDlkjCoe1VUQCnp2RwPrQcz3h/8msGnh+FtAa9p",
+	"RrSiHE3IAqcz0BNatzXT80QHjkrpkxVe0KSb2d0dvNk8s2txZKsurB5PO/Byl1IeoSCszqnKsAkZvCIG",
+	"thoNApBAJAK5JtdfCK/bTLc63/3UC2/5PCQhnK15GT/7av/qnWKpRP3CnDBm2/zlTaW7v2e3yrvkaJD9",
+	"f/d42zKeNg3rt/nuGdzKjEqJl3bVSKd54fK0H0iWpvKsh2RpOjWB+tLWzSZpIo11bzMY1isH0yNy7Saf",
+	"cbA1PmN30i/dhs/YBZo2rlK0B8Ja2KxOPQgbcBUCy0WjSK/FJuluIBe3N0ZTrMicC0ok4qKkf5djOPOM",
+	"S4UEmRKmitSBRtDXzge2ML7JThSUxYdkLNcLIggiqSRGq+Zr3WvrAs+wolOcWgcFG0Wjd55yhpZEUJ4g",
+	"wpJGNyBYbgeR/B+qLbMzpErzVBzMEw1JlG4X1KIHOlEka4pnMVeIyyvAktIJzGpVcBrmGDa5ABL6mOBq",
+	"dxJcaVwDQGglTSFIbo3Ix/JY7ajKp7RfARHUz0MS6MP8aF9BKuxaQ2CGGZC7fXRUTjRiqVeYFsTGfSEs",
+	"0TVJU23yLBAag19IQFONO7zMJ7CKsR/ZOI+MA29bM1SM9lmpTH+piczJcW8iqNnVYKl3EdPXRf5OjvvP",
+	"hN6DhHYCR54XuTzukTmBsQsHIsqKfbl3CyLMRSqapuAH7iB0J8nBB31cFbTtSxCeCSIVF53phjAz1IDn",
+	"qgSw+6gVvQ1F8KkL0pVJ5wSeFVTfbBBb4bza7Px1N8xlBrFMNQyuT0LhS+OsTQXSeNGoKS7owald4iNZ",
+	"uB1ZcAf5TROGDx5kg7tKT0efBqIWQHc00hjOrx+lABfBPuVqQHTQ0ApfmAvdO2nPaKqIAMsOS8qMdE2A",
+	"AY+ELiR9Ax1qk5FxMdGb/mSJhaI4RZm2WTfVjoH/2gKwxh1jkQzTtOdg0PZWo0FOwHjn9lXPmD1JxKn+",
+	"oHXIktPayXHDwBuVsirrtXTeR//ExqfywjSL7WxR5+dRoLsPgQ7QtY0kvQ3pwqNA1ynQBfSzhWw6Wg2t",
+	"W6z5JvODjfIGDHOZGtBS8CuakMRQTz2CI1d1NsoR5btL2wQEajuW7nACHc6EO13kZ1uelTucKakG/hHU",
+	"8WxOb9WIV/p7XCrS/J8c1xDIfAYo1K2V+L3NA5Hel/dhc2GcLcC5dcr0DsVa/kvIkrCEsOk2HMJ+lzvv",
+	"EenNvw1AP+5i6GGnE6IgW0UubXJgfxb+8rBSmIXTODe/a1C/0QwUve4Nt5GV3Fhms2+TgEKfR+/0ExYW",
+	"djD5hAWrbw2T32CamrC7OTGcXyTQ2jo0T6x3QYTz6yqx1cD3WaCM+V/sANpuOMGOvzkeSHqdwfzw/RO1",
+	"DefS+YuQsi2mzPl9B8KtLJmh0irK6kLCvSfJMeTvL5QiB/cTZZ6BhrBfnjbdtF6zzA4TycVmL4hTo4N8",
+	"vCS2eEnwlOz4RQHA9XhbxG4Lg3dihxjgR/J8W/IcGm46KLRzVexRZdI510jJp9TkK6trn2DoJy6h2cS4",
+	"dEpJ5wzEjKctIvqZr72xO9S8MA5Vs8LFjCb+5aC0Q+ajPoMvBeWCqlXD8MHrIRP44D5rnYLP1r6gSyBu",
+	"hqLF5hE2jZuQRjhNR+MRYdpo9GlkclqMxiMLKRpudYvPj06SW3KSdMWf+lnVHG3YBQXt1guAPgDd7C9E",
+	"lc8tfk1cUXLdLxYdWsJfltXU2trcpgRBImcvg+BcaDy238gFFuXs7aFx/5xpq2BTS33DjZHkwl414IaF",
+	"jkzsJE4lR5IQKBlqv4WOGtzW/6nfje4v/lyP1yf43OGZOYydNRpLfFUUg7iye+mAyvxuyaSEjQeP/dxY",
+	"nc1J69NFkigNUdYvn6d5xuQ+ghMzwQuCXmm+Z7JClqIenrN42hCABA0/mekfs7LPiWZXALCa/PkKOLnb",
+	"+kF6nK0GXhsArYOEfr51y/OVxZ77zX3oc21Zd3ADTLuLmRqvCrSCPasjpaf0z6Y8Z6ofwU/plee6+MyO",
+	"II0vmqb9BE8XMGDbpTBGZH++D1ybpAmZYIEmOJlDBeZXMBfAbmCbqrkBA7sC9EZw0kbYTXf3TN5h0AH5",
+	"+sz+Axfp4Hv3/Bn0HMPycqa6dyds1T0bYi4KcFbdVh/dbKsuCjCBetT4/RyTD3bm14yUo5oTHzoTcDz3",
+	"zgHD7jwQ74Qa3xJlWyw9jNOWXYPXg/tkBAoXgkco43HjeT8Q606AoOFlbJnjcTNjfGglMkknNKVqVVSB",
+	"NzaF/XP2wfLLV56DduaGyaoQ1GAUQ0Y4I7LyLqQ7UV453xnsuNsCNIMZ9XvFz20nK9gKo95yQxpAf7wh",
+	"+1t6+5CvMp/XaVQwOQ9CZt4LDk6bhCiDmp5A5rhINDFqEQSiEoaRCYyawAj71hBxofhFRkzAUNhTYKiI",
+	"9domYPQ2X9wltXtUju+IcvysXH67oDE7EnjySOwqEYk560vp9HdkmoPBTGP3hGBBxFGuFqOXnz7ffL75",
+	"/wcAXFbummxpAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -13,6 +13,108 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AssignmentStrategy.
+const (
+	Category   AssignmentStrategy = "category"
+	LeastOpen  AssignmentStrategy = "least_open"
+	RoundRobin AssignmentStrategy = "round_robin"
+)
+
+// Defines values for BulkTicketOperationType.
+const (
+	BulkTicketOperationTypeAddTag    BulkTicketOperationType = "add_tag"
+	BulkTicketOperationTypeAssign    BulkTicketOperationType = "assign"
+	BulkTicketOperationTypeCategory  BulkTicketOperationType = "category"
+	BulkTicketOperationTypeComment   BulkTicketOperationType = "comment"
+	BulkTicketOperationTypeDelete    BulkTicketOperationType = "delete"
+	BulkTicketOperationTypePriority  BulkTicketOperationType = "priority"
+	BulkTicketOperationTypeRemoveTag BulkTicketOperationType = "remove_tag"
+	BulkTicketOperationTypeStatus    BulkTicketOperationType = "status"
+)
+
+// Defines values for CustomFieldType.
+const (
+	Date   CustomFieldType = "date"
+	Enum   CustomFieldType = "enum"
+	Number CustomFieldType = "number"
+	Text   CustomFieldType = "text"
+	User   CustomFieldType = "user"
+)
+
+// Defines values for MacroActionType.
+const (
+	Assign   MacroActionType = "assign"
+	Comment  MacroActionType = "comment"
+	Priority MacroActionType = "priority"
+	Status   MacroActionType = "status"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationMention NotificationType = "mention"
+)
+
+// Defines values for RecurringScheduleType.
+const (
+	Cron  RecurringScheduleType = "cron"
+	Rrule RecurringScheduleType = "rrule"
+)
+
+// Defines values for SLATarget.
+const (
+	FirstResponse SLATarget = "first_response"
+	Resolution    SLATarget = "resolution"
+)
+
+// Defines values for SatisfactionGroupBy.
+const (
+	SatisfactionByAgent        SatisfactionGroupBy = "agent"
+	SatisfactionByCategory     SatisfactionGroupBy = "category"
+	SatisfactionByOrganization SatisfactionGroupBy = "organization"
+)
+
+// Defines values for TicketEventType.
+const (
+	Assigned           TicketEventType = "assigned"
+	AttachmentAdded    TicketEventType = "attachment_added"
+	AttachmentDeleted  TicketEventType = "attachment_deleted"
+	CategoryChanged    TicketEventType = "category_changed"
+	CommentAdded       TicketEventType = "comment_added"
+	CommentDeleted     TicketEventType = "comment_deleted"
+	CommentEdited      TicketEventType = "comment_edited"
+	DescriptionChanged TicketEventType = "description_changed"
+	Escalated          TicketEventType = "escalated"
+	FieldChanged       TicketEventType = "field_changed"
+	ImpactChanged      TicketEventType = "impact_changed"
+	Merged             TicketEventType = "merged"
+	MergedInto         TicketEventType = "merged_into"
+	PriorityChanged    TicketEventType = "priority_changed"
+	PriorityOverridden TicketEventType = "priority_overridden"
+	RelationAdded      TicketEventType = "relation_added"
+	RelationRemoved    TicketEventType = "relation_removed"
+	Split              TicketEventType = "split"
+	SplitFrom          TicketEventType = "split_from"
+	StatusChanged      TicketEventType = "status_changed"
+	SurveySubmitted    TicketEventType = "survey_submitted"
+	TagAdded           TicketEventType = "tag_added"
+	TagRemoved         TicketEventType = "tag_removed"
+	TitleChanged       TicketEventType = "title_changed"
+	Unassigned         TicketEventType = "unassigned"
+	UrgencyChanged     TicketEventType = "urgency_changed"
+	WatcherAdded       TicketEventType = "watcher_added"
+	WatcherRemoved     TicketEventType = "watcher_removed"
+	WorklogAdded       TicketEventType = "worklog_added"
+	WorklogDeleted     TicketEventType = "worklog_deleted"
+	WorklogUpdated     TicketEventType = "worklog_updated"
+)
+
+// Defines values for TicketImpact.
+const (
+	TicketImpactHigh   TicketImpact = "high"
+	TicketImpactLow    TicketImpact = "low"
+	TicketImpactMedium TicketImpact = "medium"
+)
+
 // Defines values for TicketPriority.
 const (
 	Critical TicketPriority = "critical"
@@ -21,13 +123,96 @@ const (
 	Normal   TicketPriority = "normal"
 )
 
-// Defines values for TicketStatus.
+// Defines values for TicketRelationType.
+const (
+	BlockedBy    TicketRelationType = "blocked_by"
+	Blocks       TicketRelationType = "blocks"
+	Child        TicketRelationType = "child"
+	DuplicateOf  TicketRelationType = "duplicate_of"
+	DuplicatedBy TicketRelationType = "duplicated_by"
+	Parent       TicketRelationType = "parent"
+	RelatesTo    TicketRelationType = "relates_to"
+)
+
+// Defines values for TicketSearchHighlightField.
+const (
+	SearchFieldComment     TicketSearchHighlightField = "comment"
+	SearchFieldDescription TicketSearchHighlightField = "description"
+	SearchFieldTitle       TicketSearchHighlightField = "title"
+)
+
+// Defines values for TicketStatusCategory.
+const (
+	Closed     TicketStatusCategory = "closed"
+	InProgress TicketStatusCategory = "in_progress"
+	New        TicketStatusCategory = "new"
+	Resolved   TicketStatusCategory = "resolved"
+	Waiting    TicketStatusCategory = "waiting"
+)
+
+// Defines values for TicketSurveyStatus.
+const (
+	SurveyStatusPending   TicketSurveyStatus = "pending"
+	SurveyStatusSubmitted TicketSurveyStatus = "submitted"
+)
+
+// Defines values for TicketUrgency.
 const (
-	Closed     TicketStatus = "closed"
-	InProgress TicketStatus = "in_progress"
-	New        TicketStatus = "new"
-	Resolved   TicketStatus = "resolved"
-	Waiting    TicketStatus = "waiting"
+	TicketUrgencyHigh   TicketUrgency = "high"
+	TicketUrgencyLow    TicketUrgency = "low"
+	TicketUrgencyMedium TicketUrgency = "medium"
+)
+
+// Defines values for TicketViewColumn.
+const (
+	ViewColumnAssignee     TicketViewColumn = "assignee"
+	ViewColumnAuthor       TicketViewColumn = "author"
+	ViewColumnCategory     TicketViewColumn = "category"
+	ViewColumnCreatedAt    TicketViewColumn = "created_at"
+	ViewColumnID           TicketViewColumn = "id"
+	ViewColumnOrganization TicketViewColumn = "organization"
+	ViewColumnPriority     TicketViewColumn = "priority"
+	ViewColumnSLA          TicketViewColumn = "sla"
+	ViewColumnStatus       TicketViewColumn = "status"
+	ViewColumnTags         TicketViewColumn = "tags"
+	ViewColumnTitle        TicketViewColumn = "title"
+	ViewColumnUpdatedAt    TicketViewColumn = "updated_at"
+)
+
+// Defines values for TicketViewSortBy.
+const (
+	ViewSortByCreatedAt TicketViewSortBy = "created_at"
+	ViewSortByPriority  TicketViewSortBy = "priority"
+	ViewSortByStatus    TicketViewSortBy = "status"
+	ViewSortByTitle     TicketViewSortBy = "title"
+	ViewSortByUpdatedAt TicketViewSortBy = "updated_at"
+)
+
+// Defines values for TicketViewSortOrder.
+const (
+	ViewSortOrderAsc  TicketViewSortOrder = "asc"
+	ViewSortOrderDesc TicketViewSortOrder = "desc"
+)
+
+// Defines values for TicketViewVisibility.
+const (
+	ViewVisibilityOrganization TicketViewVisibility = "organization"
+	ViewVisibilityPrivate      TicketViewVisibility = "private"
+	ViewVisibilityRole         TicketViewVisibility = "role"
+)
+
+// Defines values for TimeReportGroupBy.
+const (
+	TimeByAgent        TimeReportGroupBy = "agent"
+	TimeByCategory     TimeReportGroupBy = "category"
+	TimeByOrganization TimeReportGroupBy = "organization"
+)
+
+// Defines values for TrashItemType.
+const (
+	TrashItemCategory     TrashItemType = "category"
+	TrashItemOrganization TrashItemType = "organization"
+	TrashItemTicket       TrashItemType = "ticket"
 )
 
 // Defines values for UserRole.
@@ -44,14 +229,161 @@ const (
 	Author   GetUsersIDTicketsParamsRelationship = "author"
 )
 
+// AddTicketWatcherRequest defines model for AddTicketWatcherRequest.
+type AddTicketWatcherRequest struct {
+	// UserId User to subscribe (defaults to the caller)
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
 // AssignTicketRequest defines model for AssignTicketRequest.
 type AssignTicketRequest struct {
 	// AssigneeId Assignee ID (null to unassign)
 	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
 }
 
+// AssignmentStrategy How a new ticket is assigned:
+// round_robin rotates through the agent pool,
+// least_open picks the agent with the fewest open tickets,
+// category picks the least loaded agent of the ticket category rule.
+type AssignmentStrategy string
+
+// BulkTicketFilter Selects tickets like the query parameters of GET /tickets
+type BulkTicketFilter struct {
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+	AuthorId   *openapi_types.UUID `json:"author_id,omitempty"`
+	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
+
+	// CustomFields Custom field values to match
+	CustomFields *map[string]string `json:"custom_fields,omitempty"`
+
+	// Keys Ticket keys such as ACME-1042; only tickets with one of the keys are selected
+	Keys           *[]string           `json:"keys,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// Priority Ticket priority level
+	Priority *TicketPriority `json:"priority,omitempty"`
+
+	// Status Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	Status *TicketStatus `json:"status,omitempty"`
+
+	// Tags Tickets tagged with all of the tags
+	Tags *[]string `json:"tags,omitempty"`
+
+	// TagsAny Tickets tagged with at least one of the tags
+	TagsAny   *[]string           `json:"tags_any,omitempty"`
+	WatcherId *openapi_types.UUID `json:"watcher_id,omitempty"`
+}
+
+// BulkTicketOperation defines model for BulkTicketOperation.
+type BulkTicketOperation struct {
+	// AssigneeId New assignee of an assign operation; omit to unassign
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+
+	// CategoryId New category of a category operation
+	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
+
+	// Content Comment text of a comment operation
+	Content *string `json:"content,omitempty"`
+
+	// IsInternal Whether the comment is internal (agent/admin only)
+	IsInternal *bool `json:"is_internal,omitempty"`
+
+	// Priority Ticket priority level
+	Priority *TicketPriority `json:"priority,omitempty"`
+
+	// Status Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	Status *TicketStatus `json:"status,omitempty"`
+
+	// Tag Tag of an add_tag or remove_tag operation
+	Tag  *string                 `json:"tag,omitempty"`
+	Type BulkTicketOperationType `json:"type"`
+}
+
+// BulkTicketOperationType defines model for BulkTicketOperationType.
+type BulkTicketOperationType string
+
+// BulkTicketRequest defines model for BulkTicketRequest.
+type BulkTicketRequest struct {
+	// Filter Selects tickets like the query parameters of GET /tickets
+	Filter    *BulkTicketFilter   `json:"filter,omitempty"`
+	Operation BulkTicketOperation `json:"operation"`
+
+	// TicketIds Tickets to change; exactly one of ticket_ids and filter must be set
+	TicketIds *[]openapi_types.UUID `json:"ticket_ids,omitempty"`
+}
+
+// BulkTicketResponse defines model for BulkTicketResponse.
+type BulkTicketResponse struct {
+	Failed    *int                `json:"failed,omitempty"`
+	Results   *[]BulkTicketResult `json:"results,omitempty"`
+	Succeeded *int                `json:"succeeded,omitempty"`
+}
+
+// BulkTicketResult defines model for BulkTicketResult.
+type BulkTicketResult struct {
+	Error *string `json:"error,omitempty"`
+
+	// StatusCode HTTP status the single-ticket endpoint would have returned for a failure
+	StatusCode *int               `json:"status_code,omitempty"`
+	Success    bool               `json:"success"`
+	TicketId   openapi_types.UUID `json:"ticket_id"`
+}
+
+// BusinessCalendar defines model for BusinessCalendar.
+type BusinessCalendar struct {
+	Holidays *[]openapi_types.Date `json:"holidays,omitempty"`
+
+	// Timezone IANA time zone name (defaults to UTC)
+	Timezone     *string        `json:"timezone,omitempty"`
+	WorkingHours []WorkingHours `json:"working_hours"`
+}
+
+// CannedResponse defines model for CannedResponse.
+type CannedResponse struct {
+	// Content Text with variables such as {{ticket.title}}; rendered when retrieved for a ticket
+	Content   *string             `json:"content,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+
+	// OrganizationId Organization the canned response is shared with; absent for personal ones
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// OwnerId User who created the canned response
+	OwnerId   *openapi_types.UUID `json:"owner_id,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// CategoryAssignment defines model for CategoryAssignment.
+type CategoryAssignment struct {
+	AgentIds   []openapi_types.UUID `json:"agent_ids"`
+	CategoryId openapi_types.UUID   `json:"category_id"`
+}
+
+// CommentRevision defines model for CommentRevision.
+type CommentRevision struct {
+	// Content Comment content before the edit
+	Content  *string             `json:"content,omitempty"`
+	EditedAt *time.Time          `json:"edited_at,omitempty"`
+	EditedBy *openapi_types.UUID `json:"edited_by,omitempty"`
+}
+
+// CreateCannedResponseRequest defines model for CreateCannedResponseRequest.
+type CreateCannedResponseRequest struct {
+	// Content Text with variables: {{ticket.id}}, {{ticket.title}}, {{ticket.status}}, {{ticket.priority}},
+	// {{author.name}}, {{author.email}}, {{organization.name}} and {{agent.name}}
+	Content string `json:"content"`
+	Name    string `json:"name"`
+
+	// OrganizationId Share with the agents of this organization (admin); omit for a personal canned response
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+}
+
 // CreateCategoryRequest defines model for CreateCategoryRequest.
 type CreateCategoryRequest struct {
+	// CustomFields Custom fields of tickets in the category
+	CustomFields *[]CustomFieldDefinition `json:"custom_fields,omitempty"`
+
 	// Description Category description
 	Description *string `json:"description,omitempty"`
 
@@ -83,6 +415,16 @@ type CreateCommentRequest struct {
 	IsInternal *bool `json:"is_internal,omitempty"`
 }
 
+// CreateMacroRequest defines model for CreateMacroRequest.
+type CreateMacroRequest struct {
+	Actions     []MacroAction `json:"actions"`
+	Description *string       `json:"description,omitempty"`
+	Name        string        `json:"name"`
+
+	// OrganizationId Share with the agents of this organization (admin); omit for a personal macro
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+}
+
 // CreateOrganizationRequest defines model for CreateOrganizationRequest.
 type CreateOrganizationRequest struct {
 	// Domain Organization domain (optional)
@@ -101,6 +443,43 @@ type CreateOrganizationResponse struct {
 	Id *openapi_types.UUID `json:"id,omitempty"`
 }
 
+// CreateOrganizationTagRequest defines model for CreateOrganizationTagRequest.
+type CreateOrganizationTagRequest struct {
+	// Color Display color in #RRGGBB format
+	Color       string  `json:"color"`
+	Description *string `json:"description,omitempty"`
+
+	// Name Tag name (letters, digits, '-' and '_'; stored in lower case)
+	Name string `json:"name"`
+}
+
+// CreateRecurringTemplateRequest defines model for CreateRecurringTemplateRequest.
+type CreateRecurringTemplateRequest struct {
+	// AssigneeId Agent or admin the created tickets are assigned to
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+
+	// CategoryId Category of the organization
+	CategoryId     *openapi_types.UUID `json:"category_id,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Name           string              `json:"name"`
+	OrganizationId openapi_types.UUID  `json:"organization_id"`
+
+	// Priority Ticket priority level
+	Priority TicketPriority    `json:"priority"`
+	Schedule RecurringSchedule `json:"schedule"`
+	Title    string            `json:"title"`
+}
+
+// CreateTicketRelationRequest defines model for CreateTicketRelationRequest.
+type CreateTicketRelationRequest struct {
+	// TicketId Related ticket
+	TicketId openapi_types.UUID `json:"ticket_id"`
+
+	// Type Type of the link as seen from the ticket, e.g. "blocks" means the ticket blocks the related ticket
+	// and "parent" means the ticket is the parent of the related ticket
+	Type TicketRelationType `json:"type"`
+}
+
 // CreateTicketRequest defines model for CreateTicketRequest.
 type CreateTicketRequest struct {
 	// AssigneeId Assignee ID (optional)
@@ -112,17 +491,48 @@ type CreateTicketRequest struct {
 	// CategoryId Category ID (optional)
 	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
 
+	// CustomFields Custom field values keyed by field key, validated against the schema of the ticket category
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+
 	// Description Ticket description
 	Description string `json:"description"`
 
+	// Impact How widely the problem affects the customer
+	Impact *TicketImpact `json:"impact,omitempty"`
+
 	// OrganizationId Organization ID
 	OrganizationId openapi_types.UUID `json:"organization_id"`
 
-	// Priority Ticket priority level
-	Priority TicketPriority `json:"priority"`
+	// Priority Priority set by an agent instead of the one derived from impact and urgency;
+	// ignored for customers
+	Priority TicketPriority `json:"priority,omitempty"`
 
 	// Title Ticket title
 	Title string `json:"title"`
+
+	// Urgency How soon the customer needs a resolution
+	Urgency *TicketUrgency `json:"urgency,omitempty"`
+}
+
+// CreateTicketViewRequest defines model for CreateTicketViewRequest.
+type CreateTicketViewRequest struct {
+	// Columns Columns to display; defaults to title, status, priority, assignee and updated_at
+	Columns *[]TicketViewColumn `json:"columns,omitempty"`
+
+	// Filter Ticket filter of a saved view; fields have the meaning of the GET /tickets query parameters
+	Filter *TicketViewFilter `json:"filter,omitempty"`
+	Name   string            `json:"name"`
+
+	// OrganizationId Organization to share with; required with the organization visibility
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// Role User role in the system
+	Role      *UserRole            `json:"role,omitempty"`
+	SortBy    *TicketViewSortBy    `json:"sort_by,omitempty"`
+	SortOrder *TicketViewSortOrder `json:"sort_order,omitempty"`
+
+	// Visibility Private views are seen by their owner only; shared ones by an organization or a role
+	Visibility *TicketViewVisibility `json:"visibility,omitempty"`
 }
 
 // CreateUserRequest defines model for CreateUserRequest.
@@ -137,138 +547,834 @@ type CreateUserResponse struct {
 	Id *openapi_types.UUID `json:"id,omitempty"`
 }
 
+// CustomFieldDefinition defines model for CustomFieldDefinition.
+type CustomFieldDefinition struct {
+	// Key Key of the value in ticket custom_fields, e.g. asset_tag
+	Key   string `json:"key"`
+	Label string `json:"label"`
+
+	// Options Allowed values of an enum field
+	Options *[]string `json:"options,omitempty"`
+
+	// Required Tickets of the category must have a value
+	Required *bool `json:"required,omitempty"`
+
+	// Type Type of a custom field value: text is a string, number a JSON number, date a YYYY-MM-DD string,
+	// enum one of the field options and user a user ID
+	Type CustomFieldType `json:"type"`
+}
+
+// CustomFieldType Type of a custom field value: text is a string, number a JSON number, date a YYYY-MM-DD string,
+// enum one of the field options and user a user ID
+type CustomFieldType string
+
+// CustomFieldValues Custom field values keyed by field key, validated against the schema of the ticket category
+type CustomFieldValues map[string]interface{}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// EscalationRule defines model for EscalationRule.
+type EscalationRule struct {
+	// AssignTo Reassign the ticket to this agent, for example a team lead
+	AssignTo *openapi_types.UUID `json:"assign_to,omitempty"`
+
+	// BeforeMinutes Fire this many business minutes before the target is due (0 or absent fires once it is breached)
+	BeforeMinutes *int64 `json:"before_minutes,omitempty"`
+
+	// Comment Internal comment added on behalf of the system
+	Comment *string `json:"comment,omitempty"`
+
+	// Id Rule ID. When omitted, the rule keeps the ID of the current rule with the same name,
+	// otherwise a new one is generated; a rule with a new ID fires again for tickets it already escalated.
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Name string              `json:"name"`
+
+	// RaisePriority Raise the ticket priority by one level
+	RaisePriority *bool `json:"raise_priority,omitempty"`
+
+	// Target SLA target watched by an escalation rule
+	Target SLATarget `json:"target"`
+}
+
 // GetCategoryResponse defines model for GetCategoryResponse.
 type GetCategoryResponse struct {
-	CreatedAt      *time.Time          `json:"created_at,omitempty"`
-	Description    *string             `json:"description,omitempty"`
-	Id             *openapi_types.UUID `json:"id,omitempty"`
-	IsActive       *bool               `json:"is_active,omitempty"`
-	Name           *string             `json:"name,omitempty"`
+	CreatedAt      *time.Time               `json:"created_at,omitempty"`
+	CustomFields   *[]CustomFieldDefinition `json:"custom_fields,omitempty"`
+	Description    *string                  `json:"description,omitempty"`
+	Id             *openapi_types.UUID      `json:"id,omitempty"`
+	IsActive       *bool                    `json:"is_active,omitempty"`
+	Name           *string                  `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID      `json:"organization_id,omitempty"`
+	ParentId       *openapi_types.UUID      `json:"parent_id,omitempty"`
+	UpdatedAt      *time.Time               `json:"updated_at,omitempty"`
+}
+
+// GetOrganizationResponse defines model for GetOrganizationResponse.
+type GetOrganizationResponse struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Domain    *string             `json:"domain,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	IsActive  *bool               `json:"is_active,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	ParentId  *openapi_types.UUID `json:"parent_id,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// GetTicketResponse defines model for GetTicketResponse.
+type GetTicketResponse struct {
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+
+	// AssignmentStrategy How a new ticket is assigned:
+	// round_robin rotates through the agent pool,
+	// least_open picks the agent with the fewest open tickets,
+	// category picks the least loaded agent of the ticket category rule.
+	AssignmentStrategy *AssignmentStrategy `json:"assignment_strategy,omitempty"`
+	AuthorId           *openapi_types.UUID `json:"author_id,omitempty"`
+	CategoryId         *openapi_types.UUID `json:"category_id,omitempty"`
+	ClosedAt           *time.Time          `json:"closed_at,omitempty"`
+	CreatedAt          *time.Time          `json:"created_at,omitempty"`
+
+	// CustomFields Custom field values keyed by field key, validated against the schema of the ticket category
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+
+	// Highlights Fragments matching the full-text search query; only set in search results
+	Highlights *[]TicketSearchHighlight `json:"highlights,omitempty"`
+	Id         *openapi_types.UUID      `json:"id,omitempty"`
+
+	// Impact How widely the problem affects the customer
+	Impact *TicketImpact `json:"impact,omitempty"`
+
+	// Key Human-readable key such as ACME-1042; absent when the organization has no key prefix
+	Key *string `json:"key,omitempty"`
+
+	// MergedIntoId Ticket this ticket was merged into
+	MergedIntoId   *openapi_types.UUID `json:"merged_into_id,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// Priority Ticket priority level
+	Priority *TicketPriority `json:"priority,omitempty"`
+
+	// PriorityOverridden Whether an agent set the priority instead of the one derived from impact and urgency
+	PriorityOverridden *bool               `json:"priority_overridden,omitempty"`
+	Relations          *[]TicketRelation   `json:"relations,omitempty"`
+	ResolvedAt         *time.Time          `json:"resolved_at,omitempty"`
+	Satisfaction       *TicketSatisfaction `json:"satisfaction,omitempty"`
+	Sla                *TicketSLA          `json:"sla,omitempty"`
+
+	// SplitFromId Ticket this ticket was split from
+	SplitFromId *openapi_types.UUID `json:"split_from_id,omitempty"`
+
+	// Status Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	Status *TicketStatus `json:"status,omitempty"`
+
+	// StatusCategory Built-in status that defines how a workflow status behaves
+	StatusCategory *TicketStatusCategory `json:"status_category,omitempty"`
+	Tags           *[]string             `json:"tags,omitempty"`
+
+	// TimeSpent Totals of the ticket work logs; like the work logs themselves, returned to agents and admins only
+	TimeSpent *TicketTimeSpent `json:"time_spent,omitempty"`
+	Title     *string          `json:"title,omitempty"`
+	UpdatedAt *time.Time       `json:"updated_at,omitempty"`
+
+	// Urgency How soon the customer needs a resolution
+	Urgency  *TicketUrgency   `json:"urgency,omitempty"`
+	Watchers *[]TicketWatcher `json:"watchers,omitempty"`
+}
+
+// GetUserResponse defines model for GetUserResponse.
+type GetUserResponse struct {
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             *openapi_types.UUID  `json:"id,omitempty"`
+	IsActive       *bool                `json:"is_active,omitempty"`
+	Name           *string              `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID  `json:"organization_id,omitempty"`
+
+	// Role User role in the system
+	Role      *UserRole  `json:"role,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListCategoriesResponse defines model for ListCategoriesResponse.
+type ListCategoriesResponse struct {
+	Categories *[]GetCategoryResponse `json:"categories,omitempty"`
+}
+
+// ListNotificationsResponse defines model for ListNotificationsResponse.
+type ListNotificationsResponse struct {
+	Items      []Notification      `json:"items"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+
+	// UnreadCount Number of unread notifications of the user
+	UnreadCount int64 `json:"unread_count"`
+}
+
+// ListOrganizationsResponse defines model for ListOrganizationsResponse.
+type ListOrganizationsResponse struct {
+	Organizations *[]GetOrganizationResponse `json:"organizations,omitempty"`
+	Pagination    *PaginationResponse        `json:"pagination,omitempty"`
+}
+
+// ListTicketsResponse defines model for ListTicketsResponse.
+type ListTicketsResponse struct {
+	Pagination *PaginationResponse  `json:"pagination,omitempty"`
+	Tickets    *[]GetTicketResponse `json:"tickets,omitempty"`
+}
+
+// ListTrashResponse defines model for ListTrashResponse.
+type ListTrashResponse struct {
+	Items      *[]TrashItem        `json:"items,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// ListUsersResponse defines model for ListUsersResponse.
+type ListUsersResponse struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Users      *[]GetUserResponse  `json:"users,omitempty"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+// Macro defines model for Macro.
+type Macro struct {
+	Actions     *[]MacroAction      `json:"actions,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+
+	// OrganizationId Organization the macro is shared with; absent for personal ones
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// OwnerId User who created the macro
+	OwnerId   *openapi_types.UUID `json:"owner_id,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// MacroAction defines model for MacroAction.
+type MacroAction struct {
+	// Internal Makes a comment visible to agents only
+	Internal *bool `json:"internal,omitempty"`
+
+	// Type comment adds a comment whose text may use canned response variables, status moves the ticket to a
+	// workflow status, priority sets the priority and assign assigns the ticket (an empty value unassigns it)
+	Type MacroActionType `json:"type"`
+
+	// Value Comment text, status, priority or assignee ID depending on the action type
+	Value *string `json:"value,omitempty"`
+}
+
+// MacroActionType comment adds a comment whose text may use canned response variables, status moves the ticket to a
+// workflow status, priority sets the priority and assign assigns the ticket (an empty value unassigns it)
+type MacroActionType string
+
+// MergeTicketsRequest defines model for MergeTicketsRequest.
+type MergeTicketsRequest struct {
+	// SourceTicketIds Tickets to merge into the target ticket
+	SourceTicketIds []openapi_types.UUID `json:"source_ticket_ids"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// ActorId User whose action caused the notification
+	ActorId openapi_types.UUID `json:"actor_id"`
+
+	// CommentId Comment the user was mentioned in
+	CommentId *openapi_types.UUID `json:"comment_id,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// ReadAt Time the notification was read (absent while unread)
+	ReadAt   *time.Time         `json:"read_at,omitempty"`
+	TicketId openapi_types.UUID `json:"ticket_id"`
+	Type     NotificationType   `json:"type"`
+}
+
+// NotificationType defines model for NotificationType.
+type NotificationType string
+
+// OrganizationAssignment defines model for OrganizationAssignment.
+type OrganizationAssignment struct {
+	// AgentIds Agent pool (empty means every active agent of the organization)
+	AgentIds      *[]openapi_types.UUID `json:"agent_ids,omitempty"`
+	CategoryRules *[]CategoryAssignment `json:"category_rules,omitempty"`
+
+	// Enabled Whether new tickets are assigned automatically
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Strategy How a new ticket is assigned:
+	// round_robin rotates through the agent pool,
+	// least_open picks the agent with the fewest open tickets,
+	// category picks the least loaded agent of the ticket category rule.
+	Strategy *AssignmentStrategy `json:"strategy,omitempty"`
+}
+
+// OrganizationPriorityMatrix defines model for OrganizationPriorityMatrix.
+type OrganizationPriorityMatrix struct {
+	Cells *[]PriorityMatrixCell `json:"cells,omitempty"`
+
+	// IsDefault Whether the organization uses the default priority matrix
+	IsDefault *bool `json:"is_default,omitempty"`
+}
+
+// OrganizationSLA defines model for OrganizationSLA.
+type OrganizationSLA struct {
+	Calendar    *BusinessCalendar `json:"calendar,omitempty"`
+	Escalations *[]EscalationRule `json:"escalations,omitempty"`
+
+	// IsDefault Whether the organization uses the default priority targets
+	IsDefault *bool        `json:"is_default,omitempty"`
+	Policies  *[]SLAPolicy `json:"policies,omitempty"`
+}
+
+// OrganizationSettings defines model for OrganizationSettings.
+type OrganizationSettings struct {
+	AllowPublicTickets bool `json:"allow_public_tickets"`
+
+	// AutoCloseAfterHours Hours a resolved ticket waits for a reply before it is closed automatically; 0 disables auto-close
+	AutoCloseAfterHours int `json:"auto_close_after_hours"`
+
+	// DefaultTicketPriority Ticket priority level
+	DefaultTicketPriority TicketPriority `json:"default_ticket_priority"`
+	EmailNotifications    bool           `json:"email_notifications"`
+
+	// MaxFileSize Maximum attachment size in bytes
+	MaxFileSize int64 `json:"max_file_size"`
+
+	// TicketKeyPrefix Prefix of ticket keys such as ACME in ACME-1042; empty when tickets get no keys
+	TicketKeyPrefix string `json:"ticket_key_prefix"`
+}
+
+// OrganizationTag defines model for OrganizationTag.
+type OrganizationTag struct {
+	// Color Display color in #RRGGBB format
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Name Tag name, unique within the organization
+	Name *string `json:"name,omitempty"`
+}
+
+// OrganizationWorkflow defines model for OrganizationWorkflow.
+type OrganizationWorkflow struct {
+	// IsDefault Whether the organization uses the default workflow
+	IsDefault   *bool                 `json:"is_default,omitempty"`
+	Statuses    *[]WorkflowStatus     `json:"statuses,omitempty"`
+	Transitions *[]WorkflowTransition `json:"transitions,omitempty"`
+}
+
+// PaginationResponse defines model for PaginationResponse.
+type PaginationResponse struct {
+	// HasNext Whether there are more pages
+	HasNext *bool `json:"has_next,omitempty"`
+
+	// Limit Items per page
+	Limit *int `json:"limit,omitempty"`
+
+	// Page Current page number
+	Page *int `json:"page,omitempty"`
+
+	// Total Total number of items
+	Total *int `json:"total,omitempty"`
+}
+
+// PriorityMatrixCell defines model for PriorityMatrixCell.
+type PriorityMatrixCell struct {
+	// Impact How widely the problem affects the customer
+	Impact TicketImpact `json:"impact"`
+
+	// Priority Ticket priority level
+	Priority TicketPriority `json:"priority"`
+
+	// Urgency How soon the customer needs a resolution
+	Urgency TicketUrgency `json:"urgency"`
+}
+
+// RecurringSchedule defines model for RecurringSchedule.
+type RecurringSchedule struct {
+	// Expression Cron expression or recurrence rule, e.g. "0 9 * * MON-FRI" or "FREQ=MONTHLY;BYDAY=-1FR"
+	Expression string `json:"expression"`
+
+	// StartAt No tickets are created before this time; for recurrence rules it is also DTSTART, which INTERVAL
+	// counts from and which supplies unspecified time and day. Defaults to the creation time.
+	StartAt *time.Time `json:"start_at,omitempty"`
+
+	// Timezone IANA time zone the schedule is evaluated in; defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Type cron is a five-field expression (minute, hour, day of month, month, day of week) or a macro such as
+	// @daily; rrule is an RFC 5545 recurrence rule with FREQ DAILY, WEEKLY, MONTHLY or YEARLY
+	Type RecurringScheduleType `json:"type"`
+}
+
+// RecurringScheduleType cron is a five-field expression (minute, hour, day of month, month, day of week) or a macro such as
+// @daily; rrule is an RFC 5545 recurrence rule with FREQ DAILY, WEEKLY, MONTHLY or YEARLY
+type RecurringScheduleType string
+
+// RecurringTemplate defines model for RecurringTemplate.
+type RecurringTemplate struct {
+	// AssigneeId Default assignee of the created tickets
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+
+	// CreatedBy Agent who created the template; also the author of the created tickets
+	CreatedBy *openapi_types.UUID `json:"created_by,omitempty"`
+
+	// Description Description of the created tickets
+	Description *string             `json:"description,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+
+	// IsActive Paused templates and templates whose schedule has ended are inactive
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// LastRunAt Scheduled time of the last created ticket
+	LastRunAt    *time.Time          `json:"last_run_at,omitempty"`
+	LastTicketId *openapi_types.UUID `json:"last_ticket_id,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+
+	// NextRunAt Scheduled time of the next ticket; absent for inactive templates
+	NextRunAt      *time.Time          `json:"next_run_at,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// Priority Ticket priority level
+	Priority *TicketPriority    `json:"priority,omitempty"`
+	Schedule *RecurringSchedule `json:"schedule,omitempty"`
+
+	// Title Title of the created tickets
+	Title *string `json:"title,omitempty"`
+
+	// UpcomingRuns Next scheduled runs, up to five
+	UpcomingRuns *[]time.Time `json:"upcoming_runs,omitempty"`
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"`
+}
+
+// SLAPolicy defines model for SLAPolicy.
+type SLAPolicy struct {
+	// AroundTheClock Count targets around the clock instead of using the business calendar
+	AroundTheClock *bool `json:"around_the_clock,omitempty"`
+
+	// CategoryId Category the policy applies to (absent means any category)
+	CategoryId           *openapi_types.UUID `json:"category_id,omitempty"`
+	FirstResponseMinutes int64               `json:"first_response_minutes"`
+
+	// Id Policy ID (generated when omitted)
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Name string              `json:"name"`
+
+	// Priority Ticket priority level
+	Priority          *TicketPriority `json:"priority,omitempty"`
+	ResolutionMinutes int64           `json:"resolution_minutes"`
+}
+
+// SLATarget SLA target watched by an escalation rule
+type SLATarget string
+
+// SLATargetStatus defines model for SLATargetStatus.
+type SLATargetStatus struct {
+	Breached *bool `json:"breached,omitempty"`
+
+	// CompletedAt Time the target was met (absent while pending)
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// DueAt Due date (absent while the clock is paused before the target is reached)
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// RemainingSeconds Remaining business time in seconds, negative when the target is breached
+	RemainingSeconds *int64 `json:"remaining_seconds,omitempty"`
+
+	// TargetMinutes Target in business minutes
+	TargetMinutes *int64 `json:"target_minutes,omitempty"`
+}
+
+// SatisfactionGroupBy defines model for SatisfactionGroupBy.
+type SatisfactionGroupBy string
+
+// SatisfactionReport defines model for SatisfactionReport.
+type SatisfactionReport struct {
+	GroupBy SatisfactionGroupBy `json:"group_by"`
+
+	// Groups Groups with the most ratings first
+	Groups  []SatisfactionScore `json:"groups"`
+	Overall SatisfactionScore   `json:"overall"`
+}
+
+// SatisfactionScore defines model for SatisfactionScore.
+type SatisfactionScore struct {
+	AverageRating float64 `json:"average_rating"`
+
+	// Id Agent, category or organization; absent for tickets without an assignee or category
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Responses Number of submitted ratings
+	Responses int64 `json:"responses"`
+
+	// Satisfied Number of ratings of 4 and 5
+	Satisfied int64 `json:"satisfied"`
+
+	// Score CSAT score, the share of satisfied ratings in percent
+	Score float64 `json:"score"`
+}
+
+// SplitTicketRequest defines model for SplitTicketRequest.
+type SplitTicketRequest struct {
+	// CommentIds Comments to move into the new ticket
+	CommentIds  []openapi_types.UUID `json:"comment_ids"`
+	Description *string              `json:"description,omitempty"`
+
+	// Priority Ticket priority level
+	Priority *TicketPriority `json:"priority,omitempty"`
+	Title    string          `json:"title"`
+}
+
+// SubmitTicketSurveyRequest defines model for SubmitTicketSurveyRequest.
+type SubmitTicketSurveyRequest struct {
+	Comment *string `json:"comment,omitempty"`
+	Rating  int     `json:"rating"`
+
+	// Token One-time survey token
+	Token string `json:"token"`
+}
+
+// TicketAttachment defines model for TicketAttachment.
+type TicketAttachment struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	FileName  *string    `json:"file_name,omitempty"`
+
+	// FileSize File size in bytes
+	FileSize   *int64              `json:"file_size,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	MimeType   *string             `json:"mime_type,omitempty"`
+	TicketId   *openapi_types.UUID `json:"ticket_id,omitempty"`
+	UploadedBy *openapi_types.UUID `json:"uploaded_by,omitempty"`
+}
+
+// TicketComment defines model for TicketComment.
+type TicketComment struct {
+	AuthorId  *openapi_types.UUID `json:"author_id,omitempty"`
+	Content   *string             `json:"content,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// IsInternal Internal comment (not visible to customers)
+	IsInternal *bool `json:"is_internal,omitempty"`
+
+	// Mentions Users mentioned in the comment as @email or @username; each of them was notified
+	Mentions *[]openapi_types.UUID `json:"mentions,omitempty"`
+
+	// Revisions Previous versions of the comment, oldest first
+	Revisions *[]CommentRevision  `json:"revisions,omitempty"`
+	TicketId  *openapi_types.UUID `json:"ticket_id,omitempty"`
+
+	// UpdatedAt Time of the last edit (absent if the comment was never edited)
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TicketEscalation defines model for TicketEscalation.
+type TicketEscalation struct {
+	EscalatedAt *time.Time          `json:"escalated_at,omitempty"`
+	RuleId      *openapi_types.UUID `json:"rule_id,omitempty"`
+	RuleName    *string             `json:"rule_name,omitempty"`
+
+	// Target SLA target watched by an escalation rule
+	Target *SLATarget `json:"target,omitempty"`
+}
+
+// TicketEvent defines model for TicketEvent.
+type TicketEvent struct {
+	// ActorId User who made the change (absent for system actions)
+	ActorId   *openapi_types.UUID `json:"actor_id,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// IsInternal Event is visible to agents and admins only
+	IsInternal *bool               `json:"is_internal,omitempty"`
+	NewValue   *string             `json:"new_value,omitempty"`
+	OldValue   *string             `json:"old_value,omitempty"`
+	TicketId   *openapi_types.UUID `json:"ticket_id,omitempty"`
+
+	// Type Type of ticket history event
+	Type *TicketEventType `json:"type,omitempty"`
+}
+
+// TicketEventType Type of ticket history event
+type TicketEventType string
+
+// TicketHistoryResponse defines model for TicketHistoryResponse.
+type TicketHistoryResponse struct {
+	Events     *[]TicketEvent      `json:"events,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// TicketImpact How widely the problem affects the customer
+type TicketImpact string
+
+// TicketPriority Ticket priority level
+type TicketPriority string
+
+// TicketRelation defines model for TicketRelation.
+type TicketRelation struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	CreatedBy *openapi_types.UUID `json:"created_by,omitempty"`
+
+	// TicketId Related ticket
+	TicketId *openapi_types.UUID `json:"ticket_id,omitempty"`
+
+	// Type Type of the link as seen from the ticket, e.g. "blocks" means the ticket blocks the related ticket
+	// and "parent" means the ticket is the parent of the related ticket
+	Type *TicketRelationType `json:"type,omitempty"`
+}
+
+// TicketRelationType Type of the link as seen from the ticket, e.g. "blocks" means the ticket blocks the related ticket
+// and "parent" means the ticket is the parent of the related ticket
+type TicketRelationType string
+
+// TicketSLA defines model for TicketSLA.
+type TicketSLA struct {
+	Escalations   *[]TicketEscalation `json:"escalations,omitempty"`
+	FirstResponse *SLATargetStatus    `json:"first_response,omitempty"`
+
+	// Paused Whether the SLA clock is paused while the ticket waits on the customer
+	Paused *bool `json:"paused,omitempty"`
+
+	// PolicyId Applied organization policy (absent when default priority targets are used)
+	PolicyId   *openapi_types.UUID `json:"policy_id,omitempty"`
+	PolicyName *string             `json:"policy_name,omitempty"`
+	Resolution *SLATargetStatus    `json:"resolution,omitempty"`
+}
+
+// TicketSatisfaction defines model for TicketSatisfaction.
+type TicketSatisfaction struct {
+	Comment *string   `json:"comment,omitempty"`
+	RatedAt time.Time `json:"rated_at"`
+	Rating  int       `json:"rating"`
+}
+
+// TicketSearchHighlight defines model for TicketSearchHighlight.
+type TicketSearchHighlight struct {
+	// CommentId Comment containing the match, for comment fragments
+	CommentId *openapi_types.UUID        `json:"comment_id,omitempty"`
+	Field     TicketSearchHighlightField `json:"field"`
+
+	// Snippet HTML-escaped fragment of the field with the matching words wrapped in <mark> tags
+	Snippet string `json:"snippet"`
+}
+
+// TicketSearchHighlightField defines model for TicketSearchHighlight.Field.
+type TicketSearchHighlightField string
+
+// TicketStatus Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+type TicketStatus = string
+
+// TicketStatusCategory Built-in status that defines how a workflow status behaves
+type TicketStatusCategory string
+
+// TicketSurvey defines model for TicketSurvey.
+type TicketSurvey struct {
+	// AgentId Agent assigned when the ticket was resolved
+	AgentId     *openapi_types.UUID `json:"agent_id,omitempty"`
+	Comment     *string             `json:"comment,omitempty"`
+	RatedAt     *time.Time          `json:"rated_at,omitempty"`
+	Rating      *int                `json:"rating,omitempty"`
+	RequestedAt time.Time           `json:"requested_at"`
+	Status      TicketSurveyStatus  `json:"status"`
+
+	// Token One-time survey token; returned only to the ticket author while the survey is pending
+	Token *string `json:"token,omitempty"`
+}
+
+// TicketSurveyStatus defines model for TicketSurvey.Status.
+type TicketSurveyStatus string
+
+// TicketTimeSpent Totals of the ticket work logs; like the work logs themselves, returned to agents and admins only
+type TicketTimeSpent struct {
+	// BillableMinutes Billable time logged on the ticket in minutes
+	BillableMinutes int64 `json:"billable_minutes"`
+
+	// Minutes Total time logged on the ticket in minutes
+	Minutes int64 `json:"minutes"`
+}
+
+// TicketUrgency How soon the customer needs a resolution
+type TicketUrgency string
+
+// TicketView defines model for TicketView.
+type TicketView struct {
+	Columns   *[]TicketViewColumn `json:"columns,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+
+	// Filter Ticket filter of a saved view; fields have the meaning of the GET /tickets query parameters
+	Filter *TicketViewFilter   `json:"filter,omitempty"`
+	Id     *openapi_types.UUID `json:"id,omitempty"`
+	Name   *string             `json:"name,omitempty"`
+
+	// OrganizationId Organization the view is shared with
 	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
-	ParentId       *openapi_types.UUID `json:"parent_id,omitempty"`
-	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+
+	// OwnerId User who created the view
+	OwnerId *openapi_types.UUID `json:"owner_id,omitempty"`
+
+	// Role User role in the system
+	Role      *UserRole            `json:"role,omitempty"`
+	SortBy    *TicketViewSortBy    `json:"sort_by,omitempty"`
+	SortOrder *TicketViewSortOrder `json:"sort_order,omitempty"`
+	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
+
+	// Visibility Private views are seen by their owner only; shared ones by an organization or a role
+	Visibility *TicketViewVisibility `json:"visibility,omitempty"`
 }
 
-// GetOrganizationResponse defines model for GetOrganizationResponse.
-type GetOrganizationResponse struct {
-	CreatedAt *time.Time          `json:"created_at,omitempty"`
-	Domain    *string             `json:"domain,omitempty"`
-	Id        *openapi_types.UUID `json:"id,omitempty"`
-	IsActive  *bool               `json:"is_active,omitempty"`
-	Name      *string             `json:"name,omitempty"`
-	ParentId  *openapi_types.UUID `json:"parent_id,omitempty"`
-	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+// TicketViewColumn defines model for TicketViewColumn.
+type TicketViewColumn string
+
+// TicketViewCount defines model for TicketViewCount.
+type TicketViewCount struct {
+	Count  int64              `json:"count"`
+	ViewId openapi_types.UUID `json:"view_id"`
 }
 
-// GetTicketResponse defines model for GetTicketResponse.
-type GetTicketResponse struct {
+// TicketViewFilter Ticket filter of a saved view; fields have the meaning of the GET /tickets query parameters
+type TicketViewFilter struct {
+	// AssignedToMe Tickets assigned to the user running the view
+	AssignedToMe   *bool               `json:"assigned_to_me,omitempty"`
 	AssigneeId     *openapi_types.UUID `json:"assignee_id,omitempty"`
 	AuthorId       *openapi_types.UUID `json:"author_id,omitempty"`
 	CategoryId     *openapi_types.UUID `json:"category_id,omitempty"`
-	ClosedAt       *time.Time          `json:"closed_at,omitempty"`
-	CreatedAt      *time.Time          `json:"created_at,omitempty"`
-	Description    *string             `json:"description,omitempty"`
-	Id             *openapi_types.UUID `json:"id,omitempty"`
+	CustomFields   *map[string]string  `json:"custom_fields,omitempty"`
 	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
 
 	// Priority Ticket priority level
-	Priority   *TicketPriority `json:"priority,omitempty"`
-	ResolvedAt *time.Time      `json:"resolved_at,omitempty"`
+	Priority *TicketPriority `json:"priority,omitempty"`
 
-	// Status Ticket status
-	Status    *TicketStatus `json:"status,omitempty"`
-	Title     *string       `json:"title,omitempty"`
-	UpdatedAt *time.Time    `json:"updated_at,omitempty"`
-}
+	// Q Full-text search query
+	Q *string `json:"q,omitempty"`
 
-// GetUserResponse defines model for GetUserResponse.
-type GetUserResponse struct {
-	CreatedAt      *time.Time           `json:"created_at,omitempty"`
-	Email          *openapi_types.Email `json:"email,omitempty"`
-	Id             *openapi_types.UUID  `json:"id,omitempty"`
-	IsActive       *bool                `json:"is_active,omitempty"`
-	Name           *string              `json:"name,omitempty"`
-	OrganizationId *openapi_types.UUID  `json:"organization_id,omitempty"`
+	// Status Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	Status *TicketStatus `json:"status,omitempty"`
 
-	// Role User role in the system
-	Role      *UserRole  `json:"role,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-}
+	// StatusCategories Tickets whose status belongs to one of the categories, e.g. all open statuses
+	StatusCategories *[]TicketStatusCategory `json:"status_categories,omitempty"`
+	Tags             *[]string               `json:"tags,omitempty"`
+	TagsAny          *[]string               `json:"tags_any,omitempty"`
 
-// ListCategoriesResponse defines model for ListCategoriesResponse.
-type ListCategoriesResponse struct {
-	Categories *[]GetCategoryResponse `json:"categories,omitempty"`
+	// Unassigned Tickets without an assignee
+	Unassigned *bool               `json:"unassigned,omitempty"`
+	WatcherId  *openapi_types.UUID `json:"watcher_id,omitempty"`
 }
 
-// ListOrganizationsResponse defines model for ListOrganizationsResponse.
-type ListOrganizationsResponse struct {
-	Organizations *[]GetOrganizationResponse `json:"organizations,omitempty"`
-	Pagination    *PaginationResponse        `json:"pagination,omitempty"`
-}
+// TicketViewSortBy defines model for TicketViewSortBy.
+type TicketViewSortBy string
 
-// ListTicketsResponse defines model for ListTicketsResponse.
-type ListTicketsResponse struct {
-	Pagination *PaginationResponse  `json:"pagination,omitempty"`
-	Tickets    *[]GetTicketResponse `json:"tickets,omitempty"`
-}
+// TicketViewSortOrder defines model for TicketViewSortOrder.
+type TicketViewSortOrder string
 
-// ListUsersResponse defines model for ListUsersResponse.
-type ListUsersResponse struct {
-	Pagination *PaginationResponse `json:"pagination,omitempty"`
-	Users      *[]GetUserResponse  `json:"users,omitempty"`
+// TicketViewVisibility Private views are seen by their owner only; shared ones by an organization or a role
+type TicketViewVisibility string
+
+// TicketWatcher defines model for TicketWatcher.
+type TicketWatcher struct {
+	AddedAt *time.Time          `json:"added_at,omitempty"`
+	AddedBy *openapi_types.UUID `json:"added_by,omitempty"`
+	UserId  *openapi_types.UUID `json:"user_id,omitempty"`
 }
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
+// TicketWorkLog defines model for TicketWorkLog.
+type TicketWorkLog struct {
+	// AgentId Agent who spent the time
+	AgentId openapi_types.UUID `json:"agent_id"`
+
+	// Billable Whether the time is billed to the customer
+	Billable  bool      `json:"billable"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User who logged the entry
+	CreatedBy openapi_types.UUID `json:"created_by"`
+
+	// Date Work date
+	Date openapi_types.Date `json:"date"`
+	Id   openapi_types.UUID `json:"id"`
+
+	// Minutes Time spent in minutes
+	Minutes   int        `json:"minutes"`
+	Note      *string    `json:"note,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
-// LoginResponse defines model for LoginResponse.
-type LoginResponse struct {
-	Token string `json:"token"`
+// TimeReport defines model for TimeReport.
+type TimeReport struct {
+	From    openapi_types.Date `json:"from"`
+	GroupBy TimeReportGroupBy  `json:"group_by"`
+
+	// Groups Groups with the most time logged first
+	Groups  []TimeReportGroup  `json:"groups"`
+	Overall TimeReportGroup    `json:"overall"`
+	To      openapi_types.Date `json:"to"`
 }
 
-// PaginationResponse defines model for PaginationResponse.
-type PaginationResponse struct {
-	// HasNext Whether there are more pages
-	HasNext *bool `json:"has_next,omitempty"`
+// TimeReportGroup defines model for TimeReportGroup.
+type TimeReportGroup struct {
+	// BillableMinutes Billable time logged in minutes
+	BillableMinutes int64 `json:"billable_minutes"`
 
-	// Limit Items per page
-	Limit *int `json:"limit,omitempty"`
+	// Entries Number of work log entries
+	Entries int64 `json:"entries"`
 
-	// Page Current page number
-	Page *int `json:"page,omitempty"`
+	// Id Agent, category or organization; absent for tickets without a category
+	Id *openapi_types.UUID `json:"id,omitempty"`
 
-	// Total Total number of items
-	Total *int `json:"total,omitempty"`
+	// Minutes Time logged in minutes
+	Minutes int64 `json:"minutes"`
 }
 
-// TicketComment defines model for TicketComment.
-type TicketComment struct {
-	AuthorId  *openapi_types.UUID `json:"author_id,omitempty"`
-	Content   *string             `json:"content,omitempty"`
-	CreatedAt *time.Time          `json:"created_at,omitempty"`
-	Id        *openapi_types.UUID `json:"id,omitempty"`
+// TimeReportGroupBy defines model for TimeReportGroupBy.
+type TimeReportGroupBy string
 
-	// IsInternal Internal comment (not visible to customers)
-	IsInternal *bool               `json:"is_internal,omitempty"`
-	TicketId   *openapi_types.UUID `json:"ticket_id,omitempty"`
+// TrashItem defines model for TrashItem.
+type TrashItem struct {
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// DeletedBy User who deleted the item
+	DeletedBy *openapi_types.UUID `json:"deleted_by,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// Name Ticket title or category and organization name
+	Name string `json:"name"`
+
+	// OrganizationId Organization of a ticket or category
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// PurgeAt When the item is purged automatically
+	PurgeAt time.Time     `json:"purge_at"`
+	Type    TrashItemType `json:"type"`
 }
 
-// TicketPriority Ticket priority level
-type TicketPriority string
+// TrashItemType defines model for TrashItemType.
+type TrashItemType string
 
-// TicketStatus Ticket status
-type TicketStatus string
+// UpdateCannedResponseRequest defines model for UpdateCannedResponseRequest.
+type UpdateCannedResponseRequest struct {
+	Content string `json:"content"`
+	Name    string `json:"name"`
+}
 
 // UpdateCategoryRequest defines model for UpdateCategoryRequest.
 type UpdateCategoryRequest struct {
+	// CustomFields Replaces the custom field schema; an empty list removes it
+	CustomFields *[]CustomFieldDefinition `json:"custom_fields,omitempty"`
+
 	// Description Category description
 	Description *string `json:"description,omitempty"`
 
@@ -282,6 +1388,38 @@ type UpdateCategoryRequest struct {
 	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
 }
 
+// UpdateCommentRequest defines model for UpdateCommentRequest.
+type UpdateCommentRequest struct {
+	// Content New comment content
+	Content string `json:"content"`
+}
+
+// UpdateMacroRequest defines model for UpdateMacroRequest.
+type UpdateMacroRequest struct {
+	Actions     []MacroAction `json:"actions"`
+	Description *string       `json:"description,omitempty"`
+	Name        string        `json:"name"`
+}
+
+// UpdateOrganizationAssignmentRequest defines model for UpdateOrganizationAssignmentRequest.
+type UpdateOrganizationAssignmentRequest struct {
+	AgentIds *[]openapi_types.UUID `json:"agent_ids,omitempty"`
+
+	// CategoryRules Required for the category strategy; categories without a rule use the agent pool
+	CategoryRules *[]CategoryAssignment `json:"category_rules,omitempty"`
+
+	// Strategy How a new ticket is assigned:
+	// round_robin rotates through the agent pool,
+	// least_open picks the agent with the fewest open tickets,
+	// category picks the least loaded agent of the ticket category rule.
+	Strategy AssignmentStrategy `json:"strategy"`
+}
+
+// UpdateOrganizationPriorityMatrixRequest defines model for UpdateOrganizationPriorityMatrixRequest.
+type UpdateOrganizationPriorityMatrixRequest struct {
+	Cells []PriorityMatrixCell `json:"cells"`
+}
+
 // UpdateOrganizationRequest defines model for UpdateOrganizationRequest.
 type UpdateOrganizationRequest struct {
 	// Domain Organization domain
@@ -297,27 +1435,119 @@ type UpdateOrganizationRequest struct {
 	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
 }
 
+// UpdateOrganizationSLARequest defines model for UpdateOrganizationSLARequest.
+type UpdateOrganizationSLARequest struct {
+	Calendar    *BusinessCalendar `json:"calendar,omitempty"`
+	Escalations *[]EscalationRule `json:"escalations,omitempty"`
+	Policies    []SLAPolicy       `json:"policies"`
+}
+
+// UpdateOrganizationSettingsRequest defines model for UpdateOrganizationSettingsRequest.
+type UpdateOrganizationSettingsRequest struct {
+	AllowPublicTickets *bool `json:"allow_public_tickets,omitempty"`
+
+	// AutoCloseAfterHours Hours a resolved ticket waits for a reply before it is closed automatically; 0 disables auto-close
+	AutoCloseAfterHours *int `json:"auto_close_after_hours,omitempty"`
+
+	// DefaultTicketPriority Ticket priority level
+	DefaultTicketPriority *TicketPriority `json:"default_ticket_priority,omitempty"`
+	EmailNotifications    *bool           `json:"email_notifications,omitempty"`
+
+	// MaxFileSize Maximum attachment size in bytes
+	MaxFileSize *int64 `json:"max_file_size,omitempty"`
+
+	// TicketKeyPrefix Prefix of ticket keys, 2-10 letters and digits starting with a letter, stored in upper case. New tickets
+	// are numbered from a sequence of the prefix; existing tickets keep their keys. Empty stops issuing keys.
+	TicketKeyPrefix *string `json:"ticket_key_prefix,omitempty"`
+}
+
+// UpdateOrganizationTagRequest defines model for UpdateOrganizationTagRequest.
+type UpdateOrganizationTagRequest struct {
+	// Color Display color in #RRGGBB format
+	Color       string  `json:"color"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateOrganizationWorkflowRequest defines model for UpdateOrganizationWorkflowRequest.
+type UpdateOrganizationWorkflowRequest struct {
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+// UpdateRecurringTemplateRequest defines model for UpdateRecurringTemplateRequest.
+type UpdateRecurringTemplateRequest struct {
+	// AssigneeId Agent or admin the created tickets are assigned to
+	AssigneeId *openapi_types.UUID `json:"assignee_id,omitempty"`
+
+	// CategoryId Category of the organization
+	CategoryId  *openapi_types.UUID `json:"category_id,omitempty"`
+	Description *string             `json:"description,omitempty"`
+
+	// IsActive Pauses or resumes the template; unchanged when omitted
+	IsActive       *bool              `json:"is_active,omitempty"`
+	Name           string             `json:"name"`
+	OrganizationId openapi_types.UUID `json:"organization_id"`
+
+	// Priority Ticket priority level
+	Priority TicketPriority    `json:"priority"`
+	Schedule RecurringSchedule `json:"schedule"`
+	Title    string            `json:"title"`
+}
+
 // UpdateTicketRequest defines model for UpdateTicketRequest.
 type UpdateTicketRequest struct {
+	// AddTags Tags to put on the ticket (must be defined by the ticket's organization)
+	AddTags *[]string `json:"add_tags,omitempty"`
+
 	// CategoryId Category ID
 	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
 
+	// CustomFields Custom field values to change; a null value removes the field.
+	// Values of fields the new category does not define are dropped when the category changes.
+	CustomFields *map[string]interface{} `json:"custom_fields,omitempty"`
+
 	// Description Ticket description
 	Description *string `json:"description,omitempty"`
 
-	// Priority Ticket priority level
+	// Impact How widely the problem affects the customer
+	Impact *TicketImpact `json:"impact,omitempty"`
+
+	// Priority Agents only; overrides the priority derived from impact and urgency
 	Priority *TicketPriority `json:"priority,omitempty"`
 
+	// RemoveTags Tags to remove from the ticket
+	RemoveTags *[]string `json:"remove_tags,omitempty"`
+
+	// ResetPriority Agents only; drops the priority override and derives the priority from impact and urgency again
+	ResetPriority *bool `json:"reset_priority,omitempty"`
+
 	// Title Ticket title
 	Title *string `json:"title,omitempty"`
+
+	// Urgency How soon the customer needs a resolution
+	Urgency *TicketUrgency `json:"urgency,omitempty"`
 }
 
 // UpdateTicketStatusRequest defines model for UpdateTicketStatusRequest.
 type UpdateTicketStatusRequest struct {
-	// Status Ticket status
+	// Cascade When the ticket is closed, also close its open child tickets
+	Cascade *bool `json:"cascade,omitempty"`
+
+	// Status Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
 	Status TicketStatus `json:"status"`
 }
 
+// UpdateTicketViewRequest defines model for UpdateTicketViewRequest.
+type UpdateTicketViewRequest struct {
+	Columns *[]TicketViewColumn `json:"columns,omitempty"`
+
+	// Filter Ticket filter of a saved view; fields have the meaning of the GET /tickets query parameters
+	Filter    *TicketViewFilter    `json:"filter,omitempty"`
+	Name      string               `json:"name"`
+	SortBy    *TicketViewSortBy    `json:"sort_by,omitempty"`
+	SortOrder *TicketViewSortOrder `json:"sort_order,omitempty"`
+}
+
 // UpdateUserRequest defines model for UpdateUserRequest.
 type UpdateUserRequest struct {
 	// Email User email
@@ -339,9 +1569,70 @@ type UpdateUserRoleRequest struct {
 	Role UserRole `json:"role"`
 }
 
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	// File File content
+	File openapi_types.File `json:"file"`
+}
+
 // UserRole User role in the system
 type UserRole string
 
+// WorkLogRequest defines model for WorkLogRequest.
+type WorkLogRequest struct {
+	// AgentId Agent who spent the time (defaults to the caller; admin only for others)
+	AgentId *openapi_types.UUID `json:"agent_id,omitempty"`
+
+	// Billable Whether the time is billed to the customer
+	Billable *bool `json:"billable,omitempty"`
+
+	// Date Work date
+	Date openapi_types.Date `json:"date"`
+
+	// Minutes Time spent in minutes
+	Minutes int     `json:"minutes"`
+	Note    *string `json:"note,omitempty"`
+}
+
+// WorkflowStatus defines model for WorkflowStatus.
+type WorkflowStatus struct {
+	// Category Built-in status that defines how a workflow status behaves
+	Category TicketStatusCategory `json:"category"`
+
+	// Key Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	Key TicketStatus `json:"key"`
+
+	// Name Display name (defaults to the built-in name)
+	Name *string `json:"name,omitempty"`
+}
+
+// WorkflowTransition defines model for WorkflowTransition.
+type WorkflowTransition struct {
+	// AllowedRoles Roles allowed to perform the transition (empty means any role)
+	AllowedRoles *[]UserRole `json:"allowed_roles,omitempty"`
+
+	// From Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	From TicketStatus `json:"from"`
+
+	// To Ticket status key. Built-in statuses are new, in_progress, waiting, resolved and closed; organizations may declare additional statuses in their workflow
+	To TicketStatus `json:"to"`
+}
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	End   string `json:"end"`
+	Start string `json:"start"`
+
+	// Weekday Day of the week (0 is Sunday)
+	Weekday int `json:"weekday"`
+}
+
+// GetCannedResponsesParams defines parameters for GetCannedResponses.
+type GetCannedResponsesParams struct {
+	// OrganizationId Only include shared canned responses of this organization; personal ones are always included
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+}
+
 // GetCategoriesParams defines parameters for GetCategories.
 type GetCategoriesParams struct {
 	// OrganizationId Filter by organization ID
@@ -357,6 +1648,12 @@ type GetCategoriesParams struct {
 	IncludeChildren *bool `form:"include_children,omitempty" json:"include_children,omitempty"`
 }
 
+// PutCategoriesIDParams defines parameters for PutCategoriesID.
+type PutCategoriesIDParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the category has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // GetCategoriesIDTicketsParams defines parameters for GetCategoriesIDTickets.
 type GetCategoriesIDTicketsParams struct {
 	// Status Filter by ticket status
@@ -375,6 +1672,24 @@ type GetCategoriesIDTicketsParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetMacrosParams defines parameters for GetMacros.
+type GetMacrosParams struct {
+	// OrganizationId Only include shared macros of this organization; personal ones are always included
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+}
+
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Unread Only unread (true) or only read (false) notifications
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Page Page number for pagination
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetOrganizationsParams defines parameters for GetOrganizations.
 type GetOrganizationsParams struct {
 	// Name Filter by organization name (partial match)
@@ -396,6 +1711,12 @@ type GetOrganizationsParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PutOrganizationsIDParams defines parameters for PutOrganizationsID.
+type PutOrganizationsIDParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the organization has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // GetOrganizationsIDTicketsParams defines parameters for GetOrganizationsIDTickets.
 type GetOrganizationsIDTicketsParams struct {
 	// Status Filter by ticket status
@@ -420,6 +1741,48 @@ type GetOrganizationsIDUsersParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetRecurringTemplatesParams defines parameters for GetRecurringTemplates.
+type GetRecurringTemplatesParams struct {
+	// OrganizationId Only templates creating tickets in this organization
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// IsActive Only active or only paused templates
+	IsActive *bool `form:"is_active,omitempty" json:"is_active,omitempty"`
+}
+
+// GetReportsSatisfactionParams defines parameters for GetReportsSatisfaction.
+type GetReportsSatisfactionParams struct {
+	// GroupBy Dimension to group ratings by
+	GroupBy SatisfactionGroupBy `form:"group_by" json:"group_by"`
+
+	// OrganizationId Only tickets of the organization
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// From Only ratings submitted at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only ratings submitted before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetReportsTimeParams defines parameters for GetReportsTime.
+type GetReportsTimeParams struct {
+	// GroupBy Dimension to group logged time by
+	GroupBy TimeReportGroupBy `form:"group_by" json:"group_by"`
+
+	// From First work date of the range
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To Last work date of the range, inclusive
+	To openapi_types.Date `form:"to" json:"to"`
+
+	// OrganizationId Only tickets of the organization
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// Billable Only billable or only non-billable entries
+	Billable *bool `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
 // GetTicketsParams defines parameters for GetTickets.
 type GetTicketsParams struct {
 	// Status Filter by ticket status
@@ -440,6 +1803,27 @@ type GetTicketsParams struct {
 	// AuthorId Filter by author ID
 	AuthorId *openapi_types.UUID `form:"author_id,omitempty" json:"author_id,omitempty"`
 
+	// WatcherId Filter by watcher ID (customers may only pass their own ID)
+	WatcherId *openapi_types.UUID `form:"watcher_id,omitempty" json:"watcher_id,omitempty"`
+
+	// Tags Comma-separated tags; only tickets carrying all of them are returned
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagsAny Comma-separated tags; tickets carrying at least one of them are returned
+	TagsAny *[]string `form:"tags_any,omitempty" json:"tags_any,omitempty"`
+
+	// CustomFields Custom field values to match, e.g. custom_fields[asset_tag]=LT-1042
+	CustomFields *map[string]string `json:"custom_fields,omitempty"`
+
+	// Key Comma-separated ticket keys such as ACME-1042; only tickets with one of the keys are returned
+	Key *[]string `form:"key,omitempty" json:"key,omitempty"`
+
+	// Q Full-text search over the title, description and comments; results are ordered by relevance
+	// and carry highlights. Words match by their stems, "quoted phrases" are required and -words exclude tickets.
+	// Internal comments are searched only for agents and admins. A ticket key such as ACME-1042 finds the
+	// ticket with that key instead.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
 	// Page Page number for pagination
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
@@ -447,12 +1831,54 @@ type GetTicketsParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PutTicketsIDParams defines parameters for PutTicketsID.
+type PutTicketsIDParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the ticket has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchTicketsIDAssignParams defines parameters for PatchTicketsIDAssign.
+type PatchTicketsIDAssignParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the ticket has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // GetTicketsIDCommentsParams defines parameters for GetTicketsIDComments.
 type GetTicketsIDCommentsParams struct {
 	// IncludeInternal Include internal comments (admin/agent only)
 	IncludeInternal *bool `form:"include_internal,omitempty" json:"include_internal,omitempty"`
 }
 
+// GetTicketsIDHistoryParams defines parameters for GetTicketsIDHistory.
+type GetTicketsIDHistoryParams struct {
+	// Page Page number for pagination
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchTicketsIDStatusParams defines parameters for PatchTicketsIDStatus.
+type PatchTicketsIDStatusParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the ticket has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTrashParams defines parameters for GetTrash.
+type GetTrashParams struct {
+	// Type Kind of trashed items to list
+	Type TrashItemType `form:"type" json:"type"`
+
+	// OrganizationId Only tickets and categories of the organization
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// Page Page number for pagination
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetUsersParams defines parameters for GetUsers.
 type GetUsersParams struct {
 	// Name Filter by user name (partial match)
@@ -477,6 +1903,18 @@ type GetUsersParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PutUsersIDParams defines parameters for PutUsersID.
+type PutUsersIDParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the user has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchUsersIDRoleParams defines parameters for PatchUsersIDRole.
+type PatchUsersIDRoleParams struct {
+	// IfMatch ETag the change is based on; the update fails with 412 if the user has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // GetUsersIDTicketsParams defines parameters for GetUsersIDTickets.
 type GetUsersIDTicketsParams struct {
 	// Status Filter by ticket status
@@ -498,6 +1936,21 @@ type GetUsersIDTicketsParams struct {
 // GetUsersIDTicketsParamsRelationship defines parameters for GetUsersIDTickets.
 type GetUsersIDTicketsParamsRelationship string
 
+// GetViewsIDTicketsParams defines parameters for GetViewsIDTickets.
+type GetViewsIDTicketsParams struct {
+	// Page Page number for pagination
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostCannedResponsesJSONRequestBody defines body for PostCannedResponses for application/json ContentType.
+type PostCannedResponsesJSONRequestBody = CreateCannedResponseRequest
+
+// PutCannedResponsesIDJSONRequestBody defines body for PutCannedResponsesID for application/json ContentType.
+type PutCannedResponsesIDJSONRequestBody = UpdateCannedResponseRequest
+
 // PostCategoriesJSONRequestBody defines body for PostCategories for application/json ContentType.
 type PostCategoriesJSONRequestBody = CreateCategoryRequest
 
@@ -507,27 +1960,90 @@ type PutCategoriesIDJSONRequestBody = UpdateCategoryRequest
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody = LoginRequest
 
+// PostMacrosJSONRequestBody defines body for PostMacros for application/json ContentType.
+type PostMacrosJSONRequestBody = CreateMacroRequest
+
+// PutMacrosIDJSONRequestBody defines body for PutMacrosID for application/json ContentType.
+type PutMacrosIDJSONRequestBody = UpdateMacroRequest
+
 // PostOrganizationsJSONRequestBody defines body for PostOrganizations for application/json ContentType.
 type PostOrganizationsJSONRequestBody = CreateOrganizationRequest
 
 // PutOrganizationsIDJSONRequestBody defines body for PutOrganizationsID for application/json ContentType.
 type PutOrganizationsIDJSONRequestBody = UpdateOrganizationRequest
 
+// PutOrganizationsIDAssignmentJSONRequestBody defines body for PutOrganizationsIDAssignment for application/json ContentType.
+type PutOrganizationsIDAssignmentJSONRequestBody = UpdateOrganizationAssignmentRequest
+
+// PutOrganizationsIDPriorityMatrixJSONRequestBody defines body for PutOrganizationsIDPriorityMatrix for application/json ContentType.
+type PutOrganizationsIDPriorityMatrixJSONRequestBody = UpdateOrganizationPriorityMatrixRequest
+
+// PutOrganizationsIDSettingsJSONRequestBody defines body for PutOrganizationsIDSettings for application/json ContentType.
+type PutOrganizationsIDSettingsJSONRequestBody = UpdateOrganizationSettingsRequest
+
+// PutOrganizationsIDSlaJSONRequestBody defines body for PutOrganizationsIDSla for application/json ContentType.
+type PutOrganizationsIDSlaJSONRequestBody = UpdateOrganizationSLARequest
+
+// PostOrganizationsIDTagsJSONRequestBody defines body for PostOrganizationsIDTags for application/json ContentType.
+type PostOrganizationsIDTagsJSONRequestBody = CreateOrganizationTagRequest
+
+// PutOrganizationsIDTagsNameJSONRequestBody defines body for PutOrganizationsIDTagsName for application/json ContentType.
+type PutOrganizationsIDTagsNameJSONRequestBody = UpdateOrganizationTagRequest
+
+// PutOrganizationsIDWorkflowJSONRequestBody defines body for PutOrganizationsIDWorkflow for application/json ContentType.
+type PutOrganizationsIDWorkflowJSONRequestBody = UpdateOrganizationWorkflowRequest
+
+// PostRecurringTemplatesJSONRequestBody defines body for PostRecurringTemplates for application/json ContentType.
+type PostRecurringTemplatesJSONRequestBody = CreateRecurringTemplateRequest
+
+// PutRecurringTemplatesIDJSONRequestBody defines body for PutRecurringTemplatesID for application/json ContentType.
+type PutRecurringTemplatesIDJSONRequestBody = UpdateRecurringTemplateRequest
+
 // PostTicketsJSONRequestBody defines body for PostTickets for application/json ContentType.
 type PostTicketsJSONRequestBody = CreateTicketRequest
 
+// PostTicketsBulkJSONRequestBody defines body for PostTicketsBulk for application/json ContentType.
+type PostTicketsBulkJSONRequestBody = BulkTicketRequest
+
 // PutTicketsIDJSONRequestBody defines body for PutTicketsID for application/json ContentType.
 type PutTicketsIDJSONRequestBody = UpdateTicketRequest
 
 // PatchTicketsIDAssignJSONRequestBody defines body for PatchTicketsIDAssign for application/json ContentType.
 type PatchTicketsIDAssignJSONRequestBody = AssignTicketRequest
 
+// PostTicketsIDAttachmentsMultipartRequestBody defines body for PostTicketsIDAttachments for multipart/form-data ContentType.
+type PostTicketsIDAttachmentsMultipartRequestBody = UploadAttachmentRequest
+
 // PostTicketsIDCommentsJSONRequestBody defines body for PostTicketsIDComments for application/json ContentType.
 type PostTicketsIDCommentsJSONRequestBody = CreateCommentRequest
 
+// PutTicketsIDCommentsCommentIDJSONRequestBody defines body for PutTicketsIDCommentsCommentID for application/json ContentType.
+type PutTicketsIDCommentsCommentIDJSONRequestBody = UpdateCommentRequest
+
+// PostTicketsIDMergeJSONRequestBody defines body for PostTicketsIDMerge for application/json ContentType.
+type PostTicketsIDMergeJSONRequestBody = MergeTicketsRequest
+
+// PostTicketsIDRelationsJSONRequestBody defines body for PostTicketsIDRelations for application/json ContentType.
+type PostTicketsIDRelationsJSONRequestBody = CreateTicketRelationRequest
+
+// PostTicketsIDSplitJSONRequestBody defines body for PostTicketsIDSplit for application/json ContentType.
+type PostTicketsIDSplitJSONRequestBody = SplitTicketRequest
+
 // PatchTicketsIDStatusJSONRequestBody defines body for PatchTicketsIDStatus for application/json ContentType.
 type PatchTicketsIDStatusJSONRequestBody = UpdateTicketStatusRequest
 
+// PostTicketsIDSurveyJSONRequestBody defines body for PostTicketsIDSurvey for application/json ContentType.
+type PostTicketsIDSurveyJSONRequestBody = SubmitTicketSurveyRequest
+
+// PostTicketsIDWatchersJSONRequestBody defines body for PostTicketsIDWatchers for application/json ContentType.
+type PostTicketsIDWatchersJSONRequestBody = AddTicketWatcherRequest
+
+// PostTicketsIDWorklogsJSONRequestBody defines body for PostTicketsIDWorklogs for application/json ContentType.
+type PostTicketsIDWorklogsJSONRequestBody = WorkLogRequest
+
+// PutTicketsIDWorklogsWorklogIDJSONRequestBody defines body for PutTicketsIDWorklogsWorklogID for application/json ContentType.
+type PutTicketsIDWorklogsWorklogIDJSONRequestBody = WorkLogRequest
+
 // PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
 type PostUsersJSONRequestBody = CreateUserRequest
 
@@ -536,3 +2052,9 @@ type PutUsersIDJSONRequestBody = UpdateUserRequest
 
 // PatchUsersIDRoleJSONRequestBody defines body for PatchUsersIDRole for application/json ContentType.
 type PatchUsersIDRoleJSONRequestBody = UpdateUserRoleRequest
+
+// PostViewsJSONRequestBody defines body for PostViews for application/json ContentType.
+type PostViewsJSONRequestBody = CreateTicketViewRequest
+
+// PutViewsIDJSONRequestBody defines body for PutViewsID for application/json ContentType.
+type PutViewsIDJSONRequestBody = UpdateTicketViewRequest
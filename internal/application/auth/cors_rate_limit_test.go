@@ -129,11 +129,17 @@ func (s *AuthSuite) setupServerWithGlobalRateLimit(requestsPerSecond int) *echo.
 		s.TicketsRepo,
 		s.OrganizationsRepo,
 		s.CategoriesRepo,
+		s.MacrosRepo,
+		s.ViewsRepo,
+		s.RecurringRepo,
+		s.NotificationsRepo,
+		s.BlobStore,
 		health.NoopPinger{},
 		"test-jwt-signing-key",
 		time.Hour,
 		[]string{"*"},
 		requestsPerSecond,
+		30*24*time.Hour,
 	)
 	s.Require().NoError(err)
 
@@ -0,0 +1,148 @@
+package autoclose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// JobName identifies the auto-close job and its lease
+const JobName = "ticket-auto-close"
+
+const pageSize = 200
+
+type TicketRepository interface {
+	UpdateTicket(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*tickets.Ticket) (bool, error),
+	) (*tickets.Ticket, error)
+	ListTickets(ctx context.Context, filter queries.TicketFilter) ([]*tickets.Ticket, error)
+}
+
+type OrganizationRepository interface {
+	GetOrganization(ctx context.Context, id uuid.UUID) (*organizations.Organization, error)
+}
+
+// policy is the auto-close configuration of one organization
+type policy struct {
+	after    time.Duration
+	workflow *tickets.Workflow
+}
+
+// Closer closes resolved tickets that have waited for a reply longer than the grace period of their organization
+type Closer struct {
+	repo    TicketRepository
+	orgRepo OrganizationRepository
+}
+
+func NewCloser(repo TicketRepository, orgRepo OrganizationRepository) *Closer {
+	return &Closer{
+		repo:    repo,
+		orgRepo: orgRepo,
+	}
+}
+
+// CloseStale scans resolved tickets once and closes those past the grace period.
+// A failure on one ticket does not stop the scan; all failures are returned together.
+func (c *Closer) CloseStale(ctx context.Context, now time.Time) error {
+	policies := make(map[uuid.UUID]policy)
+	filter := queries.TicketFilter{
+		BaseFilter: queries.BaseFilter{
+			Limit:     pageSize,
+			SortBy:    "created_at",
+			SortOrder: "asc",
+		},
+		StatusCategories: []tickets.Status{tickets.StatusResolved},
+	}
+
+	var errs []error
+	for {
+		page, err := c.repo.ListTickets(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list resolved tickets: %w", err)
+		}
+
+		closed := 0
+		for _, ticket := range page {
+			orgPolicy, policyErr := c.policy(ctx, policies, ticket.OrganizationID())
+			if policyErr != nil {
+				errs = append(errs, policyErr)
+				continue
+			}
+			if !ticket.AutoCloseDue(orgPolicy.after, now) {
+				continue
+			}
+			ok, closeErr := c.close(ctx, ticket.ID(), orgPolicy, now)
+			if closeErr != nil {
+				errs = append(errs, closeErr)
+			}
+			if ok {
+				closed++
+			}
+		}
+
+		if len(page) < filter.Limit {
+			return errors.Join(errs...)
+		}
+		// Closed tickets leave the resolved set, so the next page starts that much earlier
+		filter.Offset += filter.Limit - closed
+	}
+}
+
+func (c *Closer) close(ctx context.Context, ticketID uuid.UUID, orgPolicy policy, now time.Time) (bool, error) {
+	closed := false
+	_, err := c.repo.UpdateTicket(ctx, ticketID, func(ticket *tickets.Ticket) (bool, error) {
+		var closeErr error
+		closed, closeErr = ticket.AutoClose(orgPolicy.workflow, orgPolicy.after, now)
+		return closed, closeErr
+	})
+	if errors.Is(err, tickets.ErrTicketNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to auto-close ticket %s: %w", ticketID, err)
+	}
+
+	if closed {
+		slog.InfoContext(ctx, "ticket closed automatically",
+			"ticket_id", ticketID.String(),
+			"grace_period", orgPolicy.after.String(),
+		)
+	}
+	return closed, nil
+}
+
+// policy returns the organization auto-close configuration, caching it for the duration of one scan
+func (c *Closer) policy(ctx context.Context, policies map[uuid.UUID]policy, orgID uuid.UUID) (policy, error) {
+	if orgPolicy, ok := policies[orgID]; ok {
+		return orgPolicy, nil
+	}
+
+	orgPolicy := policy{
+		after:    organizations.DefaultSettings().AutoCloseAfter,
+		workflow: tickets.DefaultWorkflow(),
+	}
+	org, err := c.orgRepo.GetOrganization(ctx, orgID)
+	switch {
+	case errors.Is(err, organizations.ErrOrganizationNotFound):
+	case err != nil:
+		return policy{}, fmt.Errorf("failed to load organization %s: %w", orgID, err)
+	default:
+		orgPolicy = policy{
+			after:    org.Settings().AutoCloseAfter,
+			workflow: org.Workflow(),
+		}
+	}
+
+	policies[orgID] = orgPolicy
+	return orgPolicy, nil
+}
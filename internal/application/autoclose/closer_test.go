@@ -0,0 +1,92 @@
+package autoclose_test
+
+import (
+	"context"
+	"time"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+func (s *AutoCloseSuite) createOrganization(autoCloseAfter time.Duration) uuid.UUID {
+	ctx := context.Background()
+	org, err := s.OrganizationsRepo.CreateOrganization(ctx, func() (*organizations.Organization, error) {
+		return organizations.NewOrganization(uuid.New(), "Auto-Close Org", "autoclose.com", nil)
+	})
+	s.Require().NoError(err)
+
+	_, err = s.OrganizationsRepo.UpdateOrganization(ctx, org.ID(),
+		func(org *organizations.Organization) (bool, error) {
+			settings := org.Settings()
+			settings.AutoCloseAfter = autoCloseAfter
+			org.UpdateSettings(settings)
+			return true, nil
+		})
+	s.Require().NoError(err)
+	return org.ID()
+}
+
+func (s *AutoCloseSuite) createTicket(orgID uuid.UUID, statuses ...tickets.Status) uuid.UUID {
+	ticket, err := s.TicketsRepo.CreateTicket(context.Background(), func() (*tickets.Ticket, error) {
+		ticket, err := tickets.NewTicket(
+			uuid.New(), "Printer is broken", "The office printer does not print",
+			tickets.PriorityNormal, orgID, uuid.New(), nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, status := range statuses {
+			if statusErr := ticket.ChangeStatus(status); statusErr != nil {
+				return nil, statusErr
+			}
+		}
+		return ticket, nil
+	})
+	s.Require().NoError(err)
+	return ticket.ID()
+}
+
+func (s *AutoCloseSuite) getTicket(id uuid.UUID) *tickets.Ticket {
+	ticket, err := s.TicketsRepo.GetTicket(context.Background(), id)
+	s.Require().NoError(err)
+	return ticket
+}
+
+func (s *AutoCloseSuite) TestCloseStale() {
+	ctx := context.Background()
+	hourlyOrg := s.createOrganization(time.Hour)
+	disabledOrg := s.createOrganization(0)
+
+	hourly := s.createTicket(hourlyOrg, tickets.StatusInProgress, tickets.StatusResolved)
+	disabled := s.createTicket(disabledOrg, tickets.StatusInProgress, tickets.StatusResolved)
+	inProgress := s.createTicket(hourlyOrg, tickets.StatusInProgress)
+	// Tickets of unknown organizations fall back to the default grace period of 7 days
+	defaulted := s.createTicket(uuid.New(), tickets.StatusInProgress, tickets.StatusResolved)
+
+	s.Run("Tickets within the grace period stay resolved", func() {
+		s.Require().NoError(s.closer.CloseStale(ctx, time.Now().Add(30*time.Minute)))
+		s.Equal(tickets.StatusResolved, s.getTicket(hourly).Status())
+	})
+
+	s.Run("Stale tickets are closed according to their organization", func() {
+		s.Require().NoError(s.closer.CloseStale(ctx, time.Now().Add(2*time.Hour)))
+
+		closed := s.getTicket(hourly)
+		s.Equal(tickets.StatusClosed, closed.Status())
+		s.Require().Len(closed.Comments(), 1)
+		s.Equal(tickets.SystemAuthorID(), closed.Comments()[0].AuthorID)
+		s.Contains(closed.Comments()[0].Content, "1 hour")
+
+		s.Equal(tickets.StatusResolved, s.getTicket(defaulted).Status())
+		s.Equal(tickets.StatusInProgress, s.getTicket(inProgress).Status())
+	})
+
+	s.Run("Default and disabled grace periods", func() {
+		s.Require().NoError(s.closer.CloseStale(ctx, time.Now().Add(8*24*time.Hour)))
+		s.Equal(tickets.StatusClosed, s.getTicket(defaulted).Status())
+		s.Equal(tickets.StatusResolved, s.getTicket(disabled).Status(), "auto-close is disabled")
+		s.Len(s.getTicket(hourly).Comments(), 1, "closed tickets are not touched again")
+	})
+}
@@ -0,0 +1,26 @@
+package autoclose_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+	"simpleservicedesk/internal/application/autoclose"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AutoCloseSuite struct {
+	application.ServerSuite
+
+	closer *autoclose.Closer
+}
+
+func (s *AutoCloseSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+	s.closer = autoclose.NewCloser(s.TicketsRepo, s.OrganizationsRepo)
+}
+
+func TestAutoCloseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(AutoCloseSuite))
+}
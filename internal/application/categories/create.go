@@ -29,12 +29,19 @@ func (h CategoryHandlers) PostCategories(c echo.Context) error {
 
 	// Create the category
 	category, err := h.repo.CreateCategory(ctx, func() (*categories.Category, error) {
-		return categories.CreateCategory(
+		category, createErr := categories.CreateCategory(
 			req.Name,
 			stringValue(req.Description),
 			organizationID,
 			parentID,
 		)
+		if createErr != nil {
+			return nil, createErr
+		}
+		if _, schemaErr := h.updateCategoryCustomFields(req.CustomFields, category); schemaErr != nil {
+			return nil, schemaErr
+		}
+		return category, nil
 	})
 	if err != nil {
 		return h.handleCategoryError(c, err)
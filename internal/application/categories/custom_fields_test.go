@@ -0,0 +1,83 @@
+package categories_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *CategoriesSuite) sendCategoryRequest(method, path string, body any) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewBuffer(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *CategoriesSuite) TestCategoryCustomFields() {
+	org, err := s.OrganizationsRepo.CreateOrganization(context.Background(),
+		func() (*organizations.Organization, error) {
+			return organizations.CreateOrganization("Custom Fields Org", "fields.com")
+		})
+	s.Require().NoError(err)
+
+	required := true
+	fields := []openapi.CustomFieldDefinition{
+		{Key: "asset_tag", Label: "Asset tag", Type: openapi.Text, Required: &required},
+		{Key: "os", Label: "Operating system", Type: openapi.Enum, Options: &[]string{"linux", "macos"}},
+	}
+
+	rec := s.sendCategoryRequest(http.MethodPost, "/categories", openapi.CreateCategoryRequest{
+		Name:           "Hardware",
+		OrganizationId: org.ID(),
+		CustomFields:   &fields,
+	})
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var created openapi.CreateCategoryResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	categoryPath := "/categories/" + created.Id.String()
+
+	s.Run("Schema is returned with the category", func() {
+		rec = s.sendCategoryRequest(http.MethodGet, categoryPath, nil)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var category openapi.GetCategoryResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &category))
+		s.Require().NotNil(category.CustomFields)
+		s.Require().Len(*category.CustomFields, 2)
+		s.True(*(*category.CustomFields)[0].Required)
+		s.Equal([]string{"linux", "macos"}, *(*category.CustomFields)[1].Options)
+	})
+
+	s.Run("Invalid schemas are rejected", func() {
+		invalid := []openapi.CustomFieldDefinition{{Key: "os", Label: "Operating system", Type: openapi.Enum}}
+		rec = s.sendCategoryRequest(http.MethodPost, "/categories", openapi.CreateCategoryRequest{
+			Name:           "Software",
+			OrganizationId: org.ID(),
+			CustomFields:   &invalid,
+		})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		invalid = []openapi.CustomFieldDefinition{{Key: "asset tag", Label: "Asset tag", Type: openapi.Text}}
+		rec = s.sendCategoryRequest(http.MethodPut, categoryPath, openapi.UpdateCategoryRequest{CustomFields: &invalid})
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Empty list removes the schema", func() {
+		rec = s.sendCategoryRequest(http.MethodPut, categoryPath,
+			openapi.UpdateCategoryRequest{CustomFields: &[]openapi.CustomFieldDefinition{}})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var category openapi.GetCategoryResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &category))
+		s.Nil(category.CustomFields)
+	})
+}
@@ -3,14 +3,30 @@ package categories
 import (
 	"net/http"
 
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/pkg/echomiddleware"
+
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// DeleteCategoriesID moves the category to the trash
 func (h CategoryHandlers) DeleteCategoriesID(c echo.Context, id openapi_types.UUID) error {
 	ctx := c.Request().Context()
 
-	err := h.repo.DeleteCategory(ctx, id)
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		msg := "unauthorized"
+		return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: &msg})
+	}
+	deletedBy, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		msg := "unauthorized"
+		return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: &msg})
+	}
+
+	err = h.repo.DeleteCategory(ctx, id, deletedBy)
 	if err != nil {
 		return h.handleCategoryError(c, err)
 	}
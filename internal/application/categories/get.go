@@ -3,6 +3,8 @@ package categories
 import (
 	"net/http"
 
+	"simpleservicedesk/pkg/etag"
+
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -15,6 +17,7 @@ func (h CategoryHandlers) GetCategoriesID(c echo.Context, id openapi_types.UUID)
 		return h.handleCategoryError(c, err)
 	}
 
+	etag.Set(c, category.Version())
 	response := h.categoryToResponse(category)
 	return c.JSON(http.StatusOK, response)
 }
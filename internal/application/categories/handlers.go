@@ -19,7 +19,7 @@ type CategoryRepository interface {
 	) (*categories.Category, error)
 	GetCategory(ctx context.Context, id uuid.UUID) (*categories.Category, error)
 	ListCategories(ctx context.Context, filter queries.CategoryFilter) ([]*categories.Category, error)
-	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	DeleteCategory(ctx context.Context, id, deletedBy uuid.UUID) error
 }
 
 type TicketRepository interface {
@@ -7,6 +7,7 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -41,6 +42,7 @@ func (h CategoryHandlers) categoryToResponse(category *categories.Category) open
 		OrganizationId: &organizationID,
 		ParentId:       parentID,
 		IsActive:       &isActive,
+		CustomFields:   customFieldSchemaToResponse(category.CustomFieldSchema()),
 		CreatedAt:      &createdAt,
 		UpdatedAt:      &updatedAt,
 	}
@@ -54,9 +56,15 @@ func (h CategoryHandlers) handleCategoryError(c echo.Context, err error) error {
 	case errors.Is(err, categories.ErrCategoryAlreadyExist):
 		msg := "category already exists"
 		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
-	case errors.Is(err, categories.ErrCategoryValidation):
+	case errors.Is(err, categories.ErrCategoryValidation) || errors.Is(err, tickets.ErrInvalidCustomField):
 		msg := err.Error()
 		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, categories.ErrVersionConflict):
+		msg := err.Error()
+		return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, categories.ErrCategoryHasChildren) || errors.Is(err, categories.ErrConcurrentUpdate):
+		msg := err.Error()
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
 	case errors.Is(err, categories.ErrCircularReference):
 		msg := "circular reference detected"
 		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
@@ -114,6 +122,13 @@ func (h CategoryHandlers) applyCategoryUpdates(
 		hasChanges = true
 	}
 
+	// Replace custom field schema if provided
+	if changed, err := h.updateCategoryCustomFields(req.CustomFields, cat); err != nil {
+		return false, err
+	} else if changed {
+		hasChanges = true
+	}
+
 	return hasChanges, nil
 }
 
@@ -180,3 +195,69 @@ func (h CategoryHandlers) updateCategoryActiveStatus(isActive *bool, cat *catego
 	}
 	return false
 }
+
+// updateCategoryCustomFields replaces the custom field schema; an empty list removes it
+func (h CategoryHandlers) updateCategoryCustomFields(
+	fields *[]openapi.CustomFieldDefinition,
+	cat *categories.Category,
+) (bool, error) {
+	if fields == nil {
+		return false, nil
+	}
+	if len(*fields) == 0 {
+		if cat.CustomFieldSchema() == nil {
+			return false, nil
+		}
+		cat.ResetCustomFieldSchema()
+		return true, nil
+	}
+
+	definitions := make([]tickets.CustomFieldDefinition, 0, len(*fields))
+	for _, field := range *fields {
+		definition := tickets.CustomFieldDefinition{
+			Key:   field.Key,
+			Label: field.Label,
+			Type:  tickets.CustomFieldType(field.Type),
+		}
+		if field.Required != nil {
+			definition.Required = *field.Required
+		}
+		if field.Options != nil {
+			definition.Options = *field.Options
+		}
+		definitions = append(definitions, definition)
+	}
+
+	schema, err := tickets.NewCustomFieldSchema(definitions)
+	if err != nil {
+		return false, err
+	}
+	if setErr := cat.SetCustomFieldSchema(schema); setErr != nil {
+		return false, setErr
+	}
+	return true, nil
+}
+
+// customFieldSchemaToResponse returns nil for categories without custom fields
+func customFieldSchemaToResponse(schema *tickets.CustomFieldSchema) *[]openapi.CustomFieldDefinition {
+	if schema == nil {
+		return nil
+	}
+
+	fields := make([]openapi.CustomFieldDefinition, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		required := field.Required
+		response := openapi.CustomFieldDefinition{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     openapi.CustomFieldType(field.Type),
+			Required: &required,
+		}
+		if len(field.Options) > 0 {
+			options := field.Options
+			response.Options = &options
+		}
+		fields = append(fields, response)
+	}
+	return &fields
+}
@@ -135,6 +135,7 @@ func categoryTicketToResponse(ticket *ticketdomain.Ticket) openapi.GetTicketResp
 	title := ticket.Title()
 	description := ticket.Description()
 	status := openapi.TicketStatus(ticket.Status().String())
+	statusCategory := openapi.TicketStatusCategory(ticket.StatusCategory().String())
 	priority := openapi.TicketPriority(ticket.Priority().String())
 	organizationID := ticket.OrganizationID()
 	authorID := ticket.AuthorID()
@@ -146,6 +147,7 @@ func categoryTicketToResponse(ticket *ticketdomain.Ticket) openapi.GetTicketResp
 		Title:          &title,
 		Description:    &description,
 		Status:         &status,
+		StatusCategory: &statusCategory,
 		Priority:       &priority,
 		OrganizationId: &organizationID,
 		AuthorId:       &authorID,
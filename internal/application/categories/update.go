@@ -5,25 +5,40 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h CategoryHandlers) PutCategoriesID(c echo.Context, id openapi_types.UUID) error {
+func (h CategoryHandlers) PutCategoriesID(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PutCategoriesIDParams,
+) error {
 	ctx := c.Request().Context()
 	var req openapi.UpdateCategoryRequest
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
+	precondition, err := etag.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
 
 	category, err := h.repo.UpdateCategory(ctx, id, func(cat *categories.Category) (bool, error) {
+		if !precondition.Matches(cat.Version()) {
+			return false, categories.ErrVersionConflict
+		}
 		return h.applyCategoryUpdates(&req, cat)
 	})
 	if err != nil {
 		return h.handleCategoryError(c, err)
 	}
 
+	etag.Set(c, category.Version())
+
 	response := h.categoryToResponse(category)
 	return c.JSON(http.StatusOK, response)
 }
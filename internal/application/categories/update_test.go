@@ -161,3 +161,50 @@ func (s *CategoriesSuite) TestUpdateCategory() {
 		s.Require().Equal(http.StatusBadRequest, invalidUpdateRec.Code)
 	})
 }
+
+func (s *CategoriesSuite) TestUpdateCategoryIfMatch() {
+	post := func(path string, payload any) []byte {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		return rec.Body.Bytes()
+	}
+	domain := "concurrent.com"
+	var orgResp openapi.CreateOrganizationResponse
+	s.Require().NoError(json.Unmarshal(post("/organizations", openapi.CreateOrganizationRequest{
+		Name:   "Concurrent Organization",
+		Domain: &domain,
+	}), &orgResp))
+	var createResp openapi.CreateCategoryResponse
+	s.Require().NoError(json.Unmarshal(post("/categories", openapi.CreateCategoryRequest{
+		Name:           "Concurrent Category",
+		OrganizationId: *orgResp.Id,
+	}), &createResp))
+	path := "/categories/" + createResp.Id.String()
+
+	update := func(name, ifMatch string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(openapi.UpdateCategoryRequest{Name: &name})
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", ifMatch)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		return rec
+	}
+
+	getRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(getRec, httptest.NewRequest(http.MethodGet, path, nil))
+	s.Require().Equal(http.StatusOK, getRec.Code)
+	loaded := getRec.Header().Get("ETag")
+	s.Require().Equal(`"1"`, loaded)
+
+	rec := update("Renamed Category", loaded)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+
+	rec = update("Lost Update", loaded)
+	s.Equal(http.StatusPreconditionFailed, rec.Code)
+}
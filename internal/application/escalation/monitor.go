@@ -0,0 +1,138 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// JobName identifies the SLA escalation job and its lease
+const JobName = "sla-escalation"
+
+const pageSize = 200
+
+type TicketRepository interface {
+	UpdateTicket(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*tickets.Ticket) (bool, error),
+	) (*tickets.Ticket, error)
+	ListTickets(ctx context.Context, filter queries.TicketFilter) ([]*tickets.Ticket, error)
+}
+
+type OrganizationRepository interface {
+	GetOrganization(ctx context.Context, id uuid.UUID) (*organizations.Organization, error)
+}
+
+// Monitor finds open tickets that are approaching or past their SLA targets
+// and applies the escalation rules of their organizations
+type Monitor struct {
+	repo    TicketRepository
+	orgRepo OrganizationRepository
+}
+
+func NewMonitor(repo TicketRepository, orgRepo OrganizationRepository) *Monitor {
+	return &Monitor{
+		repo:    repo,
+		orgRepo: orgRepo,
+	}
+}
+
+// Check scans open tickets once and escalates those whose rules are due.
+// A failure on one ticket does not stop the scan; all failures are returned together.
+func (m *Monitor) Check(ctx context.Context, now time.Time) error {
+	configs := make(map[uuid.UUID]*tickets.SLAConfig)
+	filter := queries.TicketFilter{
+		BaseFilter: queries.BaseFilter{
+			Limit:     pageSize,
+			SortBy:    "created_at",
+			SortOrder: "asc",
+		},
+		// Tickets waiting on the customer have their SLA clock paused and cannot become due
+		StatusCategories: []tickets.Status{tickets.StatusNew, tickets.StatusInProgress},
+	}
+
+	var errs []error
+	for {
+		page, err := m.repo.ListTickets(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list open tickets: %w", err)
+		}
+
+		for _, ticket := range page {
+			config, configErr := m.slaConfig(ctx, configs, ticket.OrganizationID())
+			if configErr != nil {
+				errs = append(errs, configErr)
+				continue
+			}
+			if len(ticket.DueEscalations(config, now)) == 0 {
+				continue
+			}
+			if escalateErr := m.escalate(ctx, ticket.ID(), config, now); escalateErr != nil {
+				errs = append(errs, escalateErr)
+			}
+		}
+
+		if len(page) < filter.Limit {
+			return errors.Join(errs...)
+		}
+		filter.Offset += filter.Limit
+	}
+}
+
+func (m *Monitor) escalate(ctx context.Context, ticketID uuid.UUID, config *tickets.SLAConfig, now time.Time) error {
+	var applied []tickets.EscalationRule
+	_, err := m.repo.UpdateTicket(ctx, ticketID, func(ticket *tickets.Ticket) (bool, error) {
+		var applyErr error
+		applied, applyErr = ticket.ApplyEscalations(config, now)
+		return len(applied) > 0, applyErr
+	})
+	if errors.Is(err, tickets.ErrTicketNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to escalate ticket %s: %w", ticketID, err)
+	}
+
+	for _, rule := range applied {
+		slog.InfoContext(ctx, "ticket escalated",
+			"ticket_id", ticketID.String(),
+			"rule_id", rule.ID.String(),
+			"rule", rule.Name,
+			"target", string(rule.Target),
+		)
+	}
+	return nil
+}
+
+// slaConfig returns the organization SLA configuration, caching it for the duration of one scan
+func (m *Monitor) slaConfig(
+	ctx context.Context,
+	configs map[uuid.UUID]*tickets.SLAConfig,
+	orgID uuid.UUID,
+) (*tickets.SLAConfig, error) {
+	if config, ok := configs[orgID]; ok {
+		return config, nil
+	}
+
+	config := tickets.DefaultSLAConfig()
+	org, err := m.orgRepo.GetOrganization(ctx, orgID)
+	switch {
+	case errors.Is(err, organizations.ErrOrganizationNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to load organization %s: %w", orgID, err)
+	case org.SLAConfig() != nil:
+		config = org.SLAConfig()
+	}
+
+	configs[orgID] = config
+	return config, nil
+}
@@ -0,0 +1,140 @@
+package escalation_test
+
+import (
+	"context"
+	"time"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+func (s *EscalationSuite) createEscalationOrganization(rules []tickets.EscalationRule) uuid.UUID {
+	ctx := context.Background()
+	config, err := tickets.NewSLAConfig(nil, nil, rules)
+	s.Require().NoError(err)
+
+	org, err := s.OrganizationsRepo.CreateOrganization(ctx, func() (*organizations.Organization, error) {
+		return organizations.NewOrganization(uuid.New(), "Escalation Org", "escalation.com", nil)
+	})
+	s.Require().NoError(err)
+
+	_, err = s.OrganizationsRepo.UpdateOrganization(ctx, org.ID(),
+		func(org *organizations.Organization) (bool, error) {
+			return true, org.SetSLAConfig(config)
+		})
+	s.Require().NoError(err)
+	return org.ID()
+}
+
+func (s *EscalationSuite) createEscalationTicket(
+	orgID uuid.UUID,
+	priority tickets.Priority,
+	age time.Duration,
+) *tickets.Ticket {
+	ticket, err := s.TicketsRepo.CreateTicket(context.Background(), func() (*tickets.Ticket, error) {
+		ticket, err := tickets.NewTicket(
+			uuid.New(), "Printer is broken", "The office printer does not print", priority, orgID, uuid.New(), nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ticket.SetCreatedAt(time.Now().Add(-age))
+		return ticket, nil
+	})
+	s.Require().NoError(err)
+	return ticket
+}
+
+func (s *EscalationSuite) getTicket(id uuid.UUID) *tickets.Ticket {
+	ticket, err := s.TicketsRepo.GetTicket(context.Background(), id)
+	s.Require().NoError(err)
+	return ticket
+}
+
+func (s *EscalationSuite) TestCheck() {
+	ctx := context.Background()
+	teamLeadID := uuid.New()
+	orgID := s.createEscalationOrganization([]tickets.EscalationRule{
+		{Name: "Due soon", Target: tickets.SLATargetResolution, Before: time.Hour, Comment: "Resolution is due soon"},
+		{Name: "Breached", Target: tickets.SLATargetResolution, RaisePriority: true, AssignTo: &teamLeadID},
+	})
+
+	// Обычный приоритет: 24 часа на решение
+	fresh := s.createEscalationTicket(orgID, tickets.PriorityNormal, time.Hour)
+	approaching := s.createEscalationTicket(orgID, tickets.PriorityNormal, 23*time.Hour+30*time.Minute)
+	breached := s.createEscalationTicket(orgID, tickets.PriorityNormal, 30*time.Hour)
+	otherOrg := s.createEscalationTicket(uuid.New(), tickets.PriorityNormal, 30*time.Hour)
+
+	s.Require().NoError(s.monitor.Check(ctx, time.Now()))
+
+	s.Empty(s.getTicket(fresh.ID()).Escalations())
+	s.Empty(s.getTicket(otherOrg.ID()).Escalations(), "organizations without rules are not escalated")
+
+	escalated := s.getTicket(approaching.ID())
+	s.Require().Len(escalated.Escalations(), 1)
+	s.Equal("Due soon", escalated.Escalations()[0].RuleName)
+	s.Require().Len(escalated.Comments(), 1)
+	s.True(escalated.Comments()[0].IsInternal)
+	s.Equal(tickets.PriorityNormal, escalated.Priority())
+
+	escalated = s.getTicket(breached.ID())
+	s.Len(escalated.Escalations(), 2)
+	s.Equal(tickets.PriorityHigh, escalated.Priority())
+	s.Require().NotNil(escalated.AssigneeID())
+	s.Equal(teamLeadID, *escalated.AssigneeID())
+
+	events, err := s.TicketsRepo.ListTicketEvents(ctx, queries.TicketEventFilter{
+		TicketID:        breached.ID(),
+		IncludeInternal: true,
+	})
+	s.Require().NoError(err)
+	var escalationEvents int
+	for _, event := range events {
+		if event.Type == tickets.EventEscalated {
+			escalationEvents++
+		}
+	}
+	s.Equal(2, escalationEvents)
+
+	s.Run("Rules fire only once", func() {
+		s.Require().NoError(s.monitor.Check(ctx, time.Now()))
+
+		escalated = s.getTicket(breached.ID())
+		s.Len(escalated.Escalations(), 2)
+		s.Equal(tickets.PriorityHigh, escalated.Priority())
+		s.Len(s.getTicket(approaching.ID()).Comments(), 1)
+	})
+}
+
+func (s *EscalationSuite) TestCheck_SkipsPausedAndResolvedTickets() {
+	ctx := context.Background()
+	orgID := s.createEscalationOrganization([]tickets.EscalationRule{
+		{Name: "Breached", Target: tickets.SLATargetResolution, RaisePriority: true},
+	})
+
+	waiting := s.createEscalationTicket(orgID, tickets.PriorityNormal, 30*time.Hour)
+	resolved := s.createEscalationTicket(orgID, tickets.PriorityNormal, 30*time.Hour)
+	for id, statuses := range map[uuid.UUID][]tickets.Status{
+		waiting.ID():  {tickets.StatusInProgress, tickets.StatusWaiting},
+		resolved.ID(): {tickets.StatusInProgress, tickets.StatusResolved},
+	} {
+		_, err := s.TicketsRepo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+			for _, status := range statuses {
+				if err := ticket.ChangeStatus(status); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(s.monitor.Check(ctx, time.Now()))
+
+	s.Empty(s.getTicket(waiting.ID()).Escalations())
+	s.Equal(tickets.PriorityNormal, s.getTicket(waiting.ID()).Priority())
+	s.Empty(s.getTicket(resolved.ID()).Escalations())
+}
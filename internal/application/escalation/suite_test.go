@@ -0,0 +1,26 @@
+package escalation_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+	"simpleservicedesk/internal/application/escalation"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EscalationSuite struct {
+	application.ServerSuite
+
+	monitor *escalation.Monitor
+}
+
+func (s *EscalationSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+	s.monitor = escalation.NewMonitor(s.TicketsRepo, s.OrganizationsRepo)
+}
+
+func TestEscalationSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(EscalationSuite))
+}
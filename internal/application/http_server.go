@@ -14,11 +14,18 @@ import (
 	"simpleservicedesk/internal/application/auth"
 	"simpleservicedesk/internal/application/categories"
 	"simpleservicedesk/internal/application/health"
+	"simpleservicedesk/internal/application/macros"
+	"simpleservicedesk/internal/application/notifications"
 	"simpleservicedesk/internal/application/organizations"
+	"simpleservicedesk/internal/application/recurring"
+	"simpleservicedesk/internal/application/reports"
 	"simpleservicedesk/internal/application/tickets"
+	"simpleservicedesk/internal/application/trash"
 	"simpleservicedesk/internal/application/users"
+	"simpleservicedesk/internal/application/views"
 	userdomain "simpleservicedesk/internal/domain/users"
 	appmiddleware "simpleservicedesk/pkg/echomiddleware"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
@@ -33,6 +40,12 @@ type httpServer struct {
 	tickets.TicketHandlers
 	categories.CategoryHandlers
 	organizations.OrganizationHandlers
+	macros.MacroHandlers
+	views.ViewHandlers
+	recurring.RecurringHandlers
+	trash.TrashHandlers
+	reports.ReportHandlers
+	notifications.NotificationHandlers
 }
 
 func SetupHTTPServer(
@@ -40,11 +53,17 @@ func SetupHTTPServer(
 	ticketRepo TicketRepository,
 	organizationRepo OrganizationRepository,
 	categoryRepo CategoryRepository,
+	macroRepo MacroRepository,
+	viewRepo ViewRepository,
+	recurringRepo RecurringTemplateRepository,
+	notificationRepo NotificationRepository,
+	blobStore BlobStore,
 	pinger health.Pinger,
 	jwtSigningKey string,
 	jwtExpiration time.Duration,
 	corsAllowedOrigins []string,
 	rateLimitRPS int,
+	trashRetention time.Duration,
 ) (*echo.Echo, error) {
 	e := echo.New()
 	e.IPExtractor = echo.ExtractIPDirect()
@@ -65,9 +84,11 @@ func SetupHTTPServer(
 		AllowHeaders: []string{
 			echo.HeaderContentType,
 			echo.HeaderAuthorization,
+			etag.HeaderIfMatch,
 		},
 		ExposeHeaders: []string{
 			echo.HeaderXRequestID,
+			etag.HeaderETag,
 		},
 	}))
 	e.Use(newRateLimiterMiddleware(
@@ -107,9 +128,24 @@ func SetupHTTPServer(
 	server.Handlers = auth.SetupHandlers(authService)
 
 	server.UserHandlers = users.SetupHandlers(userRepo)
-	server.TicketHandlers = tickets.SetupHandlers(ticketRepo, userRepo)
+	server.TicketHandlers = tickets.SetupHandlers(
+		ticketRepo,
+		userRepo,
+		organizationRepo,
+		categoryRepo,
+		macroRepo,
+		viewRepo,
+		blobStore,
+		notificationRepo,
+	)
 	server.CategoryHandlers = categories.SetupHandlers(categoryRepo, ticketRepo)
 	server.OrganizationHandlers = organizations.SetupHandlers(organizationRepo)
+	server.MacroHandlers = macros.SetupHandlers(macroRepo)
+	server.ViewHandlers = views.SetupHandlers(viewRepo, userRepo)
+	server.RecurringHandlers = recurring.SetupHandlers(recurringRepo, organizationRepo, categoryRepo, userRepo)
+	server.TrashHandlers = trash.SetupHandlers(ticketRepo, categoryRepo, organizationRepo, blobStore, trashRetention)
+	server.ReportHandlers = reports.SetupHandlers(ticketRepo)
+	server.NotificationHandlers = notifications.SetupHandlers(notificationRepo)
 
 	registerRoutes(e, server, authService)
 
@@ -146,14 +182,45 @@ func registerRoutes(e *echo.Echo, server httpServer, tokenValidator appmiddlewar
 	e.PUT("/organizations/:id", wrapper.PutOrganizationsID, authMiddleware)
 	e.GET("/organizations/:id/tickets", wrapper.GetOrganizationsIDTickets, authMiddleware)
 	e.GET("/organizations/:id/users", wrapper.GetOrganizationsIDUsers, authMiddleware)
+	e.GET("/organizations/:id/workflow", wrapper.GetOrganizationsIDWorkflow, authMiddleware)
+	e.GET("/organizations/:id/sla", wrapper.GetOrganizationsIDSla, authMiddleware)
+	e.GET("/organizations/:id/priority-matrix", wrapper.GetOrganizationsIDPriorityMatrix, authMiddleware)
+	e.GET("/organizations/:id/assignment", wrapper.GetOrganizationsIDAssignment, authMiddleware, requireAgent)
+	e.GET("/organizations/:id/settings", wrapper.GetOrganizationsIDSettings, authMiddleware, requireAgent)
+	e.GET("/organizations/:id/tags", wrapper.GetOrganizationsIDTags, authMiddleware, requireAgent)
 
 	e.GET("/tickets", wrapper.GetTickets, authMiddleware)
 	e.POST("/tickets", wrapper.PostTickets, authMiddleware)
+	e.POST("/tickets/bulk", wrapper.PostTicketsBulk, authMiddleware)
 	e.DELETE("/tickets/:id", wrapper.DeleteTicketsID, authMiddleware)
 	e.GET("/tickets/:id", wrapper.GetTicketsID, authMiddleware)
 	e.PUT("/tickets/:id", wrapper.PutTicketsID, authMiddleware)
 	e.GET("/tickets/:id/comments", wrapper.GetTicketsIDComments, authMiddleware)
 	e.POST("/tickets/:id/comments", wrapper.PostTicketsIDComments, authMiddleware)
+	e.PUT("/tickets/:id/comments/:commentId", wrapper.PutTicketsIDCommentsCommentID, authMiddleware)
+	e.DELETE("/tickets/:id/comments/:commentId", wrapper.DeleteTicketsIDCommentsCommentID, authMiddleware)
+	e.GET("/tickets/:id/attachments", wrapper.GetTicketsIDAttachments, authMiddleware)
+	e.POST("/tickets/:id/attachments", wrapper.PostTicketsIDAttachments, authMiddleware)
+	e.GET("/tickets/:id/attachments/:attachmentId", wrapper.GetTicketsIDAttachmentsAttachmentID, authMiddleware)
+	e.DELETE("/tickets/:id/attachments/:attachmentId", wrapper.DeleteTicketsIDAttachmentsAttachmentID, authMiddleware)
+	e.GET("/tickets/:id/history", wrapper.GetTicketsIDHistory, authMiddleware)
+	e.GET("/tickets/:id/watchers", wrapper.GetTicketsIDWatchers, authMiddleware)
+	e.POST("/tickets/:id/watchers", wrapper.PostTicketsIDWatchers, authMiddleware)
+	e.DELETE("/tickets/:id/watchers/:userId", wrapper.DeleteTicketsIDWatchersUserID, authMiddleware)
+	e.GET("/tickets/:id/survey", wrapper.GetTicketsIDSurvey, authMiddleware)
+	e.POST("/tickets/:id/survey", wrapper.PostTicketsIDSurvey, authMiddleware)
+	e.GET("/tickets/:id/relations", wrapper.GetTicketsIDRelations, authMiddleware)
+
+	e.GET("/views", wrapper.GetViews, authMiddleware)
+	e.POST("/views", wrapper.PostViews, authMiddleware)
+	e.GET("/views/counts", wrapper.GetViewsCounts, authMiddleware)
+	e.DELETE("/views/:id", wrapper.DeleteViewsID, authMiddleware)
+	e.GET("/views/:id", wrapper.GetViewsID, authMiddleware)
+	e.PUT("/views/:id", wrapper.PutViewsID, authMiddleware)
+	e.GET("/views/:id/tickets", wrapper.GetViewsIDTickets, authMiddleware)
+
+	e.GET("/notifications", wrapper.GetNotifications, authMiddleware)
+	e.POST("/notifications/:id/read", wrapper.PostNotificationsIDRead, authMiddleware)
 
 	e.GET("/users/:id", wrapper.GetUsersID, authMiddleware)
 	e.PUT("/users/:id", wrapper.PutUsersID, authMiddleware)
@@ -162,12 +229,65 @@ func registerRoutes(e *echo.Echo, server httpServer, tokenValidator appmiddlewar
 	// Agent+ endpoints.
 	e.PATCH("/tickets/:id/assign", wrapper.PatchTicketsIDAssign, authMiddleware, requireAgent)
 	e.PATCH("/tickets/:id/status", wrapper.PatchTicketsIDStatus, authMiddleware, requireAgent)
+	e.POST("/tickets/:id/merge", wrapper.PostTicketsIDMerge, authMiddleware, requireAgent)
+	e.POST("/tickets/:id/split", wrapper.PostTicketsIDSplit, authMiddleware, requireAgent)
+	e.GET("/tickets/:id/worklogs", wrapper.GetTicketsIDWorklogs, authMiddleware, requireAgent)
+	e.POST("/tickets/:id/worklogs", wrapper.PostTicketsIDWorklogs, authMiddleware, requireAgent)
+	e.PUT("/tickets/:id/worklogs/:worklogId", wrapper.PutTicketsIDWorklogsWorklogID, authMiddleware, requireAgent)
+	e.DELETE("/tickets/:id/worklogs/:worklogId", wrapper.DeleteTicketsIDWorklogsWorklogID, authMiddleware, requireAgent)
+	e.POST("/tickets/:id/relations", wrapper.PostTicketsIDRelations, authMiddleware, requireAgent)
+	e.DELETE(
+		"/tickets/:id/relations/:type/:relatedId",
+		wrapper.DeleteTicketsIDRelationsTypeRelatedID,
+		authMiddleware,
+		requireAgent,
+	)
+	e.GET(
+		"/tickets/:id/canned-responses/:responseId",
+		wrapper.GetTicketsIDCannedResponsesResponseID,
+		authMiddleware,
+		requireAgent,
+	)
+	e.POST("/tickets/:id/macros/:macroId/apply", wrapper.PostTicketsIDMacrosMacroIDApply, authMiddleware, requireAgent)
 	e.GET("/users", wrapper.GetUsers, authMiddleware, requireAgent)
+	e.GET("/canned-responses", wrapper.GetCannedResponses, authMiddleware, requireAgent)
+	e.POST("/canned-responses", wrapper.PostCannedResponses, authMiddleware, requireAgent)
+	e.GET("/canned-responses/:id", wrapper.GetCannedResponsesID, authMiddleware, requireAgent)
+	e.PUT("/canned-responses/:id", wrapper.PutCannedResponsesID, authMiddleware, requireAgent)
+	e.DELETE("/canned-responses/:id", wrapper.DeleteCannedResponsesID, authMiddleware, requireAgent)
+	e.GET("/macros", wrapper.GetMacros, authMiddleware, requireAgent)
+	e.POST("/macros", wrapper.PostMacros, authMiddleware, requireAgent)
+	e.GET("/macros/:id", wrapper.GetMacrosID, authMiddleware, requireAgent)
+	e.PUT("/macros/:id", wrapper.PutMacrosID, authMiddleware, requireAgent)
+	e.DELETE("/macros/:id", wrapper.DeleteMacrosID, authMiddleware, requireAgent)
+	e.GET("/reports/satisfaction", wrapper.GetReportsSatisfaction, authMiddleware, requireAgent)
+	e.GET("/reports/time", wrapper.GetReportsTime, authMiddleware, requireAgent)
+	e.GET("/recurring-templates", wrapper.GetRecurringTemplates, authMiddleware, requireAgent)
+	e.POST("/recurring-templates", wrapper.PostRecurringTemplates, authMiddleware, requireAgent)
+	e.GET("/recurring-templates/:id", wrapper.GetRecurringTemplatesID, authMiddleware, requireAgent)
+	e.PUT("/recurring-templates/:id", wrapper.PutRecurringTemplatesID, authMiddleware, requireAgent)
+	e.DELETE("/recurring-templates/:id", wrapper.DeleteRecurringTemplatesID, authMiddleware, requireAgent)
 
 	// Admin-only endpoints.
 	e.POST("/users", wrapper.PostUsers, authMiddleware, requireAdmin)
 	e.DELETE("/users/:id", wrapper.DeleteUsersID, authMiddleware, requireAdmin)
 	e.PATCH("/users/:id/role", wrapper.PatchUsersIDRole, authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/workflow", wrapper.PutOrganizationsIDWorkflow, authMiddleware, requireAdmin)
+	e.DELETE("/organizations/:id/workflow", wrapper.DeleteOrganizationsIDWorkflow, authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/sla", wrapper.PutOrganizationsIDSla, authMiddleware, requireAdmin)
+	e.DELETE("/organizations/:id/sla", wrapper.DeleteOrganizationsIDSla, authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/assignment", wrapper.PutOrganizationsIDAssignment, authMiddleware, requireAdmin)
+	e.DELETE("/organizations/:id/assignment", wrapper.DeleteOrganizationsIDAssignment, authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/priority-matrix", wrapper.PutOrganizationsIDPriorityMatrix, authMiddleware, requireAdmin)
+	e.DELETE("/organizations/:id/priority-matrix", wrapper.DeleteOrganizationsIDPriorityMatrix,
+		authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/settings", wrapper.PutOrganizationsIDSettings, authMiddleware, requireAdmin)
+	e.POST("/organizations/:id/tags", wrapper.PostOrganizationsIDTags, authMiddleware, requireAdmin)
+	e.PUT("/organizations/:id/tags/:name", wrapper.PutOrganizationsIDTagsName, authMiddleware, requireAdmin)
+	e.DELETE("/organizations/:id/tags/:name", wrapper.DeleteOrganizationsIDTagsName, authMiddleware, requireAdmin)
+	e.GET("/trash", wrapper.GetTrash, authMiddleware, requireAdmin)
+	e.POST("/trash/:type/:id/restore", wrapper.PostTrashTypeIDRestore, authMiddleware, requireAdmin)
+	e.DELETE("/trash/:type/:id", wrapper.DeleteTrashTypeID, authMiddleware, requireAdmin)
 }
 
 const loginRateLimitPerSecond = rate.Limit(5.0 / 60.0)
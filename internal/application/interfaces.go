@@ -2,11 +2,16 @@ package application
 
 import (
 	"context"
+	"io"
 
 	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/domain/notifications"
 	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
 	"simpleservicedesk/internal/domain/tickets"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -32,8 +37,19 @@ type TicketRepository interface {
 		updateFn func(*tickets.Ticket) (bool, error),
 	) (*tickets.Ticket, error)
 	GetTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+	GetTicketByKey(ctx context.Context, key string) (*tickets.Ticket, error)
+	NextTicketNumber(ctx context.Context, prefix string) (int64, error)
 	ListTickets(ctx context.Context, filter queries.TicketFilter) ([]*tickets.Ticket, error)
-	DeleteTicket(ctx context.Context, id uuid.UUID) error
+	CountTickets(ctx context.Context, filter queries.TicketFilter) (int64, error)
+	DeleteTicket(ctx context.Context, id, deletedBy uuid.UUID) error
+	ListDeletedTickets(ctx context.Context, filter queries.TrashFilter) ([]*tickets.Ticket, error)
+	CountDeletedTickets(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreTicket(ctx context.Context, id uuid.UUID) error
+	PurgeTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+	ListTicketEvents(ctx context.Context, filter queries.TicketEventFilter) ([]tickets.Event, error)
+	CountTicketEvents(ctx context.Context, filter queries.TicketEventFilter) (int64, error)
+	AggregateSatisfaction(ctx context.Context, filter queries.SatisfactionFilter) ([]tickets.SatisfactionStats, error)
+	AggregateWorkLogs(ctx context.Context, filter queries.WorkLogFilter) ([]tickets.WorkLogStats, error)
 }
 
 // CategoryTree represents a hierarchical category structure
@@ -52,7 +68,11 @@ type CategoryRepository interface {
 	GetCategory(ctx context.Context, id uuid.UUID) (*categories.Category, error)
 	ListCategories(ctx context.Context, filter queries.CategoryFilter) ([]*categories.Category, error)
 	GetCategoryHierarchy(ctx context.Context, rootID uuid.UUID) (*CategoryTree, error)
-	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	DeleteCategory(ctx context.Context, id, deletedBy uuid.UUID) error
+	ListDeletedCategories(ctx context.Context, filter queries.TrashFilter) ([]*categories.Category, error)
+	CountDeletedCategories(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreCategory(ctx context.Context, id uuid.UUID) error
+	PurgeCategory(ctx context.Context, id uuid.UUID) error
 }
 
 // OrganizationTree represents a hierarchical organization structure
@@ -71,9 +91,77 @@ type OrganizationRepository interface {
 		id uuid.UUID,
 		updateFn func(*organizations.Organization) (bool, error),
 	) (*organizations.Organization, error)
+	TakeAutoAssignmentTurn(ctx context.Context, id uuid.UUID) (int64, error)
 	GetOrganization(ctx context.Context, id uuid.UUID) (*organizations.Organization, error)
 	ListOrganizations(ctx context.Context, filter queries.OrganizationFilter) ([]*organizations.Organization, error)
 	CountOrganizations(ctx context.Context, filter queries.OrganizationFilter) (int64, error)
 	GetOrganizationHierarchy(ctx context.Context, rootID uuid.UUID) (*OrganizationTree, error)
-	DeleteOrganization(ctx context.Context, id uuid.UUID) error
+	DeleteOrganization(ctx context.Context, id, deletedBy uuid.UUID) error
+	ListDeletedOrganizations(ctx context.Context, filter queries.TrashFilter) ([]*organizations.Organization, error)
+	CountDeletedOrganizations(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreOrganization(ctx context.Context, id uuid.UUID) error
+	PurgeOrganization(ctx context.Context, id uuid.UUID) error
+}
+
+type MacroRepository interface {
+	CreateCannedResponse(
+		ctx context.Context,
+		createFn func() (*macros.CannedResponse, error),
+	) (*macros.CannedResponse, error)
+	UpdateCannedResponse(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*macros.CannedResponse) (bool, error),
+	) (*macros.CannedResponse, error)
+	GetCannedResponse(ctx context.Context, id uuid.UUID) (*macros.CannedResponse, error)
+	ListCannedResponses(ctx context.Context, filter queries.MacroFilter) ([]*macros.CannedResponse, error)
+	DeleteCannedResponse(ctx context.Context, id uuid.UUID) error
+
+	CreateMacro(ctx context.Context, createFn func() (*macros.Macro, error)) (*macros.Macro, error)
+	UpdateMacro(ctx context.Context, id uuid.UUID, updateFn func(*macros.Macro) (bool, error)) (*macros.Macro, error)
+	GetMacro(ctx context.Context, id uuid.UUID) (*macros.Macro, error)
+	ListMacros(ctx context.Context, filter queries.MacroFilter) ([]*macros.Macro, error)
+	DeleteMacro(ctx context.Context, id uuid.UUID) error
+}
+
+type ViewRepository interface {
+	CreateView(ctx context.Context, createFn func() (*views.View, error)) (*views.View, error)
+	UpdateView(ctx context.Context, id uuid.UUID, updateFn func(*views.View) (bool, error)) (*views.View, error)
+	GetView(ctx context.Context, id uuid.UUID) (*views.View, error)
+	ListViews(ctx context.Context, filter queries.ViewFilter) ([]*views.View, error)
+	DeleteView(ctx context.Context, id uuid.UUID) error
+}
+
+type RecurringTemplateRepository interface {
+	CreateTemplate(ctx context.Context, createFn func() (*recurring.Template, error)) (*recurring.Template, error)
+	UpdateTemplate(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*recurring.Template) (bool, error),
+	) (*recurring.Template, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (*recurring.Template, error)
+	ListTemplates(ctx context.Context, filter queries.RecurringTemplateFilter) ([]*recurring.Template, error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+}
+
+type NotificationRepository interface {
+	CreateNotification(
+		ctx context.Context,
+		createFn func() (*notifications.Notification, error),
+	) (*notifications.Notification, error)
+	UpdateNotification(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*notifications.Notification) (bool, error),
+	) (*notifications.Notification, error)
+	GetNotification(ctx context.Context, id uuid.UUID) (*notifications.Notification, error)
+	ListNotifications(ctx context.Context, filter queries.NotificationFilter) ([]*notifications.Notification, error)
+	CountNotifications(ctx context.Context, filter queries.NotificationFilter) (int64, error)
+}
+
+// BlobStore stores binary content such as ticket attachments
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
 }
@@ -0,0 +1,79 @@
+package macros
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/macros"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/pkg/echomiddleware"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var errSharedRequiresAdmin = errors.New("only admins manage canned responses and macros shared with an organization")
+
+func authUser(c echo.Context) (uuid.UUID, userdomain.Role, bool) {
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, "", false
+	}
+
+	userID, err := uuid.Parse(strings.TrimSpace(claims.UserID))
+	if err != nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, "", false
+	}
+
+	return userID, claims.Role, true
+}
+
+// checkVisible hides personal items of other users as if they did not exist
+func checkVisible(scope macros.Scope, userID uuid.UUID, notFound error) error {
+	if !scope.IsVisibleTo(userID) {
+		return notFound
+	}
+	return nil
+}
+
+// checkManage verifies that the user may change the item: personal items by their owner, shared ones by admins
+func checkManage(scope macros.Scope, userID uuid.UUID, role userdomain.Role, notFound error) error {
+	if err := checkVisible(scope, userID, notFound); err != nil {
+		return err
+	}
+	if !scope.IsPersonal() && role != userdomain.RoleAdmin {
+		return errSharedRequiresAdmin
+	}
+	return nil
+}
+
+// requestScope returns the scope of a new item: shared with the organization if one is given, personal otherwise
+func requestScope(organizationID *uuid.UUID, userID uuid.UUID, role userdomain.Role) (macros.Scope, error) {
+	if organizationID == nil {
+		return macros.PersonalScope(userID), nil
+	}
+	if role != userdomain.RoleAdmin {
+		return macros.Scope{}, errSharedRequiresAdmin
+	}
+	return macros.OrganizationScope(*organizationID, userID), nil
+}
+
+func handleMacroError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, macros.ErrCannedResponseNotFound) || errors.Is(err, macros.ErrMacroNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, errSharedRequiresAdmin):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, macros.ErrCannedResponseValidation) ||
+		errors.Is(err, macros.ErrMacroValidation) ||
+		errors.Is(err, macros.ErrInvalidTemplate):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
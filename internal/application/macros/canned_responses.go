@@ -0,0 +1,151 @@
+package macros
+
+import (
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h MacroHandlers) GetCannedResponses(c echo.Context, params openapi.GetCannedResponsesParams) error {
+	ctx := c.Request().Context()
+	userID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	filter, err := queries.FromOpenAPICannedResponseParams(userID, params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	responses, err := h.repo.ListCannedResponses(ctx, filter)
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	result := make([]openapi.CannedResponse, 0, len(responses))
+	for _, response := range responses {
+		result = append(result, convertCannedResponseToResponse(response))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h MacroHandlers) PostCannedResponses(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.CreateCannedResponseRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	scope, err := requestScope(req.OrganizationId, userID, role)
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	response, err := h.repo.CreateCannedResponse(ctx, func() (*macros.CannedResponse, error) {
+		return macros.CreateCannedResponse(req.Name, req.Content, scope)
+	})
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertCannedResponseToResponse(response))
+}
+
+func (h MacroHandlers) GetCannedResponsesID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	response, err := h.repo.GetCannedResponse(ctx, id)
+	if err == nil {
+		err = checkVisible(response.Scope(), userID, macros.ErrCannedResponseNotFound)
+	}
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertCannedResponseToResponse(response))
+}
+
+func (h MacroHandlers) PutCannedResponsesID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.UpdateCannedResponseRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	response, err := h.repo.UpdateCannedResponse(ctx, id, func(response *macros.CannedResponse) (bool, error) {
+		if manageErr := checkManage(response.Scope(), userID, role, macros.ErrCannedResponseNotFound); manageErr != nil {
+			return false, manageErr
+		}
+		if updateErr := response.Update(req.Name, req.Content); updateErr != nil {
+			return false, updateErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertCannedResponseToResponse(response))
+}
+
+func (h MacroHandlers) DeleteCannedResponsesID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	response, err := h.repo.GetCannedResponse(ctx, id)
+	if err == nil {
+		err = checkManage(response.Scope(), userID, role, macros.ErrCannedResponseNotFound)
+	}
+	if err == nil {
+		err = h.repo.DeleteCannedResponse(ctx, id)
+	}
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func convertCannedResponseToResponse(response *macros.CannedResponse) openapi.CannedResponse {
+	id := response.ID()
+	name := response.Name()
+	content := response.Content()
+	scope := response.Scope()
+	ownerID := scope.OwnerID
+	createdAt := response.CreatedAt()
+	updatedAt := response.UpdatedAt()
+
+	return openapi.CannedResponse{
+		Id:             &id,
+		Name:           &name,
+		Content:        &content,
+		OrganizationId: scope.OrganizationID,
+		OwnerId:        &ownerID,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package macros_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *MacrosSuite) createCannedResponse(
+	req openapi.CreateCannedResponseRequest,
+	token string,
+) openapi.CannedResponse {
+	rec := s.RequestAs(http.MethodPost, "/canned-responses", req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var response openapi.CannedResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	return response
+}
+
+func (s *MacrosSuite) listCannedResponseNames(query, token string) []string {
+	rec := s.RequestAs(http.MethodGet, "/canned-responses"+query, nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var responses []openapi.CannedResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &responses))
+	names := make([]string, 0, len(responses))
+	for _, response := range responses {
+		names = append(names, *response.Name)
+	}
+	return names
+}
+
+func (s *MacrosSuite) TestCannedResponses() {
+	orgID := uuid.New()
+	_, agentToken := s.LoginAs("canned-agent@example.com", openapi.Agent)
+
+	shared := s.createCannedResponse(openapi.CreateCannedResponseRequest{
+		Name:           "Greeting",
+		Content:        "Hello {{author.name}}, we are looking into {{ ticket.title }}",
+		OrganizationId: &orgID,
+	}, "")
+	adminPersonal := s.createCannedResponse(openapi.CreateCannedResponseRequest{
+		Name:    "Admin notes",
+		Content: "Escalated to the vendor",
+	}, "")
+
+	s.Run("Agents create personal responses", func() {
+		response := s.createCannedResponse(openapi.CreateCannedResponseRequest{
+			Name:    "Closing",
+			Content: "Closing {{ticket.id}} as resolved",
+		}, agentToken)
+		s.Nil(response.OrganizationId)
+		s.Equal("Closing {{ticket.id}} as resolved", *response.Content)
+	})
+
+	s.Run("Lists contain shared responses and own personal ones", func() {
+		s.Equal([]string{"Closing", "Greeting"}, s.listCannedResponseNames("", agentToken))
+		s.Equal([]string{"Admin notes", "Greeting"}, s.listCannedResponseNames("", ""))
+		s.Equal([]string{"Closing"}, s.listCannedResponseNames("?organization_id="+uuid.NewString(), agentToken))
+	})
+
+	s.Run("Personal responses of other users are not found", func() {
+		path := "/canned-responses/" + adminPersonal.Id.String()
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, path, nil, agentToken).Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodPut, path,
+			openapi.UpdateCannedResponseRequest{Name: "Mine", Content: "Mine"}, agentToken).Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodDelete, path, nil, agentToken).Code)
+	})
+
+	s.Run("Only admins manage shared responses", func() {
+		rec := s.RequestAs(http.MethodPost, "/canned-responses", openapi.CreateCannedResponseRequest{
+			Name:           "Shared by agent",
+			Content:        "Hello",
+			OrganizationId: &orgID,
+		}, agentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+
+		path := "/canned-responses/" + shared.Id.String()
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, path, nil, agentToken).Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodPut, path,
+			openapi.UpdateCannedResponseRequest{Name: "Greeting", Content: "Hi"}, agentToken).Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodDelete, path, nil, agentToken).Code)
+
+		rec = s.RequestAs(http.MethodPut, path,
+			openapi.UpdateCannedResponseRequest{Name: "Greeting", Content: "Hi {{ author.name }}"}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var updated openapi.CannedResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.Equal("Hi {{ author.name }}", *updated.Content)
+	})
+
+	s.Run("Unknown variables are rejected", func() {
+		rec := s.RequestAs(http.MethodPost, "/canned-responses", openapi.CreateCannedResponseRequest{
+			Name:    "Broken",
+			Content: "Hello {{ customer.phone }}",
+		}, agentToken)
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Delete a response", func() {
+		path := "/canned-responses/" + adminPersonal.Id.String()
+		s.Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, path, nil, "").Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, path, nil, "").Code)
+	})
+
+	s.Run("Customers cannot use canned responses", func() {
+		_, customerToken := s.LoginAs("canned-customer@example.com", openapi.Customer)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, "/canned-responses", nil, customerToken).Code)
+	})
+}
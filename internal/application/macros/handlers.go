@@ -0,0 +1,41 @@
+package macros
+
+import (
+	"context"
+
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+type MacroRepository interface {
+	CreateCannedResponse(
+		ctx context.Context,
+		createFn func() (*macros.CannedResponse, error),
+	) (*macros.CannedResponse, error)
+	UpdateCannedResponse(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*macros.CannedResponse) (bool, error),
+	) (*macros.CannedResponse, error)
+	GetCannedResponse(ctx context.Context, id uuid.UUID) (*macros.CannedResponse, error)
+	ListCannedResponses(ctx context.Context, filter queries.MacroFilter) ([]*macros.CannedResponse, error)
+	DeleteCannedResponse(ctx context.Context, id uuid.UUID) error
+
+	CreateMacro(ctx context.Context, createFn func() (*macros.Macro, error)) (*macros.Macro, error)
+	UpdateMacro(ctx context.Context, id uuid.UUID, updateFn func(*macros.Macro) (bool, error)) (*macros.Macro, error)
+	GetMacro(ctx context.Context, id uuid.UUID) (*macros.Macro, error)
+	ListMacros(ctx context.Context, filter queries.MacroFilter) ([]*macros.Macro, error)
+	DeleteMacro(ctx context.Context, id uuid.UUID) error
+}
+
+type MacroHandlers struct {
+	repo MacroRepository
+}
+
+func SetupHandlers(repo MacroRepository) MacroHandlers {
+	return MacroHandlers{
+		repo: repo,
+	}
+}
@@ -0,0 +1,183 @@
+package macros
+
+import (
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h MacroHandlers) GetMacros(c echo.Context, params openapi.GetMacrosParams) error {
+	ctx := c.Request().Context()
+	userID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	filter, err := queries.FromOpenAPIMacroParams(userID, params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	list, err := h.repo.ListMacros(ctx, filter)
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	result := make([]openapi.Macro, 0, len(list))
+	for _, macro := range list {
+		result = append(result, convertMacroToResponse(macro))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h MacroHandlers) PostMacros(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.CreateMacroRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	scope, err := requestScope(req.OrganizationId, userID, role)
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	macro, err := h.repo.CreateMacro(ctx, func() (*macros.Macro, error) {
+		return macros.CreateMacro(req.Name, stringValue(req.Description), convertActionsFromRequest(req.Actions), scope)
+	})
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertMacroToResponse(macro))
+}
+
+func (h MacroHandlers) GetMacrosID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	macro, err := h.repo.GetMacro(ctx, id)
+	if err == nil {
+		err = checkVisible(macro.Scope(), userID, macros.ErrMacroNotFound)
+	}
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertMacroToResponse(macro))
+}
+
+func (h MacroHandlers) PutMacrosID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.UpdateMacroRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	macro, err := h.repo.UpdateMacro(ctx, id, func(macro *macros.Macro) (bool, error) {
+		if manageErr := checkManage(macro.Scope(), userID, role, macros.ErrMacroNotFound); manageErr != nil {
+			return false, manageErr
+		}
+		actions := convertActionsFromRequest(req.Actions)
+		if updateErr := macro.Update(req.Name, stringValue(req.Description), actions); updateErr != nil {
+			return false, updateErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertMacroToResponse(macro))
+}
+
+func (h MacroHandlers) DeleteMacrosID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	macro, err := h.repo.GetMacro(ctx, id)
+	if err == nil {
+		err = checkManage(macro.Scope(), userID, role, macros.ErrMacroNotFound)
+	}
+	if err == nil {
+		err = h.repo.DeleteMacro(ctx, id)
+	}
+	if err != nil {
+		return handleMacroError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func convertActionsFromRequest(actions []openapi.MacroAction) []macros.Action {
+	result := make([]macros.Action, 0, len(actions))
+	for _, action := range actions {
+		result = append(result, macros.Action{
+			Type:     macros.ActionType(action.Type),
+			Value:    stringValue(action.Value),
+			Internal: action.Internal != nil && *action.Internal,
+		})
+	}
+	return result
+}
+
+func convertMacroToResponse(macro *macros.Macro) openapi.Macro {
+	id := macro.ID()
+	name := macro.Name()
+	description := macro.Description()
+	scope := macro.Scope()
+	ownerID := scope.OwnerID
+	createdAt := macro.CreatedAt()
+	updatedAt := macro.UpdatedAt()
+
+	actions := make([]openapi.MacroAction, 0, len(macro.Actions()))
+	for _, action := range macro.Actions() {
+		value := action.Value
+		internal := action.Internal
+		actions = append(actions, openapi.MacroAction{
+			Type:     openapi.MacroActionType(action.Type),
+			Value:    &value,
+			Internal: &internal,
+		})
+	}
+
+	return openapi.Macro{
+		Id:             &id,
+		Name:           &name,
+		Description:    &description,
+		Actions:        &actions,
+		OrganizationId: scope.OrganizationID,
+		OwnerId:        &ownerID,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,98 @@
+package macros_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *MacrosSuite) TestMacros() {
+	orgID := uuid.New()
+	_, agentToken := s.LoginAs("macros-agent@example.com", openapi.Agent)
+	value := func(v string) *string { return &v }
+	internal := true
+
+	var macroID uuid.UUID
+	s.Run("Create a macro", func() {
+		rec := s.RequestAs(http.MethodPost, "/macros", openapi.CreateMacroRequest{
+			Name:        "Resolve password reset",
+			Description: value("Answers and resolves password reset requests"),
+			Actions: []openapi.MacroAction{
+				{Type: openapi.Comment, Value: value("Your password was reset, {{ author.name }}")},
+				{Type: openapi.Comment, Value: value("Reset via AD console"), Internal: &internal},
+				{Type: openapi.Status, Value: value("Resolved")},
+				{Type: openapi.Priority, Value: value("LOW")},
+				{Type: openapi.Assign},
+			},
+			OrganizationId: &orgID,
+		}, "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var macro openapi.Macro
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &macro))
+		macroID = *macro.Id
+		s.Equal(orgID, *macro.OrganizationId)
+		s.Require().Len(*macro.Actions, 5)
+		actions := *macro.Actions
+		s.True(*actions[1].Internal)
+		s.Equal("resolved", *actions[2].Value)
+		s.Equal("low", *actions[3].Value)
+		s.Empty(*actions[4].Value)
+	})
+
+	s.Run("Invalid actions are rejected", func() {
+		for _, action := range []openapi.MacroAction{
+			{Type: openapi.Status, Value: value("Not A Status!")},
+			{Type: openapi.Priority, Value: value("highest")},
+			{Type: openapi.Assign, Value: value("nobody")},
+			{Type: openapi.Status, Value: value("closed"), Internal: &internal},
+			{Type: openapi.Comment, Value: value("Hi {{ agent.phone }}")},
+		} {
+			rec := s.RequestAs(http.MethodPost, "/macros", openapi.CreateMacroRequest{
+				Name:    "Broken",
+				Actions: []openapi.MacroAction{action},
+			}, agentToken)
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+		rec := s.RequestAs(http.MethodPost, "/macros", openapi.CreateMacroRequest{Name: "Empty"}, agentToken)
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Agents see shared macros and manage personal ones", func() {
+		path := "/macros/" + macroID.String()
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, path, nil, agentToken).Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodDelete, path, nil, agentToken).Code)
+
+		rec := s.RequestAs(http.MethodPost, "/macros", openapi.CreateMacroRequest{
+			Name:    "Take it",
+			Actions: []openapi.MacroAction{{Type: openapi.Assign, Value: value(uuid.NewString())}},
+		}, agentToken)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var personal openapi.Macro
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &personal))
+
+		personalPath := "/macros/" + personal.Id.String()
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, personalPath, nil, "").Code)
+
+		rec = s.RequestAs(http.MethodPut, personalPath, openapi.UpdateMacroRequest{
+			Name:    "Take it and raise",
+			Actions: []openapi.MacroAction{{Type: openapi.Priority, Value: value("high")}},
+		}, agentToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.NoError(json.Unmarshal(rec.Body.Bytes(), &personal))
+		s.Equal("Take it and raise", *personal.Name)
+		s.Len(*personal.Actions, 1)
+
+		rec = s.RequestAs(http.MethodGet, "/macros", nil, agentToken)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var list []openapi.Macro
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+		s.Len(list, 2)
+
+		s.Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, personalPath, nil, agentToken).Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, personalPath, nil, agentToken).Code)
+	})
+}
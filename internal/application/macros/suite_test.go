@@ -0,0 +1,22 @@
+package macros_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MacrosSuite struct {
+	application.ServerSuite
+}
+
+func (s *MacrosSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestMacrosSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(MacrosSuite))
+}
@@ -0,0 +1,30 @@
+package notifications
+
+import (
+	"context"
+
+	"simpleservicedesk/internal/domain/notifications"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	UpdateNotification(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*notifications.Notification) (bool, error),
+	) (*notifications.Notification, error)
+	ListNotifications(ctx context.Context, filter queries.NotificationFilter) ([]*notifications.Notification, error)
+	CountNotifications(ctx context.Context, filter queries.NotificationFilter) (int64, error)
+}
+
+type NotificationHandlers struct {
+	repo NotificationRepository
+}
+
+func SetupHandlers(repo NotificationRepository) NotificationHandlers {
+	return NotificationHandlers{
+		repo: repo,
+	}
+}
@@ -0,0 +1,125 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/notifications"
+	"simpleservicedesk/internal/queries"
+	"simpleservicedesk/pkg/echomiddleware"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h NotificationHandlers) GetNotifications(c echo.Context, params openapi.GetNotificationsParams) error {
+	ctx := c.Request().Context()
+	userID, ok := authUserID(c)
+	if !ok {
+		return nil
+	}
+
+	filter, err := queries.FromOpenAPINotificationParams(userID, params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	list, err := h.repo.ListNotifications(ctx, filter)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	total, err := h.repo.CountNotifications(ctx, filter)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	unread := true
+	unreadCount, err := h.repo.CountNotifications(ctx, queries.NotificationFilter{UserID: userID, Unread: &unread})
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+
+	items := make([]openapi.Notification, 0, len(list))
+	for _, notification := range list {
+		items = append(items, convertNotificationToResponse(notification))
+	}
+
+	page := 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	limit := filter.Limit
+	totalInt := int(total)
+	hasNext := filter.Offset+len(items) < totalInt
+
+	return c.JSON(http.StatusOK, openapi.ListNotificationsResponse{
+		Items:       items,
+		UnreadCount: unreadCount,
+		Pagination: &openapi.PaginationResponse{
+			Total:   &totalInt,
+			Page:    &page,
+			Limit:   &limit,
+			HasNext: &hasNext,
+		},
+	})
+}
+
+func (h NotificationHandlers) PostNotificationsIDRead(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, ok := authUserID(c)
+	if !ok {
+		return nil
+	}
+
+	notification, err := h.repo.UpdateNotification(ctx, id, func(notification *notifications.Notification) (bool, error) {
+		// Someone else's notification is reported as missing so that its existence is not revealed
+		if !notification.BelongsTo(userID) {
+			return false, fmt.Errorf("%w: %s", notifications.ErrNotificationNotFound, id)
+		}
+		return notification.MarkRead(), nil
+	})
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertNotificationToResponse(notification))
+}
+
+func convertNotificationToResponse(notification *notifications.Notification) openapi.Notification {
+	return openapi.Notification{
+		Id:        notification.ID(),
+		Type:      openapi.NotificationType(notification.Type()),
+		ActorId:   notification.ActorID(),
+		TicketId:  notification.TicketID(),
+		CommentId: notification.CommentID(),
+		CreatedAt: notification.CreatedAt(),
+		ReadAt:    notification.ReadAt(),
+	}
+}
+
+func authUserID(c echo.Context) (uuid.UUID, bool) {
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(strings.TrimSpace(claims.UserID))
+	if err != nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func handleNotificationError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, notifications.ErrNotificationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
@@ -0,0 +1,98 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/notifications"
+
+	"github.com/google/uuid"
+)
+
+func (s *NotificationsSuite) createMention(userID uuid.UUID, createdAt time.Time) uuid.UUID {
+	notification, err := s.NotificationsRepo.CreateNotification(context.Background(),
+		func() (*notifications.Notification, error) {
+			notification, err := notifications.NewMentionNotification(userID, uuid.New(), uuid.New(), uuid.New())
+			if err != nil {
+				return nil, err
+			}
+			notification.RestoreState(createdAt, nil)
+			return notification, nil
+		})
+	s.Require().NoError(err)
+	return notification.ID()
+}
+
+func (s *NotificationsSuite) listNotifications(query, token string) openapi.ListNotificationsResponse {
+	rec := s.RequestAs(http.MethodGet, "/notifications"+query, nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp openapi.ListNotificationsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func (s *NotificationsSuite) TestNotifications() {
+	userID, token := s.LoginAs("notified@example.com", openapi.Customer)
+	_, otherToken := s.LoginAs("other@example.com", openapi.Customer)
+	now := time.Now()
+	older := s.createMention(userID, now.Add(-time.Hour))
+	newer := s.createMention(userID, now)
+
+	s.Run("Users see only their own notifications, newest first", func() {
+		list := s.listNotifications("", token)
+		s.Require().Len(list.Items, 2)
+		s.Equal(newer, list.Items[0].Id)
+		s.Equal(older, list.Items[1].Id)
+		s.Equal(int64(2), list.UnreadCount)
+		s.Equal(2, *list.Pagination.Total)
+
+		s.Empty(s.listNotifications("", otherToken).Items)
+	})
+
+	s.Run("Notifications are paginated", func() {
+		list := s.listNotifications("?limit=1&page=2", token)
+		s.Require().Len(list.Items, 1)
+		s.Equal(older, list.Items[0].Id)
+		s.False(*list.Pagination.HasNext)
+	})
+
+	s.Run("Notifications are marked as read", func() {
+		path := fmt.Sprintf("/notifications/%s/read", older)
+		rec := s.RequestAs(http.MethodPost, path, nil, otherToken)
+		s.Equal(http.StatusNotFound, rec.Code)
+
+		rec = s.RequestAs(http.MethodPost, path, nil, token)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var read openapi.Notification
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &read))
+		s.Require().NotNil(read.ReadAt)
+
+		rec = s.RequestAs(http.MethodPost, path, nil, token)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var again openapi.Notification
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &again))
+		s.True(read.ReadAt.Equal(*again.ReadAt))
+
+		unread := s.listNotifications("?unread=true", token)
+		s.Require().Len(unread.Items, 1)
+		s.Equal(newer, unread.Items[0].Id)
+		s.Equal(int64(1), unread.UnreadCount)
+
+		readOnly := s.listNotifications("?unread=false", token)
+		s.Require().Len(readOnly.Items, 1)
+		s.Equal(older, readOnly.Items[0].Id)
+	})
+
+	s.Run("Invalid requests are rejected", func() {
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/notifications/%s/read", uuid.New()), nil, token)
+		s.Equal(http.StatusNotFound, rec.Code)
+
+		rec = s.RequestAs(http.MethodGet, "/notifications?limit=0", nil, token)
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
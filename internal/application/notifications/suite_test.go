@@ -0,0 +1,22 @@
+package notifications_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationsSuite struct {
+	application.ServerSuite
+}
+
+func (s *NotificationsSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestNotificationsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(NotificationsSuite))
+}
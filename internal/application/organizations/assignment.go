@@ -0,0 +1,125 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h OrganizationHandlers) GetOrganizationsIDAssignment(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handleAssignmentError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildAssignmentResponse(org))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDAssignment(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationAssignmentRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	config, err := assignmentConfigFromRequest(req)
+	if err != nil {
+		return h.handleAssignmentError(c, err)
+	}
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if setErr := org.SetAssignmentConfig(config); setErr != nil {
+			return false, setErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleAssignmentError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildAssignmentResponse(org))
+}
+
+func (h OrganizationHandlers) DeleteOrganizationsIDAssignment(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if org.AssignmentConfig() == nil {
+			return false, nil
+		}
+		org.ResetAssignmentConfig()
+		return true, nil
+	})
+	if err != nil {
+		return h.handleAssignmentError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildAssignmentResponse(org))
+}
+
+func (h OrganizationHandlers) handleAssignmentError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidAssignmentConfig) || errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+func assignmentConfigFromRequest(req openapi.UpdateOrganizationAssignmentRequest) (*tickets.AssignmentConfig, error) {
+	var agentIDs []uuid.UUID
+	if req.AgentIds != nil {
+		agentIDs = *req.AgentIds
+	}
+
+	var rules []tickets.CategoryAssignment
+	if req.CategoryRules != nil {
+		rules = make([]tickets.CategoryAssignment, 0, len(*req.CategoryRules))
+		for _, rule := range *req.CategoryRules {
+			rules = append(rules, tickets.CategoryAssignment{
+				CategoryID: rule.CategoryId,
+				AgentIDs:   rule.AgentIds,
+			})
+		}
+	}
+
+	return tickets.NewAssignmentConfig(tickets.AssignmentStrategy(req.Strategy), agentIDs, rules)
+}
+
+func buildAssignmentResponse(org *organizations.Organization) openapi.OrganizationAssignment {
+	config := org.AssignmentConfig()
+	enabled := config != nil
+	response := openapi.OrganizationAssignment{Enabled: &enabled}
+	if config == nil {
+		return response
+	}
+
+	strategy := openapi.AssignmentStrategy(config.Strategy())
+	agentIDs := config.AgentIDs()
+	rules := make([]openapi.CategoryAssignment, 0, len(config.CategoryRules()))
+	for _, rule := range config.CategoryRules() {
+		rules = append(rules, openapi.CategoryAssignment{
+			CategoryId: rule.CategoryID,
+			AgentIds:   rule.AgentIDs,
+		})
+	}
+
+	response.Strategy = &strategy
+	response.AgentIds = &agentIDs
+	response.CategoryRules = &rules
+	return response
+}
@@ -0,0 +1,110 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *OrganizationsSuite) sendAssignmentRequest(
+	method string,
+	orgID uuid.UUID,
+	body any,
+) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reqBody.Write(payload)
+	}
+
+	req := httptest.NewRequest(method, fmt.Sprintf("/organizations/%s/assignment", orgID), &reqBody)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OrganizationsSuite) TestOrganizationAssignment() {
+	agentID := uuid.New()
+	categoryID := uuid.New()
+	categoryAgentID := uuid.New()
+
+	assignmentRequest := openapi.UpdateOrganizationAssignmentRequest{
+		Strategy: openapi.Category,
+		AgentIds: &[]uuid.UUID{agentID, agentID},
+		CategoryRules: &[]openapi.CategoryAssignment{
+			{CategoryId: categoryID, AgentIds: []uuid.UUID{categoryAgentID}},
+		},
+	}
+
+	s.Run("Organization without configuration assigns manually", func() {
+		orgID := s.createWorkflowTestOrganization("Manual Assignment Org", "manual-assignment.com")
+
+		rec := s.sendAssignmentRequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationAssignment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.False(*resp.Enabled)
+		s.Nil(resp.Strategy)
+	})
+
+	s.Run("Configure, read and disable automatic assignment", func() {
+		orgID := s.createWorkflowTestOrganization("Auto Assignment Org", "auto-assignment.com")
+
+		rec := s.sendAssignmentRequest(http.MethodPut, orgID, assignmentRequest)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = s.sendAssignmentRequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationAssignment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.Enabled)
+		s.Equal(openapi.Category, *resp.Strategy)
+		s.Equal([]uuid.UUID{agentID}, *resp.AgentIds)
+		s.Require().Len(*resp.CategoryRules, 1)
+		s.Equal(categoryID, (*resp.CategoryRules)[0].CategoryId)
+		s.Equal([]uuid.UUID{categoryAgentID}, (*resp.CategoryRules)[0].AgentIds)
+
+		rec = s.sendAssignmentRequest(http.MethodDelete, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		resp = openapi.OrganizationAssignment{}
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.False(*resp.Enabled)
+		s.Nil(resp.Strategy)
+	})
+
+	s.Run("Invalid assignment configuration returns 400", func() {
+		orgID := s.createWorkflowTestOrganization("Invalid Assignment Org", "invalid-assignment.com")
+
+		invalid := []openapi.UpdateOrganizationAssignmentRequest{
+			{Strategy: openapi.Category},
+			{Strategy: openapi.RoundRobin, AgentIds: &[]uuid.UUID{uuid.Nil}},
+			{Strategy: openapi.Category, CategoryRules: &[]openapi.CategoryAssignment{
+				{CategoryId: categoryID, AgentIds: []uuid.UUID{agentID}},
+				{CategoryId: categoryID, AgentIds: []uuid.UUID{categoryAgentID}},
+			}},
+		}
+
+		for _, req := range invalid {
+			rec := s.sendAssignmentRequest(http.MethodPut, orgID, req)
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+
+		rec := s.sendAssignmentRequest(http.MethodPut, orgID, map[string]string{"strategy": "random"})
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Unknown organization returns 404", func() {
+		rec := s.sendAssignmentRequest(http.MethodPut, uuid.New(), assignmentRequest)
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
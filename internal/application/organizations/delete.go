@@ -6,20 +6,37 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/pkg/echomiddleware"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// DeleteOrganizationsID moves the organization to the trash
 func (h OrganizationHandlers) DeleteOrganizationsID(c echo.Context, id openapi_types.UUID) error {
 	ctx := c.Request().Context()
 
-	err := h.repo.DeleteOrganization(ctx, id)
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		msg := "unauthorized"
+		return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: &msg})
+	}
+	deletedBy, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		msg := "unauthorized"
+		return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: &msg})
+	}
+
+	err = h.repo.DeleteOrganization(ctx, id, deletedBy)
 	if err != nil {
 		msg := err.Error()
 		if errors.Is(err, organizations.ErrOrganizationNotFound) {
 			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
 		}
+		if errors.Is(err, organizations.ErrOrganizationHasChildren) {
+			return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+		}
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
 
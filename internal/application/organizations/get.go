@@ -6,6 +6,7 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -23,6 +24,7 @@ func (h OrganizationHandlers) GetOrganizationsID(c echo.Context, id openapi_type
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
 
+	etag.Set(c, org.Version())
 	return c.JSON(http.StatusOK, h.buildOrganizationResponse(org))
 }
 
@@ -48,7 +48,7 @@ func (r countFailingOrganizationRepo) CountOrganizations(
 	return 0, errors.New("count failed")
 }
 
-func (r countFailingOrganizationRepo) DeleteOrganization(_ context.Context, _ uuid.UUID) error {
+func (r countFailingOrganizationRepo) DeleteOrganization(_ context.Context, _, _ uuid.UUID) error {
 	return errors.New("not implemented")
 }
 
@@ -0,0 +1,105 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h OrganizationHandlers) GetOrganizationsIDPriorityMatrix(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handlePriorityMatrixError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildPriorityMatrixResponse(org))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDPriorityMatrix(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationPriorityMatrixRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	cells := make([]tickets.PriorityMatrixCell, 0, len(req.Cells))
+	for _, cell := range req.Cells {
+		cells = append(cells, tickets.PriorityMatrixCell{
+			Impact:   tickets.Impact(cell.Impact),
+			Urgency:  tickets.Urgency(cell.Urgency),
+			Priority: tickets.Priority(cell.Priority),
+		})
+	}
+	matrix, err := tickets.NewPriorityMatrix(cells)
+	if err != nil {
+		return h.handlePriorityMatrixError(c, err)
+	}
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if setErr := org.SetPriorityMatrix(matrix); setErr != nil {
+			return false, setErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handlePriorityMatrixError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildPriorityMatrixResponse(org))
+}
+
+func (h OrganizationHandlers) DeleteOrganizationsIDPriorityMatrix(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if !org.HasCustomPriorityMatrix() {
+			return false, nil
+		}
+		org.ResetPriorityMatrix()
+		return true, nil
+	})
+	if err != nil {
+		return h.handlePriorityMatrixError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildPriorityMatrixResponse(org))
+}
+
+func (h OrganizationHandlers) handlePriorityMatrixError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidPriorityMatrix) || errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+func buildPriorityMatrixResponse(org *organizations.Organization) openapi.OrganizationPriorityMatrix {
+	cells := make([]openapi.PriorityMatrixCell, 0, len(org.PriorityMatrix().Cells()))
+	for _, cell := range org.PriorityMatrix().Cells() {
+		cells = append(cells, openapi.PriorityMatrixCell{
+			Impact:   openapi.TicketImpact(cell.Impact),
+			Urgency:  openapi.TicketUrgency(cell.Urgency),
+			Priority: openapi.TicketPriority(cell.Priority),
+		})
+	}
+
+	isDefault := !org.HasCustomPriorityMatrix()
+	return openapi.OrganizationPriorityMatrix{
+		Cells:     &cells,
+		IsDefault: &isDefault,
+	}
+}
@@ -0,0 +1,103 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *OrganizationsSuite) sendPriorityMatrixRequest(
+	method string,
+	orgID uuid.UUID,
+	body any,
+) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reqBody.Write(payload)
+	}
+
+	req := httptest.NewRequest(method, fmt.Sprintf("/organizations/%s/priority-matrix", orgID), &reqBody)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+// uniformPriorityMatrix returns a matrix that maps every impact and urgency to the same priority
+func uniformPriorityMatrix(priority openapi.TicketPriority) []openapi.PriorityMatrixCell {
+	levels := []string{"low", "medium", "high"}
+	cells := make([]openapi.PriorityMatrixCell, 0, len(levels)*len(levels))
+	for _, impact := range levels {
+		for _, urgency := range levels {
+			cells = append(cells, openapi.PriorityMatrixCell{
+				Impact:   openapi.TicketImpact(impact),
+				Urgency:  openapi.TicketUrgency(urgency),
+				Priority: priority,
+			})
+		}
+	}
+	return cells
+}
+
+func (s *OrganizationsSuite) TestOrganizationPriorityMatrix() {
+	s.Run("Organization without configuration uses the default matrix", func() {
+		orgID := s.createWorkflowTestOrganization("Default Matrix Org", "default-matrix.com")
+
+		rec := s.sendPriorityMatrixRequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationPriorityMatrix
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.IsDefault)
+		s.Require().Len(*resp.Cells, 9)
+		s.Equal(openapi.TicketPriority("critical"), (*resp.Cells)[8].Priority)
+	})
+
+	s.Run("Configure, read and reset the matrix", func() {
+		orgID := s.createWorkflowTestOrganization("Custom Matrix Org", "custom-matrix.com")
+
+		rec := s.sendPriorityMatrixRequest(http.MethodPut, orgID,
+			openapi.UpdateOrganizationPriorityMatrixRequest{Cells: uniformPriorityMatrix("high")})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = s.sendPriorityMatrixRequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationPriorityMatrix
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.False(*resp.IsDefault)
+		s.Equal(uniformPriorityMatrix("high"), *resp.Cells)
+
+		rec = s.sendPriorityMatrixRequest(http.MethodDelete, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		resp = openapi.OrganizationPriorityMatrix{}
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.IsDefault)
+	})
+
+	s.Run("Incomplete matrix returns 400", func() {
+		orgID := s.createWorkflowTestOrganization("Invalid Matrix Org", "invalid-matrix.com")
+
+		cells := uniformPriorityMatrix("high")
+		duplicated := slices.Concat(cells[1:], cells[1:2])
+		for _, invalid := range [][]openapi.PriorityMatrixCell{cells[1:], duplicated} {
+			rec := s.sendPriorityMatrixRequest(http.MethodPut, orgID,
+				openapi.UpdateOrganizationPriorityMatrixRequest{Cells: invalid})
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+	})
+
+	s.Run("Unknown organization returns 404", func() {
+		rec := s.sendPriorityMatrixRequest(http.MethodGet, uuid.New(), nil)
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,101 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h OrganizationHandlers) GetOrganizationsIDSettings(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handleSettingsError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildSettingsResponse(org.Settings()))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDSettings(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		settings := applySettingsRequest(org.Settings(), req)
+		if settings == org.Settings() {
+			return false, nil
+		}
+		if validateErr := settings.Validate(); validateErr != nil {
+			return false, validateErr
+		}
+		org.UpdateSettings(settings)
+		return true, nil
+	})
+	if err != nil {
+		return h.handleSettingsError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildSettingsResponse(org.Settings()))
+}
+
+func (h OrganizationHandlers) handleSettingsError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrKeyPrefixTaken) || errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+// applySettingsRequest returns the settings with the fields present in the request replaced
+func applySettingsRequest(
+	settings organizations.OrganizationSettings,
+	req openapi.UpdateOrganizationSettingsRequest,
+) organizations.OrganizationSettings {
+	if req.AllowPublicTickets != nil {
+		settings.AllowPublicTickets = *req.AllowPublicTickets
+	}
+	if req.DefaultTicketPriority != nil {
+		settings.DefaultTicketPriority = string(*req.DefaultTicketPriority)
+	}
+	if req.EmailNotifications != nil {
+		settings.EmailNotifications = *req.EmailNotifications
+	}
+	if req.MaxFileSize != nil {
+		settings.MaxFileSize = *req.MaxFileSize
+	}
+	if req.AutoCloseAfterHours != nil {
+		settings.AutoCloseAfter = time.Duration(*req.AutoCloseAfterHours) * time.Hour
+	}
+	if req.TicketKeyPrefix != nil {
+		settings.TicketKeyPrefix = strings.ToUpper(strings.TrimSpace(*req.TicketKeyPrefix))
+	}
+	return settings
+}
+
+func buildSettingsResponse(settings organizations.OrganizationSettings) openapi.OrganizationSettings {
+	return openapi.OrganizationSettings{
+		AllowPublicTickets:    settings.AllowPublicTickets,
+		DefaultTicketPriority: openapi.TicketPriority(settings.DefaultTicketPriority),
+		EmailNotifications:    settings.EmailNotifications,
+		MaxFileSize:           settings.MaxFileSize,
+		AutoCloseAfterHours:   int(settings.AutoCloseAfter / time.Hour),
+		TicketKeyPrefix:       settings.TicketKeyPrefix,
+	}
+}
@@ -0,0 +1,121 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *OrganizationsSuite) sendSettingsRequest(
+	method string,
+	orgID uuid.UUID,
+	body any,
+) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reqBody.Write(payload)
+	}
+
+	req := httptest.NewRequest(method, fmt.Sprintf("/organizations/%s/settings", orgID), &reqBody)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OrganizationsSuite) TestOrganizationSettings() {
+	orgID := s.createWorkflowTestOrganization("Settings Org", "settings.com")
+
+	s.Run("New organizations close resolved tickets after 7 days", func() {
+		rec := s.sendSettingsRequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationSettings
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal(7*24, resp.AutoCloseAfterHours)
+		s.Equal(openapi.TicketPriority("normal"), resp.DefaultTicketPriority)
+		s.Equal(int64(10*1024*1024), resp.MaxFileSize)
+	})
+
+	s.Run("Only the settings in the request change", func() {
+		hours := 48
+		rec := s.sendSettingsRequest(http.MethodPut, orgID, openapi.UpdateOrganizationSettingsRequest{
+			AutoCloseAfterHours: &hours,
+		})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp openapi.OrganizationSettings
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal(48, resp.AutoCloseAfterHours)
+		s.True(resp.EmailNotifications)
+
+		rec = s.sendSettingsRequest(http.MethodGet, orgID, nil)
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal(48, resp.AutoCloseAfterHours)
+	})
+
+	s.Run("Invalid settings are rejected", func() {
+		hours := -1
+		rec := s.sendSettingsRequest(http.MethodPut, orgID, openapi.UpdateOrganizationSettingsRequest{
+			AutoCloseAfterHours: &hours,
+		})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		priority := openapi.TicketPriority("whenever")
+		rec = s.sendSettingsRequest(http.MethodPut, orgID, openapi.UpdateOrganizationSettingsRequest{
+			DefaultTicketPriority: &priority,
+		})
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Unknown organization", func() {
+		s.Equal(http.StatusNotFound, s.sendSettingsRequest(http.MethodGet, uuid.New(), nil).Code)
+	})
+}
+
+func (s *OrganizationsSuite) TestOrganizationTicketKeyPrefix() {
+	orgID := s.createWorkflowTestOrganization("Acme", "acme.com")
+	otherOrgID := s.createWorkflowTestOrganization("Globex", "globex.com")
+
+	s.Run("Prefix is stored in upper case", func() {
+		prefix := " acme "
+		rec := s.sendSettingsRequest(http.MethodPut, orgID, openapi.UpdateOrganizationSettingsRequest{
+			TicketKeyPrefix: &prefix,
+		})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp openapi.OrganizationSettings
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal("ACME", resp.TicketKeyPrefix)
+	})
+
+	s.Run("Malformed prefix", func() {
+		for _, prefix := range []string{"A", "AC-ME", "1ACME"} {
+			rec := s.sendSettingsRequest(http.MethodPut, otherOrgID, openapi.UpdateOrganizationSettingsRequest{
+				TicketKeyPrefix: &prefix,
+			})
+			s.Equal(http.StatusBadRequest, rec.Code, prefix)
+		}
+	})
+
+	s.Run("Prefix of another organization", func() {
+		prefix := "ACME"
+		rec := s.sendSettingsRequest(http.MethodPut, otherOrgID, openapi.UpdateOrganizationSettingsRequest{
+			TicketKeyPrefix: &prefix,
+		})
+		s.Equal(http.StatusConflict, rec.Code, rec.Body.String())
+
+		rec = s.sendSettingsRequest(http.MethodGet, otherOrgID, nil)
+		var resp openapi.OrganizationSettings
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Empty(resp.TicketKeyPrefix)
+	})
+}
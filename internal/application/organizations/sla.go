@@ -0,0 +1,284 @@
+package organizations
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const clockFormat = "%02d:%02d"
+
+func (h OrganizationHandlers) GetOrganizationsIDSla(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handleSLAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildSLAResponse(org))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDSla(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationSLARequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		config, configErr := slaConfigFromRequest(req, org.SLAConfig().EscalationRules())
+		if configErr != nil {
+			return false, configErr
+		}
+		if setErr := org.SetSLAConfig(config); setErr != nil {
+			return false, setErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleSLAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildSLAResponse(org))
+}
+
+func (h OrganizationHandlers) DeleteOrganizationsIDSla(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if org.SLAConfig() == nil {
+			return false, nil
+		}
+		org.ResetSLAConfig()
+		return true, nil
+	})
+	if err != nil {
+		return h.handleSLAError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildSLAResponse(org))
+}
+
+func (h OrganizationHandlers) handleSLAError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidSLAPolicy) || errors.Is(err, tickets.ErrInvalidCalendar) ||
+		errors.Is(err, tickets.ErrInvalidEscalationRule) || errors.Is(err, tickets.ErrInvalidPriority) ||
+		errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+// slaConfigFromRequest builds the SLA configuration; escalation rules sent without an ID keep the ID
+// of the current rule with the same name, so tickets they already fired for are not escalated again
+func slaConfigFromRequest(
+	req openapi.UpdateOrganizationSLARequest,
+	currentRules []tickets.EscalationRule,
+) (*tickets.SLAConfig, error) {
+	var calendar *tickets.BusinessCalendar
+	if req.Calendar != nil {
+		var err error
+		if calendar, err = calendarFromRequest(*req.Calendar); err != nil {
+			return nil, err
+		}
+	}
+
+	policies := make([]tickets.SLAPolicy, 0, len(req.Policies))
+	for _, policy := range req.Policies {
+		sp := tickets.SLAPolicy{
+			Name:          policy.Name,
+			CategoryID:    policy.CategoryId,
+			FirstResponse: time.Duration(policy.FirstResponseMinutes) * time.Minute,
+			Resolution:    time.Duration(policy.ResolutionMinutes) * time.Minute,
+		}
+		if policy.Id != nil {
+			sp.ID = *policy.Id
+		}
+		if policy.Priority != nil {
+			sp.Priority = tickets.Priority(*policy.Priority)
+		}
+		if policy.AroundTheClock != nil {
+			sp.AroundTheClock = *policy.AroundTheClock
+		}
+		policies = append(policies, sp)
+	}
+
+	var escalations []tickets.EscalationRule
+	if req.Escalations != nil {
+		escalations = tickets.InheritEscalationRuleIDs(escalationRulesFromRequest(*req.Escalations), currentRules)
+	}
+
+	return tickets.NewSLAConfig(calendar, policies, escalations)
+}
+
+func escalationRulesFromRequest(req []openapi.EscalationRule) []tickets.EscalationRule {
+	rules := make([]tickets.EscalationRule, 0, len(req))
+	for _, rule := range req {
+		er := tickets.EscalationRule{
+			Name:     rule.Name,
+			Target:   tickets.SLATarget(rule.Target),
+			AssignTo: rule.AssignTo,
+		}
+		if rule.Id != nil {
+			er.ID = *rule.Id
+		}
+		if rule.BeforeMinutes != nil {
+			er.Before = time.Duration(*rule.BeforeMinutes) * time.Minute
+		}
+		if rule.RaisePriority != nil {
+			er.RaisePriority = *rule.RaisePriority
+		}
+		if rule.Comment != nil {
+			er.Comment = *rule.Comment
+		}
+		rules = append(rules, er)
+	}
+	return rules
+}
+
+func calendarFromRequest(req openapi.BusinessCalendar) (*tickets.BusinessCalendar, error) {
+	hours := make([]tickets.WorkingHours, 0, len(req.WorkingHours))
+	for _, wh := range req.WorkingHours {
+		start, err := parseClock(wh.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(wh.End)
+		if err != nil {
+			return nil, err
+		}
+		hours = append(hours, tickets.WorkingHours{
+			Weekday: time.Weekday(wh.Weekday),
+			Start:   start,
+			End:     end,
+		})
+	}
+
+	var holidays []string
+	if req.Holidays != nil {
+		for _, holiday := range *req.Holidays {
+			holidays = append(holidays, holiday.Format(tickets.HolidayDateLayout))
+		}
+	}
+
+	var timezone string
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+
+	return tickets.NewBusinessCalendar(timezone, hours, holidays)
+}
+
+// parseClock converts an "HH:MM" time of day into an offset from midnight
+func parseClock(value string) (time.Duration, error) {
+	hoursPart, minutesPart, found := strings.Cut(value, ":")
+	hours, hoursErr := strconv.Atoi(hoursPart)
+	minutes, minutesErr := strconv.Atoi(minutesPart)
+	if !found || hoursErr != nil || minutesErr != nil {
+		return 0, fmt.Errorf("%w: invalid time of day %q", tickets.ErrInvalidCalendar, value)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+func formatClock(offset time.Duration) string {
+	return fmt.Sprintf(clockFormat, int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
+
+func buildSLAResponse(org *organizations.Organization) openapi.OrganizationSLA {
+	config := org.SLAConfig()
+	isDefault := config == nil
+
+	policies := make([]openapi.SLAPolicy, 0, len(config.Policies()))
+	for _, policy := range config.Policies() {
+		id := policy.ID
+		aroundTheClock := policy.AroundTheClock
+		sp := openapi.SLAPolicy{
+			Id:                   &id,
+			Name:                 policy.Name,
+			CategoryId:           policy.CategoryID,
+			FirstResponseMinutes: int64(policy.FirstResponse / time.Minute),
+			ResolutionMinutes:    int64(policy.Resolution / time.Minute),
+			AroundTheClock:       &aroundTheClock,
+		}
+		if policy.Priority != "" {
+			priority := openapi.TicketPriority(policy.Priority)
+			sp.Priority = &priority
+		}
+		policies = append(policies, sp)
+	}
+
+	escalations := make([]openapi.EscalationRule, 0, len(config.EscalationRules()))
+	for _, rule := range config.EscalationRules() {
+		id := rule.ID
+		beforeMinutes := int64(rule.Before / time.Minute)
+		raisePriority := rule.RaisePriority
+		er := openapi.EscalationRule{
+			Id:            &id,
+			Name:          rule.Name,
+			Target:        openapi.SLATarget(rule.Target),
+			BeforeMinutes: &beforeMinutes,
+			RaisePriority: &raisePriority,
+			AssignTo:      rule.AssignTo,
+		}
+		if rule.Comment != "" {
+			comment := rule.Comment
+			er.Comment = &comment
+		}
+		escalations = append(escalations, er)
+	}
+
+	return openapi.OrganizationSLA{
+		Calendar:    buildCalendarResponse(config.Calendar()),
+		Policies:    &policies,
+		Escalations: &escalations,
+		IsDefault:   &isDefault,
+	}
+}
+
+func buildCalendarResponse(calendar *tickets.BusinessCalendar) *openapi.BusinessCalendar {
+	if calendar == nil {
+		return nil
+	}
+
+	hours := make([]openapi.WorkingHours, 0, len(calendar.WorkingHours()))
+	for _, wh := range calendar.WorkingHours() {
+		hours = append(hours, openapi.WorkingHours{
+			Weekday: int(wh.Weekday),
+			Start:   formatClock(wh.Start),
+			End:     formatClock(wh.End),
+		})
+	}
+
+	holidays := make([]openapi_types.Date, 0, len(calendar.Holidays()))
+	for _, holiday := range calendar.Holidays() {
+		date, err := time.Parse(tickets.HolidayDateLayout, holiday)
+		if err != nil {
+			continue
+		}
+		holidays = append(holidays, openapi_types.Date{Time: date})
+	}
+
+	timezone := calendar.Timezone()
+	return &openapi.BusinessCalendar{
+		Timezone:     &timezone,
+		WorkingHours: hours,
+		Holidays:     &holidays,
+	}
+}
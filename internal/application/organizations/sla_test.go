@@ -0,0 +1,173 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *OrganizationsSuite) sendSLARequest(method string, orgID uuid.UUID, body any) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reqBody.Write(payload)
+	}
+
+	req := httptest.NewRequest(method, fmt.Sprintf("/organizations/%s/sla", orgID), &reqBody)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OrganizationsSuite) TestOrganizationSLA() {
+	timezone := "Europe/Moscow"
+	critical := openapi.TicketPriority("critical")
+	aroundTheClock := true
+	holiday := openapi_types.Date{}
+	beforeMinutes := int64(30)
+	raisePriority := true
+	teamLeadID := uuid.New()
+	s.Require().NoError(holiday.UnmarshalText([]byte("2026-11-04")))
+
+	slaRequest := openapi.UpdateOrganizationSLARequest{
+		Calendar: &openapi.BusinessCalendar{
+			Timezone: &timezone,
+			WorkingHours: []openapi.WorkingHours{
+				{Weekday: 1, Start: "09:00", End: "18:00"},
+				{Weekday: 2, Start: "09:00", End: "18:00"},
+			},
+			Holidays: &[]openapi_types.Date{holiday},
+		},
+		Policies: []openapi.SLAPolicy{
+			{Name: "Standard", FirstResponseMinutes: 240, ResolutionMinutes: 2400},
+			{
+				Name:                 "Critical",
+				Priority:             &critical,
+				FirstResponseMinutes: 15,
+				ResolutionMinutes:    240,
+				AroundTheClock:       &aroundTheClock,
+			},
+		},
+		Escalations: &[]openapi.EscalationRule{
+			{Name: "Due soon", Target: openapi.Resolution, BeforeMinutes: &beforeMinutes, RaisePriority: &raisePriority},
+			{Name: "Breached", Target: openapi.FirstResponse, AssignTo: &teamLeadID},
+		},
+	}
+
+	s.Run("Organization without SLA policies returns default", func() {
+		orgID := s.createWorkflowTestOrganization("Default SLA Org", "default-sla.com")
+
+		rec := s.sendSLARequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationSLA
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.IsDefault)
+		s.Empty(*resp.Policies)
+		s.Nil(resp.Calendar)
+	})
+
+	s.Run("Configure, read and reset SLA policies", func() {
+		orgID := s.createWorkflowTestOrganization("Custom SLA Org", "custom-sla.com")
+
+		rec := s.sendSLARequest(http.MethodPut, orgID, slaRequest)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = s.sendSLARequest(http.MethodGet, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.OrganizationSLA
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.False(*resp.IsDefault)
+		s.Require().Len(*resp.Policies, 2)
+		s.Equal("Critical", (*resp.Policies)[1].Name)
+		s.NotNil((*resp.Policies)[1].Id)
+		s.True(*(*resp.Policies)[1].AroundTheClock)
+		s.Require().NotNil(resp.Calendar)
+		s.Equal(timezone, *resp.Calendar.Timezone)
+		s.Len(resp.Calendar.WorkingHours, 2)
+		s.Equal("09:00", resp.Calendar.WorkingHours[0].Start)
+		s.Equal("18:00", resp.Calendar.WorkingHours[0].End)
+		s.Require().Len(*resp.Calendar.Holidays, 1)
+		s.Equal("2026-11-04", (*resp.Calendar.Holidays)[0].String())
+		s.Require().Len(*resp.Escalations, 2)
+		s.NotNil((*resp.Escalations)[0].Id)
+		s.Equal(int64(30), *(*resp.Escalations)[0].BeforeMinutes)
+		s.True(*(*resp.Escalations)[0].RaisePriority)
+		s.Equal(openapi.FirstResponse, (*resp.Escalations)[1].Target)
+		s.Equal(teamLeadID, *(*resp.Escalations)[1].AssignTo)
+
+		rec = s.sendSLARequest(http.MethodDelete, orgID, nil)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.IsDefault)
+		s.Empty(*resp.Policies)
+		s.Empty(*resp.Escalations)
+	})
+
+	s.Run("Escalation rules sent again without IDs keep their identity", func() {
+		orgID := s.createWorkflowTestOrganization("Repeated SLA Org", "repeated-sla.com")
+
+		var first, second openapi.OrganizationSLA
+		rec := s.sendSLARequest(http.MethodPut, orgID, slaRequest)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &first))
+
+		rec = s.sendSLARequest(http.MethodPut, orgID, slaRequest)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &second))
+
+		s.Require().Len(*second.Escalations, 2)
+		s.Equal(*(*first.Escalations)[0].Id, *(*second.Escalations)[0].Id)
+		s.Equal(*(*first.Escalations)[1].Id, *(*second.Escalations)[1].Id)
+	})
+
+	s.Run("Invalid SLA configuration returns 400", func() {
+		orgID := s.createWorkflowTestOrganization("Invalid SLA Org", "invalid-sla.com")
+		unknownZone := "Mars/Olympus"
+
+		invalid := []openapi.UpdateOrganizationSLARequest{
+			{Policies: []openapi.SLAPolicy{{Name: "Slow", FirstResponseMinutes: 600, ResolutionMinutes: 60}}},
+			{Policies: []openapi.SLAPolicy{
+				{Name: "A", FirstResponseMinutes: 60, ResolutionMinutes: 120},
+				{Name: "B", FirstResponseMinutes: 30, ResolutionMinutes: 60},
+			}},
+			{
+				Calendar: &openapi.BusinessCalendar{
+					Timezone:     &unknownZone,
+					WorkingHours: []openapi.WorkingHours{{Weekday: 1, Start: "09:00", End: "18:00"}},
+				},
+				Policies: []openapi.SLAPolicy{},
+			},
+			{
+				Calendar: &openapi.BusinessCalendar{
+					WorkingHours: []openapi.WorkingHours{{Weekday: 1, Start: "18:00", End: "09:00"}},
+				},
+				Policies: []openapi.SLAPolicy{},
+			},
+			{
+				Policies:    []openapi.SLAPolicy{},
+				Escalations: &[]openapi.EscalationRule{{Name: "Does nothing", Target: openapi.Resolution}},
+			},
+		}
+
+		for _, req := range invalid {
+			rec := s.sendSLARequest(http.MethodPut, orgID, req)
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+	})
+
+	s.Run("Unknown organization returns 404", func() {
+		rec := s.sendSLARequest(http.MethodPut, uuid.New(), slaRequest)
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
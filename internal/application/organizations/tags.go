@@ -0,0 +1,126 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h OrganizationHandlers) GetOrganizationsIDTags(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	response := make([]openapi.OrganizationTag, 0, len(org.Tags()))
+	for _, tag := range org.Tags() {
+		response = append(response, convertTagToResponse(tag))
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h OrganizationHandlers) PostOrganizationsIDTags(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.CreateOrganizationTagRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	tag, err := tickets.NewTag(req.Name, req.Color, stringValue(req.Description))
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	_, err = h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if addErr := org.AddTag(tag); addErr != nil {
+			return false, addErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertTagToResponse(tag))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDTagsName(c echo.Context, id openapi_types.UUID, name string) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationTagRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	tag, err := tickets.NewTag(name, req.Color, stringValue(req.Description))
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	_, err = h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if updateErr := org.UpdateTag(tag); updateErr != nil {
+			return false, updateErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertTagToResponse(tag))
+}
+
+func (h OrganizationHandlers) DeleteOrganizationsIDTagsName(c echo.Context, id openapi_types.UUID, name string) error {
+	ctx := c.Request().Context()
+
+	_, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if removeErr := org.RemoveTag(name); removeErr != nil {
+			return false, removeErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleTagError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h OrganizationHandlers) handleTagError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) || errors.Is(err, tickets.ErrTagNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrTagExists) || errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidTag) || errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+func convertTagToResponse(tag tickets.Tag) openapi.OrganizationTag {
+	name := tag.Name
+	color := tag.Color
+	description := tag.Description
+	return openapi.OrganizationTag{
+		Name:        &name,
+		Color:       &color,
+		Description: &description,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
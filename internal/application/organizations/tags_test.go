@@ -0,0 +1,102 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *OrganizationsSuite) sendTagRequest(method, path string, body any) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reqBody.Write(payload)
+	}
+
+	req := httptest.NewRequest(method, path, &reqBody)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OrganizationsSuite) getOrganizationTags(orgID uuid.UUID) []openapi.OrganizationTag {
+	rec := s.sendTagRequest(http.MethodGet, fmt.Sprintf("/organizations/%s/tags", orgID), nil)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var tags []openapi.OrganizationTag
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &tags))
+	return tags
+}
+
+func (s *OrganizationsSuite) TestOrganizationTags() {
+	orgID := s.createWorkflowTestOrganization("Tags Org", "tags.com")
+	tagsPath := fmt.Sprintf("/organizations/%s/tags", orgID)
+	description := "Key accounts"
+
+	s.Run("Organization starts without tags", func() {
+		s.Empty(s.getOrganizationTags(orgID))
+	})
+
+	s.Run("Define a tag", func() {
+		rec := s.sendTagRequest(http.MethodPost, tagsPath, openapi.CreateOrganizationTagRequest{
+			Name:        "VIP",
+			Color:       "#ffd700",
+			Description: &description,
+		})
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var tag openapi.OrganizationTag
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &tag))
+		s.Equal("vip", *tag.Name)
+		s.Equal("#FFD700", *tag.Color)
+
+		tags := s.getOrganizationTags(orgID)
+		s.Require().Len(tags, 1)
+		s.Equal(description, *tags[0].Description)
+	})
+
+	s.Run("Invalid and duplicate tags are rejected", func() {
+		rec := s.sendTagRequest(http.MethodPost, tagsPath,
+			openapi.CreateOrganizationTagRequest{Name: "vip", Color: "#000000"})
+		s.Equal(http.StatusConflict, rec.Code)
+
+		rec = s.sendTagRequest(http.MethodPost, tagsPath,
+			openapi.CreateOrganizationTagRequest{Name: "hardware recall", Color: "#000000"})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		rec = s.sendTagRequest(http.MethodPost, tagsPath,
+			openapi.CreateOrganizationTagRequest{Name: "security", Color: "black"})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		rec = s.sendTagRequest(http.MethodPost, fmt.Sprintf("/organizations/%s/tags", uuid.New()),
+			openapi.CreateOrganizationTagRequest{Name: "security", Color: "#000000"})
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Update a tag", func() {
+		rec := s.sendTagRequest(http.MethodPut, tagsPath+"/vip", openapi.UpdateOrganizationTagRequest{Color: "#C0C0C0"})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		tags := s.getOrganizationTags(orgID)
+		s.Require().Len(tags, 1)
+		s.Equal("#C0C0C0", *tags[0].Color)
+		s.Empty(*tags[0].Description)
+
+		rec = s.sendTagRequest(http.MethodPut, tagsPath+"/security", openapi.UpdateOrganizationTagRequest{Color: "#000000"})
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Delete a tag", func() {
+		s.Equal(http.StatusNoContent, s.sendTagRequest(http.MethodDelete, tagsPath+"/vip", nil).Code)
+		s.Empty(s.getOrganizationTags(orgID))
+		s.Equal(http.StatusNotFound, s.sendTagRequest(http.MethodDelete, tagsPath+"/vip", nil).Code)
+	})
+}
@@ -6,20 +6,33 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h OrganizationHandlers) PutOrganizationsID(c echo.Context, id openapi_types.UUID) error {
+func (h OrganizationHandlers) PutOrganizationsID(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PutOrganizationsIDParams,
+) error {
 	ctx := c.Request().Context()
 	var req openapi.UpdateOrganizationRequest
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
+	precondition, err := etag.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
 
 	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if !precondition.Matches(org.Version()) {
+			return false, organizations.ErrVersionConflict
+		}
 		return h.updateOrganizationFields(req, org)
 	})
 
@@ -27,6 +40,7 @@ func (h OrganizationHandlers) PutOrganizationsID(c echo.Context, id openapi_type
 		return h.handleUpdateError(c, err)
 	}
 
+	etag.Set(c, org.Version())
 	return c.JSON(http.StatusOK, h.buildOrganizationResponse(org))
 }
 
@@ -100,5 +114,11 @@ func (h OrganizationHandlers) handleUpdateError(c echo.Context, err error) error
 	if errors.Is(err, organizations.ErrCircularReference) {
 		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
 	}
+	if errors.Is(err, organizations.ErrVersionConflict) {
+		return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
 	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 }
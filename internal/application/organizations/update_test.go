@@ -205,3 +205,42 @@ func (s *OrganizationsSuite) TestUpdateOrganization() {
 		s.Require().Equal(http.StatusOK, rec.Code)
 	})
 }
+
+func (s *OrganizationsSuite) TestUpdateOrganizationIfMatch() {
+	domain := "concurrent.com"
+	reqBody, _ := json.Marshal(openapi.CreateOrganizationRequest{Name: "Concurrent Organization", Domain: &domain})
+	req := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewBuffer(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	var createResp openapi.CreateOrganizationResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &createResp))
+	path := fmt.Sprintf("/organizations/%s", createResp.Id)
+
+	update := func(name, ifMatch string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(openapi.UpdateOrganizationRequest{Name: &name})
+		updateReq := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(body))
+		updateReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		updateReq.Header.Set("If-Match", ifMatch)
+		updateRec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(updateRec, updateReq)
+		return updateRec
+	}
+
+	getRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(getRec, httptest.NewRequest(http.MethodGet, path, nil))
+	s.Require().Equal(http.StatusOK, getRec.Code)
+	loaded := getRec.Header().Get("ETag")
+	s.Require().Equal(`"1"`, loaded)
+
+	rec = update("Renamed Organization", loaded)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+
+	rec = update("Lost Update", loaded)
+	s.Equal(http.StatusPreconditionFailed, rec.Code)
+
+	rec = update("Lost Update", "W/\"2\"")
+	s.Equal(http.StatusPreconditionFailed, rec.Code)
+}
@@ -0,0 +1,145 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h OrganizationHandlers) GetOrganizationsIDWorkflow(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.GetOrganization(ctx, id)
+	if err != nil {
+		return h.handleWorkflowError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildWorkflowResponse(org))
+}
+
+func (h OrganizationHandlers) PutOrganizationsIDWorkflow(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateOrganizationWorkflowRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	workflow, err := workflowFromRequest(req)
+	if err != nil {
+		return h.handleWorkflowError(c, err)
+	}
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if setErr := org.SetWorkflow(workflow); setErr != nil {
+			return false, setErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWorkflowError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildWorkflowResponse(org))
+}
+
+func (h OrganizationHandlers) DeleteOrganizationsIDWorkflow(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	org, err := h.repo.UpdateOrganization(ctx, id, func(org *organizations.Organization) (bool, error) {
+		if !org.HasCustomWorkflow() {
+			return false, nil
+		}
+		org.ResetWorkflow()
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWorkflowError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, buildWorkflowResponse(org))
+}
+
+func (h OrganizationHandlers) handleWorkflowError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, organizations.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidWorkflow) || errors.Is(err, organizations.ErrOrganizationValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+func workflowFromRequest(req openapi.UpdateOrganizationWorkflowRequest) (*tickets.Workflow, error) {
+	statuses := make([]tickets.WorkflowStatus, 0, len(req.Statuses))
+	for _, status := range req.Statuses {
+		ws := tickets.WorkflowStatus{
+			Key:      tickets.Status(status.Key),
+			Category: tickets.Status(status.Category),
+		}
+		if status.Name != nil {
+			ws.Name = *status.Name
+		}
+		statuses = append(statuses, ws)
+	}
+
+	transitions := make([]tickets.WorkflowTransition, 0, len(req.Transitions))
+	for _, transition := range req.Transitions {
+		wt := tickets.WorkflowTransition{
+			From: tickets.Status(transition.From),
+			To:   tickets.Status(transition.To),
+		}
+		if transition.AllowedRoles != nil {
+			for _, role := range *transition.AllowedRoles {
+				wt.AllowedRoles = append(wt.AllowedRoles, users.Role(role))
+			}
+		}
+		transitions = append(transitions, wt)
+	}
+
+	return tickets.NewWorkflow(statuses, transitions)
+}
+
+func buildWorkflowResponse(org *organizations.Organization) openapi.OrganizationWorkflow {
+	workflow := org.Workflow()
+
+	statuses := make([]openapi.WorkflowStatus, 0, len(workflow.Statuses()))
+	for _, status := range workflow.Statuses() {
+		name := status.Name
+		statuses = append(statuses, openapi.WorkflowStatus{
+			Key:      status.Key.String(),
+			Name:     &name,
+			Category: openapi.TicketStatusCategory(status.Category.String()),
+		})
+	}
+
+	transitions := make([]openapi.WorkflowTransition, 0, len(workflow.Transitions()))
+	for _, transition := range workflow.Transitions() {
+		roles := make([]openapi.UserRole, 0, len(transition.AllowedRoles))
+		for _, role := range transition.AllowedRoles {
+			roles = append(roles, openapi.UserRole(role))
+		}
+		transitions = append(transitions, openapi.WorkflowTransition{
+			From:         transition.From.String(),
+			To:           transition.To.String(),
+			AllowedRoles: &roles,
+		})
+	}
+
+	isDefault := !org.HasCustomWorkflow()
+	return openapi.OrganizationWorkflow{
+		Statuses:    &statuses,
+		Transitions: &transitions,
+		IsDefault:   &isDefault,
+	}
+}
@@ -0,0 +1,140 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *OrganizationsSuite) createWorkflowTestOrganization(name, domain string) uuid.UUID {
+	orgReq := openapi.CreateOrganizationRequest{Name: name, Domain: &domain}
+	reqBody, _ := json.Marshal(orgReq)
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewBuffer(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusCreated, rec.Code)
+
+	var createResp openapi.CreateOrganizationResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &createResp))
+	return *createResp.Id
+}
+
+func (s *OrganizationsSuite) putWorkflow(
+	orgID uuid.UUID,
+	workflowReq openapi.UpdateOrganizationWorkflowRequest,
+) *httptest.ResponseRecorder {
+	reqBody, _ := json.Marshal(workflowReq)
+	req := httptest.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("/organizations/%s/workflow", orgID),
+		bytes.NewBuffer(reqBody),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OrganizationsSuite) TestOrganizationWorkflow() {
+	vendorName := "Pending vendor"
+	adminOnly := []openapi.UserRole{openapi.Admin}
+	customWorkflow := openapi.UpdateOrganizationWorkflowRequest{
+		Statuses: []openapi.WorkflowStatus{
+			{Key: "new", Category: openapi.New},
+			{Key: "in_progress", Category: openapi.InProgress},
+			{Key: "pending_vendor", Name: &vendorName, Category: openapi.Waiting},
+			{Key: "closed", Category: openapi.Closed},
+		},
+		Transitions: []openapi.WorkflowTransition{
+			{From: "new", To: "in_progress"},
+			{From: "in_progress", To: "pending_vendor"},
+			{From: "pending_vendor", To: "in_progress"},
+			{From: "in_progress", To: "closed", AllowedRoles: &adminOnly},
+		},
+	}
+
+	s.Run("Organization without custom workflow returns default", func() {
+		orgID := s.createWorkflowTestOrganization("Default Workflow Org", "default-workflow.com")
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/organizations/%s/workflow", orgID), nil)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var resp openapi.OrganizationWorkflow
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Require().NotNil(resp.IsDefault)
+		s.True(*resp.IsDefault)
+		s.Require().NotNil(resp.Statuses)
+		s.Len(*resp.Statuses, 5)
+		s.Require().NotNil(resp.Transitions)
+		s.NotEmpty(*resp.Transitions)
+	})
+
+	s.Run("Configure and reset custom workflow", func() {
+		orgID := s.createWorkflowTestOrganization("Custom Workflow Org", "custom-workflow.com")
+
+		rec := s.putWorkflow(orgID, customWorkflow)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp openapi.OrganizationWorkflow
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.False(*resp.IsDefault)
+		s.Require().Len(*resp.Statuses, 4)
+		s.Equal("pending_vendor", (*resp.Statuses)[2].Key)
+		s.Equal(vendorName, *(*resp.Statuses)[2].Name)
+		s.Equal(openapi.Waiting, (*resp.Statuses)[2].Category)
+		s.Require().Len(*resp.Transitions, 4)
+		s.Equal(adminOnly, *(*resp.Transitions)[3].AllowedRoles)
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/organizations/%s/workflow", orgID), nil)
+		rec = httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.True(*resp.IsDefault)
+		s.Len(*resp.Statuses, 5)
+	})
+
+	s.Run("Invalid workflow returns 400", func() {
+		orgID := s.createWorkflowTestOrganization("Invalid Workflow Org", "invalid-workflow.com")
+
+		rec := s.putWorkflow(orgID, openapi.UpdateOrganizationWorkflowRequest{
+			Statuses: []openapi.WorkflowStatus{
+				{Key: "in_progress", Category: openapi.InProgress},
+			},
+			Transitions: []openapi.WorkflowTransition{},
+		})
+		s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+		rec = s.putWorkflow(orgID, openapi.UpdateOrganizationWorkflowRequest{
+			Statuses: []openapi.WorkflowStatus{
+				{Key: "new", Category: openapi.New},
+			},
+			Transitions: []openapi.WorkflowTransition{
+				{From: "new", To: "resolved"},
+			},
+		})
+		s.Require().Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Unknown organization returns 404", func() {
+		rec := s.putWorkflow(uuid.New(), customWorkflow)
+		s.Require().Equal(http.StatusNotFound, rec.Code)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/organizations/%s/workflow", uuid.New()), nil)
+		rec = httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusNotFound, rec.Code)
+	})
+}
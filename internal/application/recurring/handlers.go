@@ -0,0 +1,65 @@
+package recurring
+
+import (
+	"context"
+
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+type TemplateRepository interface {
+	CreateTemplate(ctx context.Context, createFn func() (*recurring.Template, error)) (*recurring.Template, error)
+	UpdateTemplate(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*recurring.Template) (bool, error),
+	) (*recurring.Template, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (*recurring.Template, error)
+	ListTemplates(ctx context.Context, filter queries.RecurringTemplateFilter) ([]*recurring.Template, error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+}
+
+type TicketRepository interface {
+	CreateTicket(ctx context.Context, createFn func() (*tickets.Ticket, error)) (*tickets.Ticket, error)
+	GetTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+	NextTicketNumber(ctx context.Context, prefix string) (int64, error)
+}
+
+type OrganizationRepository interface {
+	GetOrganization(ctx context.Context, id uuid.UUID) (*organizations.Organization, error)
+}
+
+type CategoryRepository interface {
+	GetCategory(ctx context.Context, id uuid.UUID) (*categories.Category, error)
+}
+
+type UserRepository interface {
+	GetUser(ctx context.Context, id uuid.UUID) (*users.User, error)
+}
+
+type RecurringHandlers struct {
+	repo         TemplateRepository
+	orgRepo      OrganizationRepository
+	categoryRepo CategoryRepository
+	userRepo     UserRepository
+}
+
+func SetupHandlers(
+	repo TemplateRepository,
+	orgRepo OrganizationRepository,
+	categoryRepo CategoryRepository,
+	userRepo UserRepository,
+) RecurringHandlers {
+	return RecurringHandlers{
+		repo:         repo,
+		orgRepo:      orgRepo,
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+	}
+}
@@ -0,0 +1,177 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// JobName identifies the recurring tickets job and its lease
+const JobName = "recurring-tickets"
+
+// ticketPolicy is the configuration of one organization applied to new tickets
+type ticketPolicy struct {
+	slaConfig *tickets.SLAConfig
+	keyPrefix string
+}
+
+// Runner creates tickets from recurring templates whose next run has come
+type Runner struct {
+	repo       TemplateRepository
+	ticketRepo TicketRepository
+	orgRepo    OrganizationRepository
+}
+
+func NewRunner(repo TemplateRepository, ticketRepo TicketRepository, orgRepo OrganizationRepository) *Runner {
+	return &Runner{
+		repo:       repo,
+		ticketRepo: ticketRepo,
+		orgRepo:    orgRepo,
+	}
+}
+
+// CreateDue creates one ticket for every due template and advances the template to its next run.
+// Each run gets a ticket ID derived from the template and the scheduled time, so a run that was
+// already turned into a ticket by another replica or an interrupted scan is not duplicated.
+// Runs missed while the server was down collapse into a single ticket.
+func (r *Runner) CreateDue(ctx context.Context, now time.Time) error {
+	due, err := r.repo.ListTemplates(ctx, queries.RecurringTemplateFilter{DueBefore: &now})
+	if err != nil {
+		return fmt.Errorf("failed to list due recurring templates: %w", err)
+	}
+
+	policies := make(map[uuid.UUID]ticketPolicy)
+	var errs []error
+	for _, template := range due {
+		if runErr := r.run(ctx, template, policies, now); runErr != nil {
+			errs = append(errs, runErr)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Runner) run(
+	ctx context.Context,
+	template *recurring.Template,
+	policies map[uuid.UUID]ticketPolicy,
+	now time.Time,
+) error {
+	scheduledAt := *template.NextRunAt()
+	orgPolicy, err := r.policy(ctx, policies, template.Draft().OrganizationID)
+	if err != nil {
+		return err
+	}
+	ticket, created, err := r.createOccurrence(ctx, template, scheduledAt, orgPolicy)
+	if err != nil {
+		return err
+	}
+
+	ticketID := template.OccurrenceTicketID(scheduledAt)
+	_, err = r.repo.UpdateTemplate(ctx, template.ID(), func(template *recurring.Template) (bool, error) {
+		return template.RecordRun(scheduledAt, ticketID, now), nil
+	})
+	if err != nil && !errors.Is(err, recurring.ErrTemplateNotFound) {
+		return fmt.Errorf("failed to record run of recurring template %s: %w", template.ID(), err)
+	}
+
+	if created {
+		slog.InfoContext(ctx, "recurring ticket created",
+			"template_id", template.ID().String(),
+			"ticket_id", ticket.ID().String(),
+			"scheduled_at", scheduledAt.Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// createOccurrence creates the ticket of a scheduled run and reports false when the run already has one.
+// The ticket is looked up before a key is issued, so a repeated run does not leave a hole in the key sequence.
+func (r *Runner) createOccurrence(
+	ctx context.Context,
+	template *recurring.Template,
+	scheduledAt time.Time,
+	orgPolicy ticketPolicy,
+) (*tickets.Ticket, bool, error) {
+	_, err := r.ticketRepo.GetTicket(ctx, template.OccurrenceTicketID(scheduledAt))
+	if err == nil {
+		return nil, false, nil
+	}
+	if !errors.Is(err, tickets.ErrTicketNotFound) {
+		return nil, false, fmt.Errorf("failed to look up ticket of recurring template %s: %w", template.ID(), err)
+	}
+
+	key, err := r.issueTicketKey(ctx, orgPolicy.keyPrefix)
+	if err != nil {
+		return nil, false, err
+	}
+	ticket, err := r.ticketRepo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		ticket, ticketErr := template.NewTicket(scheduledAt)
+		if ticketErr != nil {
+			return nil, ticketErr
+		}
+		ticket.ApplySLAConfig(orgPolicy.slaConfig)
+		if key != "" {
+			if keyErr := ticket.AssignKey(key); keyErr != nil {
+				return nil, keyErr
+			}
+		}
+		return ticket, nil
+	})
+	// A trashed ticket of the run is not found above but still blocks the insert
+	if errors.Is(err, tickets.ErrTicketExists) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create ticket from recurring template %s: %w", template.ID(), err)
+	}
+	return ticket, true, nil
+}
+
+// policy returns the SLA policies and ticket key prefix of the organization,
+// caching them for the duration of one scan
+func (r *Runner) policy(
+	ctx context.Context,
+	policies map[uuid.UUID]ticketPolicy,
+	orgID uuid.UUID,
+) (ticketPolicy, error) {
+	if orgPolicy, ok := policies[orgID]; ok {
+		return orgPolicy, nil
+	}
+
+	orgPolicy := ticketPolicy{slaConfig: tickets.DefaultSLAConfig()}
+	org, err := r.orgRepo.GetOrganization(ctx, orgID)
+	switch {
+	case errors.Is(err, organizations.ErrOrganizationNotFound):
+	case err != nil:
+		return ticketPolicy{}, fmt.Errorf("failed to load organization %s: %w", orgID, err)
+	default:
+		if config := org.SLAConfig(); config != nil {
+			orgPolicy.slaConfig = config
+		}
+		orgPolicy.keyPrefix = org.Settings().TicketKeyPrefix
+	}
+
+	policies[orgID] = orgPolicy
+	return orgPolicy, nil
+}
+
+// issueTicketKey takes the next key with the prefix; organizations without a prefix issue no keys
+func (r *Runner) issueTicketKey(ctx context.Context, prefix string) (string, error) {
+	if prefix == "" {
+		return "", nil
+	}
+	number, err := r.ticketRepo.NextTicketNumber(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to issue ticket key: %w", err)
+	}
+	return tickets.FormatTicketKey(prefix, number), nil
+}
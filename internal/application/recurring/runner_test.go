@@ -0,0 +1,149 @@
+package recurring_test
+
+import (
+	"context"
+	"time"
+
+	apprecurring "simpleservicedesk/internal/application/recurring"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+func (s *RecurringSuite) createTemplate(orgID uuid.UUID, assigneeID *uuid.UUID, expression string) *recurring.Template {
+	schedule, err := recurring.NewSchedule(recurring.ScheduleCron, expression, "", time.Now())
+	s.Require().NoError(err)
+	template, err := s.RecurringRepo.CreateTemplate(context.Background(), func() (*recurring.Template, error) {
+		return recurring.CreateTemplate("Hourly check", recurring.TicketDraft{
+			Title:          "Check the backup logs",
+			Priority:       tickets.PriorityNormal,
+			OrganizationID: orgID,
+			AssigneeID:     assigneeID,
+		}, schedule, uuid.New())
+	})
+	s.Require().NoError(err)
+	return template
+}
+
+func (s *RecurringSuite) getTemplate(id uuid.UUID) *recurring.Template {
+	template, err := s.RecurringRepo.GetTemplate(context.Background(), id)
+	s.Require().NoError(err)
+	return template
+}
+
+func (s *RecurringSuite) organizationTickets(orgID uuid.UUID) []*tickets.Ticket {
+	list, err := s.TicketsRepo.ListTickets(context.Background(), queries.TicketFilter{OrganizationID: &orgID})
+	s.Require().NoError(err)
+	return list
+}
+
+func (s *RecurringSuite) TestCreateDue() {
+	ctx := context.Background()
+	runner := apprecurring.NewRunner(s.RecurringRepo, s.TicketsRepo, s.OrganizationsRepo)
+	orgID := s.createOrganization("Recurring Org")
+	agentID := s.createUser(orgID, users.RoleAgent, true)
+	template := s.createTemplate(orgID, &agentID, "0 * * * *")
+	scheduledAt := *template.NextRunAt()
+
+	s.Run("Templates that are not due yet are skipped", func() {
+		s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(-time.Minute)))
+		s.Empty(s.organizationTickets(orgID))
+	})
+
+	s.Run("Due template creates a ticket and advances", func() {
+		s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Second)))
+
+		created := s.organizationTickets(orgID)
+		s.Require().Len(created, 1)
+		ticket := created[0]
+		s.Equal(template.OccurrenceTicketID(scheduledAt), ticket.ID())
+		s.Equal("Check the backup logs", ticket.Title())
+		s.Equal(template.CreatedBy(), ticket.AuthorID())
+		s.Equal(agentID, *ticket.AssigneeID())
+		s.NotNil(ticket.SLA())
+
+		advanced := s.getTemplate(template.ID())
+		s.Equal(scheduledAt, *advanced.LastRunAt())
+		s.Equal(ticket.ID(), *advanced.LastTicketID())
+		s.Equal(scheduledAt.Add(time.Hour), *advanced.NextRunAt())
+	})
+
+	s.Run("Repeated scan does not duplicate the ticket", func() {
+		s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Second)))
+		s.Len(s.organizationTickets(orgID), 1)
+	})
+
+	s.Run("Missed runs collapse into one ticket", func() {
+		s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(5*time.Hour+time.Minute)))
+		s.Len(s.organizationTickets(orgID), 2)
+		s.Equal(scheduledAt.Add(6*time.Hour), *s.getTemplate(template.ID()).NextRunAt())
+	})
+}
+
+func (s *RecurringSuite) TestCreateDueWhenTicketExists() {
+	ctx := context.Background()
+	runner := apprecurring.NewRunner(s.RecurringRepo, s.TicketsRepo, s.OrganizationsRepo)
+	orgID := s.createOrganization("Recurring Org")
+	template := s.createTemplate(orgID, nil, "@daily")
+	scheduledAt := *template.NextRunAt()
+
+	// Another replica created the ticket but stopped before recording the run
+	_, err := s.TicketsRepo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		return template.NewTicket(scheduledAt)
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Second)))
+	s.Len(s.organizationTickets(orgID), 1)
+	advanced := s.getTemplate(template.ID())
+	s.Equal(template.OccurrenceTicketID(scheduledAt), *advanced.LastTicketID())
+	s.Equal(scheduledAt.Add(24*time.Hour), *advanced.NextRunAt())
+}
+
+func (s *RecurringSuite) TestCreateDueIssuesTicketKeys() {
+	ctx := context.Background()
+	runner := apprecurring.NewRunner(s.RecurringRepo, s.TicketsRepo, s.OrganizationsRepo)
+	orgID := s.createOrganization("Recurring Org")
+	_, err := s.OrganizationsRepo.UpdateOrganization(ctx, orgID, func(org *organizations.Organization) (bool, error) {
+		settings := org.Settings()
+		settings.TicketKeyPrefix = "OPS"
+		org.UpdateSettings(settings)
+		return true, nil
+	})
+	s.Require().NoError(err)
+	template := s.createTemplate(orgID, nil, "@hourly")
+	scheduledAt := *template.NextRunAt()
+
+	// The run already has a ticket, so it takes no key number
+	_, err = s.TicketsRepo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		return template.NewTicket(scheduledAt)
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Second)))
+
+	s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Hour+time.Second)))
+	next, err := s.TicketsRepo.GetTicket(ctx, template.OccurrenceTicketID(scheduledAt.Add(time.Hour)))
+	s.Require().NoError(err)
+	s.Equal("OPS-1", next.Key())
+}
+
+func (s *RecurringSuite) TestCreateDueSkipsPausedTemplates() {
+	ctx := context.Background()
+	runner := apprecurring.NewRunner(s.RecurringRepo, s.TicketsRepo, s.OrganizationsRepo)
+	orgID := s.createOrganization("Recurring Org")
+	template := s.createTemplate(orgID, nil, "@hourly")
+	scheduledAt := *template.NextRunAt()
+
+	_, err := s.RecurringRepo.UpdateTemplate(ctx, template.ID(), func(template *recurring.Template) (bool, error) {
+		template.Deactivate()
+		return true, nil
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(runner.CreateDue(ctx, scheduledAt.Add(time.Hour)))
+	s.Empty(s.organizationTickets(orgID))
+}
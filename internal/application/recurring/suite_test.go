@@ -0,0 +1,22 @@
+package recurring_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RecurringSuite struct {
+	application.ServerSuite
+}
+
+func (s *RecurringSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestRecurringSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RecurringSuite))
+}
@@ -0,0 +1,290 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+	"simpleservicedesk/pkg/echomiddleware"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// upcomingRunsShown is how many next runs a template response lists
+const upcomingRunsShown = 5
+
+func (h RecurringHandlers) GetRecurringTemplates(c echo.Context, params openapi.GetRecurringTemplatesParams) error {
+	list, err := h.repo.ListTemplates(c.Request().Context(), queries.RecurringTemplateFilter{
+		OrganizationID: params.OrganizationId,
+		IsActive:       params.IsActive,
+	})
+	if err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	result := make([]openapi.RecurringTemplate, 0, len(list))
+	for _, template := range list {
+		result = append(result, convertTemplateToResponse(template))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h RecurringHandlers) PostRecurringTemplates(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := authUserID(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.CreateRecurringTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	draft := draftFromRequest(req.Title, req.Description, req.Priority, req.OrganizationId, req.CategoryId,
+		req.AssigneeId)
+	if err := h.validateDraft(ctx, draft); err != nil {
+		return handleTemplateError(c, err)
+	}
+	schedule, err := scheduleFromRequest(req.Schedule, time.Now())
+	if err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	template, err := h.repo.CreateTemplate(ctx, func() (*recurring.Template, error) {
+		return recurring.CreateTemplate(req.Name, draft, schedule, userID)
+	})
+	if err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertTemplateToResponse(template))
+}
+
+func (h RecurringHandlers) GetRecurringTemplatesID(c echo.Context, id openapi_types.UUID) error {
+	template, err := h.repo.GetTemplate(c.Request().Context(), id)
+	if err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertTemplateToResponse(template))
+}
+
+func (h RecurringHandlers) PutRecurringTemplatesID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	var req openapi.UpdateRecurringTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	draft := draftFromRequest(req.Title, req.Description, req.Priority, req.OrganizationId, req.CategoryId,
+		req.AssigneeId)
+	if err := h.validateDraft(ctx, draft); err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	template, err := h.repo.UpdateTemplate(ctx, id, func(template *recurring.Template) (bool, error) {
+		now := time.Now()
+		// A schedule without start_at keeps the current start, so RRULE intervals keep counting from it
+		schedule, scheduleErr := scheduleFromRequest(req.Schedule, template.Schedule().StartAt())
+		if scheduleErr != nil {
+			return false, scheduleErr
+		}
+		if updateErr := template.Update(req.Name, draft); updateErr != nil {
+			return false, updateErr
+		}
+		if !schedule.Equal(template.Schedule()) {
+			if rescheduleErr := template.Reschedule(schedule, now); rescheduleErr != nil {
+				return false, rescheduleErr
+			}
+		}
+		return true, applyActivation(template, req.IsActive, now)
+	})
+	if err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertTemplateToResponse(template))
+}
+
+func (h RecurringHandlers) DeleteRecurringTemplatesID(c echo.Context, id openapi_types.UUID) error {
+	if err := h.repo.DeleteTemplate(c.Request().Context(), id); err != nil {
+		return handleTemplateError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// validateDraft checks that the organization exists, the category belongs to it
+// and the default assignee is an active agent or admin
+func (h RecurringHandlers) validateDraft(ctx context.Context, draft recurring.TicketDraft) error {
+	_, err := h.orgRepo.GetOrganization(ctx, draft.OrganizationID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return fmt.Errorf("%w: organization %s not found", recurring.ErrTemplateValidation, draft.OrganizationID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if draft.CategoryID != nil {
+		category, categoryErr := h.categoryRepo.GetCategory(ctx, *draft.CategoryID)
+		if errors.Is(categoryErr, categories.ErrCategoryNotFound) ||
+			(categoryErr == nil && category.OrganizationID() != draft.OrganizationID) {
+			return fmt.Errorf("%w: category %s not found in the organization",
+				recurring.ErrTemplateValidation, *draft.CategoryID)
+		}
+		if categoryErr != nil {
+			return categoryErr
+		}
+	}
+
+	if draft.AssigneeID != nil {
+		assignee, userErr := h.userRepo.GetUser(ctx, *draft.AssigneeID)
+		if errors.Is(userErr, users.ErrUserNotFound) ||
+			(userErr == nil && (!assignee.IsActive() || !assignee.Role().HasHigherOrEqualLevel(users.RoleAgent))) {
+			return fmt.Errorf("%w: assignee %s must be an active agent or admin",
+				recurring.ErrTemplateValidation, *draft.AssigneeID)
+		}
+		if userErr != nil {
+			return userErr
+		}
+	}
+	return nil
+}
+
+// applyActivation pauses or resumes the template; nil leaves it as it is
+func applyActivation(template *recurring.Template, isActive *bool, now time.Time) error {
+	switch {
+	case isActive == nil:
+		return nil
+	case *isActive:
+		return template.Activate(now)
+	default:
+		template.Deactivate()
+		return nil
+	}
+}
+
+func draftFromRequest(
+	title string,
+	description *string,
+	priority openapi.TicketPriority,
+	organizationID openapi_types.UUID,
+	categoryID, assigneeID *openapi_types.UUID,
+) recurring.TicketDraft {
+	draft := recurring.TicketDraft{
+		Title:          title,
+		Priority:       tickets.Priority(priority),
+		OrganizationID: organizationID,
+		CategoryID:     categoryID,
+		AssigneeID:     assigneeID,
+	}
+	if description != nil {
+		draft.Description = *description
+	}
+	return draft
+}
+
+// scheduleFromRequest parses the requested schedule; defaultStartAt applies when start_at is omitted
+func scheduleFromRequest(req openapi.RecurringSchedule, defaultStartAt time.Time) (recurring.Schedule, error) {
+	startAt := defaultStartAt
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+	timezone := ""
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+	return recurring.NewSchedule(recurring.ScheduleType(req.Type), req.Expression, timezone, startAt)
+}
+
+func convertTemplateToResponse(template *recurring.Template) openapi.RecurringTemplate {
+	id := template.ID()
+	name := template.Name()
+	draft := template.Draft()
+	priority := openapi.TicketPriority(draft.Priority.String())
+	isActive := template.IsActive()
+	createdBy := template.CreatedBy()
+	createdAt := template.CreatedAt()
+	updatedAt := template.UpdatedAt()
+
+	schedule := template.Schedule()
+	timezone := schedule.Timezone()
+	startAt := schedule.StartAt()
+
+	upcoming := make([]time.Time, 0, upcomingRunsShown)
+	if next := template.NextRunAt(); next != nil {
+		upcoming = append(upcoming, *next)
+		upcoming = append(upcoming, schedule.Upcoming(*next, upcomingRunsShown-1)...)
+	}
+
+	return openapi.RecurringTemplate{
+		Id:             &id,
+		Name:           &name,
+		Title:          &draft.Title,
+		Description:    &draft.Description,
+		Priority:       &priority,
+		OrganizationId: &draft.OrganizationID,
+		CategoryId:     draft.CategoryID,
+		AssigneeId:     draft.AssigneeID,
+		Schedule: &openapi.RecurringSchedule{
+			Type:       openapi.RecurringScheduleType(schedule.Type().String()),
+			Expression: schedule.Expression(),
+			Timezone:   &timezone,
+			StartAt:    &startAt,
+		},
+		IsActive:     &isActive,
+		NextRunAt:    template.NextRunAt(),
+		UpcomingRuns: &upcoming,
+		LastRunAt:    template.LastRunAt(),
+		LastTicketId: template.LastTicketID(),
+		CreatedBy:    &createdBy,
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+	}
+}
+
+func authUserID(c echo.Context) (uuid.UUID, bool) {
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(strings.TrimSpace(claims.UserID))
+	if err != nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func handleTemplateError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, recurring.ErrTemplateNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, recurring.ErrConcurrentUpdate):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, recurring.ErrTemplateValidation) ||
+		errors.Is(err, recurring.ErrInvalidSchedule) ||
+		errors.Is(err, tickets.ErrTicketValidation) ||
+		errors.Is(err, tickets.ErrInvalidTicket) ||
+		errors.Is(err, tickets.ErrInvalidPriority):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
@@ -0,0 +1,274 @@
+package recurring_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *RecurringSuite) createOrganization(name string) uuid.UUID {
+	org, err := s.OrganizationsRepo.CreateOrganization(context.Background(),
+		func() (*organizations.Organization, error) {
+			return organizations.CreateOrganization(name, uuid.NewString()[:8]+".com")
+		})
+	s.Require().NoError(err)
+	return org.ID()
+}
+
+func (s *RecurringSuite) createCategory(orgID uuid.UUID) uuid.UUID {
+	category, err := s.CategoriesRepo.CreateCategory(context.Background(), func() (*categories.Category, error) {
+		return categories.NewCategory(uuid.New(), "Maintenance", "Planned maintenance", orgID, nil)
+	})
+	s.Require().NoError(err)
+	return category.ID()
+}
+
+func (s *RecurringSuite) createUser(orgID uuid.UUID, role users.Role, isActive bool) uuid.UUID {
+	email := uuid.NewString()[:8] + "@recurring.com"
+	user, err := s.UsersRepo.CreateUser(context.Background(), email, []byte("hash"),
+		func() (*users.User, error) {
+			return users.NewUserWithDetails(
+				uuid.New(), "Recurring Agent", email, []byte("hash"), role, &orgID, isActive, time.Now(), time.Now(),
+			)
+		})
+	s.Require().NoError(err)
+	return user.ID()
+}
+
+func (s *RecurringSuite) templateRequest(orgID uuid.UUID, expression string) openapi.CreateRecurringTemplateRequest {
+	description := "Swap the weekly backup tapes in the server room"
+	return openapi.CreateRecurringTemplateRequest{
+		Name:           "Weekly backups",
+		Title:          "Rotate backup tapes",
+		Description:    &description,
+		Priority:       openapi.TicketPriority("high"),
+		OrganizationId: orgID,
+		Schedule: openapi.RecurringSchedule{
+			Type:       openapi.Cron,
+			Expression: expression,
+		},
+	}
+}
+
+func (s *RecurringSuite) postTemplate(req openapi.CreateRecurringTemplateRequest) openapi.RecurringTemplate {
+	rec := s.RequestAs(http.MethodPost, "/recurring-templates", req, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var template openapi.RecurringTemplate
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &template))
+	return template
+}
+
+func (s *RecurringSuite) TestCreateTemplate() {
+	orgID := s.createOrganization("Recurring Org")
+	categoryID := s.createCategory(orgID)
+	agentID := s.createUser(orgID, users.RoleAgent, true)
+
+	req := s.templateRequest(orgID, "0 9 * * MON")
+	req.CategoryId = &categoryID
+	req.AssigneeId = &agentID
+	timezone := "Europe/Berlin"
+	req.Schedule.Timezone = &timezone
+
+	template := s.postTemplate(req)
+	s.Require().NotNil(template.Id)
+	s.Equal("Weekly backups", *template.Name)
+	s.Equal(openapi.TicketPriority("high"), *template.Priority)
+	s.Equal(categoryID, *template.CategoryId)
+	s.Equal(agentID, *template.AssigneeId)
+	s.True(*template.IsActive)
+	s.Equal(timezone, *template.Schedule.Timezone)
+	s.Nil(template.LastRunAt)
+
+	s.Require().NotNil(template.NextRunAt)
+	s.Require().Len(*template.UpcomingRuns, 5)
+	s.Equal(template.NextRunAt.UTC(), (*template.UpcomingRuns)[0].UTC())
+	location, err := time.LoadLocation(timezone)
+	s.Require().NoError(err)
+	// Runs stay at 9:00 local time on Mondays, across daylight saving changes too
+	for i, run := range *template.UpcomingRuns {
+		local := run.In(location)
+		s.Equal(time.Monday, local.Weekday())
+		s.Equal(9, local.Hour())
+		if i > 0 {
+			s.True(run.After((*template.UpcomingRuns)[i-1]))
+		}
+	}
+}
+
+func (s *RecurringSuite) TestCreateTemplateValidation() {
+	orgID := s.createOrganization("Recurring Org")
+	otherCategoryID := s.createCategory(s.createOrganization("Other Org"))
+	customerID := s.createUser(orgID, users.RoleCustomer, true)
+	inactiveAgentID := s.createUser(orgID, users.RoleAgent, false)
+	missingID := uuid.New()
+
+	cases := []struct {
+		name   string
+		modify func(*openapi.CreateRecurringTemplateRequest)
+	}{
+		{"Invalid cron expression", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.Schedule.Expression = "61 * * * *"
+		}},
+		{"Invalid recurrence rule", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.Schedule.Type = openapi.Rrule
+			r.Schedule.Expression = "FREQ=SECONDLY"
+		}},
+		{"Unknown time zone", func(r *openapi.CreateRecurringTemplateRequest) {
+			timezone := "Mars/Olympus"
+			r.Schedule.Timezone = &timezone
+		}},
+		{"Unknown organization", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.OrganizationId = missingID
+		}},
+		{"Category of another organization", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.CategoryId = &otherCategoryID
+		}},
+		{"Customer assignee", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.AssigneeId = &customerID
+		}},
+		{"Inactive assignee", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.AssigneeId = &inactiveAgentID
+		}},
+		{"Unknown assignee", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.AssigneeId = &missingID
+		}},
+		{"Finished schedule", func(r *openapi.CreateRecurringTemplateRequest) {
+			r.Schedule.Type = openapi.Rrule
+			r.Schedule.Expression = "FREQ=DAILY;UNTIL=20200110"
+		}},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := s.templateRequest(orgID, "@daily")
+			tc.modify(&req)
+			rec := s.RequestAs(http.MethodPost, "/recurring-templates", req, "")
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func (s *RecurringSuite) TestListAndGetTemplates() {
+	orgID := s.createOrganization("Recurring Org")
+	otherOrgID := s.createOrganization("Other Org")
+	daily := s.postTemplate(s.templateRequest(orgID, "@daily"))
+	s.postTemplate(s.templateRequest(otherOrgID, "@weekly"))
+
+	rec := s.RequestAs(http.MethodGet, "/recurring-templates?organization_id="+orgID.String(), nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	var list []openapi.RecurringTemplate
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	s.Require().Len(list, 1)
+	s.Equal(*daily.Id, *list[0].Id)
+
+	rec = s.RequestAs(http.MethodGet, "/recurring-templates/"+daily.Id.String(), nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, "/recurring-templates/"+uuid.NewString(), nil, "")
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *RecurringSuite) TestUpdateTemplate() {
+	orgID := s.createOrganization("Recurring Org")
+	created := s.postTemplate(s.templateRequest(orgID, "0 9 * * *"))
+	path := "/recurring-templates/" + created.Id.String()
+
+	update := openapi.UpdateRecurringTemplateRequest{
+		Name:           "Evening backups",
+		Title:          "Rotate backup tapes",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		Schedule: openapi.RecurringSchedule{
+			Type:       openapi.Cron,
+			Expression: "30 18 * * *",
+		},
+	}
+
+	s.Run("Schedule change recomputes the next run", func() {
+		rec := s.RequestAs(http.MethodPut, path, update, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var updated openapi.RecurringTemplate
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.Equal("Evening backups", *updated.Name)
+		s.Equal(openapi.TicketPriority("normal"), *updated.Priority)
+		s.Require().NotNil(updated.NextRunAt)
+		s.Equal(18, updated.NextRunAt.UTC().Hour())
+		s.Equal(30, updated.NextRunAt.UTC().Minute())
+	})
+
+	s.Run("Pausing clears the next run", func() {
+		paused := false
+		update.IsActive = &paused
+		rec := s.RequestAs(http.MethodPut, path, update, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var updated openapi.RecurringTemplate
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.False(*updated.IsActive)
+		s.Nil(updated.NextRunAt)
+		s.Empty(*updated.UpcomingRuns)
+
+		rec = s.RequestAs(http.MethodGet, "/recurring-templates?is_active=true", nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var active []openapi.RecurringTemplate
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &active))
+		s.Empty(active)
+	})
+
+	s.Run("Resuming schedules the next run", func() {
+		resumed := true
+		update.IsActive = &resumed
+		rec := s.RequestAs(http.MethodPut, path, update, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var updated openapi.RecurringTemplate
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.True(*updated.IsActive)
+		s.NotNil(updated.NextRunAt)
+	})
+
+	s.Run("Invalid schedule", func() {
+		invalid := update
+		invalid.Schedule.Expression = "every day"
+		rec := s.RequestAs(http.MethodPut, path, invalid, "")
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	})
+
+	s.Run("Unknown template", func() {
+		rec := s.RequestAs(http.MethodPut, "/recurring-templates/"+uuid.NewString(), update, "")
+		s.Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+	})
+}
+
+func (s *RecurringSuite) TestDeleteTemplate() {
+	orgID := s.createOrganization("Recurring Org")
+	created := s.postTemplate(s.templateRequest(orgID, "@daily"))
+	path := "/recurring-templates/" + created.Id.String()
+
+	rec := s.RequestAs(http.MethodDelete, path, nil, "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, path, nil, "")
+	s.Equal(http.StatusNotFound, rec.Code)
+	rec = s.RequestAs(http.MethodDelete, path, nil, "")
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *RecurringSuite) TestTemplatesRequireAgent() {
+	orgID := s.createOrganization("Recurring Org")
+	_, customerToken := s.LoginAs("customer@recurring.com", openapi.Customer)
+	_, agentToken := s.LoginAs("agent@recurring.com", openapi.Agent)
+
+	rec := s.RequestAs(http.MethodGet, "/recurring-templates", nil, customerToken)
+	s.Equal(http.StatusForbidden, rec.Code)
+	rec = s.RequestAs(http.MethodPost, "/recurring-templates", s.templateRequest(orgID, "@daily"), customerToken)
+	s.Equal(http.StatusForbidden, rec.Code)
+
+	rec = s.RequestAs(http.MethodPost, "/recurring-templates", s.templateRequest(orgID, "@daily"), agentToken)
+	s.Equal(http.StatusCreated, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,23 @@
+package reports
+
+import (
+	"context"
+
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+)
+
+type TicketRepository interface {
+	AggregateSatisfaction(ctx context.Context, filter queries.SatisfactionFilter) ([]tickets.SatisfactionStats, error)
+	AggregateWorkLogs(ctx context.Context, filter queries.WorkLogFilter) ([]tickets.WorkLogStats, error)
+}
+
+type ReportHandlers struct {
+	ticketRepo TicketRepository
+}
+
+func SetupHandlers(ticketRepo TicketRepository) ReportHandlers {
+	return ReportHandlers{
+		ticketRepo: ticketRepo,
+	}
+}
@@ -0,0 +1,50 @@
+package reports
+
+import (
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h ReportHandlers) GetReportsSatisfaction(c echo.Context, params openapi.GetReportsSatisfactionParams) error {
+	ctx := c.Request().Context()
+
+	filter, err := queries.FromOpenAPISatisfactionParams(params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	stats, err := h.ticketRepo.AggregateSatisfaction(ctx, filter)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+
+	var overall tickets.SatisfactionStats
+	groups := make([]openapi.SatisfactionScore, 0, len(stats))
+	for _, group := range stats {
+		overall.Merge(group)
+		groups = append(groups, convertScoreToResponse(group))
+	}
+
+	return c.JSON(http.StatusOK, openapi.SatisfactionReport{
+		GroupBy: params.GroupBy,
+		Overall: convertScoreToResponse(overall),
+		Groups:  groups,
+	})
+}
+
+func convertScoreToResponse(stats tickets.SatisfactionStats) openapi.SatisfactionScore {
+	return openapi.SatisfactionScore{
+		Id:            stats.GroupID,
+		Responses:     stats.Responses,
+		Satisfied:     stats.Satisfied,
+		AverageRating: stats.AverageRating(),
+		Score:         stats.Score(),
+	}
+}
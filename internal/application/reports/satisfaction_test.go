@@ -0,0 +1,137 @@
+package reports_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// createRatedTicket resolves a new ticket handled by the agent and rates it on behalf of the author
+func (s *ReportsSuite) createRatedTicket(orgID, agentID uuid.UUID, categoryID *uuid.UUID, rating int) uuid.UUID {
+	ctx := context.Background()
+	ticket, err := s.TicketsRepo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		return tickets.NewTicket(
+			uuid.New(), "Rated ticket", "Ticket for CSAT reports", tickets.PriorityNormal, orgID, uuid.New(), categoryID,
+		)
+	})
+	s.Require().NoError(err)
+
+	_, err = s.TicketsRepo.UpdateTicket(ctx, ticket.ID(), func(ticket *tickets.Ticket) (bool, error) {
+		if assignErr := ticket.AssignTo(agentID); assignErr != nil {
+			return false, assignErr
+		}
+		if statusErr := ticket.ChangeStatus(tickets.StatusInProgress); statusErr != nil {
+			return false, statusErr
+		}
+		if statusErr := ticket.ChangeStatus(tickets.StatusResolved); statusErr != nil {
+			return false, statusErr
+		}
+		return true, ticket.SubmitSurvey(ticket.Survey().Token, rating, "")
+	})
+	s.Require().NoError(err)
+	return ticket.ID()
+}
+
+func (s *ReportsSuite) getSatisfaction(query url.Values) openapi.SatisfactionReport {
+	rec := s.RequestAs(http.MethodGet, "/reports/satisfaction?"+query.Encode(), nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var report openapi.SatisfactionReport
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &report))
+	return report
+}
+
+func (s *ReportsSuite) TestSatisfactionReport() {
+	orgID, otherOrgID := uuid.New(), uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+	categoryID := uuid.New()
+
+	s.createRatedTicket(orgID, alice, &categoryID, 5)
+	s.createRatedTicket(orgID, alice, &categoryID, 4)
+	s.createRatedTicket(orgID, alice, nil, 2)
+	s.createRatedTicket(otherOrgID, bob, nil, 1)
+
+	s.Run("Ratings are grouped by agent", func() {
+		report := s.getSatisfaction(url.Values{"group_by": {"agent"}})
+		s.Equal(openapi.SatisfactionByAgent, report.GroupBy)
+		s.Require().Len(report.Groups, 2)
+
+		top := report.Groups[0]
+		s.Equal(&alice, top.Id)
+		s.Equal(int64(3), top.Responses)
+		s.Equal(int64(2), top.Satisfied)
+		s.InDelta(11.0/3, top.AverageRating, 0.001)
+		s.InDelta(200.0/3, top.Score, 0.001)
+
+		s.Equal(int64(4), report.Overall.Responses)
+		s.InDelta(50.0, report.Overall.Score, 0.001)
+		s.Nil(report.Overall.Id)
+	})
+
+	s.Run("Tickets without a category form their own group", func() {
+		report := s.getSatisfaction(url.Values{"group_by": {"category"}})
+		s.Require().Len(report.Groups, 2)
+		scores := make(map[uuid.UUID]openapi.SatisfactionScore)
+		for _, group := range report.Groups {
+			key := uuid.Nil
+			if group.Id != nil {
+				key = *group.Id
+			}
+			scores[key] = group
+		}
+		s.Equal(int64(2), scores[uuid.Nil].Responses)
+		s.InDelta(0.0, scores[uuid.Nil].Score, 0.001)
+		s.Equal(int64(2), scores[categoryID].Responses)
+		s.InDelta(100.0, scores[categoryID].Score, 0.001)
+	})
+
+	s.Run("Reports can be limited to one organization", func() {
+		report := s.getSatisfaction(url.Values{"group_by": {"organization"}, "organization_id": {orgID.String()}})
+		s.Require().Len(report.Groups, 1)
+		s.Equal(&orgID, report.Groups[0].Id)
+		s.Equal(int64(3), report.Overall.Responses)
+	})
+
+	s.Run("Reports can be limited to a period", func() {
+		future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		report := s.getSatisfaction(url.Values{"group_by": {"agent"}, "from": {future}})
+		s.Empty(report.Groups)
+		s.Zero(report.Overall.Responses)
+		s.Zero(report.Overall.Score)
+	})
+}
+
+func (s *ReportsSuite) TestSatisfactionReportValidation() {
+	s.Run("Customers cannot read reports", func() {
+		_, token := s.LoginAs("reports-customer@example.com", openapi.Customer)
+		rec := s.RequestAs(http.MethodGet, "/reports/satisfaction?group_by=agent", nil, token)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Agents can read reports", func() {
+		_, token := s.LoginAs("reports-agent@example.com", openapi.Agent)
+		rec := s.RequestAs(http.MethodGet, "/reports/satisfaction?group_by=agent", nil, token)
+		s.Equal(http.StatusOK, rec.Code)
+	})
+
+	s.Run("Invalid parameters are rejected", func() {
+		s.Equal(http.StatusBadRequest, s.RequestAs(http.MethodGet, "/reports/satisfaction", nil, "").Code)
+		s.Equal(http.StatusBadRequest,
+			s.RequestAs(http.MethodGet, "/reports/satisfaction?group_by=priority", nil, "").Code)
+
+		query := url.Values{
+			"group_by": {"agent"},
+			"from":     {"2026-02-01T00:00:00Z"},
+			"to":       {"2026-01-01T00:00:00Z"},
+		}
+		s.Equal(http.StatusBadRequest,
+			s.RequestAs(http.MethodGet, "/reports/satisfaction?"+query.Encode(), nil, "").Code)
+	})
+}
@@ -0,0 +1,22 @@
+package reports_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportsSuite struct {
+	application.ServerSuite
+}
+
+func (s *ReportsSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestReportsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReportsSuite))
+}
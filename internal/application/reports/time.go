@@ -0,0 +1,52 @@
+package reports
+
+import (
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h ReportHandlers) GetReportsTime(c echo.Context, params openapi.GetReportsTimeParams) error {
+	ctx := c.Request().Context()
+
+	filter, err := queries.FromOpenAPITimeReportParams(params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	stats, err := h.ticketRepo.AggregateWorkLogs(ctx, filter)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+
+	var overall tickets.WorkLogStats
+	groups := make([]openapi.TimeReportGroup, 0, len(stats))
+	for _, group := range stats {
+		overall.Merge(group)
+		groups = append(groups, convertTimeGroupToResponse(group))
+	}
+
+	return c.JSON(http.StatusOK, openapi.TimeReport{
+		GroupBy: params.GroupBy,
+		From:    openapi_types.Date{Time: filter.From},
+		To:      openapi_types.Date{Time: filter.To},
+		Overall: convertTimeGroupToResponse(overall),
+		Groups:  groups,
+	})
+}
+
+func convertTimeGroupToResponse(stats tickets.WorkLogStats) openapi.TimeReportGroup {
+	return openapi.TimeReportGroup{
+		Id:              stats.GroupID,
+		Entries:         stats.Entries,
+		Minutes:         stats.Minutes,
+		BillableMinutes: stats.BillableMinutes,
+	}
+}
@@ -0,0 +1,133 @@
+package reports_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// createLoggedTicket creates a ticket with work log entries of the agent
+func (s *ReportsSuite) createLoggedTicket(
+	orgID, agentID uuid.UUID,
+	categoryID *uuid.UUID,
+	entries ...tickets.WorkLogEntry,
+) uuid.UUID {
+	ctx := context.Background()
+	ticket, err := s.TicketsRepo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		return tickets.NewTicket(
+			uuid.New(), "Billed ticket", "Ticket for time reports", tickets.PriorityNormal, orgID, uuid.New(), categoryID,
+		)
+	})
+	s.Require().NoError(err)
+
+	_, err = s.TicketsRepo.UpdateTicket(ctx, ticket.ID(), func(ticket *tickets.Ticket) (bool, error) {
+		for _, entry := range entries {
+			entry.AgentID = agentID
+			if _, logErr := ticket.AddWorkLog(entry); logErr != nil {
+				return false, logErr
+			}
+		}
+		return true, nil
+	})
+	s.Require().NoError(err)
+	return ticket.ID()
+}
+
+func (s *ReportsSuite) getTimeReport(query url.Values) openapi.TimeReport {
+	rec := s.RequestAs(http.MethodGet, "/reports/time?"+query.Encode(), nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var report openapi.TimeReport
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &report))
+	return report
+}
+
+func (s *ReportsSuite) TestTimeReport() {
+	orgID, otherOrgID := uuid.New(), uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+	categoryID := uuid.New()
+	monday := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	s.createLoggedTicket(orgID, alice, &categoryID,
+		tickets.WorkLogEntry{Date: monday, Minutes: 60, Billable: true},
+		tickets.WorkLogEntry{Date: tuesday, Minutes: 30},
+	)
+	s.createLoggedTicket(orgID, bob, nil, tickets.WorkLogEntry{Date: tuesday, Minutes: 45, Billable: true})
+	s.createLoggedTicket(otherOrgID, bob, nil, tickets.WorkLogEntry{Date: monday.AddDate(0, 0, -7), Minutes: 240})
+
+	week := func(groupBy string) url.Values {
+		return url.Values{"group_by": {groupBy}, "from": {"2026-03-02"}, "to": {"2026-03-08"}}
+	}
+
+	s.Run("Time is grouped by agent", func() {
+		report := s.getTimeReport(week("agent"))
+		s.Equal(openapi.TimeByAgent, report.GroupBy)
+		s.Equal(monday, report.From.Time)
+		s.Require().Len(report.Groups, 2)
+
+		top := report.Groups[0]
+		s.Equal(&alice, top.Id)
+		s.Equal(int64(2), top.Entries)
+		s.Equal(int64(90), top.Minutes)
+		s.Equal(int64(60), top.BillableMinutes)
+
+		s.Equal(int64(3), report.Overall.Entries)
+		s.Equal(int64(135), report.Overall.Minutes)
+		s.Equal(int64(105), report.Overall.BillableMinutes)
+		s.Nil(report.Overall.Id)
+	})
+
+	s.Run("Time is grouped by category and organization", func() {
+		report := s.getTimeReport(week("category"))
+		s.Require().Len(report.Groups, 2)
+		s.Equal(&categoryID, report.Groups[0].Id)
+		s.Nil(report.Groups[1].Id)
+
+		report = s.getTimeReport(url.Values{"group_by": {"organization"}, "from": {"2026-02-01"}, "to": {"2026-03-31"}})
+		s.Require().Len(report.Groups, 2)
+		s.Equal(&otherOrgID, report.Groups[0].Id)
+		s.Equal(int64(240), report.Groups[0].Minutes)
+	})
+
+	s.Run("Reports can be limited to an organization and billable time", func() {
+		query := week("agent")
+		query.Set("organization_id", orgID.String())
+		query.Set("billable", "true")
+		report := s.getTimeReport(query)
+		s.Equal(int64(2), report.Overall.Entries)
+		s.Equal(int64(105), report.Overall.Minutes)
+	})
+
+	s.Run("The last day of the range is included", func() {
+		report := s.getTimeReport(url.Values{"group_by": {"agent"}, "from": {"2026-03-03"}, "to": {"2026-03-03"}})
+		s.Equal(int64(75), report.Overall.Minutes)
+	})
+}
+
+func (s *ReportsSuite) TestTimeReportValidation() {
+	s.Run("Customers cannot read reports", func() {
+		_, token := s.LoginAs("time-customer@example.com", openapi.Customer)
+		rec := s.RequestAs(http.MethodGet, "/reports/time?group_by=agent&from=2026-03-01&to=2026-03-31", nil, token)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Invalid parameters are rejected", func() {
+		for _, query := range []string{
+			"group_by=agent",
+			"group_by=priority&from=2026-03-01&to=2026-03-31",
+			"group_by=agent&from=2026-03-31&to=2026-03-01",
+			"group_by=agent&from=yesterday&to=2026-03-01",
+		} {
+			rec := s.RequestAs(http.MethodGet, "/reports/time?"+query, nil, "")
+			s.Equal(http.StatusBadRequest, rec.Code, query)
+		}
+	})
+}
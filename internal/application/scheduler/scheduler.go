@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// leaseTTLFactor defines how many intervals a lease outlives its last renewal,
+// so a replica that stops renewing hands the job over after at most two missed ticks
+const leaseTTLFactor = 2
+
+const releaseTimeout = 5 * time.Second
+
+type LeaseRepository interface {
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+}
+
+// Job is a periodic background task
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+// Scheduler runs periodic jobs on every server replica while a lease
+// makes sure that only one replica executes each job at a time
+type Scheduler struct {
+	leases LeaseRepository
+	holder string
+}
+
+func New(leases LeaseRepository, holder string) *Scheduler {
+	return &Scheduler{
+		leases: leases,
+		holder: holder,
+	}
+}
+
+// Run executes the job every interval until ctx is canceled.
+// Job failures are logged and never stop the loop, so a broken job cannot take the server down.
+func (s *Scheduler) Run(ctx context.Context, job Job) error {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "starting background job", "job", job.Name, "interval", job.Interval.String())
+	for {
+		select {
+		case <-ctx.Done():
+			s.release(job)
+			slog.InfoContext(ctx, "background job stopped", "job", job.Name)
+			return nil
+		case now := <-ticker.C:
+			s.RunOnce(ctx, job, now)
+		}
+	}
+}
+
+// RunOnce executes the job if this replica holds or can take over its lease
+func (s *Scheduler) RunOnce(ctx context.Context, job Job, now time.Time) bool {
+	acquired, err := s.leases.AcquireLease(ctx, job.Name, s.holder, leaseTTLFactor*job.Interval)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to acquire job lease", "job", job.Name, "error", err)
+		return false
+	}
+	if !acquired {
+		return false
+	}
+
+	if err = job.Run(ctx, now); err != nil {
+		slog.ErrorContext(ctx, "background job failed", "job", job.Name, "error", err)
+	}
+	return true
+}
+
+// release hands the lease over to other replicas right away instead of waiting for it to expire
+func (s *Scheduler) release(job Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if err := s.leases.ReleaseLease(ctx, job.Name, s.holder); err != nil {
+		slog.ErrorContext(ctx, "failed to release job lease", "job", job.Name, "error", err)
+	}
+}
@@ -0,0 +1,148 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"simpleservicedesk/internal/application/scheduler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLeases emulates the lease collection shared by all replicas
+type memoryLeases struct {
+	mu      sync.Mutex
+	holders map[string]string
+	expires map[string]time.Time
+	err     error
+}
+
+func newMemoryLeases() *memoryLeases {
+	return &memoryLeases{
+		holders: make(map[string]string),
+		expires: make(map[string]time.Time),
+	}
+}
+
+func (m *memoryLeases) AcquireLease(_ context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return false, m.err
+	}
+	now := time.Now()
+	if current, ok := m.holders[name]; ok && current != holder && now.Before(m.expires[name]) {
+		return false, nil
+	}
+	m.holders[name] = holder
+	m.expires[name] = now.Add(ttl)
+	return true, nil
+}
+
+func (m *memoryLeases) ReleaseLease(_ context.Context, name, holder string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.holders[name] == holder {
+		delete(m.holders, name)
+		delete(m.expires, name)
+	}
+	return nil
+}
+
+func (m *memoryLeases) holder(name string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.holders[name]
+}
+
+func TestScheduler_RunOnce_SingleReplicaRunsJob(t *testing.T) {
+	leases := newMemoryLeases()
+	first := scheduler.New(leases, "replica-1")
+	second := scheduler.New(leases, "replica-2")
+
+	var runs int
+	job := scheduler.Job{
+		Name:     "test-job",
+		Interval: time.Minute,
+		Run: func(_ context.Context, _ time.Time) error {
+			runs++
+			return nil
+		},
+	}
+
+	now := time.Now()
+	assert.True(t, first.RunOnce(context.Background(), job, now))
+	assert.False(t, second.RunOnce(context.Background(), job, now))
+	assert.True(t, first.RunOnce(context.Background(), job, now), "the holder renews its own lease")
+	assert.Equal(t, 2, runs)
+}
+
+func TestScheduler_RunOnce_Failures(t *testing.T) {
+	t.Run("job error does not release the lease", func(t *testing.T) {
+		leases := newMemoryLeases()
+		replica := scheduler.New(leases, "replica-1")
+		job := scheduler.Job{
+			Name:     "failing-job",
+			Interval: time.Minute,
+			Run: func(_ context.Context, _ time.Time) error {
+				return errors.New("boom")
+			},
+		}
+
+		assert.True(t, replica.RunOnce(context.Background(), job, time.Now()))
+		assert.Equal(t, "replica-1", leases.holder("failing-job"))
+	})
+
+	t.Run("lease storage error skips the run", func(t *testing.T) {
+		leases := newMemoryLeases()
+		leases.err = errors.New("mongo is down")
+		replica := scheduler.New(leases, "replica-1")
+
+		job := scheduler.Job{
+			Name:     "test-job",
+			Interval: time.Minute,
+			Run: func(_ context.Context, _ time.Time) error {
+				t.Fatal("job must not run without a lease")
+				return nil
+			},
+		}
+		assert.False(t, replica.RunOnce(context.Background(), job, time.Now()))
+	})
+}
+
+func TestScheduler_Run(t *testing.T) {
+	leases := newMemoryLeases()
+	replica := scheduler.New(leases, "replica-1")
+
+	var runs atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- replica.Run(ctx, scheduler.Job{
+			Name:     "periodic-job",
+			Interval: 10 * time.Millisecond,
+			Run: func(_ context.Context, _ time.Time) error {
+				runs.Add(1)
+				return nil
+			},
+		})
+	}()
+
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after context cancellation")
+	}
+	assert.Empty(t, leases.holder("periodic-job"), "stopped replica releases its lease")
+}
@@ -1,24 +1,37 @@
 package application
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/application/health"
 	authdomain "simpleservicedesk/internal/domain/auth"
 	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/domain/notifications"
 	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/recurring"
 	"simpleservicedesk/internal/domain/tickets"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -27,16 +40,22 @@ type ServerSuite struct {
 	suite.Suite
 
 	HTTPServer        *echo.Echo
-	UsersRepo         UserRepository         // Interface for repository
-	TicketsRepo       TicketRepository       // Interface for ticket repository
-	OrganizationsRepo OrganizationRepository // Interface for organization repository
-	CategoriesRepo    CategoryRepository     // Interface for category repository
+	UsersRepo         UserRepository              // Interface for repository
+	TicketsRepo       TicketRepository            // Interface for ticket repository
+	OrganizationsRepo OrganizationRepository      // Interface for organization repository
+	CategoriesRepo    CategoryRepository          // Interface for category repository
+	MacrosRepo        MacroRepository             // Interface for canned response and macro repository
+	ViewsRepo         ViewRepository              // Interface for saved ticket view repository
+	RecurringRepo     RecurringTemplateRepository // Interface for recurring ticket template repository
+	NotificationsRepo NotificationRepository      // Interface for in-app notification repository
+	BlobStore         BlobStore                   // Interface for attachment storage
 }
 
 const (
 	testBypassHeaderKey = "X-Test-Bypass"
 	testAuthUserID      = "00000000-0000-0000-0000-000000000001"
 	testRateLimitRPS    = 1000
+	testTrashRetention  = 30 * 24 * time.Hour
 )
 
 // mockUserRepository is a simple mock for testing
@@ -72,6 +91,7 @@ func (m *mockUserRepository) CreateUser(
 
 	// Track the email and store the user
 	m.createdEmails[normalizedEmail] = true
+	user.RestoreVersion(1)
 	m.users[user.ID()] = user
 
 	return user, nil
@@ -94,6 +114,7 @@ func (m *mockUserRepository) UpdateUser(
 	}
 	if updated {
 		// Store updated user back
+		user.RestoreVersion(user.Version() + 1)
 		m.users[id] = user
 	}
 	return user, nil
@@ -186,15 +207,27 @@ func (m *mockUserRepository) CountUsers(_ context.Context, filter queries.UserFi
 
 // mockTicketRepository is a simple mock for testing
 type mockTicketRepository struct {
-	tickets map[uuid.UUID]*tickets.Ticket
+	tickets      map[uuid.UUID]*tickets.Ticket
+	trash        map[uuid.UUID]*tickets.Ticket
+	events       map[uuid.UUID][]tickets.Event
+	counters     map[string]int64
+	beforeUpdate func(id uuid.UUID) // Simulates a concurrent write landing just before an update
 }
 
 func newMockTicketRepository() *mockTicketRepository {
 	return &mockTicketRepository{
-		tickets: make(map[uuid.UUID]*tickets.Ticket),
+		tickets:  make(map[uuid.UUID]*tickets.Ticket),
+		trash:    make(map[uuid.UUID]*tickets.Ticket),
+		events:   make(map[uuid.UUID][]tickets.Event),
+		counters: make(map[string]int64),
 	}
 }
 
+func (m *mockTicketRepository) saveEvents(ticket *tickets.Ticket) {
+	m.events[ticket.ID()] = append(m.events[ticket.ID()], ticket.PendingEvents()...)
+	ticket.ClearPendingEvents()
+}
+
 func (m *mockTicketRepository) CreateTicket(
 	_ context.Context,
 	createFn func() (*tickets.Ticket, error),
@@ -203,7 +236,15 @@ func (m *mockTicketRepository) CreateTicket(
 	if err != nil {
 		return nil, err
 	}
+	if _, exists := m.tickets[ticket.ID()]; exists {
+		return nil, tickets.ErrTicketExists
+	}
+	if _, exists := m.trash[ticket.ID()]; exists {
+		return nil, tickets.ErrTicketExists
+	}
+	ticket.RestoreVersion(1)
 	m.tickets[ticket.ID()] = ticket
+	m.saveEvents(ticket)
 	return ticket, nil
 }
 
@@ -212,16 +253,25 @@ func (m *mockTicketRepository) UpdateTicket(
 	id uuid.UUID,
 	updateFn func(*tickets.Ticket) (bool, error),
 ) (*tickets.Ticket, error) {
+	if hook := m.beforeUpdate; hook != nil {
+		// Updates made by the hook itself do not trigger it again
+		m.beforeUpdate = nil
+		hook(id)
+		m.beforeUpdate = hook
+	}
 	ticket, exists := m.tickets[id]
 	if !exists {
 		return nil, tickets.ErrTicketNotFound
 	}
 	updated, err := updateFn(ticket)
 	if err != nil {
+		ticket.ClearPendingEvents()
 		return nil, err
 	}
 	if updated {
+		ticket.RestoreVersion(ticket.Version() + 1)
 		m.tickets[id] = ticket
+		m.saveEvents(ticket)
 	}
 	return ticket, nil
 }
@@ -234,34 +284,349 @@ func (m *mockTicketRepository) GetTicket(_ context.Context, id uuid.UUID) (*tick
 	return ticket, nil
 }
 
+func (m *mockTicketRepository) GetTicketByKey(_ context.Context, key string) (*tickets.Ticket, error) {
+	for _, ticket := range m.tickets {
+		if ticket.Key() == key {
+			return ticket, nil
+		}
+	}
+	return nil, tickets.ErrTicketNotFound
+}
+
+func (m *mockTicketRepository) NextTicketNumber(_ context.Context, prefix string) (int64, error) {
+	m.counters[prefix]++
+	return m.counters[prefix], nil
+}
+
 func (m *mockTicketRepository) ListTickets(
 	_ context.Context,
-	_ queries.TicketFilter,
+	filter queries.TicketFilter,
 ) ([]*tickets.Ticket, error) {
 	result := make([]*tickets.Ticket, 0, len(m.tickets))
 	for _, ticket := range m.tickets {
+		if !ticketMatchesFilter(ticket, filter) {
+			continue
+		}
 		result = append(result, ticket)
 	}
+	if filter.Search != nil {
+		slices.SortFunc(result, func(a, b *tickets.Ticket) int {
+			return cmp.Compare(b.SearchScore(*filter.Search, filter.SearchInternal),
+				a.SearchScore(*filter.Search, filter.SearchInternal))
+		})
+	} else if filter.SortBy == "title" {
+		slices.SortFunc(result, func(a, b *tickets.Ticket) int {
+			if filter.SortOrder == "desc" {
+				return cmp.Compare(b.Title(), a.Title())
+			}
+			return cmp.Compare(a.Title(), b.Title())
+		})
+	}
 	return result, nil
 }
 
-func (m *mockTicketRepository) DeleteTicket(_ context.Context, id uuid.UUID) error {
-	_, exists := m.tickets[id]
+func (m *mockTicketRepository) CountTickets(_ context.Context, filter queries.TicketFilter) (int64, error) {
+	count := int64(0)
+	for _, ticket := range m.tickets {
+		if ticketMatchesFilter(ticket, filter) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func ticketMatchesFilter(ticket *tickets.Ticket, filter queries.TicketFilter) bool {
+	if len(filter.StatusCategories) > 0 && !slices.Contains(filter.StatusCategories, ticket.StatusCategory()) {
+		return false
+	}
+	if filter.Status != nil && ticket.Status() != *filter.Status {
+		return false
+	}
+	if filter.Priority != nil && ticket.Priority() != *filter.Priority {
+		return false
+	}
+	if filter.AssigneeID != nil && (ticket.AssigneeID() == nil || *ticket.AssigneeID() != *filter.AssigneeID) {
+		return false
+	}
+	if filter.Unassigned && ticket.AssigneeID() != nil {
+		return false
+	}
+	if filter.OrganizationID != nil && ticket.OrganizationID() != *filter.OrganizationID {
+		return false
+	}
+	if filter.Keys != nil && !slices.Contains(filter.Keys, ticket.Key()) {
+		return false
+	}
+	if filter.AuthorID != nil && ticket.AuthorID() != *filter.AuthorID {
+		return false
+	}
+	if filter.WatcherID != nil && !ticket.IsWatcher(*filter.WatcherID) {
+		return false
+	}
+	if filter.Search != nil && ticket.SearchScore(*filter.Search, filter.SearchInternal) == 0 {
+		return false
+	}
+	return ticketMatchesTags(ticket, filter.Tags, filter.TagsAny) && ticketMatchesCustomFields(ticket, filter.CustomFields)
+}
+
+func ticketMatchesCustomFields(ticket *tickets.Ticket, fields map[string]string) bool {
+	values := ticket.CustomFields()
+	for key, expected := range fields {
+		value, ok := values[key]
+		if !ok || tickets.FormatCustomFieldValue(value) != expected {
+			return false
+		}
+	}
+	return true
+}
+
+func ticketMatchesTags(ticket *tickets.Ticket, all, anyOf []string) bool {
+	for _, tag := range all {
+		if !ticket.HasTag(tag) {
+			return false
+		}
+	}
+	return len(anyOf) == 0 || slices.ContainsFunc(anyOf, ticket.HasTag)
+}
+
+func (m *mockTicketRepository) DeleteTicket(_ context.Context, id, deletedBy uuid.UUID) error {
+	ticket, exists := m.tickets[id]
 	if !exists {
 		return tickets.ErrTicketNotFound
 	}
+	ticket.MarkDeleted(deletedBy, time.Now())
+	ticket.RestoreVersion(ticket.Version() + 1)
+	m.trash[id] = ticket
 	delete(m.tickets, id)
 	return nil
 }
 
+func (m *mockTicketRepository) ListDeletedTickets(
+	_ context.Context,
+	filter queries.TrashFilter,
+) ([]*tickets.Ticket, error) {
+	var result []*tickets.Ticket
+	for _, ticket := range m.trash {
+		if filter.OrganizationID != nil && ticket.OrganizationID() != *filter.OrganizationID {
+			continue
+		}
+		result = append(result, ticket)
+	}
+	return trashPage(result, (*tickets.Ticket).DeletedAt, filter), nil
+}
+
+func (m *mockTicketRepository) CountDeletedTickets(_ context.Context, filter queries.TrashFilter) (int64, error) {
+	filter.Limit, filter.Offset = 0, 0
+	page, err := m.ListDeletedTickets(context.Background(), filter)
+	return int64(len(page)), err
+}
+
+func (m *mockTicketRepository) RestoreTicket(_ context.Context, id uuid.UUID) error {
+	ticket, exists := m.trash[id]
+	if !exists {
+		return tickets.ErrTicketNotFound
+	}
+	ticket.Undelete()
+	ticket.RestoreVersion(ticket.Version() + 1)
+	m.tickets[id] = ticket
+	delete(m.trash, id)
+	return nil
+}
+
+func (m *mockTicketRepository) PurgeTicket(_ context.Context, id uuid.UUID) (*tickets.Ticket, error) {
+	ticket, exists := m.trash[id]
+	if !exists {
+		return nil, tickets.ErrTicketNotFound
+	}
+	delete(m.trash, id)
+	delete(m.events, id)
+	return ticket, nil
+}
+
+// trashPage orders trashed items by deletion time and applies the filter limits
+func trashPage[T any](items []T, deletedAt func(T) *time.Time, filter queries.TrashFilter) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if filter.DeletedBefore == nil || deletedAt(item).Before(*filter.DeletedBefore) {
+			result = append(result, item)
+		}
+	}
+	slices.SortFunc(result, func(a, b T) int {
+		if filter.SortOrder == "asc" {
+			return deletedAt(a).Compare(*deletedAt(b))
+		}
+		return deletedAt(b).Compare(*deletedAt(a))
+	})
+
+	start := min(filter.Offset, len(result))
+	end := len(result)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, len(result))
+	}
+	return result[start:end]
+}
+
+func (m *mockTicketRepository) ListTicketEvents(
+	_ context.Context,
+	filter queries.TicketEventFilter,
+) ([]tickets.Event, error) {
+	visible := m.visibleEvents(filter)
+	start := min(filter.Offset, len(visible))
+	end := len(visible)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, len(visible))
+	}
+	return visible[start:end], nil
+}
+
+func (m *mockTicketRepository) CountTicketEvents(
+	_ context.Context,
+	filter queries.TicketEventFilter,
+) (int64, error) {
+	return int64(len(m.visibleEvents(filter))), nil
+}
+
+func (m *mockTicketRepository) AggregateSatisfaction(
+	_ context.Context,
+	filter queries.SatisfactionFilter,
+) ([]tickets.SatisfactionStats, error) {
+	groups := make(map[uuid.UUID]*tickets.SatisfactionStats)
+	for _, ticket := range m.tickets {
+		survey := ticket.Survey()
+		if !surveyMatchesFilter(ticket, survey, filter) {
+			continue
+		}
+
+		groupID := satisfactionGroupID(ticket, survey, filter.GroupBy)
+		key := uuid.Nil
+		if groupID != nil {
+			key = *groupID
+		}
+		if groups[key] == nil {
+			groups[key] = &tickets.SatisfactionStats{GroupID: groupID}
+		}
+		groups[key].Add(survey.Rating)
+	}
+
+	result := make([]tickets.SatisfactionStats, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	slices.SortFunc(result, func(a, b tickets.SatisfactionStats) int {
+		return cmp.Compare(b.Responses, a.Responses)
+	})
+	return result, nil
+}
+
+func surveyMatchesFilter(ticket *tickets.Ticket, survey *tickets.Survey, filter queries.SatisfactionFilter) bool {
+	if survey == nil || !survey.IsSubmitted() {
+		return false
+	}
+	if filter.OrganizationID != nil && ticket.OrganizationID() != *filter.OrganizationID {
+		return false
+	}
+	if filter.RatedAfter != nil && survey.RatedAt.Before(*filter.RatedAfter) {
+		return false
+	}
+	return filter.RatedBefore == nil || survey.RatedAt.Before(*filter.RatedBefore)
+}
+
+func satisfactionGroupID(
+	ticket *tickets.Ticket,
+	survey *tickets.Survey,
+	groupBy queries.SatisfactionGroup,
+) *uuid.UUID {
+	switch groupBy {
+	case queries.SatisfactionByAgent:
+		return survey.AgentID
+	case queries.SatisfactionByCategory:
+		return ticket.CategoryID()
+	case queries.SatisfactionByOrganization:
+		orgID := ticket.OrganizationID()
+		return &orgID
+	}
+	return nil
+}
+
+func (m *mockTicketRepository) AggregateWorkLogs(
+	_ context.Context,
+	filter queries.WorkLogFilter,
+) ([]tickets.WorkLogStats, error) {
+	groups := make(map[uuid.UUID]*tickets.WorkLogStats)
+	for _, ticket := range m.tickets {
+		if filter.OrganizationID != nil && ticket.OrganizationID() != *filter.OrganizationID {
+			continue
+		}
+		for _, workLog := range ticket.WorkLogs() {
+			if !workLogMatchesFilter(workLog, filter) {
+				continue
+			}
+
+			groupID := workLogGroupID(ticket, workLog, filter.GroupBy)
+			key := uuid.Nil
+			if groupID != nil {
+				key = *groupID
+			}
+			if groups[key] == nil {
+				groups[key] = &tickets.WorkLogStats{GroupID: groupID}
+			}
+			groups[key].Entries++
+			groups[key].Add(workLog.WorkLogEntry)
+		}
+	}
+
+	result := make([]tickets.WorkLogStats, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	slices.SortFunc(result, func(a, b tickets.WorkLogStats) int {
+		return cmp.Compare(b.Minutes, a.Minutes)
+	})
+	return result, nil
+}
+
+func workLogMatchesFilter(workLog tickets.WorkLog, filter queries.WorkLogFilter) bool {
+	if workLog.Date.Before(filter.From) || workLog.Date.After(filter.To) {
+		return false
+	}
+	return filter.Billable == nil || workLog.Billable == *filter.Billable
+}
+
+func workLogGroupID(ticket *tickets.Ticket, workLog tickets.WorkLog, groupBy queries.WorkLogGroup) *uuid.UUID {
+	switch groupBy {
+	case queries.WorkLogByAgent:
+		agentID := workLog.AgentID
+		return &agentID
+	case queries.WorkLogByCategory:
+		return ticket.CategoryID()
+	case queries.WorkLogByOrganization:
+		orgID := ticket.OrganizationID()
+		return &orgID
+	}
+	return nil
+}
+
+func (m *mockTicketRepository) visibleEvents(filter queries.TicketEventFilter) []tickets.Event {
+	var result []tickets.Event
+	for _, event := range m.events[filter.TicketID] {
+		if event.IsInternal && !filter.IncludeInternal {
+			continue
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
 // mockOrganizationRepository is a simple mock for testing
 type mockOrganizationRepository struct {
-	orgs map[uuid.UUID]*organizations.Organization
+	orgs  map[uuid.UUID]*organizations.Organization
+	trash map[uuid.UUID]*organizations.Organization
 }
 
 func newMockOrganizationRepository() *mockOrganizationRepository {
 	return &mockOrganizationRepository{
-		orgs: make(map[uuid.UUID]*organizations.Organization),
+		orgs:  make(map[uuid.UUID]*organizations.Organization),
+		trash: make(map[uuid.UUID]*organizations.Organization),
 	}
 }
 
@@ -274,6 +639,7 @@ func (m *mockOrganizationRepository) CreateOrganization(
 		return nil, err
 	}
 
+	org.RestoreVersion(1)
 	m.orgs[org.ID()] = org
 	return org, nil
 }
@@ -288,14 +654,44 @@ func (m *mockOrganizationRepository) UpdateOrganization(
 		return nil, organizations.ErrOrganizationNotFound
 	}
 
-	_, err := updateFn(org)
+	settings := org.Settings()
+	updated, err := updateFn(org)
 	if err != nil {
 		return nil, err
 	}
+	if prefix := org.Settings().TicketKeyPrefix; prefix != "" && prefix != settings.TicketKeyPrefix &&
+		m.keyPrefixTaken(id, prefix) {
+		org.UpdateSettings(settings)
+		return nil, organizations.ErrKeyPrefixTaken
+	}
+	if updated {
+		org.RestoreVersion(org.Version() + 1)
+	}
 
 	return org, nil
 }
 
+func (m *mockOrganizationRepository) TakeAutoAssignmentTurn(_ context.Context, id uuid.UUID) (int64, error) {
+	org, exists := m.orgs[id]
+	if !exists {
+		return 0, organizations.ErrOrganizationNotFound
+	}
+	turn := org.AutoAssignmentTurns()
+	org.RestoreAutoAssignmentTurns(turn + 1)
+	return turn, nil
+}
+
+func (m *mockOrganizationRepository) keyPrefixTaken(id uuid.UUID, prefix string) bool {
+	for _, orgs := range []map[uuid.UUID]*organizations.Organization{m.orgs, m.trash} {
+		for otherID, other := range orgs {
+			if otherID != id && other.Settings().TicketKeyPrefix == prefix {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m *mockOrganizationRepository) GetOrganization(
 	_ context.Context,
 	id uuid.UUID,
@@ -334,15 +730,72 @@ func (m *mockOrganizationRepository) CountOrganizations(
 	return int64(len(m.orgs)), nil
 }
 
-func (m *mockOrganizationRepository) DeleteOrganization(_ context.Context, id uuid.UUID) error {
-	_, exists := m.orgs[id]
+func (m *mockOrganizationRepository) DeleteOrganization(_ context.Context, id, deletedBy uuid.UUID) error {
+	org, exists := m.orgs[id]
 	if !exists {
 		return organizations.ErrOrganizationNotFound
 	}
+	if hasChildOrganization(m.orgs, id) {
+		return organizations.ErrOrganizationHasChildren
+	}
+	org.MarkDeleted(deletedBy, time.Now())
+	org.RestoreVersion(org.Version() + 1)
+	m.trash[id] = org
 	delete(m.orgs, id)
 	return nil
 }
 
+func (m *mockOrganizationRepository) ListDeletedOrganizations(
+	_ context.Context,
+	filter queries.TrashFilter,
+) ([]*organizations.Organization, error) {
+	return trashPage(slices.Collect(maps.Values(m.trash)), (*organizations.Organization).DeletedAt, filter), nil
+}
+
+func (m *mockOrganizationRepository) CountDeletedOrganizations(
+	_ context.Context,
+	filter queries.TrashFilter,
+) (int64, error) {
+	filter.Limit, filter.Offset = 0, 0
+	page, err := m.ListDeletedOrganizations(context.Background(), filter)
+	return int64(len(page)), err
+}
+
+func (m *mockOrganizationRepository) RestoreOrganization(_ context.Context, id uuid.UUID) error {
+	org, exists := m.trash[id]
+	if !exists {
+		return organizations.ErrOrganizationNotFound
+	}
+	if org.ParentID() != nil && m.orgs[*org.ParentID()] == nil {
+		return organizations.ErrParentInTrash
+	}
+	org.Undelete()
+	org.RestoreVersion(org.Version() + 1)
+	m.orgs[id] = org
+	delete(m.trash, id)
+	return nil
+}
+
+func (m *mockOrganizationRepository) PurgeOrganization(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.trash[id]; !exists {
+		return organizations.ErrOrganizationNotFound
+	}
+	if hasChildOrganization(m.orgs, id) || hasChildOrganization(m.trash, id) {
+		return organizations.ErrOrganizationHasChildren
+	}
+	delete(m.trash, id)
+	return nil
+}
+
+func hasChildOrganization(orgs map[uuid.UUID]*organizations.Organization, parentID uuid.UUID) bool {
+	for _, org := range orgs {
+		if org.ParentID() != nil && *org.ParentID() == parentID {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *mockOrganizationRepository) GetOrganizationHierarchy(
 	_ context.Context,
 	rootID uuid.UUID,
@@ -361,11 +814,13 @@ func (m *mockOrganizationRepository) GetOrganizationHierarchy(
 // mockCategoryRepository is a simple mock for testing
 type mockCategoryRepository struct {
 	categories map[uuid.UUID]*categories.Category
+	trash      map[uuid.UUID]*categories.Category
 }
 
 func newMockCategoryRepository() *mockCategoryRepository {
 	return &mockCategoryRepository{
 		categories: make(map[uuid.UUID]*categories.Category),
+		trash:      make(map[uuid.UUID]*categories.Category),
 	}
 }
 
@@ -378,6 +833,7 @@ func (m *mockCategoryRepository) CreateCategory(
 		return nil, err
 	}
 
+	category.RestoreVersion(1)
 	m.categories[category.ID()] = category
 	return category, nil
 }
@@ -392,10 +848,13 @@ func (m *mockCategoryRepository) UpdateCategory(
 		return nil, categories.ErrCategoryNotFound
 	}
 
-	_, err := updateFn(category)
+	updated, err := updateFn(category)
 	if err != nil {
 		return nil, err
 	}
+	if updated {
+		category.RestoreVersion(category.Version() + 1)
+	}
 
 	return category, nil
 }
@@ -469,15 +928,76 @@ func (m *mockCategoryRepository) shouldIncludeInResult(filter queries.CategoryFi
 	return count >= filter.Offset
 }
 
-func (m *mockCategoryRepository) DeleteCategory(_ context.Context, id uuid.UUID) error {
-	_, exists := m.categories[id]
+func (m *mockCategoryRepository) DeleteCategory(_ context.Context, id, deletedBy uuid.UUID) error {
+	category, exists := m.categories[id]
 	if !exists {
 		return categories.ErrCategoryNotFound
 	}
+	if hasChildCategory(m.categories, id) {
+		return categories.ErrCategoryHasChildren
+	}
+	category.MarkDeleted(deletedBy, time.Now())
+	category.RestoreVersion(category.Version() + 1)
+	m.trash[id] = category
 	delete(m.categories, id)
 	return nil
 }
 
+func (m *mockCategoryRepository) ListDeletedCategories(
+	_ context.Context,
+	filter queries.TrashFilter,
+) ([]*categories.Category, error) {
+	var result []*categories.Category
+	for _, category := range m.trash {
+		if filter.OrganizationID != nil && category.OrganizationID() != *filter.OrganizationID {
+			continue
+		}
+		result = append(result, category)
+	}
+	return trashPage(result, (*categories.Category).DeletedAt, filter), nil
+}
+
+func (m *mockCategoryRepository) CountDeletedCategories(_ context.Context, filter queries.TrashFilter) (int64, error) {
+	filter.Limit, filter.Offset = 0, 0
+	page, err := m.ListDeletedCategories(context.Background(), filter)
+	return int64(len(page)), err
+}
+
+func (m *mockCategoryRepository) RestoreCategory(_ context.Context, id uuid.UUID) error {
+	category, exists := m.trash[id]
+	if !exists {
+		return categories.ErrCategoryNotFound
+	}
+	if category.ParentID() != nil && m.categories[*category.ParentID()] == nil {
+		return categories.ErrParentInTrash
+	}
+	category.Undelete()
+	category.RestoreVersion(category.Version() + 1)
+	m.categories[id] = category
+	delete(m.trash, id)
+	return nil
+}
+
+func (m *mockCategoryRepository) PurgeCategory(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.trash[id]; !exists {
+		return categories.ErrCategoryNotFound
+	}
+	if hasChildCategory(m.categories, id) || hasChildCategory(m.trash, id) {
+		return categories.ErrCategoryHasChildren
+	}
+	delete(m.trash, id)
+	return nil
+}
+
+func hasChildCategory(items map[uuid.UUID]*categories.Category, parentID uuid.UUID) bool {
+	for _, category := range items {
+		if category.ParentID() != nil && *category.ParentID() == parentID {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *mockCategoryRepository) GetCategoryHierarchy(
 	_ context.Context,
 	rootID uuid.UUID,
@@ -493,6 +1013,410 @@ func (m *mockCategoryRepository) GetCategoryHierarchy(
 	}, nil
 }
 
+// mockMacroRepository is a simple mock for testing
+type mockMacroRepository struct {
+	cannedResponses map[uuid.UUID]*macros.CannedResponse
+	macros          map[uuid.UUID]*macros.Macro
+}
+
+func newMockMacroRepository() *mockMacroRepository {
+	return &mockMacroRepository{
+		cannedResponses: make(map[uuid.UUID]*macros.CannedResponse),
+		macros:          make(map[uuid.UUID]*macros.Macro),
+	}
+}
+
+func (m *mockMacroRepository) CreateCannedResponse(
+	_ context.Context,
+	createFn func() (*macros.CannedResponse, error),
+) (*macros.CannedResponse, error) {
+	response, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+	m.cannedResponses[response.ID()] = response
+	return response, nil
+}
+
+func (m *mockMacroRepository) UpdateCannedResponse(
+	_ context.Context,
+	id uuid.UUID,
+	updateFn func(*macros.CannedResponse) (bool, error),
+) (*macros.CannedResponse, error) {
+	response, exists := m.cannedResponses[id]
+	if !exists {
+		return nil, macros.ErrCannedResponseNotFound
+	}
+	if _, err := updateFn(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *mockMacroRepository) GetCannedResponse(_ context.Context, id uuid.UUID) (*macros.CannedResponse, error) {
+	response, exists := m.cannedResponses[id]
+	if !exists {
+		return nil, macros.ErrCannedResponseNotFound
+	}
+	return response, nil
+}
+
+func (m *mockMacroRepository) ListCannedResponses(
+	_ context.Context,
+	filter queries.MacroFilter,
+) ([]*macros.CannedResponse, error) {
+	var result []*macros.CannedResponse
+	for _, response := range m.cannedResponses {
+		if matchesMacroFilter(response.Scope(), filter) {
+			result = append(result, response)
+		}
+	}
+	slices.SortFunc(result, func(a, b *macros.CannedResponse) int { return strings.Compare(a.Name(), b.Name()) })
+	return result, nil
+}
+
+func (m *mockMacroRepository) DeleteCannedResponse(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.cannedResponses[id]; !exists {
+		return macros.ErrCannedResponseNotFound
+	}
+	delete(m.cannedResponses, id)
+	return nil
+}
+
+func (m *mockMacroRepository) CreateMacro(
+	_ context.Context,
+	createFn func() (*macros.Macro, error),
+) (*macros.Macro, error) {
+	macro, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+	m.macros[macro.ID()] = macro
+	return macro, nil
+}
+
+func (m *mockMacroRepository) UpdateMacro(
+	_ context.Context,
+	id uuid.UUID,
+	updateFn func(*macros.Macro) (bool, error),
+) (*macros.Macro, error) {
+	macro, exists := m.macros[id]
+	if !exists {
+		return nil, macros.ErrMacroNotFound
+	}
+	if _, err := updateFn(macro); err != nil {
+		return nil, err
+	}
+	return macro, nil
+}
+
+func (m *mockMacroRepository) GetMacro(_ context.Context, id uuid.UUID) (*macros.Macro, error) {
+	macro, exists := m.macros[id]
+	if !exists {
+		return nil, macros.ErrMacroNotFound
+	}
+	return macro, nil
+}
+
+func (m *mockMacroRepository) ListMacros(_ context.Context, filter queries.MacroFilter) ([]*macros.Macro, error) {
+	var result []*macros.Macro
+	for _, macro := range m.macros {
+		if matchesMacroFilter(macro.Scope(), filter) {
+			result = append(result, macro)
+		}
+	}
+	slices.SortFunc(result, func(a, b *macros.Macro) int { return strings.Compare(a.Name(), b.Name()) })
+	return result, nil
+}
+
+func (m *mockMacroRepository) DeleteMacro(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.macros[id]; !exists {
+		return macros.ErrMacroNotFound
+	}
+	delete(m.macros, id)
+	return nil
+}
+
+func matchesMacroFilter(scope macros.Scope, filter queries.MacroFilter) bool {
+	if scope.IsPersonal() {
+		return scope.OwnerID == filter.OwnerID
+	}
+	return filter.OrganizationID == nil || *scope.OrganizationID == *filter.OrganizationID
+}
+
+// mockViewRepository is a simple mock for testing
+type mockViewRepository struct {
+	views map[uuid.UUID]*views.View
+}
+
+func newMockViewRepository() *mockViewRepository {
+	return &mockViewRepository{
+		views: make(map[uuid.UUID]*views.View),
+	}
+}
+
+func (m *mockViewRepository) CreateView(_ context.Context, createFn func() (*views.View, error)) (*views.View, error) {
+	view, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+	m.views[view.ID()] = view
+	return view, nil
+}
+
+func (m *mockViewRepository) UpdateView(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*views.View) (bool, error),
+) (*views.View, error) {
+	view, err := m.GetView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = updateFn(view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (m *mockViewRepository) GetView(_ context.Context, id uuid.UUID) (*views.View, error) {
+	view, exists := m.views[id]
+	if !exists {
+		return nil, views.ErrViewNotFound
+	}
+	return view, nil
+}
+
+func (m *mockViewRepository) ListViews(_ context.Context, filter queries.ViewFilter) ([]*views.View, error) {
+	var result []*views.View
+	for _, view := range m.views {
+		if view.Sharing().IsVisibleTo(filter.Viewer) {
+			result = append(result, view)
+		}
+	}
+	slices.SortFunc(result, func(a, b *views.View) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return result, nil
+}
+
+func (m *mockViewRepository) DeleteView(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.views[id]; !exists {
+		return views.ErrViewNotFound
+	}
+	delete(m.views, id)
+	return nil
+}
+
+// mockRecurringTemplateRepository is a simple mock for testing
+type mockRecurringTemplateRepository struct {
+	templates map[uuid.UUID]*recurring.Template
+}
+
+func newMockRecurringTemplateRepository() *mockRecurringTemplateRepository {
+	return &mockRecurringTemplateRepository{
+		templates: make(map[uuid.UUID]*recurring.Template),
+	}
+}
+
+func (m *mockRecurringTemplateRepository) CreateTemplate(
+	_ context.Context,
+	createFn func() (*recurring.Template, error),
+) (*recurring.Template, error) {
+	template, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+	template.RestoreVersion(1)
+	m.templates[template.ID()] = template
+	return template, nil
+}
+
+func (m *mockRecurringTemplateRepository) UpdateTemplate(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*recurring.Template) (bool, error),
+) (*recurring.Template, error) {
+	template, err := m.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := updateFn(template)
+	if err != nil {
+		return nil, err
+	}
+	if updated {
+		template.RestoreVersion(template.Version() + 1)
+	}
+	return template, nil
+}
+
+func (m *mockRecurringTemplateRepository) GetTemplate(_ context.Context, id uuid.UUID) (*recurring.Template, error) {
+	template, exists := m.templates[id]
+	if !exists {
+		return nil, recurring.ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+func (m *mockRecurringTemplateRepository) ListTemplates(
+	_ context.Context,
+	filter queries.RecurringTemplateFilter,
+) ([]*recurring.Template, error) {
+	var result []*recurring.Template
+	for _, template := range m.templates {
+		if filter.OrganizationID != nil && template.Draft().OrganizationID != *filter.OrganizationID {
+			continue
+		}
+		if filter.IsActive != nil && template.IsActive() != *filter.IsActive {
+			continue
+		}
+		if filter.DueBefore != nil && !template.IsDue(*filter.DueBefore) {
+			continue
+		}
+		result = append(result, template)
+	}
+	slices.SortFunc(result, func(a, b *recurring.Template) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return result, nil
+}
+
+func (m *mockRecurringTemplateRepository) DeleteTemplate(_ context.Context, id uuid.UUID) error {
+	if _, exists := m.templates[id]; !exists {
+		return recurring.ErrTemplateNotFound
+	}
+	delete(m.templates, id)
+	return nil
+}
+
+// mockNotificationRepository is a simple mock for testing
+type mockNotificationRepository struct {
+	notifications map[uuid.UUID]*notifications.Notification
+}
+
+func newMockNotificationRepository() *mockNotificationRepository {
+	return &mockNotificationRepository{
+		notifications: make(map[uuid.UUID]*notifications.Notification),
+	}
+}
+
+func (m *mockNotificationRepository) CreateNotification(
+	_ context.Context,
+	createFn func() (*notifications.Notification, error),
+) (*notifications.Notification, error) {
+	notification, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+	m.notifications[notification.ID()] = notification
+	return notification, nil
+}
+
+func (m *mockNotificationRepository) UpdateNotification(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*notifications.Notification) (bool, error),
+) (*notifications.Notification, error) {
+	notification, err := m.GetNotification(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = updateFn(notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (m *mockNotificationRepository) GetNotification(
+	_ context.Context,
+	id uuid.UUID,
+) (*notifications.Notification, error) {
+	notification, exists := m.notifications[id]
+	if !exists {
+		return nil, notifications.ErrNotificationNotFound
+	}
+	return notification, nil
+}
+
+func (m *mockNotificationRepository) ListNotifications(
+	_ context.Context,
+	filter queries.NotificationFilter,
+) ([]*notifications.Notification, error) {
+	var result []*notifications.Notification
+	for _, notification := range m.notifications {
+		if notificationMatchesFilter(notification, filter) {
+			result = append(result, notification)
+		}
+	}
+	slices.SortFunc(result, func(a, b *notifications.Notification) int {
+		return b.CreatedAt().Compare(a.CreatedAt())
+	})
+
+	start := min(filter.Offset, len(result))
+	end := len(result)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, len(result))
+	}
+	return result[start:end], nil
+}
+
+func (m *mockNotificationRepository) CountNotifications(
+	_ context.Context,
+	filter queries.NotificationFilter,
+) (int64, error) {
+	var count int64
+	for _, notification := range m.notifications {
+		if notificationMatchesFilter(notification, filter) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func notificationMatchesFilter(notification *notifications.Notification, filter queries.NotificationFilter) bool {
+	if !notification.BelongsTo(filter.UserID) {
+		return false
+	}
+	return filter.Unread == nil || notification.IsRead() != *filter.Unread
+}
+
+// mockBlobStore is a simple in-memory blob store for testing
+type mockBlobStore struct {
+	blobs map[string][]byte
+}
+
+func newMockBlobStore() *mockBlobStore {
+	return &mockBlobStore{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (m *mockBlobStore) Put(_ context.Context, key string, content io.Reader) (int64, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return 0, err
+	}
+	m.blobs[key] = data
+	return int64(len(data)), nil
+}
+
+func (m *mockBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	data, exists := m.blobs[key]
+	if !exists {
+		return nil, tickets.ErrAttachmentNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *mockBlobStore) Delete(_ context.Context, key string) error {
+	if _, exists := m.blobs[key]; !exists {
+		return tickets.ErrAttachmentNotFound
+	}
+	delete(m.blobs, key)
+	return nil
+}
+
 // SetupTest for integration tests
 func (s *ServerSuite) SetupTest() {
 	// Initialize mock repositories with fresh state
@@ -500,6 +1424,11 @@ func (s *ServerSuite) SetupTest() {
 	s.TicketsRepo = newMockTicketRepository()
 	s.OrganizationsRepo = newMockOrganizationRepository()
 	s.CategoriesRepo = newMockCategoryRepository()
+	s.MacrosRepo = newMockMacroRepository()
+	s.ViewsRepo = newMockViewRepository()
+	s.RecurringRepo = newMockRecurringTemplateRepository()
+	s.NotificationsRepo = newMockNotificationRepository()
+	s.BlobStore = newMockBlobStore()
 
 	mockUsersRepo, ok := s.UsersRepo.(*mockUserRepository)
 	s.Require().True(ok)
@@ -511,17 +1440,96 @@ func (s *ServerSuite) SetupTest() {
 		s.TicketsRepo,
 		s.OrganizationsRepo,
 		s.CategoriesRepo,
+		s.MacrosRepo,
+		s.ViewsRepo,
+		s.RecurringRepo,
+		s.NotificationsRepo,
+		s.BlobStore,
 		health.NoopPinger{},
 		"test-jwt-signing-key",
 		time.Hour,
 		[]string{"*"},
 		testRateLimitRPS,
+		testTrashRetention,
 	)
 	s.Require().NoError(err)
 	s.HTTPServer = server
 	attachDefaultTestAuthHeader(s.HTTPServer, "test-jwt-signing-key", users.RoleAdmin)
 }
 
+// RequestAs sends a JSON request on behalf of the token owner; an empty token acts as the default admin
+func (s *ServerSuite) RequestAs(method, path string, payload any, token string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	if payload != nil {
+		s.Require().NoError(json.NewEncoder(&body).Encode(payload))
+	}
+	req := httptest.NewRequest(method, path, &body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+// CreateTicket creates a ticket on behalf of the token owner and returns it.
+// Title, description, author and organization get test values when the request leaves them empty.
+func (s *ServerSuite) CreateTicket(req openapi.CreateTicketRequest, token string) openapi.GetTicketResponse {
+	if req.Title == "" {
+		req.Title = "Test ticket"
+	}
+	if req.Description == "" {
+		req.Description = "Ticket created by a test"
+	}
+	if req.AuthorId == uuid.Nil {
+		req.AuthorId = uuid.New()
+	}
+	if req.OrganizationId == uuid.Nil {
+		req.OrganizationId = uuid.New()
+	}
+	rec := s.RequestAs(http.MethodPost, "/tickets", req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var resp openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+// BeforeTicketUpdate runs hook before every ticket update, so tests can race a write against a handler
+func (s *ServerSuite) BeforeTicketUpdate(hook func(ticketID uuid.UUID)) {
+	repo, ok := s.TicketsRepo.(*mockTicketRepository)
+	s.Require().True(ok, "ticket updates can be hooked only in the mock repository")
+	repo.beforeUpdate = hook
+}
+
+// LoginAs creates a user with the given role and returns its ID and access token
+func (s *ServerSuite) LoginAs(email string, role openapi.UserRole) (uuid.UUID, string) {
+	rec := s.RequestAs(http.MethodPost, "/users", openapi.CreateUserRequest{
+		Name:     "Test User",
+		Email:    openapi_types.Email(email),
+		Password: "password123",
+	}, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var created openapi.CreateUserResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+	if role != openapi.Customer {
+		rec = s.RequestAs(http.MethodPatch, "/users/"+created.Id.String()+"/role",
+			openapi.UpdateUserRoleRequest{Role: role}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	rec = s.RequestAs(http.MethodPost, "/login", openapi.LoginRequest{
+		Email:    openapi_types.Email(email),
+		Password: "password123",
+	}, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	var login openapi.LoginResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &login))
+	return *created.Id, login.Token
+}
+
 func seedDefaultAuthUser(repo *mockUserRepository, role users.Role) error {
 	testUserID, err := uuid.Parse(testAuthUserID)
 	if err != nil {
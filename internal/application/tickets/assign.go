@@ -6,19 +6,37 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h TicketHandlers) PatchTicketsIDAssign(c echo.Context, id openapi_types.UUID) error {
+func (h TicketHandlers) PatchTicketsIDAssign(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PatchTicketsIDAssignParams,
+) error {
 	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+	precondition, ok := parseIfMatch(c, params.IfMatch)
+	if !ok {
+		return nil
+	}
+
 	var req openapi.AssignTicketRequest
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
 
 	ticket, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		if !precondition.Matches(ticket.Version()) {
+			return false, tickets.ErrVersionConflict
+		}
+		ticket.ActAs(authUserID)
 		if req.AssigneeId == nil {
 			// Unassign ticket
 			ticket.Unassign()
@@ -37,12 +55,19 @@ func (h TicketHandlers) PatchTicketsIDAssign(c echo.Context, id openapi_types.UU
 		if errors.Is(err, tickets.ErrTicketNotFound) {
 			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
 		}
+		if errors.Is(err, tickets.ErrVersionConflict) {
+			return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+		}
+		if errors.Is(err, tickets.ErrConcurrentUpdate) {
+			return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+		}
 		if errors.Is(err, tickets.ErrTicketValidation) {
 			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
 		}
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
 
-	response := convertTicketToResponse(ticket)
+	etag.Set(c, ticket.Version())
+	response := convertTicketToResponse(ticket, hasElevatedTicketAccess(role))
 	return c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,133 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// autoAssignment is the agent picked for a new ticket together with the strategy that picked it
+type autoAssignment struct {
+	agentID  uuid.UUID
+	strategy tickets.AssignmentStrategy
+}
+
+// pickAutoAssignee selects an agent for a new ticket of the organization.
+// It reports false when the organization assigns tickets manually or has no eligible agents.
+// Round-robin picks claim a turn from the organization counter, so concurrent tickets never share a turn;
+// the counter leaves the organization version alone, so ticket creation never races with admin edits.
+func (h TicketHandlers) pickAutoAssignee(
+	ctx context.Context,
+	orgID uuid.UUID,
+	categoryID *uuid.UUID,
+) (autoAssignment, bool, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return autoAssignment{}, false, nil
+	}
+	if err != nil {
+		return autoAssignment{}, false, err
+	}
+
+	config := org.AssignmentConfig()
+	if config == nil || h.userRepo == nil {
+		return autoAssignment{}, false, nil
+	}
+
+	candidates, err := h.assignmentCandidates(ctx, orgID, config, categoryID)
+	if err != nil || len(candidates) == 0 {
+		return autoAssignment{}, false, err
+	}
+
+	turn := org.AutoAssignmentTurns()
+	if config.Strategy() == tickets.AssignmentRoundRobin {
+		// A lost turn only skews the rotation, so the ticket still gets an agent
+		if turn, err = h.orgRepo.TakeAutoAssignmentTurn(ctx, orgID); err != nil {
+			slog.WarnContext(ctx, "failed to take round-robin turn", "organization_id", orgID, "error", err)
+			turn = org.AutoAssignmentTurns()
+		}
+	}
+
+	agentID, picked := config.Pick(candidates, turn)
+	if !picked {
+		return autoAssignment{}, false, nil
+	}
+
+	return autoAssignment{agentID: agentID, strategy: config.Strategy()}, true, nil
+}
+
+// assignmentCandidates returns the active agents eligible for a ticket of the category.
+// Open ticket counts are loaded only for strategies that balance workload.
+func (h TicketHandlers) assignmentCandidates(
+	ctx context.Context,
+	orgID uuid.UUID,
+	config *tickets.AssignmentConfig,
+	categoryID *uuid.UUID,
+) ([]tickets.AssignmentCandidate, error) {
+	agentIDs, err := h.eligibleAgents(ctx, orgID, config.AgentPool(categoryID))
+	if err != nil {
+		return nil, err
+	}
+
+	openStatuses := []tickets.Status{tickets.StatusNew, tickets.StatusInProgress, tickets.StatusWaiting}
+	candidates := make([]tickets.AssignmentCandidate, 0, len(agentIDs))
+	for _, agentID := range agentIDs {
+		candidate := tickets.AssignmentCandidate{AgentID: agentID}
+		if config.NeedsWorkload() {
+			candidate.OpenTickets, err = h.repo.CountTickets(ctx, queries.TicketFilter{
+				AssigneeID:       &agentID,
+				StatusCategories: openStatuses,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// eligibleAgents filters the configured pool down to active agents.
+// An empty pool means every active agent of the organization.
+func (h TicketHandlers) eligibleAgents(ctx context.Context, orgID uuid.UUID, pool []uuid.UUID) ([]uuid.UUID, error) {
+	if len(pool) == 0 {
+		role := string(users.RoleAgent)
+		isActive := true
+		agents, err := h.userRepo.ListUsers(ctx, queries.UserFilter{
+			Role:           &role,
+			OrganizationID: &orgID,
+			IsActive:       &isActive,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		agentIDs := make([]uuid.UUID, 0, len(agents))
+		for _, agent := range agents {
+			agentIDs = append(agentIDs, agent.ID())
+		}
+		return agentIDs, nil
+	}
+
+	agentIDs := make([]uuid.UUID, 0, len(pool))
+	for _, id := range pool {
+		agent, err := h.userRepo.GetUser(ctx, id)
+		if errors.Is(err, users.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if agent.Role() == users.RoleAgent && agent.IsActive() {
+			agentIDs = append(agentIDs, agent.ID())
+		}
+	}
+	return agentIDs, nil
+}
@@ -0,0 +1,164 @@
+package tickets_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createAssignmentTestOrganization(name string) uuid.UUID {
+	org, err := s.OrganizationsRepo.CreateOrganization(context.Background(),
+		func() (*organizations.Organization, error) {
+			return organizations.CreateOrganization(name, uuid.NewString()[:8]+".com")
+		})
+	s.Require().NoError(err)
+	return org.ID()
+}
+
+func (s *TicketsSuite) createAssignmentTestUser(orgID uuid.UUID, role users.Role, isActive bool) uuid.UUID {
+	email := uuid.NewString()[:8] + "@assignment.com"
+	user, err := s.UsersRepo.CreateUser(context.Background(), email, []byte("hash"),
+		func() (*users.User, error) {
+			return users.NewUserWithDetails(
+				uuid.New(), "Assignment Agent", email, []byte("hash"), role, &orgID, isActive, time.Now(), time.Now(),
+			)
+		})
+	s.Require().NoError(err)
+	return user.ID()
+}
+
+func (s *TicketsSuite) configureAssignment(orgID uuid.UUID, req openapi.UpdateOrganizationAssignmentRequest) {
+	s.sendTicketRequest(http.MethodPut, fmt.Sprintf("/organizations/%s/assignment", orgID), req)
+}
+
+func (s *TicketsSuite) TestAutoAssignment() {
+	s.Run("Organization without configuration keeps tickets unassigned", func() {
+		orgID := s.createAssignmentTestOrganization("Manual Org")
+		s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Nil(ticket.AssigneeId)
+		s.Nil(ticket.AssignmentStrategy)
+	})
+
+	s.Run("Round robin rotates through active agents of the organization", func() {
+		orgID := s.createAssignmentTestOrganization("Round Robin Org")
+		agents := map[uuid.UUID]bool{
+			s.createAssignmentTestUser(orgID, users.RoleAgent, true): true,
+			s.createAssignmentTestUser(orgID, users.RoleAgent, true): true,
+			s.createAssignmentTestUser(orgID, users.RoleAgent, true): true,
+		}
+		s.createAssignmentTestUser(orgID, users.RoleAgent, false)
+		s.createAssignmentTestUser(orgID, users.RoleCustomer, true)
+		s.createAssignmentTestUser(s.createAssignmentTestOrganization("Other Org"), users.RoleAgent, true)
+
+		s.configureAssignment(orgID, openapi.UpdateOrganizationAssignmentRequest{Strategy: openapi.RoundRobin})
+		org, err := s.OrganizationsRepo.GetOrganization(context.Background(), orgID)
+		s.Require().NoError(err)
+		version := org.Version()
+
+		assigned := make([]uuid.UUID, 0, 4)
+		for range 4 {
+			ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+			s.Require().NotNil(ticket.AssigneeId)
+			s.Require().NotNil(ticket.AssignmentStrategy)
+			s.Equal(openapi.RoundRobin, *ticket.AssignmentStrategy)
+			s.True(agents[*ticket.AssigneeId], "only active agents of the organization are eligible")
+			assigned = append(assigned, *ticket.AssigneeId)
+		}
+		s.NotEqual(assigned[0], assigned[1])
+		s.NotEqual(assigned[1], assigned[2])
+		s.NotEqual(assigned[0], assigned[2])
+		s.Equal(assigned[0], assigned[3], "the rotation starts over")
+
+		org, err = s.OrganizationsRepo.GetOrganization(context.Background(), orgID)
+		s.Require().NoError(err)
+		s.Equal(version, org.Version(), "assignments do not bump the organization version")
+	})
+
+	s.Run("Least open picks the agent with the fewest open tickets", func() {
+		orgID := s.createAssignmentTestOrganization("Least Open Org")
+		busyAgent := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+		freeAgent := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+
+		for range 2 {
+			_, err := s.TicketsRepo.CreateTicket(context.Background(), func() (*tickets.Ticket, error) {
+				ticket, err := tickets.NewTicket(
+					uuid.New(), "Busy ticket", "Ticket already in work", tickets.PriorityNormal, orgID, uuid.New(), nil,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return ticket, ticket.AssignTo(busyAgent)
+			})
+			s.Require().NoError(err)
+		}
+
+		s.configureAssignment(orgID, openapi.UpdateOrganizationAssignmentRequest{
+			Strategy: openapi.LeastOpen,
+			AgentIds: &[]uuid.UUID{busyAgent, freeAgent},
+		})
+
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Require().NotNil(ticket.AssigneeId)
+		s.Equal(freeAgent, *ticket.AssigneeId)
+		s.Equal(openapi.LeastOpen, *ticket.AssignmentStrategy)
+
+		ticket = s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Equal(freeAgent, *ticket.AssigneeId)
+
+		ticket = s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Contains([]uuid.UUID{busyAgent, freeAgent}, *ticket.AssigneeId)
+
+		org, err := s.OrganizationsRepo.GetOrganization(context.Background(), orgID)
+		s.Require().NoError(err)
+		s.Zero(org.AutoAssignmentTurns(), "only round-robin takes rotation turns")
+	})
+
+	s.Run("Category rules route tickets to category agents", func() {
+		orgID := s.createAssignmentTestOrganization("Category Org")
+		networkAgent := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+		generalAgent := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+		networkCategory := uuid.New()
+
+		s.configureAssignment(orgID, openapi.UpdateOrganizationAssignmentRequest{
+			Strategy: openapi.Category,
+			AgentIds: &[]uuid.UUID{generalAgent},
+			CategoryRules: &[]openapi.CategoryAssignment{
+				{CategoryId: networkCategory, AgentIds: []uuid.UUID{networkAgent}},
+			},
+		})
+
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, CategoryId: &networkCategory}, "")
+		s.Require().NotNil(ticket.AssigneeId)
+		s.Equal(networkAgent, *ticket.AssigneeId)
+		s.Equal(openapi.Category, *ticket.AssignmentStrategy)
+
+		ticket = s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Require().NotNil(ticket.AssigneeId)
+		s.Equal(generalAgent, *ticket.AssigneeId)
+	})
+
+	s.Run("Pool without active agents keeps tickets unassigned", func() {
+		orgID := s.createAssignmentTestOrganization("Inactive Org")
+		inactiveAgent := s.createAssignmentTestUser(orgID, users.RoleAgent, false)
+		customer := s.createAssignmentTestUser(orgID, users.RoleCustomer, true)
+
+		s.configureAssignment(orgID, openapi.UpdateOrganizationAssignmentRequest{
+			Strategy: openapi.RoundRobin,
+			AgentIds: &[]uuid.UUID{inactiveAgent, customer, uuid.New()},
+		})
+
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, "")
+		s.Nil(ticket.AssigneeId)
+		s.Nil(ticket.AssignmentStrategy)
+	})
+}
@@ -0,0 +1,242 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	attachmentFormField   = "file"
+	defaultAttachmentMime = "application/octet-stream"
+)
+
+var errAttachmentTooLarge = errors.New("attachment exceeds the organization file size limit")
+
+func (h TicketHandlers) PostTicketsIDAttachments(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.accessibleTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	maxFileSize, err := h.organizationMaxFileSize(ctx, ticket.OrganizationID())
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	fileHeader, err := c.FormFile(attachmentFormField)
+	if err != nil {
+		msg := fmt.Sprintf("%s form field is required: %v", attachmentFormField, err)
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	if fileHeader.Size > maxFileSize {
+		return h.handleAttachmentError(c, fmt.Errorf("%w: %d bytes allowed", errAttachmentTooLarge, maxFileSize))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+	defer file.Close()
+
+	mimeType := fileHeader.Header.Get(echo.HeaderContentType)
+	if mimeType == "" {
+		mimeType = defaultAttachmentMime
+	}
+
+	key := fmt.Sprintf("tickets/%s/%s", id, uuid.New())
+	size, err := h.blobStore.Put(ctx, key, file)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		if addErr := ticket.AddAttachment(fileHeader.Filename, size, mimeType, key, authUserID); addErr != nil {
+			return false, addErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		h.deleteBlob(ctx, key)
+		return h.handleAttachmentError(c, err)
+	}
+
+	attachments := ticket.Attachments()
+	return c.JSON(http.StatusCreated, convertAttachmentToResponse(attachments[len(attachments)-1]))
+}
+
+func (h TicketHandlers) GetTicketsIDAttachments(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.readableTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	attachments := ticket.Attachments()
+	responses := make([]openapi.TicketAttachment, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = convertAttachmentToResponse(attachment)
+	}
+
+	return c.JSON(http.StatusOK, responses)
+}
+
+func (h TicketHandlers) GetTicketsIDAttachmentsAttachmentID(
+	c echo.Context, id openapi_types.UUID, attachmentID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.readableTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	attachment, err := ticket.Attachment(attachmentID)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	content, err := h.blobStore.Get(ctx, attachment.FilePath)
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+	defer content.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition,
+		mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.FileSize, 10))
+
+	mimeType := attachment.MimeType
+	if mimeType == "" {
+		mimeType = defaultAttachmentMime
+	}
+	return c.Stream(http.StatusOK, mimeType, content)
+}
+
+func (h TicketHandlers) DeleteTicketsIDAttachmentsAttachmentID(
+	c echo.Context, id openapi_types.UUID, attachmentID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	if _, err := h.accessibleTicket(ctx, id, authUserID, role); err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	var removed tickets.Attachment
+	_, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		attachment, findErr := ticket.Attachment(attachmentID)
+		if findErr != nil {
+			return false, findErr
+		}
+		if !isOwnerOrAdmin(attachment.UploadedBy, authUserID, role) {
+			return false, tickets.ErrUnauthorizedAccess
+		}
+		if removeErr := ticket.RemoveAttachment(attachmentID); removeErr != nil {
+			return false, removeErr
+		}
+		removed = attachment
+		return true, nil
+	})
+	if err != nil {
+		return h.handleAttachmentError(c, err)
+	}
+
+	h.deleteBlob(ctx, removed.FilePath)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// organizationMaxFileSize returns the upload limit configured for the organization
+func (h TicketHandlers) organizationMaxFileSize(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return organizations.DefaultSettings().MaxFileSize, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return org.Settings().MaxFileSize, nil
+}
+
+// deleteBlob removes stored content that is no longer referenced by a ticket.
+// Failures only leave an orphaned blob behind, so they are logged instead of returned.
+func (h TicketHandlers) deleteBlob(ctx context.Context, key string) {
+	if err := h.blobStore.Delete(ctx, key); err != nil && !errors.Is(err, tickets.ErrAttachmentNotFound) {
+		slog.WarnContext(ctx, "failed to delete attachment blob", "key", key, "error", err)
+	}
+}
+
+func (h TicketHandlers) handleAttachmentError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) || errors.Is(err, tickets.ErrAttachmentNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrUnauthorizedAccess) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, errAttachmentTooLarge) {
+		return c.JSON(http.StatusRequestEntityTooLarge, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrTicketValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
+// convertAttachmentToResponse converts domain attachment to OpenAPI response.
+// The storage key is internal and never exposed.
+func convertAttachmentToResponse(attachment tickets.Attachment) openapi.TicketAttachment {
+	id := attachment.ID
+	ticketID := attachment.TicketID
+	fileName := attachment.FileName
+	fileSize := attachment.FileSize
+	mimeType := attachment.MimeType
+	uploadedBy := attachment.UploadedBy
+	createdAt := attachment.CreatedAt
+
+	return openapi.TicketAttachment{
+		Id:         &id,
+		TicketId:   &ticketID,
+		FileName:   &fileName,
+		FileSize:   &fileSize,
+		MimeType:   &mimeType,
+		UploadedBy: &uploadedBy,
+		CreatedAt:  &createdAt,
+	}
+}
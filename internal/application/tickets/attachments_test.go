@@ -0,0 +1,142 @@
+package tickets_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *TicketsSuite) uploadAttachment(
+	ticketID uuid.UUID, fileName string, content []byte, token string,
+) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	s.Require().NoError(err)
+	_, err = part.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/attachments", ticketID), &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) attachmentRequest(
+	method string, ticketID, attachmentID uuid.UUID, token string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, fmt.Sprintf("/tickets/%s/attachments/%s", ticketID, attachmentID), nil)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) TestTicketAttachments() {
+	s.Run("Upload, list, download and delete", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: uuid.New()}, "").Id
+		content := []byte("log line 1\nlog line 2\n")
+
+		rec := s.uploadAttachment(ticketID, "server.log", content, "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var uploaded openapi.TicketAttachment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &uploaded))
+		s.Equal("server.log", *uploaded.FileName)
+		s.Equal(int64(len(content)), *uploaded.FileSize)
+		s.Equal(ticketID, *uploaded.TicketId)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/attachments", ticketID), nil)
+		listRec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(listRec, req)
+		s.Require().Equal(http.StatusOK, listRec.Code)
+
+		var attachments []openapi.TicketAttachment
+		s.Require().NoError(json.Unmarshal(listRec.Body.Bytes(), &attachments))
+		s.Require().Len(attachments, 1)
+		s.Equal(*uploaded.Id, *attachments[0].Id)
+
+		rec = s.attachmentRequest(http.MethodGet, ticketID, *uploaded.Id, "")
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Equal(content, rec.Body.Bytes())
+		s.Contains(rec.Header().Get(echo.HeaderContentDisposition), `filename=server.log`)
+
+		rec = s.attachmentRequest(http.MethodDelete, ticketID, *uploaded.Id, "")
+		s.Require().Equal(http.StatusNoContent, rec.Code)
+
+		rec = s.attachmentRequest(http.MethodGet, ticketID, *uploaded.Id, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("File larger than organization limit returns 413", func() {
+		org, err := s.OrganizationsRepo.CreateOrganization(
+			context.Background(),
+			func() (*organizations.Organization, error) {
+				org, orgErr := organizations.NewOrganization(uuid.New(), "Small Files Org", "small-files.com", nil)
+				if orgErr != nil {
+					return nil, orgErr
+				}
+				settings := org.Settings()
+				settings.MaxFileSize = 8
+				org.UpdateSettings(settings)
+				return org, nil
+			},
+		)
+		s.Require().NoError(err)
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: org.ID()}, "").Id
+
+		rec := s.uploadAttachment(ticketID, "small.txt", []byte("12345678"), "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		rec = s.uploadAttachment(ticketID, "large.txt", []byte("123456789"), "")
+		s.Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	s.Run("Empty file returns 400", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: uuid.New()}, "").Id
+
+		rec := s.uploadAttachment(ticketID, "empty.txt", nil, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Customer cannot reach attachments of another ticket", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: uuid.New()}, "").Id
+		rec := s.uploadAttachment(ticketID, "secret.txt", []byte("secret"), "")
+		s.Require().Equal(http.StatusCreated, rec.Code)
+
+		var uploaded openapi.TicketAttachment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &uploaded))
+
+		_, customerToken := s.LoginAs("attachment-customer@example.com", openapi.Customer)
+
+		rec = s.attachmentRequest(http.MethodGet, ticketID, *uploaded.Id, customerToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+
+		rec = s.uploadAttachment(ticketID, "other.txt", []byte("data"), customerToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Unknown attachment returns 404", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: uuid.New()}, "").Id
+
+		rec := s.attachmentRequest(http.MethodGet, ticketID, uuid.New(), "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
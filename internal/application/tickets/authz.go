@@ -1,9 +1,12 @@
 package tickets
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strings"
 
+	"simpleservicedesk/internal/domain/tickets"
 	userdomain "simpleservicedesk/internal/domain/users"
 	"simpleservicedesk/pkg/echomiddleware"
 
@@ -30,3 +33,67 @@ func authUser(c echo.Context) (uuid.UUID, userdomain.Role, bool) {
 func hasElevatedTicketAccess(role userdomain.Role) bool {
 	return role == userdomain.RoleAgent || role == userdomain.RoleAdmin
 }
+
+// isOwnerOrAdmin reports whether the user may modify an item (comment, attachment) created by ownerID
+func isOwnerOrAdmin(ownerID, userID uuid.UUID, role userdomain.Role) bool {
+	return role == userdomain.RoleAdmin || ownerID == userID
+}
+
+// accessibleTicket loads the ticket and verifies that the user may access it
+func (h TicketHandlers) accessibleTicket(
+	ctx context.Context, id, userID uuid.UUID, role userdomain.Role,
+) (*tickets.Ticket, error) {
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !hasElevatedTicketAccess(role) && ticket.AuthorID() != userID {
+		return nil, tickets.ErrUnauthorizedAccess
+	}
+	return ticket, nil
+}
+
+// canReadTicket reports whether the user may read the ticket and its public comments.
+// Besides agents, admins and the author, customers of the ticket's organization who watch it have read access.
+func (h TicketHandlers) canReadTicket(
+	ctx context.Context, ticket *tickets.Ticket, userID uuid.UUID, role userdomain.Role,
+) (bool, error) {
+	if hasElevatedTicketAccess(role) || ticket.AuthorID() == userID {
+		return true, nil
+	}
+	if !ticket.IsWatcher(userID) || h.userRepo == nil {
+		return false, nil
+	}
+
+	// Membership is checked on every read: a watcher who moved to another organization loses access
+	user, err := h.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, userdomain.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return isOrganizationMember(user, ticket.OrganizationID()), nil
+}
+
+// readableTicket loads the ticket and verifies that the user may read it
+func (h TicketHandlers) readableTicket(
+	ctx context.Context, id, userID uuid.UUID, role userdomain.Role,
+) (*tickets.Ticket, error) {
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	canRead, err := h.canReadTicket(ctx, ticket, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !canRead {
+		return nil, tickets.ErrUnauthorizedAccess
+	}
+	return ticket, nil
+}
+
+func isOrganizationMember(user *userdomain.User, orgID uuid.UUID) bool {
+	return user.IsActive() && user.OrganizationID() != nil && *user.OrganizationID() == orgID
+}
@@ -0,0 +1,313 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// maxBulkTickets limits how many tickets a single bulk operation changes
+const maxBulkTickets = 500
+
+var errInvalidBulkRequest = errors.New("invalid bulk request")
+
+// bulkOperation is a validated operation of a bulk request
+type bulkOperation struct {
+	kind       openapi.BulkTicketOperationType
+	assigneeID *uuid.UUID
+	status     tickets.Status
+	priority   tickets.Priority
+	categoryID *uuid.UUID
+	schema     *tickets.CustomFieldSchema // Custom fields of the new category
+	tag        string
+	content    string
+	internal   bool
+}
+
+// requiresAgent reports whether only agents and admins may perform the operation,
+// as with the single-ticket endpoints
+func (o bulkOperation) requiresAgent() bool {
+	switch o.kind {
+	case openapi.BulkTicketOperationTypeAssign,
+		openapi.BulkTicketOperationTypeStatus,
+		openapi.BulkTicketOperationTypePriority,
+		openapi.BulkTicketOperationTypeAddTag,
+		openapi.BulkTicketOperationTypeRemoveTag:
+		return true
+	case openapi.BulkTicketOperationTypeComment:
+		return o.internal
+	case openapi.BulkTicketOperationTypeCategory,
+		openapi.BulkTicketOperationTypeDelete:
+	}
+	return false
+}
+
+// PostTicketsBulk applies one operation to many tickets.
+// Every ticket is changed in its own update, so a failure affects only that ticket.
+func (h TicketHandlers) PostTicketsBulk(c echo.Context) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.BulkTicketRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	operation, err := parseBulkOperation(req.Operation)
+	if err == nil && operation.kind == openapi.BulkTicketOperationTypeCategory {
+		operation.schema, err = h.customFieldSchema(ctx, operation.categoryID)
+	}
+	var ticketIDs []uuid.UUID
+	if err == nil {
+		ticketIDs, err = h.bulkTicketIDs(ctx, req, authUserID, role)
+	}
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, errInvalidBulkRequest) {
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+		}
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+
+	results := make([]openapi.BulkTicketResult, 0, len(ticketIDs))
+	succeeded := 0
+	for _, id := range ticketIDs {
+		result := openapi.BulkTicketResult{TicketId: id, Success: true}
+		if opErr := h.applyBulkOperation(ctx, id, operation, authUserID, role); opErr != nil {
+			statusCode := bulkErrorStatus(opErr)
+			msg := opErr.Error()
+			result.Success = false
+			result.StatusCode = &statusCode
+			result.Error = &msg
+		} else {
+			succeeded++
+		}
+		results = append(results, result)
+	}
+
+	failed := len(results) - succeeded
+	return c.JSON(http.StatusOK, openapi.BulkTicketResponse{
+		Results:   &results,
+		Succeeded: &succeeded,
+		Failed:    &failed,
+	})
+}
+
+// bulkTicketIDs returns the listed tickets or the tickets matching the filter.
+// Customers' filters are limited to the tickets they can read, like their ticket list.
+func (h TicketHandlers) bulkTicketIDs(
+	ctx context.Context,
+	req openapi.BulkTicketRequest,
+	userID uuid.UUID,
+	role userdomain.Role,
+) ([]uuid.UUID, error) {
+	if (req.TicketIds == nil) == (req.Filter == nil) {
+		return nil, fmt.Errorf("%w: exactly one of ticket_ids and filter must be set", errInvalidBulkRequest)
+	}
+	if req.TicketIds != nil {
+		ids := make([]uuid.UUID, 0, len(*req.TicketIds))
+		seen := make(map[uuid.UUID]struct{}, len(*req.TicketIds))
+		for _, id := range *req.TicketIds {
+			if _, duplicate := seen[id]; !duplicate {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+
+	filter, err := queries.FromOpenAPIBulkTicketFilter(*req.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidBulkRequest, err)
+	}
+	if role == userdomain.RoleCustomer {
+		if filter, err = h.customerTicketFilter(ctx, filter, userID); err != nil {
+			return nil, err
+		}
+	}
+	filter.Limit = maxBulkTickets
+	filter.Offset = 0
+	if filter, err = filter.ValidateAndSetDefaults(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidBulkRequest, err)
+	}
+
+	count, err := h.repo.CountTickets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if count > maxBulkTickets {
+		return nil, fmt.Errorf("%w: filter matches %d tickets, at most %d can be changed at once",
+			errInvalidBulkRequest, count, maxBulkTickets)
+	}
+	ticketList, err := h.repo.ListTickets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(ticketList))
+	for _, ticket := range ticketList {
+		ids = append(ids, ticket.ID())
+	}
+	return ids, nil
+}
+
+func (h TicketHandlers) applyBulkOperation(
+	ctx context.Context,
+	id uuid.UUID,
+	operation bulkOperation,
+	userID uuid.UUID,
+	role userdomain.Role,
+) error {
+	ticket, err := h.accessibleTicket(ctx, id, userID, role)
+	if err != nil {
+		return err
+	}
+	if operation.requiresAgent() && !hasElevatedTicketAccess(role) {
+		return tickets.ErrUnauthorizedAccess
+	}
+	if operation.kind == openapi.BulkTicketOperationTypeDelete {
+		return h.deleteTicket(ctx, ticket, userID)
+	}
+
+	var comment tickets.Comment
+	_, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(userID)
+		if operation.kind == openapi.BulkTicketOperationTypeComment {
+			var commentErr error
+			comment, commentErr = h.addMentioningComment(ctx, ticket, userID, operation.content, operation.internal, role)
+			return commentErr == nil, commentErr
+		}
+		if changeErr := h.applyBulkChange(ctx, ticket, operation, role); changeErr != nil {
+			return false, changeErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	h.notifyMentioned(ctx, userID, comment, comment.Mentions)
+	return nil
+}
+
+func (h TicketHandlers) applyBulkChange(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	operation bulkOperation,
+	role userdomain.Role,
+) error {
+	switch operation.kind {
+	case openapi.BulkTicketOperationTypeAssign:
+		if operation.assigneeID == nil {
+			ticket.Unassign()
+			return nil
+		}
+		return ticket.AssignTo(*operation.assigneeID)
+	case openapi.BulkTicketOperationTypeStatus:
+		return h.changeStatus(ctx, ticket, operation.status, role)
+	case openapi.BulkTicketOperationTypePriority:
+		return h.changePriority(ctx, ticket, operation.priority)
+	case openapi.BulkTicketOperationTypeCategory:
+		slaConfig, err := h.organizationSLAConfig(ctx, ticket.OrganizationID())
+		if err != nil {
+			return err
+		}
+		ticket.SetCategory(operation.categoryID)
+		values := mergeCustomFields(ticket.CustomFields(), nil, operation.schema)
+		if err = ticket.SetCustomFields(operation.schema, values); err != nil {
+			return err
+		}
+		ticket.ApplySLAConfig(slaConfig)
+		return nil
+	case openapi.BulkTicketOperationTypeAddTag, openapi.BulkTicketOperationTypeRemoveTag:
+		add, remove := &[]string{operation.tag}, (*[]string)(nil)
+		if operation.kind == openapi.BulkTicketOperationTypeRemoveTag {
+			add, remove = nil, add
+		}
+		changes, err := h.resolveTagChanges(ctx, ticket.OrganizationID(), add, remove)
+		if err != nil {
+			return err
+		}
+		_, err = applyTagChanges(ticket, changes)
+		return err
+	case openapi.BulkTicketOperationTypeComment, openapi.BulkTicketOperationTypeDelete:
+		// Comments and deletions are applied by applyBulkOperation
+	}
+	return nil
+}
+
+// parseBulkOperation checks that the operation carries the value it needs
+func parseBulkOperation(req openapi.BulkTicketOperation) (bulkOperation, error) {
+	operation := bulkOperation{kind: req.Type, assigneeID: req.AssigneeId, categoryID: req.CategoryId}
+	var err error
+	switch req.Type {
+	case openapi.BulkTicketOperationTypeStatus:
+		if req.Status == nil {
+			return bulkOperation{}, missingBulkValue(req.Type, "status")
+		}
+		operation.status, err = tickets.ParseWorkflowStatus(string(*req.Status))
+	case openapi.BulkTicketOperationTypePriority:
+		if req.Priority == nil {
+			return bulkOperation{}, missingBulkValue(req.Type, "priority")
+		}
+		operation.priority, err = tickets.ParsePriority(string(*req.Priority))
+	case openapi.BulkTicketOperationTypeCategory:
+		if req.CategoryId == nil {
+			return bulkOperation{}, missingBulkValue(req.Type, "category_id")
+		}
+	case openapi.BulkTicketOperationTypeAddTag, openapi.BulkTicketOperationTypeRemoveTag:
+		if req.Tag == nil {
+			return bulkOperation{}, missingBulkValue(req.Type, "tag")
+		}
+		operation.tag, err = tickets.ParseTagName(*req.Tag)
+	case openapi.BulkTicketOperationTypeComment:
+		if req.Content == nil {
+			return bulkOperation{}, missingBulkValue(req.Type, "content")
+		}
+		operation.content = *req.Content
+		operation.internal = req.IsInternal != nil && *req.IsInternal
+	case openapi.BulkTicketOperationTypeAssign, openapi.BulkTicketOperationTypeDelete:
+	default:
+		return bulkOperation{}, fmt.Errorf("%w: unknown operation %q", errInvalidBulkRequest, req.Type)
+	}
+	if err != nil {
+		return bulkOperation{}, fmt.Errorf("%w: %w", errInvalidBulkRequest, err)
+	}
+	return operation, nil
+}
+
+func missingBulkValue(kind openapi.BulkTicketOperationType, field string) error {
+	return fmt.Errorf("%w: %s operation requires %s", errInvalidBulkRequest, kind, field)
+}
+
+// bulkErrorStatus returns the status the single-ticket endpoint responds with for the error
+func bulkErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, tickets.ErrTicketNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, tickets.ErrUnauthorizedAccess), errors.Is(err, tickets.ErrTransitionNotAllowed):
+		return http.StatusForbidden
+	case errors.Is(err, tickets.ErrOpenBlockers), errors.Is(err, tickets.ErrConcurrentUpdate):
+		return http.StatusConflict
+	case errors.Is(err, tickets.ErrTicketValidation),
+		errors.Is(err, tickets.ErrInvalidTicket),
+		errors.Is(err, tickets.ErrInvalidStatus),
+		errors.Is(err, tickets.ErrInvalidTransition),
+		errors.Is(err, tickets.ErrInvalidPriority),
+		errors.Is(err, tickets.ErrTagNotFound),
+		errors.Is(err, tickets.ErrInvalidCustomField):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,237 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) bulkRequest(req openapi.BulkTicketRequest, token string) openapi.BulkTicketResponse {
+	rec := s.RequestAs(http.MethodPost, "/tickets/bulk", req, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response openapi.BulkTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	return response
+}
+
+// bulkFailures returns the status codes of failed tickets
+func bulkFailures(response openapi.BulkTicketResponse) map[uuid.UUID]int {
+	failures := make(map[uuid.UUID]int)
+	for _, result := range *response.Results {
+		if !result.Success {
+			failures[result.TicketId] = *result.StatusCode
+		}
+	}
+	return failures
+}
+
+func (s *TicketsSuite) TestBulkTicketOperations() {
+	orgID := s.createAssignmentTestOrganization("Bulk Org")
+	s.defineTestTags(orgID, "outage")
+	agentID := s.createAssignmentTestUser(orgID, "agent", true)
+	first := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Email is down"}, "").Id
+	second := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Email is down again"}, "").Id
+	missing := uuid.New()
+
+	s.Run("Assign listed tickets", func() {
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{first, second, first, missing},
+			Operation: openapi.BulkTicketOperation{
+				Type:       openapi.BulkTicketOperationTypeAssign,
+				AssigneeId: &agentID,
+			},
+		}, "")
+		s.Equal(2, *response.Succeeded)
+		s.Equal(1, *response.Failed)
+		s.Equal(map[uuid.UUID]int{missing: http.StatusNotFound}, bulkFailures(response))
+		s.Equal(agentID, *s.getTicketResponse(first).AssigneeId)
+		s.Equal(agentID, *s.getTicketResponse(second).AssigneeId)
+	})
+
+	s.Run("Change status of tickets matching a filter", func() {
+		status := openapi.TicketStatus("in_progress")
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			Filter:    &openapi.BulkTicketFilter{OrganizationId: &orgID},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeStatus, Status: &status},
+		}, "")
+		s.Equal(2, *response.Succeeded)
+		s.Equal(status, *s.getTicketResponse(second).Status)
+	})
+
+	s.Run("Each ticket succeeds or fails on its own", func() {
+		blocker := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Mail gateway"}, "").Id
+		s.Require().Equal(http.StatusCreated, s.linkTickets(blocker, openapi.Blocks, first).Code)
+
+		status := openapi.TicketStatus("resolved")
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{first, second},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeStatus, Status: &status},
+		}, "")
+		s.Equal(map[uuid.UUID]int{first: http.StatusConflict}, bulkFailures(response))
+		s.Equal(openapi.TicketStatus("in_progress"), *s.getTicketResponse(first).Status)
+		s.Equal(status, *s.getTicketResponse(second).Status)
+	})
+
+	s.Run("Set priority, category, tags and comments", func() {
+		ids := &[]uuid.UUID{first, second}
+		priority := openapi.TicketPriority("critical")
+		categoryID := uuid.New()
+		tag := "OUTAGE"
+		content := "Mail service is restored"
+
+		for _, operation := range []openapi.BulkTicketOperation{
+			{Type: openapi.BulkTicketOperationTypePriority, Priority: &priority},
+			{Type: openapi.BulkTicketOperationTypeCategory, CategoryId: &categoryID},
+			{Type: openapi.BulkTicketOperationTypeAddTag, Tag: &tag},
+			{Type: openapi.BulkTicketOperationTypeComment, Content: &content},
+		} {
+			response := s.bulkRequest(openapi.BulkTicketRequest{TicketIds: ids, Operation: operation}, "")
+			s.Equal(2, *response.Succeeded, operation.Type)
+		}
+
+		ticket := s.getTicketResponse(first)
+		s.Equal(priority, *ticket.Priority)
+		s.Equal(categoryID, *ticket.CategoryId)
+		s.Equal([]string{"outage"}, *ticket.Tags)
+		code, comments := s.getTicketComments(second)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(comments, 1)
+		s.Equal(content, *comments[0].Content)
+
+		unknownTag := "security"
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: ids,
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeAddTag, Tag: &unknownTag},
+		}, "")
+		s.Equal(2, *response.Failed)
+
+		response = s.bulkRequest(openapi.BulkTicketRequest{
+			Filter:    &openapi.BulkTicketFilter{Tags: &[]string{"outage"}},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeRemoveTag, Tag: &tag},
+		}, "")
+		s.Equal(2, *response.Succeeded)
+		s.Empty(s.getTicketResponse(first).Tags)
+	})
+
+	s.Run("Delete tickets", func() {
+		doomed := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Spam ticket"}, "").Id
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{doomed},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeDelete},
+		}, "")
+		s.Equal(1, *response.Succeeded)
+		rec := s.RequestAs(http.MethodGet, fmt.Sprintf("/tickets/%s", doomed), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestBulkTicketOperationsRoleChecks() {
+	orgID := s.createAssignmentTestOrganization("Bulk Customers Org")
+	authorID, token := s.createOrganizationCustomer("bulk-author@example.com", orgID)
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "Keyboard is missing keys",
+		Description:    "Several keys fell off the keyboard",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var own openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &own))
+	foreign := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Someone else's ticket"}, "").Id
+
+	s.Run("Customers change only their own tickets", func() {
+		content := "Still broken"
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{*own.Id, foreign},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeComment, Content: &content},
+		}, token)
+		s.Equal(map[uuid.UUID]int{foreign: http.StatusForbidden}, bulkFailures(response))
+	})
+
+	s.Run("Customer filters select only their own tickets", func() {
+		content := "Any news?"
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			Filter:    &openapi.BulkTicketFilter{OrganizationId: &orgID},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeComment, Content: &content},
+		}, token)
+		s.Require().Len(*response.Results, 1)
+		s.Equal(*own.Id, (*response.Results)[0].TicketId)
+	})
+
+	s.Run("Customers cannot override the priority derived from impact and urgency", func() {
+		priority := openapi.TicketPriority("critical")
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{*own.Id},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypePriority, Priority: &priority},
+		}, token)
+		s.Equal(map[uuid.UUID]int{*own.Id: http.StatusForbidden}, bulkFailures(response))
+		s.Equal(openapi.TicketPriority("normal"), *s.getTicketResponse(*own.Id).Priority)
+		s.False(*s.getTicketResponse(*own.Id).PriorityOverridden)
+	})
+
+	s.Run("Agent operations are forbidden to customers", func() {
+		status := openapi.TicketStatus("closed")
+		internal := true
+		content := "Internal note"
+		for _, operation := range []openapi.BulkTicketOperation{
+			{Type: openapi.BulkTicketOperationTypeStatus, Status: &status},
+			{Type: openapi.BulkTicketOperationTypeAssign},
+			{Type: openapi.BulkTicketOperationTypeComment, Content: &content, IsInternal: &internal},
+		} {
+			response := s.bulkRequest(openapi.BulkTicketRequest{
+				TicketIds: &[]uuid.UUID{*own.Id},
+				Operation: operation,
+			}, token)
+			s.Equal(map[uuid.UUID]int{*own.Id: http.StatusForbidden}, bulkFailures(response), operation.Type)
+		}
+	})
+}
+
+func (s *TicketsSuite) TestBulkTicketOperationsValidation() {
+	orgID := s.createAssignmentTestOrganization("Bulk Validation Org")
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Validation ticket"}, "").Id
+	assign := openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeAssign}
+
+	for name, req := range map[string]openapi.BulkTicketRequest{
+		"no tickets": {Operation: assign},
+		"both tickets and filter": {
+			TicketIds: &[]uuid.UUID{ticketID},
+			Filter:    &openapi.BulkTicketFilter{OrganizationId: &orgID},
+			Operation: assign,
+		},
+		"missing status": {
+			TicketIds: &[]uuid.UUID{ticketID},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeStatus},
+		},
+		"invalid tag filter": {
+			Filter:    &openapi.BulkTicketFilter{Tags: &[]string{"not valid"}},
+			Operation: assign,
+		},
+	} {
+		rec := s.RequestAs(http.MethodPost, "/tickets/bulk", req, "")
+		s.Equal(http.StatusBadRequest, rec.Code, name)
+	}
+
+	s.Run("Filters may not match too many tickets", func() {
+		for range 500 {
+			_, err := s.TicketsRepo.CreateTicket(context.Background(), func() (*tickets.Ticket, error) {
+				return tickets.NewTicket(uuid.New(), "Generated ticket", "Generated for the bulk limit",
+					tickets.PriorityNormal, orgID, uuid.New(), nil)
+			})
+			s.Require().NoError(err)
+		}
+		rec := s.RequestAs(http.MethodPost, "/tickets/bulk", openapi.BulkTicketRequest{
+			Filter:    &openapi.BulkTicketFilter{OrganizationId: &orgID},
+			Operation: assign,
+		}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
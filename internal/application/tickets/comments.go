@@ -3,10 +3,13 @@ package tickets
 import (
 	"errors"
 	"net/http"
+	"slices"
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -47,35 +50,146 @@ func (h TicketHandlers) PostTicketsIDComments(c echo.Context, id openapi_types.U
 		return c.NoContent(http.StatusForbidden)
 	}
 
-	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
-		if addErr := ticket.AddComment(authorID, req.Content, isInternal); addErr != nil {
+	// A customer reply on a resolved ticket reopens it instead of letting it be closed automatically
+	var reopenWorkflow *tickets.Workflow
+	if role == userdomain.RoleCustomer && ticket.StatusCategory() == tickets.StatusResolved {
+		reopenWorkflow, err = h.organizationWorkflow(ctx, ticket.OrganizationID())
+		if err != nil {
+			msg := err.Error()
+			return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+		}
+	}
+
+	directory, err := h.mentionDirectory(ctx, ticket, req.Content, role)
+	if err != nil {
+		return h.handleCommentError(c, err)
+	}
+
+	var comment tickets.Comment
+	_, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		var addErr error
+		comment, addErr = ticket.AddMentioningComment(authorID, req.Content, isInternal, directory)
+		if addErr != nil {
 			return false, addErr
 		}
+		if reopenWorkflow != nil {
+			if _, reopenErr := ticket.ReopenOnReply(reopenWorkflow); reopenErr != nil {
+				return false, reopenErr
+			}
+		}
 		return true, nil
 	})
+	if err != nil {
+		return h.handleCommentError(c, err)
+	}
+
+	h.notifyMentioned(ctx, authorID, comment, comment.Mentions)
+
+	return c.JSON(http.StatusCreated, convertCommentToResponse(comment))
+}
+
+func (h TicketHandlers) PutTicketsIDCommentsCommentID(
+	c echo.Context, id openapi_types.UUID, commentID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
 
+	ticket, err := h.accessibleTicket(ctx, id, authUserID, role)
 	if err != nil {
-		msg := err.Error()
-		if errors.Is(err, tickets.ErrTicketNotFound) {
-			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+		return h.handleCommentError(c, err)
+	}
+
+	var req openapi.UpdateCommentRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return bindErr
+	}
+
+	directory, err := h.mentionDirectory(ctx, ticket, req.Content, role)
+	if err != nil {
+		return h.handleCommentError(c, err)
+	}
+
+	var mentioned []uuid.UUID
+	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		comment, findErr := ticket.Comment(commentID)
+		if findErr != nil {
+			return false, findErr
 		}
-		if errors.Is(err, tickets.ErrTicketValidation) {
-			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+		if !isOwnerOrAdmin(comment.AuthorID, authUserID, role) {
+			return false, tickets.ErrUnauthorizedAccess
 		}
-		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+		var editErr error
+		mentioned, editErr = ticket.EditMentioningComment(commentID, authUserID, req.Content, directory)
+		if editErr != nil {
+			return false, editErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleCommentError(c, err)
 	}
 
-	// Get the last added comment
-	comments := ticket.Comments()
-	if len(comments) == 0 {
-		msg := "failed to add comment"
-		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	comment, err := ticket.Comment(commentID)
+	if err != nil {
+		return h.handleCommentError(c, err)
+	}
+	h.notifyMentioned(ctx, authUserID, comment, mentioned)
+
+	return c.JSON(http.StatusOK, convertCommentToResponse(comment))
+}
+
+func (h TicketHandlers) DeleteTicketsIDCommentsCommentID(
+	c echo.Context, id openapi_types.UUID, commentID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	if _, err := h.accessibleTicket(ctx, id, authUserID, role); err != nil {
+		return h.handleCommentError(c, err)
+	}
+
+	_, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		comment, findErr := ticket.Comment(commentID)
+		if findErr != nil {
+			return false, findErr
+		}
+		if !isOwnerOrAdmin(comment.AuthorID, authUserID, role) {
+			return false, tickets.ErrUnauthorizedAccess
+		}
+		if deleteErr := ticket.DeleteComment(commentID); deleteErr != nil {
+			return false, deleteErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleCommentError(c, err)
 	}
 
-	lastComment := comments[len(comments)-1]
-	response := convertCommentToResponse(lastComment)
+	return c.NoContent(http.StatusNoContent)
+}
 
-	return c.JSON(http.StatusCreated, response)
+func (h TicketHandlers) handleCommentError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) || errors.Is(err, tickets.ErrCommentNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrUnauthorizedAccess) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrTicketValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 }
 
 func (h TicketHandlers) GetTicketsIDComments(
@@ -95,7 +209,12 @@ func (h TicketHandlers) GetTicketsIDComments(
 		}
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
-	if !hasElevatedTicketAccess(role) && ticket.AuthorID() != authUserID {
+	canRead, err := h.canReadTicket(ctx, ticket, authUserID, role)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+	if !canRead {
 		return c.NoContent(http.StatusForbidden)
 	}
 
@@ -133,12 +252,32 @@ func convertCommentToResponse(comment tickets.Comment) openapi.TicketComment {
 	isInternal := comment.IsInternal
 	createdAt := comment.CreatedAt
 
-	return openapi.TicketComment{
+	response := openapi.TicketComment{
 		Id:         &id,
 		TicketId:   &ticketID,
 		AuthorId:   &authorID,
 		Content:    &content,
 		IsInternal: &isInternal,
 		CreatedAt:  &createdAt,
+		UpdatedAt:  comment.UpdatedAt,
+	}
+
+	if len(comment.Mentions) > 0 {
+		mentions := slices.Clone(comment.Mentions)
+		response.Mentions = &mentions
 	}
+
+	if len(comment.Revisions) > 0 {
+		revisions := make([]openapi.CommentRevision, len(comment.Revisions))
+		for i, revision := range comment.Revisions {
+			revisions[i] = openapi.CommentRevision{
+				Content:  &revision.Content,
+				EditedBy: &revision.EditedBy,
+				EditedAt: &revision.EditedAt,
+			}
+		}
+		response.Revisions = &revisions
+	}
+
+	return response
 }
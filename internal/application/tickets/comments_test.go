@@ -489,3 +489,175 @@ func (s *TicketsSuite) TestGetComments() {
 		s.Empty(resp)
 	})
 }
+
+func (s *TicketsSuite) postComment(ticketID uuid.UUID, content, token string) openapi.TicketComment {
+	body, _ := json.Marshal(openapi.CreateCommentRequest{AuthorId: uuid.New(), Content: content})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/comments", ticketID), bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var resp openapi.TicketComment
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func (s *TicketsSuite) editComment(ticketID, commentID uuid.UUID, content, token string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(openapi.UpdateCommentRequest{Content: content})
+	req := httptest.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("/tickets/%s/comments/%s", ticketID, commentID),
+		bytes.NewBuffer(body),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) deleteComment(ticketID, commentID uuid.UUID, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/tickets/%s/comments/%s", ticketID, commentID), nil)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) TestEditComment() {
+	s.Run("Author edits comment and revision is kept", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		comment := s.postComment(ticketID, "Original text", "")
+
+		rec := s.editComment(ticketID, *comment.Id, "Edited text", "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp openapi.TicketComment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal(*comment.Id, *resp.Id)
+		s.Equal("Edited text", *resp.Content)
+		s.Require().NotNil(resp.UpdatedAt)
+		s.Require().NotNil(resp.Revisions)
+		s.Require().Len(*resp.Revisions, 1)
+		s.Equal("Original text", *(*resp.Revisions)[0].Content)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/comments", ticketID), nil)
+		listRec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(listRec, req)
+		s.Require().Equal(http.StatusOK, listRec.Code)
+
+		var comments []openapi.TicketComment
+		s.Require().NoError(json.Unmarshal(listRec.Body.Bytes(), &comments))
+		s.Require().Len(comments, 1)
+		s.Equal(*comment.Id, *comments[0].Id)
+		s.Equal("Edited text", *comments[0].Content)
+	})
+
+	s.Run("Other agent cannot edit or delete comment", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		comment := s.postComment(ticketID, "Admin comment", "")
+		_, agentToken := s.LoginAs("comment-agent@example.com", openapi.Agent)
+
+		rec := s.editComment(ticketID, *comment.Id, "Hijacked", agentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+
+		rec = s.deleteComment(ticketID, *comment.Id, agentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Admin edits comment of another user", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		_, agentToken := s.LoginAs("comment-author-agent@example.com", openapi.Agent)
+		comment := s.postComment(ticketID, "Agent comment", agentToken)
+
+		rec := s.editComment(ticketID, *comment.Id, "Moderated by admin", "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	})
+
+	s.Run("Empty content returns 400", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		comment := s.postComment(ticketID, "Some text", "")
+
+		rec := s.editComment(ticketID, *comment.Id, "   ", "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Unknown comment returns 404", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+
+		rec := s.editComment(ticketID, uuid.New(), "Text", "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestDeleteComment() {
+	s.Run("Author deletes comment", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		first := s.postComment(ticketID, "First comment", "")
+		second := s.postComment(ticketID, "Second comment", "")
+
+		rec := s.deleteComment(ticketID, *first.Id, "")
+		s.Require().Equal(http.StatusNoContent, rec.Code)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/comments", ticketID), nil)
+		listRec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(listRec, req)
+		s.Require().Equal(http.StatusOK, listRec.Code)
+
+		var comments []openapi.TicketComment
+		s.Require().NoError(json.Unmarshal(listRec.Body.Bytes(), &comments))
+		s.Require().Len(comments, 1)
+		s.Equal(*second.Id, *comments[0].Id)
+
+		rec = s.deleteComment(ticketID, *first.Id, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestReplyOnResolvedTicket() {
+	orgID := s.createAssignmentTestOrganization("Reply Org")
+	authorID, customerToken := s.createOrganizationCustomer("reply-author@example.com", orgID)
+	_, agentToken := s.LoginAs("reply-agent@example.com", openapi.Agent)
+
+	createResolvedTicket := func() uuid.UUID {
+		rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+			Title:          "Mail does not sync",
+			Description:    "The mail client shows an old inbox",
+			Priority:       openapi.TicketPriority("normal"),
+			OrganizationId: orgID,
+			AuthorId:       authorID,
+		}, customerToken)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var created openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(*created.Id, openapi.TicketStatus("in_progress")).Code)
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(*created.Id, openapi.TicketStatus("resolved")).Code)
+		return *created.Id
+	}
+	reply := func(ticketID uuid.UUID, token string) {
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/comments", ticketID),
+			openapi.CreateCommentRequest{Content: "Any news on this?"}, token)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+
+	s.Run("A customer reply reopens the ticket", func() {
+		ticketID := createResolvedTicket()
+		reply(ticketID, customerToken)
+		s.Equal(openapi.TicketStatus("in_progress"), *s.getTicketResponse(ticketID).Status)
+	})
+
+	s.Run("An agent reply keeps the ticket resolved", func() {
+		ticketID := createResolvedTicket()
+		reply(ticketID, agentToken)
+		s.Equal(openapi.TicketStatus("resolved"), *s.getTicketResponse(ticketID).Status)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"simpleservicedesk/generated/openapi"
@@ -21,8 +22,6 @@ func (h TicketHandlers) PostTickets(c echo.Context) error {
 		return err
 	}
 
-	priority := tickets.Priority(req.Priority)
-
 	// Convert OpenAPI types to uuid.UUID
 	organizationID := req.OrganizationId
 	authorID := req.AuthorId
@@ -50,31 +49,89 @@ func (h TicketHandlers) PostTickets(c echo.Context) error {
 		categoryID = &cid
 	}
 
+	slaConfig, err := h.organizationSLAConfig(ctx, organizationID)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+	classification, err := h.newTicketClassification(ctx, req, role)
+	if err != nil {
+		return h.handleCreateError(c, err)
+	}
+
+	var customFields tickets.CustomFields
+	if req.CustomFields != nil {
+		customFields = tickets.CustomFields(*req.CustomFields)
+	}
+	schema, err := h.customFieldSchema(ctx, categoryID)
+	if err == nil {
+		err = h.validateCustomFieldUsers(ctx, schema, customFields)
+	}
+	if err != nil {
+		return h.handleCreateError(c, err)
+	}
+
+	// A failed auto-assignment must not lose the ticket: it is created unassigned instead
+	assignment, autoAssign, err := h.pickAutoAssignee(ctx, organizationID, categoryID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to auto-assign ticket", "organization_id", organizationID, "error", err)
+	}
+	key, err := h.issueTicketKey(ctx, organizationID)
+	if err != nil {
+		return h.handleCreateError(c, err)
+	}
+
 	ticket, err := h.repo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
-		return tickets.NewTicket(
+		ticket, newErr := tickets.NewTicket(
 			uuid.New(),
 			req.Title,
 			req.Description,
-			priority,
+			classification.priority(),
 			organizationID,
 			authorID,
 			categoryID,
 		)
+		if newErr != nil {
+			return nil, newErr
+		}
+		if keyErr := assignTicketKey(ticket, key); keyErr != nil {
+			return nil, keyErr
+		}
+		if fieldsErr := ticket.SetCustomFields(schema, customFields); fieldsErr != nil {
+			return nil, fieldsErr
+		}
+		if classifyErr := classification.apply(ticket); classifyErr != nil {
+			return nil, classifyErr
+		}
+		ticket.ApplySLAConfig(slaConfig)
+		if autoAssign {
+			if assignErr := ticket.AutoAssign(assignment.agentID, assignment.strategy); assignErr != nil {
+				return nil, assignErr
+			}
+		}
+		return ticket, nil
 	})
 	if err != nil {
-		msg := err.Error()
-		if errors.Is(err, tickets.ErrTicketValidation) ||
-			errors.Is(err, tickets.ErrInvalidTicket) ||
-			errors.Is(err, tickets.ErrInvalidPriority) {
-			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
-		}
-		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+		return h.handleCreateError(c, err)
 	}
 
-	response := convertTicketToResponse(ticket)
+	response := convertTicketToResponse(ticket, hasElevatedTicketAccess(role))
 	return c.JSON(http.StatusCreated, response)
 }
 
+func (h TicketHandlers) handleCreateError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketValidation) ||
+		errors.Is(err, tickets.ErrInvalidTicket) ||
+		errors.Is(err, tickets.ErrInvalidPriority) ||
+		errors.Is(err, tickets.ErrInvalidImpact) ||
+		errors.Is(err, tickets.ErrInvalidUrgency) ||
+		errors.Is(err, tickets.ErrInvalidCustomField) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
+
 func (h TicketHandlers) validateCustomerTicketOrganization(
 	ctx context.Context,
 	userID uuid.UUID,
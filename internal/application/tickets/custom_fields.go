@@ -0,0 +1,81 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// customFieldSchema returns the custom field schema of the ticket category.
+// Tickets without a category, unknown categories and a missing category repository have no custom fields.
+func (h TicketHandlers) customFieldSchema(
+	ctx context.Context,
+	categoryID *uuid.UUID,
+) (*tickets.CustomFieldSchema, error) {
+	var schema *tickets.CustomFieldSchema
+	if categoryID == nil || h.categoryRepo == nil {
+		return schema, nil
+	}
+	category, err := h.categoryRepo.GetCategory(ctx, *categoryID)
+	if errors.Is(err, categories.ErrCategoryNotFound) {
+		return schema, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return category.CustomFieldSchema(), nil
+}
+
+// validateCustomFieldUsers checks that user reference fields point to existing users.
+// Malformed values are left to the domain validation.
+func (h TicketHandlers) validateCustomFieldUsers(
+	ctx context.Context,
+	schema *tickets.CustomFieldSchema,
+	values map[string]any,
+) error {
+	if h.userRepo == nil {
+		return nil
+	}
+	for key, value := range values {
+		field, ok := schema.Field(key)
+		text, isString := value.(string)
+		if !ok || !isString || field.Type != tickets.CustomFieldUser {
+			continue
+		}
+		userID, err := uuid.Parse(text)
+		if err != nil {
+			continue
+		}
+		if _, err = h.userRepo.GetUser(ctx, userID); errors.Is(err, users.ErrUserNotFound) {
+			return fmt.Errorf("%w: %s references unknown user %s", tickets.ErrInvalidCustomField, key, userID)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeCustomFields applies a partial update to the current values.
+// Values of fields the schema no longer defines are dropped; nil values in the patch remove fields.
+func mergeCustomFields(
+	current tickets.CustomFields,
+	patch map[string]any,
+	schema *tickets.CustomFieldSchema,
+) tickets.CustomFields {
+	merged := make(tickets.CustomFields, len(current)+len(patch))
+	for key, value := range current {
+		if _, defined := schema.Field(key); defined {
+			merged[key] = value
+		}
+	}
+	for key, value := range patch {
+		merged[key] = value
+	}
+	return merged
+}
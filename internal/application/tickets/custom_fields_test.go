@@ -0,0 +1,136 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createCustomFieldTestCategory(
+	orgID uuid.UUID,
+	fields ...tickets.CustomFieldDefinition,
+) uuid.UUID {
+	category, err := s.CategoriesRepo.CreateCategory(context.Background(), func() (*categories.Category, error) {
+		category, err := categories.CreateCategory("Category "+uuid.NewString()[:8], "", orgID, nil)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := tickets.NewCustomFieldSchema(fields)
+		if err != nil {
+			return nil, err
+		}
+		return category, category.SetCustomFieldSchema(schema)
+	})
+	s.Require().NoError(err)
+	return category.ID()
+}
+
+func (s *TicketsSuite) createCustomFieldTestTicket(
+	orgID, categoryID uuid.UUID,
+	values openapi.CustomFieldValues,
+) *httptest.ResponseRecorder {
+	return s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "Laptop battery swells",
+		Description:    "The battery bulges and the case does not close",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       uuid.New(),
+		CategoryId:     &categoryID,
+		CustomFields:   &values,
+	}, "")
+}
+
+func (s *TicketsSuite) TestTicketCustomFields() {
+	orgID := s.createAssignmentTestOrganization("Custom Fields Org")
+	ownerID := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+	hardwareID := s.createCustomFieldTestCategory(orgID,
+		tickets.CustomFieldDefinition{Key: "asset_tag", Label: "Asset tag", Type: tickets.CustomFieldText, Required: true},
+		tickets.CustomFieldDefinition{Key: "cost", Label: "Cost", Type: tickets.CustomFieldNumber},
+		tickets.CustomFieldDefinition{
+			Key: "os", Label: "Operating system", Type: tickets.CustomFieldEnum, Options: []string{"linux", "macos"},
+		},
+		tickets.CustomFieldDefinition{Key: "owner", Label: "Owner", Type: tickets.CustomFieldUser},
+	)
+	softwareID := s.createCustomFieldTestCategory(orgID,
+		tickets.CustomFieldDefinition{Key: "os", Label: "Operating system", Type: tickets.CustomFieldText},
+	)
+
+	var ticketID uuid.UUID
+	s.Run("Values are validated against the category schema", func() {
+		rec := s.createCustomFieldTestTicket(orgID, hardwareID, openapi.CustomFieldValues{
+			"asset_tag": "LT-1042",
+			"cost":      1299.5,
+			"os":        "macos",
+			"owner":     ownerID.String(),
+		})
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var ticket openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+		s.Require().NotNil(ticket.CustomFields)
+		s.Equal("LT-1042", (*ticket.CustomFields)["asset_tag"])
+		s.InDelta(1299.5, (*ticket.CustomFields)["cost"], 0)
+		ticketID = *ticket.Id
+	})
+
+	s.Run("Invalid values are rejected", func() {
+		invalid := []openapi.CustomFieldValues{
+			{"cost": 10},
+			{"asset_tag": "LT-1", "os": "windows"},
+			{"asset_tag": "LT-1", "cost": "ten"},
+			{"asset_tag": "LT-1", "owner": uuid.NewString()},
+			{"asset_tag": "LT-1", "color": "red"},
+		}
+		for _, values := range invalid {
+			rec := s.createCustomFieldTestTicket(orgID, hardwareID, values)
+			s.Equal(http.StatusBadRequest, rec.Code, "%v", values)
+		}
+	})
+
+	s.Run("Updates change single fields", func() {
+		ticketPath := fmt.Sprintf("/tickets/%s", ticketID)
+		rec := s.RequestAs(http.MethodPut, ticketPath,
+			openapi.UpdateTicketRequest{CustomFields: &map[string]any{"os": "linux", "cost": nil}}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal(openapi.CustomFieldValues{"asset_tag": "LT-1042", "os": "linux", "owner": ownerID.String()},
+			*s.getTicketResponse(ticketID).CustomFields)
+
+		rec = s.RequestAs(http.MethodPut, ticketPath,
+			openapi.UpdateTicketRequest{CustomFields: &map[string]any{"asset_tag": nil}}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		code, history := s.getTicketHistory(ticketID, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Contains(eventTypes(history.Events), openapi.FieldChanged)
+	})
+
+	s.Run("Fields of the previous category are dropped on category change", func() {
+		rec := s.RequestAs(http.MethodPut, fmt.Sprintf("/tickets/%s", ticketID),
+			openapi.UpdateTicketRequest{CategoryId: &softwareID}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal(openapi.CustomFieldValues{"os": "linux"}, *s.getTicketResponse(ticketID).CustomFields)
+	})
+
+	s.Run("Tickets are filtered by custom field values", func() {
+		rec := s.createCustomFieldTestTicket(orgID, hardwareID, openapi.CustomFieldValues{"asset_tag": "LT-2001"})
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var other openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &other))
+
+		s.Equal([]uuid.UUID{*other.Id}, s.listTicketIDs("custom_fields[asset_tag]=LT-2001"))
+		s.Equal([]uuid.UUID{ticketID}, s.listTicketIDs("custom_fields[os]=linux"))
+		s.Empty(s.listTicketIDs("custom_fields[os]=linux&custom_fields[asset_tag]=LT-2001"))
+
+		rec = s.RequestAs(http.MethodGet, "/tickets?custom_fields[asset%20tag]=LT-2001", nil, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
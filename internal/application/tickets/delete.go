@@ -1,12 +1,14 @@
 package tickets
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -30,7 +32,7 @@ func (h TicketHandlers) DeleteTicketsID(c echo.Context, id openapi_types.UUID) e
 		return c.NoContent(http.StatusForbidden)
 	}
 
-	err = h.repo.DeleteTicket(ctx, id)
+	err = h.deleteTicket(ctx, ticket, authUserID)
 	if err != nil {
 		msg := err.Error()
 		if errors.Is(err, tickets.ErrTicketNotFound) {
@@ -41,3 +43,18 @@ func (h TicketHandlers) DeleteTicketsID(c echo.Context, id openapi_types.UUID) e
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// deleteTicket moves the ticket to the trash. Its attachments and the links of related tickets to it
+// are kept so that the ticket can be restored; they are removed when the ticket is purged.
+func (h TicketHandlers) deleteTicket(ctx context.Context, ticket *tickets.Ticket, actorID uuid.UUID) error {
+	return h.repo.DeleteTicket(ctx, ticket.ID(), actorID)
+}
+
+// removeTicket permanently removes a ticket bypassing the trash, such as the copy of a failed split
+func (h TicketHandlers) removeTicket(ctx context.Context, id, actorID uuid.UUID) error {
+	if err := h.repo.DeleteTicket(ctx, id, actorID); err != nil {
+		return err
+	}
+	_, err := h.repo.PurgeTicket(ctx, id)
+	return err
+}
@@ -57,6 +57,14 @@ func (s *TicketsSuite) TestDeleteTicket() {
 
 		s.HTTPServer.ServeHTTP(getRec, getReq)
 		s.Equal(http.StatusNotFound, getRec.Code)
+
+		// The deleted ticket waits in the trash
+		trashReq := httptest.NewRequest(http.MethodGet, "/trash?type=ticket", nil)
+		trashRec := httptest.NewRecorder()
+
+		s.HTTPServer.ServeHTTP(trashRec, trashReq)
+		s.Require().Equal(http.StatusOK, trashRec.Code)
+		s.Contains(trashRec.Body.String(), ticketID.String())
 	})
 
 	s.Run("Delete non-existent ticket returns 404", func() {
@@ -6,30 +6,39 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
-	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h TicketHandlers) GetTicketsID(c echo.Context, id openapi_types.UUID) error {
+func (h TicketHandlers) GetTicketsID(c echo.Context, id string) error {
 	ctx := c.Request().Context()
 	authUserID, role, ok := authUser(c)
 	if !ok {
 		return nil
 	}
 
-	ticket, err := h.repo.GetTicket(ctx, id)
+	ticket, err := h.ticketByIDOrKey(ctx, id)
 	if err != nil {
 		msg := err.Error()
 		if errors.Is(err, tickets.ErrTicketNotFound) {
 			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
 		}
+		if errors.Is(err, tickets.ErrInvalidTicketKey) {
+			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+		}
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+	canRead, err := h.canReadTicket(ctx, ticket, authUserID, role)
+	if err != nil {
+		msg := err.Error()
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
-	if !hasElevatedTicketAccess(role) && ticket.AuthorID() != authUserID {
+	if !canRead {
 		return c.NoContent(http.StatusForbidden)
 	}
 
-	response := convertTicketToResponse(ticket)
+	etag.Set(c, ticket.Version())
+	response := convertTicketToResponse(ticket, hasElevatedTicketAccess(role))
 	return c.JSON(http.StatusOK, response)
 }
@@ -2,9 +2,15 @@ package tickets
 
 import (
 	"context"
+	"io"
 
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/domain/notifications"
+	"simpleservicedesk/internal/domain/organizations"
 	"simpleservicedesk/internal/domain/tickets"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -18,27 +24,91 @@ type TicketRepository interface {
 		updateFn func(*tickets.Ticket) (bool, error),
 	) (*tickets.Ticket, error)
 	GetTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+	GetTicketByKey(ctx context.Context, key string) (*tickets.Ticket, error)
+	NextTicketNumber(ctx context.Context, prefix string) (int64, error)
 	ListTickets(ctx context.Context, filter queries.TicketFilter) ([]*tickets.Ticket, error)
-	DeleteTicket(ctx context.Context, id uuid.UUID) error
+	CountTickets(ctx context.Context, filter queries.TicketFilter) (int64, error)
+	DeleteTicket(ctx context.Context, id, deletedBy uuid.UUID) error
+	PurgeTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+	ListTicketEvents(ctx context.Context, filter queries.TicketEventFilter) ([]tickets.Event, error)
+	CountTicketEvents(ctx context.Context, filter queries.TicketEventFilter) (int64, error)
 }
 
 type UserRepository interface {
 	GetUser(ctx context.Context, id uuid.UUID) (*users.User, error)
+	ListUsers(ctx context.Context, filter queries.UserFilter) ([]*users.User, error)
+}
+
+type OrganizationRepository interface {
+	GetOrganization(ctx context.Context, id uuid.UUID) (*organizations.Organization, error)
+	TakeAutoAssignmentTurn(ctx context.Context, id uuid.UUID) (int64, error)
+}
+
+type CategoryRepository interface {
+	GetCategory(ctx context.Context, id uuid.UUID) (*categories.Category, error)
+}
+
+type MacroRepository interface {
+	GetCannedResponse(ctx context.Context, id uuid.UUID) (*macros.CannedResponse, error)
+	GetMacro(ctx context.Context, id uuid.UUID) (*macros.Macro, error)
+}
+
+type ViewRepository interface {
+	GetView(ctx context.Context, id uuid.UUID) (*views.View, error)
+	ListViews(ctx context.Context, filter queries.ViewFilter) ([]*views.View, error)
+}
+
+type NotificationRepository interface {
+	CreateNotification(
+		ctx context.Context,
+		createFn func() (*notifications.Notification, error),
+	) (*notifications.Notification, error)
+}
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
 }
 
 type TicketHandlers struct {
-	repo     TicketRepository
-	userRepo UserRepository
+	repo             TicketRepository
+	userRepo         UserRepository
+	orgRepo          OrganizationRepository
+	categoryRepo     CategoryRepository
+	macroRepo        MacroRepository
+	viewRepo         ViewRepository
+	blobStore        BlobStore
+	notificationRepo NotificationRepository
 }
 
-func SetupHandlers(repo TicketRepository, userRepo ...UserRepository) TicketHandlers {
-	var usersRepo UserRepository
-	if len(userRepo) > 0 {
-		usersRepo = userRepo[0]
+func SetupHandlers(
+	repo TicketRepository,
+	userRepo UserRepository,
+	orgRepo OrganizationRepository,
+	categoryRepo CategoryRepository,
+	macroRepo MacroRepository,
+	viewRepo ViewRepository,
+	blobStore BlobStore,
+	notificationRepo NotificationRepository,
+) TicketHandlers {
+	return TicketHandlers{
+		repo:             repo,
+		userRepo:         userRepo,
+		orgRepo:          orgRepo,
+		categoryRepo:     categoryRepo,
+		macroRepo:        macroRepo,
+		viewRepo:         viewRepo,
+		blobStore:        blobStore,
+		notificationRepo: notificationRepo,
 	}
+}
 
-	return TicketHandlers{
-		repo:     repo,
-		userRepo: usersRepo,
+// organization returns the ticket's organization.
+// Without an organization repository every organization is reported as not found.
+func (h TicketHandlers) organization(ctx context.Context, orgID uuid.UUID) (*organizations.Organization, error) {
+	if h.orgRepo == nil {
+		return nil, organizations.ErrOrganizationNotFound
 	}
+	return h.orgRepo.GetOrganization(ctx, orgID)
 }
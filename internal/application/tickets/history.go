@@ -0,0 +1,118 @@
+package tickets
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h TicketHandlers) GetTicketsIDHistory(
+	c echo.Context, id openapi_types.UUID, params openapi.GetTicketsIDHistoryParams,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	filter, err := queries.FromOpenAPITicketHistoryParams(id, params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, tickets.ErrTicketNotFound) {
+			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+		}
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+	canRead, err := h.canReadTicket(ctx, ticket, authUserID, role)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+	if !canRead {
+		return c.NoContent(http.StatusForbidden)
+	}
+
+	// Internal comments are hidden from customers, so are their history entries
+	filter.IncludeInternal = hasElevatedTicketAccess(role)
+
+	filter, err = filter.ValidateAndSetDefaults()
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	events, err := h.repo.ListTicketEvents(ctx, filter)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+
+	total, err := h.repo.CountTicketEvents(ctx, filter)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+
+	eventResponses := make([]openapi.TicketEvent, len(events))
+	for i, event := range events {
+		eventResponses[i] = convertEventToResponse(event)
+	}
+
+	page := 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	totalCount := int(total)
+	hasNext := filter.Offset+len(events) < totalCount
+
+	return c.JSON(http.StatusOK, openapi.TicketHistoryResponse{
+		Events: &eventResponses,
+		Pagination: &openapi.PaginationResponse{
+			Total:   &totalCount,
+			Page:    &page,
+			Limit:   &filter.Limit,
+			HasNext: &hasNext,
+		},
+	})
+}
+
+// convertEventToResponse converts domain history event to OpenAPI response
+func convertEventToResponse(event tickets.Event) openapi.TicketEvent {
+	id := event.ID
+	ticketID := event.TicketID
+	eventType := openapi.TicketEventType(event.Type)
+	isInternal := event.IsInternal
+	createdAt := event.CreatedAt
+
+	response := openapi.TicketEvent{
+		Id:         &id,
+		TicketId:   &ticketID,
+		Type:       &eventType,
+		ActorId:    event.ActorID,
+		IsInternal: &isInternal,
+		CreatedAt:  &createdAt,
+	}
+
+	if event.OldValue != "" {
+		oldValue := event.OldValue
+		response.OldValue = &oldValue
+	}
+	if event.NewValue != "" {
+		newValue := event.NewValue
+		response.NewValue = &newValue
+	}
+
+	return response
+}
@@ -0,0 +1,102 @@
+package tickets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *TicketsSuite) sendTicketRequest(method, path string, payload any) {
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(method, path, bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Less(rec.Code, http.StatusMultipleChoices, rec.Body.String())
+}
+
+func (s *TicketsSuite) getTicketHistory(ticketID uuid.UUID, query string) (int, openapi.TicketHistoryResponse) {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/history%s", ticketID, query), nil)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+
+	var resp openapi.TicketHistoryResponse
+	if rec.Code == http.StatusOK {
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec.Code, resp
+}
+
+func (s *TicketsSuite) TestGetTicketHistory() {
+	s.Run("Records changes in chronological order", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{Title: "Ticket with history"}, "").Id
+		assigneeID := uuid.New()
+		newTitle := "Renamed ticket"
+		internal := true
+
+		s.sendTicketRequest(http.MethodPut, fmt.Sprintf("/tickets/%s", ticketID),
+			openapi.UpdateTicketRequest{Title: &newTitle})
+		s.sendTicketRequest(http.MethodPatch, fmt.Sprintf("/tickets/%s/status", ticketID),
+			openapi.UpdateTicketStatusRequest{Status: openapi.TicketStatus("in_progress")})
+		s.sendTicketRequest(http.MethodPatch, fmt.Sprintf("/tickets/%s/assign", ticketID),
+			openapi.AssignTicketRequest{AssigneeId: &assigneeID})
+		s.sendTicketRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/comments", ticketID),
+			openapi.CreateCommentRequest{Content: "Internal note", IsInternal: &internal})
+
+		code, resp := s.getTicketHistory(ticketID, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().NotNil(resp.Events)
+		events := *resp.Events
+		s.Require().Len(events, 4)
+
+		s.Equal(openapi.TitleChanged, *events[0].Type)
+		s.Equal("Ticket with history", *events[0].OldValue)
+		s.Equal(newTitle, *events[0].NewValue)
+		s.Require().NotNil(events[0].ActorId)
+
+		s.Equal(openapi.StatusChanged, *events[1].Type)
+		s.Equal("in_progress", *events[1].NewValue)
+
+		s.Equal(openapi.Assigned, *events[2].Type)
+		s.Nil(events[2].OldValue)
+		s.Equal(assigneeID.String(), *events[2].NewValue)
+
+		s.Equal(openapi.CommentAdded, *events[3].Type)
+		s.True(*events[3].IsInternal)
+
+		s.Equal(4, *resp.Pagination.Total)
+		s.False(*resp.Pagination.HasNext)
+	})
+
+	s.Run("Paginates history", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{}, "").Id
+		for _, title := range []string{"First title", "Second title", "Third title"} {
+			s.sendTicketRequest(http.MethodPut, fmt.Sprintf("/tickets/%s", ticketID),
+				openapi.UpdateTicketRequest{Title: &title})
+		}
+
+		code, resp := s.getTicketHistory(ticketID, "?page=1&limit=2")
+		s.Require().Equal(http.StatusOK, code)
+		s.Len(*resp.Events, 2)
+		s.Equal(3, *resp.Pagination.Total)
+		s.True(*resp.Pagination.HasNext)
+
+		code, resp = s.getTicketHistory(ticketID, "?page=2&limit=2")
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(*resp.Events, 1)
+		s.Equal("Third title", *(*resp.Events)[0].NewValue)
+		s.False(*resp.Pagination.HasNext)
+	})
+
+	s.Run("Unknown ticket returns 404", func() {
+		code, _ := s.getTicketHistory(uuid.New(), "")
+		s.Equal(http.StatusNotFound, code)
+	})
+}
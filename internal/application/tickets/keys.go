@@ -0,0 +1,53 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// issueTicketKey takes the next key of the organization, or returns an empty key when it has no key prefix
+func (h TicketHandlers) issueTicketKey(ctx context.Context, orgID uuid.UUID) (string, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	prefix := org.Settings().TicketKeyPrefix
+	if prefix == "" {
+		return "", nil
+	}
+	number, err := h.repo.NextTicketNumber(ctx, prefix)
+	if err != nil {
+		return "", fmt.Errorf("failed to issue ticket key: %w", err)
+	}
+	return tickets.FormatTicketKey(prefix, number), nil
+}
+
+// assignTicketKey gives a new ticket its key; tickets of organizations without a key prefix stay without one
+func assignTicketKey(ticket *tickets.Ticket, key string) error {
+	if key == "" {
+		return nil
+	}
+	return ticket.AssignKey(key)
+}
+
+// ticketByIDOrKey loads a ticket by its UUID or by a key such as ACME-1042
+func (h TicketHandlers) ticketByIDOrKey(ctx context.Context, idOrKey string) (*tickets.Ticket, error) {
+	if id, err := uuid.Parse(idOrKey); err == nil {
+		return h.repo.GetTicket(ctx, id)
+	}
+	key, ok := tickets.ParseTicketKey(idOrKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is neither a ticket ID nor a ticket key", tickets.ErrInvalidTicketKey, idOrKey)
+	}
+	return h.repo.GetTicketByKey(ctx, key)
+}
@@ -0,0 +1,129 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) setTicketKeyPrefix(orgID uuid.UUID, prefix string) {
+	_, err := s.OrganizationsRepo.UpdateOrganization(context.Background(), orgID,
+		func(org *organizations.Organization) (bool, error) {
+			settings := org.Settings()
+			settings.TicketKeyPrefix = prefix
+			org.UpdateSettings(settings)
+			return true, nil
+		})
+	s.Require().NoError(err)
+}
+
+func (s *TicketsSuite) listTicketsByQuery(query url.Values) []openapi.GetTicketResponse {
+	rec := s.RequestAs(http.MethodGet, "/tickets?"+query.Encode(), nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list openapi.ListTicketsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	return *list.Tickets
+}
+
+func (s *TicketsSuite) TestTicketKeys() {
+	orgID := s.createAssignmentTestOrganization("Keys Org")
+	s.setTicketKeyPrefix(orgID, "ACME")
+	first := s.getTicketResponse(*s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Printer jams",
+	}, "").Id)
+	second := s.getTicketResponse(*s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Monitor flickers",
+	}, "").Id)
+
+	s.Run("Tickets get sequential keys", func() {
+		s.Require().NotNil(first.Key)
+		s.Equal("ACME-1", *first.Key)
+		s.Require().NotNil(second.Key)
+		s.Equal("ACME-2", *second.Key)
+	})
+
+	s.Run("Get resolves a ticket key", func() {
+		rec := s.RequestAs(http.MethodGet, "/tickets/acme-2", nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var resp openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal(*second.Id, *resp.Id)
+
+		rec = s.RequestAs(http.MethodGet, "/tickets/ACME-99", nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+		rec = s.RequestAs(http.MethodGet, "/tickets/not-a-key", nil, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("List filters and searches by key", func() {
+		byKey := s.listTicketsByQuery(url.Values{"key": {"ACME-1,acme-2"}})
+		s.Len(byKey, 2)
+
+		found := s.searchTickets(orgID, "acme-2", "")
+		s.Require().Len(found, 1)
+		s.Equal(*second.Id, *found[0].Id)
+
+		s.Empty(s.listTicketsByQuery(url.Values{"key": {"ACME-1"}, "q": {"ACME-2"}}))
+	})
+
+	s.Run("Split ticket gets the next key", func() {
+		comment := s.postComment(*first.Id, "Toner is empty too", "")
+		rec := s.postTicketAction(*first.Id, "split", openapi.SplitTicketRequest{
+			Title:      "Toner is empty",
+			CommentIds: []uuid.UUID{*comment.Id},
+		})
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var created openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+		s.Require().NotNil(created.Key)
+		s.Equal("ACME-3", *created.Key)
+	})
+
+	s.Run("Prefix change keeps issued keys", func() {
+		s.setTicketKeyPrefix(orgID, "IT")
+		renamed := s.getTicketResponse(*s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: orgID,
+			Title:          "Keyboard is sticky",
+		}, "").Id)
+		s.Require().NotNil(renamed.Key)
+		s.Equal("IT-1", *renamed.Key)
+		s.Equal("ACME-1", *s.getTicketResponse(*first.Id).Key)
+	})
+
+	s.Run("Organizations without a prefix issue no keys", func() {
+		plainOrgID := s.createAssignmentTestOrganization("Plain Org")
+		s.Nil(s.getTicketResponse(*s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: plainOrgID,
+			Title:          "No key",
+		}, "").Id).Key)
+	})
+}
+
+func (s *TicketsSuite) TestTicketKeysAccess() {
+	orgID := s.createAssignmentTestOrganization("Keys Org")
+	s.setTicketKeyPrefix(orgID, "HELP")
+	_, authorToken := s.createOrganizationCustomer("keys-author@example.com", orgID)
+	_, strangerToken := s.createOrganizationCustomer("keys-stranger@example.com", uuid.New())
+
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "VPN is down",
+		Description:    "Cannot connect from home",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+	}, authorToken)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = s.RequestAs(http.MethodGet, "/tickets/HELP-1", nil, authorToken)
+	s.Equal(http.StatusOK, rec.Code, rec.Body.String())
+	rec = s.RequestAs(http.MethodGet, "/tickets/HELP-1", nil, strangerToken)
+	s.Equal(http.StatusForbidden, rec.Code)
+}
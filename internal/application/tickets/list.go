@@ -1,7 +1,9 @@
 package tickets
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
@@ -35,8 +37,13 @@ func (h TicketHandlers) GetTickets(c echo.Context, params openapi.GetTicketsPara
 			msg := "unauthorized"
 			return c.JSON(http.StatusUnauthorized, openapi.ErrorResponse{Message: &msg})
 		}
-		filter.AuthorID = &authorID
+		filter, err = h.customerTicketFilter(ctx, filter, authorID)
+		if err != nil {
+			msg := err.Error()
+			return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+		}
 	}
+	filter.SearchInternal = hasElevatedTicketAccess(claims.Role)
 
 	// Validate filter with business rules
 	filter, validateErr := filter.ValidateAndSetDefaults()
@@ -57,19 +64,49 @@ func (h TicketHandlers) GetTickets(c echo.Context, params openapi.GetTicketsPara
 	if params.Page != nil {
 		page = *params.Page
 	}
-	response := h.buildListResponse(ticketList, filter.Limit, page)
+	response := h.buildListResponse(ticketList, filter.Limit, page, hasElevatedTicketAccess(claims.Role))
+	if filter.Search != nil {
+		for i, ticket := range ticketList {
+			highlights := convertSearchHighlights(ticket.SearchHighlights(*filter.Search, filter.SearchInternal))
+			(*response.Tickets)[i].Highlights = &highlights
+		}
+	}
 	return c.JSON(http.StatusOK, response)
 }
 
+// customerTicketFilter limits a customer's list to tickets they can read.
+// Customers see their own tickets, or with watcher_id set to themselves, watched tickets of their organization.
+func (h TicketHandlers) customerTicketFilter(
+	ctx context.Context, filter queries.TicketFilter, customerID uuid.UUID,
+) (queries.TicketFilter, error) {
+	if filter.WatcherID == nil || *filter.WatcherID != customerID || h.userRepo == nil {
+		filter.AuthorID = &customerID
+		return filter, nil
+	}
+
+	user, err := h.userRepo.GetUser(ctx, customerID)
+	if err != nil {
+		return filter, err
+	}
+	orgID := user.OrganizationID()
+	if !user.IsActive() || orgID == nil {
+		filter.AuthorID = &customerID
+		return filter, nil
+	}
+	filter.OrganizationID = orgID
+	return filter, nil
+}
+
 func (h TicketHandlers) buildListResponse(
 	ticketList []*tickets.Ticket,
 	limit int,
 	page int,
+	includeInternal bool,
 ) openapi.ListTicketsResponse {
 	// Convert domain tickets to OpenAPI responses
 	ticketResponses := make([]openapi.GetTicketResponse, len(ticketList))
 	for i, ticket := range ticketList {
-		ticketResponses[i] = convertTicketToResponse(ticket)
+		ticketResponses[i] = convertTicketToResponse(ticket, includeInternal)
 	}
 
 	// Build pagination response
@@ -89,12 +126,14 @@ func (h TicketHandlers) buildListResponse(
 	}
 }
 
-// convertTicketToResponse converts domain ticket to OpenAPI response
-func convertTicketToResponse(ticket *tickets.Ticket) openapi.GetTicketResponse {
+// convertTicketToResponse converts domain ticket to OpenAPI response.
+// Time spent is summed from internal worklogs, so it is included only when internal details are.
+func convertTicketToResponse(ticket *tickets.Ticket, includeInternal bool) openapi.GetTicketResponse {
 	id := ticket.ID()
 	title := ticket.Title()
 	description := ticket.Description()
 	status := openapi.TicketStatus(ticket.Status().String())
+	statusCategory := openapi.TicketStatusCategory(ticket.StatusCategory().String())
 	priority := openapi.TicketPriority(ticket.Priority().String())
 	organizationID := ticket.OrganizationID()
 	authorID := ticket.AuthorID()
@@ -106,6 +145,7 @@ func convertTicketToResponse(ticket *tickets.Ticket) openapi.GetTicketResponse {
 		Title:          &title,
 		Description:    &description,
 		Status:         &status,
+		StatusCategory: &statusCategory,
 		Priority:       &priority,
 		OrganizationId: &organizationID,
 		AuthorId:       &authorID,
@@ -114,6 +154,17 @@ func convertTicketToResponse(ticket *tickets.Ticket) openapi.GetTicketResponse {
 	}
 
 	// Add optional fields if they exist
+	if key := ticket.Key(); key != "" {
+		response.Key = &key
+	}
+	if ticket.IsClassified() {
+		impact := openapi.TicketImpact(ticket.Impact())
+		urgency := openapi.TicketUrgency(ticket.Urgency())
+		overridden := ticket.IsPriorityOverridden()
+		response.Impact = &impact
+		response.Urgency = &urgency
+		response.PriorityOverridden = &overridden
+	}
 	if categoryID := ticket.CategoryID(); categoryID != nil {
 		response.CategoryId = categoryID
 	}
@@ -122,6 +173,36 @@ func convertTicketToResponse(ticket *tickets.Ticket) openapi.GetTicketResponse {
 		response.AssigneeId = assigneeID
 	}
 
+	if mergedIntoID := ticket.MergedIntoID(); mergedIntoID != nil {
+		response.MergedIntoId = mergedIntoID
+	}
+
+	if splitFromID := ticket.SplitFromID(); splitFromID != nil {
+		response.SplitFromId = splitFromID
+	}
+
+	if relations := ticket.Relations(); len(relations) > 0 {
+		relationsResponse := convertRelationsToResponse(relations)
+		response.Relations = &relationsResponse
+	}
+
+	if watchers := ticket.Watchers(); len(watchers) > 0 {
+		watchersResponse := convertWatchersToResponse(watchers)
+		response.Watchers = &watchersResponse
+	}
+	if tags := ticket.Tags(); len(tags) > 0 {
+		response.Tags = &tags
+	}
+	if customFields := ticket.CustomFields(); len(customFields) > 0 {
+		values := openapi.CustomFieldValues(customFields)
+		response.CustomFields = &values
+	}
+
+	if strategy := ticket.AssignmentStrategy(); strategy != "" {
+		assignmentStrategy := openapi.AssignmentStrategy(strategy)
+		response.AssignmentStrategy = &assignmentStrategy
+	}
+
 	if resolvedAt := ticket.ResolvedAt(); resolvedAt != nil {
 		response.ResolvedAt = resolvedAt
 	}
@@ -130,5 +211,11 @@ func convertTicketToResponse(ticket *tickets.Ticket) openapi.GetTicketResponse {
 		response.ClosedAt = closedAt
 	}
 
+	response.Sla = convertSLAToResponse(ticket, time.Now())
+	response.Satisfaction = convertSatisfactionToResponse(ticket.Survey())
+	if includeInternal {
+		response.TimeSpent = convertTimeSpentToResponse(ticket.TimeSpent())
+	}
+
 	return response
 }
@@ -45,6 +45,14 @@ func (r *ticketRepoSpy) GetTicket(_ context.Context, _ uuid.UUID) (*ticketdomain
 	panic("unexpected GetTicket call")
 }
 
+func (r *ticketRepoSpy) GetTicketByKey(_ context.Context, _ string) (*ticketdomain.Ticket, error) {
+	panic("unexpected GetTicketByKey call")
+}
+
+func (r *ticketRepoSpy) NextTicketNumber(_ context.Context, _ string) (int64, error) {
+	panic("unexpected NextTicketNumber call")
+}
+
 func (r *ticketRepoSpy) ListTickets(_ context.Context, filter queries.TicketFilter) ([]*ticketdomain.Ticket, error) {
 	r.listCalled = true
 	r.listFilter = filter
@@ -54,14 +62,33 @@ func (r *ticketRepoSpy) ListTickets(_ context.Context, filter queries.TicketFilt
 	return []*ticketdomain.Ticket{}, nil
 }
 
-func (r *ticketRepoSpy) DeleteTicket(_ context.Context, _ uuid.UUID) error {
+func (r *ticketRepoSpy) CountTickets(_ context.Context, _ queries.TicketFilter) (int64, error) {
+	panic("unexpected CountTickets call")
+}
+
+func (r *ticketRepoSpy) DeleteTicket(_ context.Context, _, _ uuid.UUID) error {
 	panic("unexpected DeleteTicket call")
 }
 
+func (r *ticketRepoSpy) PurgeTicket(_ context.Context, _ uuid.UUID) (*ticketdomain.Ticket, error) {
+	panic("unexpected PurgeTicket call")
+}
+
+func (r *ticketRepoSpy) ListTicketEvents(
+	_ context.Context,
+	_ queries.TicketEventFilter,
+) ([]ticketdomain.Event, error) {
+	panic("unexpected ListTicketEvents call")
+}
+
+func (r *ticketRepoSpy) CountTicketEvents(_ context.Context, _ queries.TicketEventFilter) (int64, error) {
+	panic("unexpected CountTicketEvents call")
+}
+
 func TestGetTicketsUsesAuthContext(t *testing.T) {
 	t.Run("customer role is forced to own author id", func(t *testing.T) {
 		repo := &ticketRepoSpy{}
-		handlers := apptickets.SetupHandlers(repo)
+		handlers := apptickets.SetupHandlers(repo, nil, nil, nil, nil, nil, nil, nil)
 
 		customerID := uuid.New()
 		otherAuthorID := uuid.New()
@@ -85,7 +112,7 @@ func TestGetTicketsUsesAuthContext(t *testing.T) {
 
 	t.Run("agent role keeps explicit author filter", func(t *testing.T) {
 		repo := &ticketRepoSpy{}
-		handlers := apptickets.SetupHandlers(repo)
+		handlers := apptickets.SetupHandlers(repo, nil, nil, nil, nil, nil, nil, nil)
 
 		authorID := uuid.New()
 		params := openapi.GetTicketsParams{
@@ -107,7 +134,7 @@ func TestGetTicketsUsesAuthContext(t *testing.T) {
 
 	t.Run("missing auth claims returns unauthorized", func(t *testing.T) {
 		repo := &ticketRepoSpy{}
-		handlers := apptickets.SetupHandlers(repo)
+		handlers := apptickets.SetupHandlers(repo, nil, nil, nil, nil, nil, nil, nil)
 
 		c, rec := newTicketContextWithClaims(nil)
 
@@ -119,7 +146,7 @@ func TestGetTicketsUsesAuthContext(t *testing.T) {
 
 	t.Run("customer with invalid user id claim returns unauthorized", func(t *testing.T) {
 		repo := &ticketRepoSpy{}
-		handlers := apptickets.SetupHandlers(repo)
+		handlers := apptickets.SetupHandlers(repo, nil, nil, nil, nil, nil, nil, nil)
 
 		c, rec := newTicketContextWithClaims(&authdomain.Claims{
 			UserID: "not-a-uuid",
@@ -134,7 +161,7 @@ func TestGetTicketsUsesAuthContext(t *testing.T) {
 
 	t.Run("repository error returns internal server error", func(t *testing.T) {
 		repo := &ticketRepoSpy{listErr: errors.New("db unavailable")}
-		handlers := apptickets.SetupHandlers(repo)
+		handlers := apptickets.SetupHandlers(repo, nil, nil, nil, nil, nil, nil, nil)
 
 		c, rec := newTicketContextWithClaims(&authdomain.Claims{
 			UserID: uuid.NewString(),
@@ -0,0 +1,226 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetTicketsIDCannedResponsesResponseID returns the canned response rendered for the ticket
+func (h TicketHandlers) GetTicketsIDCannedResponsesResponseID(
+	c echo.Context,
+	id openapi_types.UUID,
+	responseID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleMacroError(c, err)
+	}
+	response, err := h.macroRepo.GetCannedResponse(ctx, responseID)
+	if err == nil && !isApplicable(response.Scope(), ticket, authUserID) {
+		err = macros.ErrCannedResponseNotFound
+	}
+	var data macros.TemplateData
+	if err == nil {
+		data, err = h.templateData(ctx, ticket, authUserID)
+	}
+	if err != nil {
+		return h.handleMacroError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertRenderedCannedResponse(response, response.Render(data)))
+}
+
+// PostTicketsIDMacrosMacroIDApply runs every action of the macro in a single ticket update,
+// so either all of them take effect or none does
+func (h TicketHandlers) PostTicketsIDMacrosMacroIDApply(
+	c echo.Context,
+	id openapi_types.UUID,
+	macroID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	macro, err := h.macroRepo.GetMacro(ctx, macroID)
+	if err != nil {
+		return h.handleMacroError(c, err)
+	}
+
+	var comments []tickets.Comment
+	ticket, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		if !isApplicable(macro.Scope(), ticket, authUserID) {
+			return false, macros.ErrMacroNotFound
+		}
+		ticket.ActAs(authUserID)
+		data, dataErr := h.templateData(ctx, ticket, authUserID)
+		if dataErr != nil {
+			return false, dataErr
+		}
+		comments = nil
+		for _, action := range macro.Actions() {
+			comment, actionErr := h.applyMacroAction(ctx, ticket, action, data, authUserID, role)
+			if actionErr != nil {
+				return false, actionErr
+			}
+			if action.Type == macros.ActionComment {
+				comments = append(comments, comment)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleMacroError(c, err)
+	}
+	for _, comment := range comments {
+		h.notifyMentioned(ctx, authUserID, comment, comment.Mentions)
+	}
+
+	return c.JSON(http.StatusOK, convertTicketToResponse(ticket, hasElevatedTicketAccess(role)))
+}
+
+// applyMacroAction runs a single action of the macro and returns the comment a comment action adds
+func (h TicketHandlers) applyMacroAction(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	action macros.Action,
+	data macros.TemplateData,
+	agentID uuid.UUID,
+	role userdomain.Role,
+) (tickets.Comment, error) {
+	switch action.Type {
+	case macros.ActionComment:
+		// Earlier actions of the macro may have changed the ticket, so comments see its current state
+		data.TicketStatus = ticket.Status().String()
+		data.TicketPriority = ticket.Priority().String()
+		content := macros.RenderTemplate(action.Value, data)
+		return h.addMentioningComment(ctx, ticket, agentID, content, action.Internal, role)
+	case macros.ActionStatus:
+		return tickets.Comment{}, h.changeStatus(ctx, ticket, tickets.Status(action.Value), role)
+	case macros.ActionPriority:
+		return tickets.Comment{}, h.changePriority(ctx, ticket, tickets.Priority(action.Value))
+	case macros.ActionAssign:
+		if assigneeID := action.AssigneeID(); assigneeID != nil {
+			return tickets.Comment{}, ticket.AssignTo(*assigneeID)
+		}
+		ticket.Unassign()
+		return tickets.Comment{}, nil
+	}
+	return tickets.Comment{}, nil
+}
+
+// templateData collects the values of template variables for the ticket.
+// Deleted authors and organizations leave their variables empty.
+func (h TicketHandlers) templateData(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	agentID uuid.UUID,
+) (macros.TemplateData, error) {
+	data := macros.TemplateData{
+		TicketID:       ticket.ID(),
+		TicketTitle:    ticket.Title(),
+		TicketStatus:   ticket.Status().String(),
+		TicketPriority: ticket.Priority().String(),
+	}
+
+	author, err := h.templateUser(ctx, ticket.AuthorID())
+	if err != nil {
+		return macros.TemplateData{}, err
+	}
+	if author != nil {
+		data.AuthorName = author.Name()
+		data.AuthorEmail = author.Email()
+	}
+	agent, err := h.templateUser(ctx, agentID)
+	if err != nil {
+		return macros.TemplateData{}, err
+	}
+	if agent != nil {
+		data.AgentName = agent.Name()
+	}
+
+	org, err := h.organization(ctx, ticket.OrganizationID())
+	if err != nil && !errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return macros.TemplateData{}, err
+	}
+	if org != nil {
+		data.OrganizationName = org.Name()
+	}
+	return data, nil
+}
+
+func (h TicketHandlers) templateUser(ctx context.Context, userID uuid.UUID) (*userdomain.User, error) {
+	var user *userdomain.User
+	if h.userRepo == nil {
+		return user, nil
+	}
+	found, err := h.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, userdomain.ErrUserNotFound) {
+		return user, nil
+	}
+	return found, err
+}
+
+// isApplicable reports whether the user may apply the canned response or macro to the ticket.
+// Items that cannot be applied are reported as not found, without revealing personal items of other agents.
+func isApplicable(scope macros.Scope, ticket *tickets.Ticket, userID uuid.UUID) bool {
+	return scope.IsVisibleTo(userID) && scope.AppliesTo(ticket.OrganizationID())
+}
+
+func convertRenderedCannedResponse(response *macros.CannedResponse, content string) openapi.CannedResponse {
+	id := response.ID()
+	name := response.Name()
+	scope := response.Scope()
+	ownerID := scope.OwnerID
+	createdAt := response.CreatedAt()
+	updatedAt := response.UpdatedAt()
+
+	return openapi.CannedResponse{
+		Id:             &id,
+		Name:           &name,
+		Content:        &content,
+		OrganizationId: scope.OrganizationID,
+		OwnerId:        &ownerID,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+}
+
+func (h TicketHandlers) handleMacroError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, tickets.ErrTicketNotFound),
+		errors.Is(err, macros.ErrMacroNotFound),
+		errors.Is(err, macros.ErrCannedResponseNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrTransitionNotAllowed):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrOpenBlockers), errors.Is(err, tickets.ErrConcurrentUpdate):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrInvalidTransition),
+		errors.Is(err, tickets.ErrInvalidStatus),
+		errors.Is(err, tickets.ErrInvalidPriority),
+		errors.Is(err, tickets.ErrTicketValidation):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
@@ -0,0 +1,147 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createTestMacro(req openapi.CreateMacroRequest) uuid.UUID {
+	rec := s.RequestAs(http.MethodPost, "/macros", req, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var macro openapi.Macro
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &macro))
+	return *macro.Id
+}
+
+func (s *TicketsSuite) TestRenderCannedResponse() {
+	orgID := s.createAssignmentTestOrganization("Canned Org")
+	authorID, token := s.createOrganizationCustomer("canned-author@example.com", orgID)
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "Printer is jammed",
+		Description:    "The printer on the second floor is jammed",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var ticket openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+
+	rec = s.RequestAs(http.MethodPost, "/canned-responses", openapi.CreateCannedResponseRequest{
+		Name:           "Acknowledge",
+		Content:        "Hello {{ author.name }} from {{organization.name}}, {{ ticket.title }} is {{ ticket.status }}",
+		OrganizationId: &orgID,
+	}, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var response openapi.CannedResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	renderPath := fmt.Sprintf("/tickets/%s/canned-responses/%s", *ticket.Id, *response.Id)
+
+	s.Run("Variables are filled from the ticket", func() {
+		rec = s.RequestAs(http.MethodGet, renderPath, nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var rendered openapi.CannedResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &rendered))
+		s.Equal("Hello Test User from Canned Org, Printer is jammed is new", *rendered.Content)
+	})
+
+	s.Run("Responses shared with another organization do not apply", func() {
+		otherTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: s.createAssignmentTestOrganization("Other Org"),
+			Title:          "Other",
+		}, "").Id
+		rec = s.RequestAs(http.MethodGet,
+			fmt.Sprintf("/tickets/%s/canned-responses/%s", otherTicket, *response.Id), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Customers cannot render responses", func() {
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, renderPath, nil, token).Code)
+	})
+}
+
+func (s *TicketsSuite) TestApplyMacro() {
+	orgID := s.createAssignmentTestOrganization("Macro Org")
+	agentID := s.createAssignmentTestUser(orgID, "agent", true)
+	assignee := agentID.String()
+	value := func(v string) *string { return &v }
+	internal := true
+
+	macroID := s.createTestMacro(openapi.CreateMacroRequest{
+		Name: "Resolve",
+		Actions: []openapi.MacroAction{
+			{Type: openapi.Assign, Value: &assignee},
+			{Type: openapi.Status, Value: value("in_progress")},
+			{Type: openapi.Priority, Value: value("high")},
+			{
+				Type:  openapi.Comment,
+				Value: value("{{ ticket.title }} is {{ ticket.status }} with {{ ticket.priority }} priority"),
+			},
+			{Type: openapi.Status, Value: value("resolved")},
+			{Type: openapi.Comment, Value: value("Resolved by macro"), Internal: &internal},
+		},
+		OrganizationId: &orgID,
+	})
+
+	s.Run("All actions are applied in one update", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Mailbox is full"}, "").Id
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/macros/%s/apply", ticketID, macroID), nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var ticket openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+		s.Equal(openapi.TicketStatus("resolved"), *ticket.Status)
+		s.Equal(openapi.TicketPriority("high"), *ticket.Priority)
+		s.Equal(agentID, *ticket.AssigneeId)
+
+		code, comments := s.getTicketComments(ticketID)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(comments, 1)
+		s.Equal("Mailbox is full is in_progress with high priority", *comments[0].Content)
+
+		rec = s.RequestAs(http.MethodGet, fmt.Sprintf("/tickets/%s/comments?include_internal=true", ticketID), nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comments))
+		s.Require().Len(comments, 2)
+		s.True(*comments[1].IsInternal)
+
+		code, history := s.getTicketHistory(ticketID, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Subset(eventTypes(history.Events), []openapi.TicketEventType{
+			openapi.Assigned, openapi.PriorityOverridden, openapi.CommentAdded, openapi.StatusChanged,
+		})
+	})
+
+	s.Run("A failing action discards the whole macro", func() {
+		ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Laptop battery drains"}, "").Id
+		blocker := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Battery recall"}, "").Id
+		s.Require().Equal(http.StatusCreated, s.linkTickets(blocker, openapi.Blocks, ticketID).Code)
+
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/macros/%s/apply", ticketID, macroID), nil, "")
+		s.Equal(http.StatusConflict, rec.Code, rec.Body.String())
+
+		code, history := s.getTicketHistory(ticketID, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.NotContains(eventTypes(history.Events), openapi.CommentAdded)
+		s.NotContains(eventTypes(history.Events), openapi.Assigned)
+	})
+
+	s.Run("Macros shared with another organization do not apply", func() {
+		otherTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: s.createAssignmentTestOrganization("Other Org"),
+			Title:          "Other",
+		}, "").Id
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/macros/%s/apply", otherTicket, macroID), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+
+		rec = s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/macros/%s/apply", otherTicket, uuid.New()), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
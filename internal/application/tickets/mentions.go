@@ -0,0 +1,127 @@
+package tickets
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"simpleservicedesk/internal/domain/notifications"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// mentionLookupLimit is enough to tell a unique username from an ambiguous one
+const mentionLookupLimit = 2
+
+// mentionDirectory resolves the handles mentioned in the content to active users the author may mention.
+// Customers only mention people they already see on the ticket; handles that do not resolve stay plain text,
+// so a mention never reveals whether someone has an account.
+func (h TicketHandlers) mentionDirectory(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	content string,
+	role userdomain.Role,
+) (tickets.MentionDirectory, error) {
+	directory := tickets.MentionDirectory{}
+	handles := tickets.ParseMentions(content)
+	if len(handles) == 0 || h.userRepo == nil {
+		return directory, nil
+	}
+
+	var visible []uuid.UUID
+	if !hasElevatedTicketAccess(role) {
+		visible = ticketParticipants(ticket)
+	}
+	for _, handle := range handles {
+		user, found, err := h.findMentionedUser(ctx, handle)
+		if err != nil {
+			return nil, err
+		}
+		if !found || !user.IsActive() || (visible != nil && !slices.Contains(visible, user.ID())) {
+			continue
+		}
+		directory[handle] = tickets.MentionedUser{ID: user.ID(), IsAgent: hasElevatedTicketAccess(user.Role())}
+	}
+	return directory, nil
+}
+
+// findMentionedUser looks a handle up by email or, for a bare username, by the part of the email before "@".
+// A username shared by several users is ambiguous and matches nobody.
+func (h TicketHandlers) findMentionedUser(ctx context.Context, handle string) (*userdomain.User, bool, error) {
+	pattern := "^" + regexp.QuoteMeta(handle) + "@"
+	if strings.Contains(handle, "@") {
+		pattern = "^" + regexp.QuoteMeta(handle) + "$"
+	}
+
+	found, err := h.userRepo.ListUsers(ctx, queries.UserFilter{
+		BaseFilter: queries.BaseFilter{Limit: mentionLookupLimit},
+		Email:      &pattern,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(found) != 1 {
+		return nil, false, nil
+	}
+	return found[0], true, nil
+}
+
+// ticketParticipants lists the users a customer sees on the ticket: the author, the assignee, the watchers
+// and the authors of public comments
+func ticketParticipants(ticket *tickets.Ticket) []uuid.UUID {
+	participants := []uuid.UUID{ticket.AuthorID()}
+	if ticket.AssigneeID() != nil {
+		participants = append(participants, *ticket.AssigneeID())
+	}
+	for _, watcher := range ticket.Watchers() {
+		participants = append(participants, watcher.UserID)
+	}
+	for _, comment := range ticket.GetPublicComments() {
+		participants = append(participants, comment.AuthorID)
+	}
+	return participants
+}
+
+// addMentioningComment adds a comment whose mentions are resolved with the permissions of the author's role
+func (h TicketHandlers) addMentioningComment(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	authorID uuid.UUID,
+	content string,
+	isInternal bool,
+	role userdomain.Role,
+) (tickets.Comment, error) {
+	directory, err := h.mentionDirectory(ctx, ticket, content, role)
+	if err != nil {
+		return tickets.Comment{}, err
+	}
+	return ticket.AddMentioningComment(authorID, content, isInternal, directory)
+}
+
+// notifyMentioned creates an in-app notification about the comment for every mentioned user.
+// The comment is already saved, so a notification that cannot be stored is logged instead of failing the request.
+func (h TicketHandlers) notifyMentioned(
+	ctx context.Context,
+	actorID uuid.UUID,
+	comment tickets.Comment,
+	mentioned []uuid.UUID,
+) {
+	if h.notificationRepo == nil {
+		return
+	}
+
+	for _, userID := range mentioned {
+		_, err := h.notificationRepo.CreateNotification(ctx, func() (*notifications.Notification, error) {
+			return notifications.NewMentionNotification(userID, actorID, comment.TicketID, comment.ID)
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "failed to notify mentioned user",
+				"user_id", userID, "ticket_id", comment.TicketID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) postMentioningComment(
+	ticketID uuid.UUID, content string, isInternal bool, token string,
+) (int, openapi.TicketComment) {
+	rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/comments", ticketID),
+		openapi.CreateCommentRequest{AuthorId: uuid.New(), Content: content, IsInternal: &isInternal}, token)
+
+	var comment openapi.TicketComment
+	if rec.Code == http.StatusCreated {
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comment))
+	}
+	return rec.Code, comment
+}
+
+func (s *TicketsSuite) listNotifications(token string) openapi.ListNotificationsResponse {
+	rec := s.RequestAs(http.MethodGet, "/notifications", nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp openapi.ListNotificationsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func commentMentions(comment openapi.TicketComment) []uuid.UUID {
+	if comment.Mentions == nil {
+		return nil
+	}
+	return *comment.Mentions
+}
+
+func (s *TicketsSuite) TestCommentMentions() {
+	orgID := s.createAssignmentTestOrganization("Mentions Org")
+	aliceID, aliceToken := s.LoginAs("mention-alice@example.com", openapi.Agent)
+	bobID, bobToken := s.LoginAs("mention-bob@example.com", openapi.Agent)
+	customerID, customerToken := s.createOrganizationCustomer("mention-customer@example.com", orgID)
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken).Id
+
+	code, comment := s.postMentioningComment(ticketID,
+		"@mention-bob could you check? cc @Mention-Customer@example.com, @mention-alice", false, aliceToken)
+	s.Require().Equal(http.StatusCreated, code)
+	s.Equal([]uuid.UUID{bobID, customerID}, commentMentions(comment))
+
+	s.Run("Mentioned users are notified", func() {
+		notifications := s.listNotifications(bobToken)
+		s.Equal(int64(1), notifications.UnreadCount)
+		s.Require().Len(notifications.Items, 1)
+		notification := notifications.Items[0]
+		s.Equal(openapi.NotificationMention, notification.Type)
+		s.Equal(aliceID, notification.ActorId)
+		s.Equal(ticketID, notification.TicketId)
+		s.Equal(comment.Id, notification.CommentId)
+		s.Nil(notification.ReadAt)
+
+		s.Len(s.listNotifications(customerToken).Items, 1)
+		s.Empty(s.listNotifications(aliceToken).Items)
+	})
+
+	s.Run("Mentions are listed with the comment", func() {
+		code, comments := s.getTicketComments(ticketID)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().NotEmpty(comments)
+		s.Equal([]uuid.UUID{bobID, customerID}, commentMentions(comments[0]))
+	})
+
+	s.Run("Internal comments may only mention agents", func() {
+		code, _ = s.postMentioningComment(ticketID, "@mention-customer@example.com is upset", true, aliceToken)
+		s.Equal(http.StatusBadRequest, code)
+
+		code, comment = s.postMentioningComment(ticketID, "@mention-bob internal note", true, aliceToken)
+		s.Require().Equal(http.StatusCreated, code)
+		s.Equal([]uuid.UUID{bobID}, commentMentions(comment))
+		s.Equal(int64(2), s.listNotifications(bobToken).UnreadCount)
+	})
+
+	s.Run("Unknown handles stay plain text", func() {
+		code, comment = s.postMentioningComment(ticketID, "@nobody@example.com and @nobody", false, aliceToken)
+		s.Require().Equal(http.StatusCreated, code)
+		s.Nil(comment.Mentions)
+	})
+}
+
+func (s *TicketsSuite) TestCommentMentionsVisibility() {
+	orgID := s.createAssignmentTestOrganization("Mention Visibility Org")
+	_, customerToken := s.createOrganizationCustomer("visibility-customer@example.com", orgID)
+	agentID, agentToken := s.LoginAs("visibility-agent@example.com", openapi.Agent)
+	_, strangerToken := s.LoginAs("visibility-stranger@example.com", openapi.Customer)
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken).Id
+
+	s.Run("Customers cannot mention people they do not see", func() {
+		code, comment := s.postMentioningComment(ticketID,
+			"@visibility-agent and @visibility-stranger@example.com", false, customerToken)
+		s.Require().Equal(http.StatusCreated, code)
+		s.Nil(comment.Mentions)
+		s.Empty(s.listNotifications(agentToken).Items)
+		s.Empty(s.listNotifications(strangerToken).Items)
+	})
+
+	s.Run("Customers mention agents who replied", func() {
+		code, _ := s.postMentioningComment(ticketID, "Looking into it", false, agentToken)
+		s.Require().Equal(http.StatusCreated, code)
+
+		code, comment := s.postMentioningComment(ticketID, "Thanks @visibility-agent!", false, customerToken)
+		s.Require().Equal(http.StatusCreated, code)
+		s.Equal([]uuid.UUID{agentID}, commentMentions(comment))
+		s.Len(s.listNotifications(agentToken).Items, 1)
+	})
+
+	s.Run("Inactive and ambiguous users are not mentioned", func() {
+		s.LoginAs("twin@one.example.com", openapi.Agent)
+		s.LoginAs("twin@two.example.com", openapi.Agent)
+		inactiveID := s.createAssignmentTestUser(orgID, users.RoleAgent, false)
+		inactive, err := s.UsersRepo.GetUser(context.Background(), inactiveID)
+		s.Require().NoError(err)
+
+		code, comment := s.postMentioningComment(ticketID, "@twin and @"+inactive.Email(), false, "")
+		s.Require().Equal(http.StatusCreated, code)
+		s.Nil(comment.Mentions)
+	})
+}
+
+func (s *TicketsSuite) TestCommentMentionsOnEveryCommentPath() {
+	orgID := s.createAssignmentTestOrganization("Mention Paths Org")
+	aliceID, aliceToken := s.LoginAs("paths-alice@example.com", openapi.Agent)
+	_, bobToken := s.LoginAs("paths-bob@example.com", openapi.Agent)
+	_, customerToken := s.createOrganizationCustomer("paths-customer@example.com", orgID)
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken).Id
+
+	s.Run("Edited comments notify newly mentioned users", func() {
+		code, comment := s.postMentioningComment(ticketID, "Looking into it", false, aliceToken)
+		s.Require().Equal(http.StatusCreated, code)
+
+		rec := s.editComment(ticketID, *comment.Id, "Looking into it with @paths-bob", aliceToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var edited openapi.TicketComment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &edited))
+		s.Len(commentMentions(edited), 1)
+
+		rec = s.editComment(ticketID, *comment.Id, "Still looking into it with @paths-bob", aliceToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		notifications := s.listNotifications(bobToken)
+		s.Require().Len(notifications.Items, 1, "a mention kept in an edit is not notified again")
+		s.Equal(aliceID, notifications.Items[0].ActorId)
+		s.Equal(comment.Id, notifications.Items[0].CommentId)
+	})
+
+	s.Run("Macro comments notify mentioned users", func() {
+		content := "@paths-bob please take over"
+		macroID := s.createTestMacro(openapi.CreateMacroRequest{
+			Name:           "Hand over",
+			Actions:        []openapi.MacroAction{{Type: openapi.Comment, Value: &content}},
+			OrganizationId: &orgID,
+		})
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/macros/%s/apply", ticketID, macroID), nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		s.Len(s.listNotifications(bobToken).Items, 2)
+	})
+
+	s.Run("Bulk comments notify mentioned users", func() {
+		content := "@paths-bob these need a look"
+		response := s.bulkRequest(openapi.BulkTicketRequest{
+			TicketIds: &[]uuid.UUID{ticketID},
+			Operation: openapi.BulkTicketOperation{Type: openapi.BulkTicketOperationTypeComment, Content: &content},
+		}, "")
+		s.Empty(bulkFailures(response))
+
+		s.Len(s.listNotifications(bobToken).Items, 3)
+	})
+}
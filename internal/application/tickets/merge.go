@@ -0,0 +1,214 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h TicketHandlers) PostTicketsIDMerge(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.MergeTicketsRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if len(req.SourceTicketIds) == 0 || len(req.SourceTicketIds) > tickets.MaxMergeSources {
+		return h.handleMergeError(c, fmt.Errorf("%w: between 1 and %d source tickets are required",
+			tickets.ErrInvalidMerge, tickets.MaxMergeSources))
+	}
+
+	target, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	// Every source is validated before anything is moved, so an invalid request changes nothing
+	sources := make([]*tickets.Ticket, 0, len(req.SourceTicketIds))
+	for _, sourceID := range req.SourceTicketIds {
+		if slices.ContainsFunc(sources, func(t *tickets.Ticket) bool { return t.ID() == sourceID }) {
+			return h.handleMergeError(c, fmt.Errorf("%w: duplicate source ticket %s", tickets.ErrInvalidMerge, sourceID))
+		}
+		source, getErr := h.repo.GetTicket(ctx, sourceID)
+		if getErr != nil {
+			return h.handleMergeError(c, getErr)
+		}
+		if mergeErr := target.CanAbsorb(source); mergeErr != nil {
+			return h.handleMergeError(c, mergeErr)
+		}
+		sources = append(sources, source)
+	}
+
+	workflow, err := h.organizationWorkflow(ctx, target.OrganizationID())
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	for _, source := range sources {
+		target, err = h.mergeSource(ctx, id, source.ID(), authUserID, workflow)
+		if err != nil {
+			return h.handleMergeError(c, err)
+		}
+	}
+
+	return c.JSON(http.StatusOK, convertTicketToResponse(target, hasElevatedTicketAccess(role)))
+}
+
+// maxMergeAttempts bounds how often a source is absorbed again after it got new comments or attachments
+const maxMergeAttempts = 3
+
+// mergeSource moves a source ticket into the target and closes the source.
+// The target absorbs the source before the source is closed: a retry after a failure
+// skips already moved comments and attachments instead of losing them. The source is read
+// inside the target update, and closing it fails on anything the target has not absorbed yet,
+// so comments and attachments added during the merge are absorbed on the next attempt.
+func (h TicketHandlers) mergeSource(
+	ctx context.Context,
+	targetID, sourceID, actorID uuid.UUID,
+	workflow *tickets.Workflow,
+) (*tickets.Ticket, error) {
+	var err error
+	for range maxMergeAttempts {
+		var target *tickets.Ticket
+		target, err = h.repo.UpdateTicket(ctx, targetID, func(ticket *tickets.Ticket) (bool, error) {
+			source, getErr := h.repo.GetTicket(ctx, sourceID)
+			if getErr != nil {
+				return false, getErr
+			}
+			ticket.ActAs(actorID)
+			if absorbErr := ticket.AbsorbTicket(source); absorbErr != nil {
+				return false, absorbErr
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = h.repo.UpdateTicket(ctx, sourceID, func(ticket *tickets.Ticket) (bool, error) {
+			ticket.ActAs(actorID)
+			if mergeErr := ticket.MergeInto(target, workflow); mergeErr != nil {
+				return false, mergeErr
+			}
+			return true, nil
+		})
+		if !errors.Is(err, tickets.ErrConcurrentUpdate) {
+			return target, err
+		}
+	}
+	return nil, err
+}
+
+func (h TicketHandlers) PostTicketsIDSplit(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.SplitTicketRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	source, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	comments, err := source.CommentsForSplit(req.CommentIds)
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	priority := source.Priority()
+	if req.Priority != nil {
+		priority = tickets.Priority(*req.Priority)
+	}
+	var description string
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	slaConfig, err := h.organizationSLAConfig(ctx, source.OrganizationID())
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+	key, err := h.issueTicketKey(ctx, source.OrganizationID())
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	created, err := h.repo.CreateTicket(ctx, func() (*tickets.Ticket, error) {
+		ticket, newErr := tickets.NewTicket(
+			uuid.New(),
+			req.Title,
+			description,
+			priority,
+			source.OrganizationID(),
+			source.AuthorID(),
+			source.CategoryID(),
+		)
+		if newErr != nil {
+			return nil, newErr
+		}
+		ticket.ActAs(authUserID)
+		ticket.ApplySLAConfig(slaConfig)
+		if keyErr := assignTicketKey(ticket, key); keyErr != nil {
+			return nil, keyErr
+		}
+		if adoptErr := ticket.AdoptSplitComments(source.ID(), comments); adoptErr != nil {
+			return nil, adoptErr
+		}
+		return ticket, nil
+	})
+	if err != nil {
+		return h.handleMergeError(c, err)
+	}
+
+	_, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		if splitErr := ticket.SplitOff(created.ID(), req.CommentIds); splitErr != nil {
+			return false, splitErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		// Comments stay on the source ticket, so the copy is removed to avoid duplicates
+		if deleteErr := h.removeTicket(ctx, created.ID(), authUserID); deleteErr != nil {
+			slog.WarnContext(ctx, "failed to remove ticket of a failed split", "ticket_id", created.ID(), "error", deleteErr)
+		}
+		return h.handleMergeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertTicketToResponse(created, hasElevatedTicketAccess(role)))
+}
+
+func (h TicketHandlers) handleMergeError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidMerge) || errors.Is(err, tickets.ErrInvalidSplit) ||
+		errors.Is(err, tickets.ErrTicketValidation) || errors.Is(err, tickets.ErrInvalidPriority) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
@@ -0,0 +1,238 @@
+package tickets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *TicketsSuite) postTicketAction(ticketID uuid.UUID, action string, payload any) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/%s", ticketID, action), bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) getTicketResponse(ticketID uuid.UUID) openapi.GetTicketResponse {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s", ticketID), nil)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func (s *TicketsSuite) TestMergeTickets() {
+	orgID := uuid.New()
+	targetID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer is broken"}, "").Id
+	firstDuplicate := *s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Printer does not print",
+	}, "").Id
+	secondDuplicate := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer again"}, "").Id
+
+	s.postComment(targetID, "Original report", "")
+	duplicateComment := s.postComment(firstDuplicate, "Duplicate report", "")
+	s.postComment(secondDuplicate, "One more report", "")
+	s.uploadAttachment(firstDuplicate, "log.txt", []byte("paper jam"), "")
+
+	s.Run("Invalid merge requests change nothing", func() {
+		otherOrgTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: uuid.New(),
+			Title:          "Other organization",
+		}, "").Id
+
+		rec := s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{
+			SourceTicketIds: []uuid.UUID{firstDuplicate, otherOrgTicket},
+		})
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		rec = s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{
+			SourceTicketIds: []uuid.UUID{targetID},
+		})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		rec = s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{
+			SourceTicketIds: []uuid.UUID{firstDuplicate, firstDuplicate},
+		})
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		rec = s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{
+			SourceTicketIds: []uuid.UUID{uuid.New()},
+		})
+		s.Equal(http.StatusNotFound, rec.Code)
+
+		s.Nil(s.getTicketResponse(firstDuplicate).MergedIntoId)
+	})
+
+	s.Run("Customers cannot merge tickets", func() {
+		_, token := s.LoginAs("merge-customer@example.com", openapi.Customer)
+		body, _ := json.Marshal(openapi.MergeTicketsRequest{SourceTicketIds: []uuid.UUID{firstDuplicate}})
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/merge", targetID), bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Comments and attachments move to the target", func() {
+		rec := s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{
+			SourceTicketIds: []uuid.UUID{firstDuplicate, secondDuplicate},
+		})
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		code, comments := s.getTicketComments(targetID)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(comments, 3)
+		s.Equal(*duplicateComment.Id, *comments[1].Id)
+		s.Equal(*duplicateComment.AuthorId, *comments[1].AuthorId)
+		s.True(duplicateComment.CreatedAt.Equal(*comments[1].CreatedAt))
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/attachments", targetID), nil)
+		attachments := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(attachments, req)
+		s.Require().Equal(http.StatusOK, attachments.Code)
+		var attachmentList []openapi.TicketAttachment
+		s.Require().NoError(json.Unmarshal(attachments.Body.Bytes(), &attachmentList))
+		s.Require().Len(attachmentList, 1)
+
+		download := s.attachmentRequest(http.MethodGet, targetID, *attachmentList[0].Id, "")
+		s.Require().Equal(http.StatusOK, download.Code)
+		s.Equal("paper jam", download.Body.String())
+
+		for _, sourceID := range []uuid.UUID{firstDuplicate, secondDuplicate} {
+			source := s.getTicketResponse(sourceID)
+			s.Require().NotNil(source.MergedIntoId)
+			s.Equal(targetID, *source.MergedIntoId)
+			s.Equal(openapi.TicketStatus("closed"), *source.Status)
+		}
+
+		code, history := s.getTicketHistory(firstDuplicate, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Contains(eventTypes(history.Events), openapi.MergedInto)
+		code, history = s.getTicketHistory(targetID, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Contains(eventTypes(history.Events), openapi.Merged)
+	})
+
+	s.Run("Merged tickets cannot be merged again", func() {
+		rec := s.postTicketAction(*s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: orgID,
+			Title:          "New target",
+		}, "").Id, "merge",
+			openapi.MergeTicketsRequest{SourceTicketIds: []uuid.UUID{firstDuplicate}})
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestMergeKeepsCommentsAddedDuringMerge() {
+	orgID := uuid.New()
+	targetID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer is broken"}, "").Id
+	sourceID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer does not print"}, "").Id
+	s.postComment(sourceID, "Duplicate report", "")
+
+	// The comment lands after the source was validated and absorbed, right before it is closed
+	var late openapi.TicketComment
+	s.BeforeTicketUpdate(func(ticketID uuid.UUID) {
+		if ticketID == sourceID && late.Id == nil {
+			late = s.postComment(sourceID, "Written while merging", "")
+		}
+	})
+	rec := s.postTicketAction(targetID, "merge", openapi.MergeTicketsRequest{SourceTicketIds: []uuid.UUID{sourceID}})
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().NotNil(late.Id)
+
+	code, comments := s.getTicketComments(targetID)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Len(comments, 2)
+	s.Equal(*late.Id, *comments[1].Id)
+	s.Require().NotNil(s.getTicketResponse(sourceID).MergedIntoId)
+}
+
+func (s *TicketsSuite) TestSplitTicket() {
+	orgID := uuid.New()
+	sourceID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer and monitor"}, "").Id
+	s.postComment(sourceID, "Printer is broken", "")
+	monitorComment := s.postComment(sourceID, "Monitor flickers too", "")
+
+	s.Run("Invalid split requests", func() {
+		rec := s.postTicketAction(sourceID, "split", openapi.SplitTicketRequest{
+			Title:      "Monitor flickers",
+			CommentIds: []uuid.UUID{uuid.New()},
+		})
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		rec = s.postTicketAction(uuid.New(), "split", openapi.SplitTicketRequest{
+			Title:      "Monitor flickers",
+			CommentIds: []uuid.UUID{*monitorComment.Id},
+		})
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Selected comments move to a new ticket", func() {
+		high := openapi.TicketPriority("high")
+		rec := s.postTicketAction(sourceID, "split", openapi.SplitTicketRequest{
+			Title:      "Monitor flickers",
+			Priority:   &high,
+			CommentIds: []uuid.UUID{*monitorComment.Id},
+		})
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var created openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+		s.Equal("Monitor flickers", *created.Title)
+		s.Equal(high, *created.Priority)
+		s.Equal(orgID, *created.OrganizationId)
+		s.Equal(*s.getTicketResponse(sourceID).AuthorId, *created.AuthorId)
+		s.Require().NotNil(created.SplitFromId)
+		s.Equal(sourceID, *created.SplitFromId)
+
+		code, comments := s.getTicketComments(*created.Id)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(comments, 1)
+		s.Equal(*monitorComment.Id, *comments[0].Id)
+		s.Equal(*monitorComment.AuthorId, *comments[0].AuthorId)
+
+		code, comments = s.getTicketComments(sourceID)
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(comments, 1)
+		s.Equal("Printer is broken", *comments[0].Content)
+	})
+}
+
+func (s *TicketsSuite) getTicketComments(ticketID uuid.UUID) (int, []openapi.TicketComment) {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/comments", ticketID), nil)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+
+	var comments []openapi.TicketComment
+	if rec.Code == http.StatusOK {
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comments))
+	}
+	return rec.Code, comments
+}
+
+func eventTypes(events *[]openapi.TicketEvent) []openapi.TicketEventType {
+	var types []openapi.TicketEventType
+	if events == nil {
+		return types
+	}
+	for _, event := range *events {
+		if event.Type != nil {
+			types = append(types, *event.Type)
+		}
+	}
+	return types
+}
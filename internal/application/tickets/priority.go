@@ -0,0 +1,158 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// organizationPriorityMatrix returns the matrix that derives ticket priority from impact and urgency
+func (h TicketHandlers) organizationPriorityMatrix(
+	ctx context.Context,
+	orgID uuid.UUID,
+) (*tickets.PriorityMatrix, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return tickets.DefaultPriorityMatrix(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return org.PriorityMatrix(), nil
+}
+
+// requestedClassification merges the impact and urgency of a request into the current ones;
+// whatever neither sets is medium
+func requestedClassification(
+	impact tickets.Impact,
+	urgency tickets.Urgency,
+	reqImpact *openapi.TicketImpact,
+	reqUrgency *openapi.TicketUrgency,
+) (tickets.Impact, tickets.Urgency) {
+	if reqImpact != nil {
+		impact = tickets.Impact(*reqImpact)
+	}
+	if reqUrgency != nil {
+		urgency = tickets.Urgency(*reqUrgency)
+	}
+	if impact == "" {
+		impact = tickets.ImpactMedium
+	}
+	if urgency == "" {
+		urgency = tickets.UrgencyMedium
+	}
+	return impact, urgency
+}
+
+// applyPriorityUpdates changes impact and urgency first, so an override in the same request takes precedence
+func applyPriorityUpdates(
+	ticket *tickets.Ticket,
+	req openapi.UpdateTicketRequest,
+	matrix *tickets.PriorityMatrix,
+) (bool, error) {
+	resetPriority := req.ResetPriority != nil && *req.ResetPriority
+	if resetPriority && req.Priority != nil {
+		return false, fmt.Errorf("%w: priority and reset_priority cannot be combined", tickets.ErrTicketValidation)
+	}
+
+	updated := false
+	if req.Impact != nil || req.Urgency != nil {
+		impact, urgency := requestedClassification(ticket.Impact(), ticket.Urgency(), req.Impact, req.Urgency)
+		if err := ticket.Classify(matrix, impact, urgency); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	if req.Priority != nil {
+		if err := ticket.OverridePriority(tickets.Priority(*req.Priority)); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	if resetPriority {
+		if err := ticket.ResetPriorityOverride(matrix); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// updatePriorityMatrix returns the matrix an update derives the priority from.
+// Updates that derive no priority never read the matrix, so they skip loading the organization.
+func (h TicketHandlers) updatePriorityMatrix(
+	ctx context.Context,
+	orgID uuid.UUID,
+	req openapi.UpdateTicketRequest,
+) (*tickets.PriorityMatrix, error) {
+	if !changesClassification(req) {
+		return tickets.DefaultPriorityMatrix(), nil
+	}
+	return h.organizationPriorityMatrix(ctx, orgID)
+}
+
+// changesPriority reports whether an update sets the priority directly, which only agents may do
+func changesPriority(req openapi.UpdateTicketRequest) bool {
+	return req.Priority != nil || req.ResetPriority != nil
+}
+
+// changesClassification reports whether an update may derive a new priority from the matrix
+func changesClassification(req openapi.UpdateTicketRequest) bool {
+	return req.Impact != nil || req.Urgency != nil || req.ResetPriority != nil
+}
+
+// ticketClassification is what the priority of a new ticket is derived from
+type ticketClassification struct {
+	matrix    *tickets.PriorityMatrix
+	impact    tickets.Impact
+	urgency   tickets.Urgency
+	requested tickets.Priority // Priority an agent asked for, it overrides the derived one
+}
+
+// newTicketClassification reads impact and urgency of a new ticket; customers only state those,
+// the priority they ask for is ignored
+func (h TicketHandlers) newTicketClassification(
+	ctx context.Context,
+	req openapi.CreateTicketRequest,
+	role userdomain.Role,
+) (ticketClassification, error) {
+	matrix, err := h.organizationPriorityMatrix(ctx, req.OrganizationId)
+	if err != nil {
+		return ticketClassification{}, err
+	}
+
+	impact, urgency := requestedClassification("", "", req.Impact, req.Urgency)
+	classification := ticketClassification{matrix: matrix, impact: impact, urgency: urgency}
+	if role != userdomain.RoleCustomer {
+		classification.requested = tickets.Priority(req.Priority)
+	}
+	return classification, nil
+}
+
+// priority returns the priority derived from the matrix
+func (c ticketClassification) priority() tickets.Priority {
+	return c.matrix.Priority(c.impact, c.urgency)
+}
+
+// apply records the impact and urgency of a new ticket; a requested priority
+// that differs from the derived one becomes an override
+func (c ticketClassification) apply(ticket *tickets.Ticket) error {
+	if err := ticket.Classify(c.matrix, c.impact, c.urgency); err != nil {
+		return err
+	}
+	if c.requested == "" {
+		return nil
+	}
+	return ticket.OverridePriority(c.requested)
+}
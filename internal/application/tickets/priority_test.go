@@ -0,0 +1,143 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createPriorityTestTicket(
+	req openapi.CreateTicketRequest,
+	token string,
+) openapi.GetTicketResponse {
+	req.Title = "Printer is on fire"
+	req.Description = "The office printer started smoking"
+	req.AuthorId = uuid.New()
+	rec := s.RequestAs(http.MethodPost, "/tickets", req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var resp openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func (s *TicketsSuite) updatePriorityTestTicket(
+	ticketID uuid.UUID,
+	req openapi.UpdateTicketRequest,
+	token string,
+) (int, openapi.GetTicketResponse) {
+	rec := s.RequestAs(http.MethodPut, fmt.Sprintf("/tickets/%s", ticketID), req, token)
+
+	var resp openapi.GetTicketResponse
+	if rec.Code == http.StatusOK {
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec.Code, resp
+}
+
+func (s *TicketsSuite) TestPriorityMatrix() {
+	orgID := s.createAssignmentTestOrganization("Priority Matrix Org")
+	_, customerToken := s.createOrganizationCustomer("matrix-customer@example.com", orgID)
+	impact := func(v openapi.TicketImpact) *openapi.TicketImpact { return &v }
+	urgency := func(v openapi.TicketUrgency) *openapi.TicketUrgency { return &v }
+
+	s.Run("Customers get the priority derived from impact and urgency", func() {
+		ticket := s.createPriorityTestTicket(openapi.CreateTicketRequest{
+			Priority:       openapi.TicketPriority("critical"),
+			Impact:         impact(openapi.TicketImpactLow),
+			Urgency:        urgency(openapi.TicketUrgencyHigh),
+			OrganizationId: orgID,
+		}, customerToken)
+		s.Equal(openapi.TicketPriority("normal"), *ticket.Priority)
+		s.Equal(openapi.TicketImpactLow, *ticket.Impact)
+		s.Equal(openapi.TicketUrgencyHigh, *ticket.Urgency)
+		s.False(*ticket.PriorityOverridden)
+
+		ticket = s.createPriorityTestTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken)
+		s.Equal(openapi.TicketPriority("normal"), *ticket.Priority)
+		s.Equal(openapi.TicketImpactMedium, *ticket.Impact)
+	})
+
+	s.Run("Agents override the derived priority with an audit trail", func() {
+		ticket := s.createPriorityTestTicket(openapi.CreateTicketRequest{
+			Priority:       openapi.TicketPriority("critical"),
+			Impact:         impact(openapi.TicketImpactLow),
+			OrganizationId: orgID,
+		}, "")
+		s.Equal(openapi.TicketPriority("critical"), *ticket.Priority)
+		s.True(*ticket.PriorityOverridden)
+
+		code, history := s.getTicketHistory(*ticket.Id, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Require().Len(*history.Events, 1)
+		event := (*history.Events)[0]
+		s.Equal(openapi.PriorityOverridden, *event.Type)
+		s.Equal("low", *event.OldValue)
+		s.Equal("critical", *event.NewValue)
+
+		code, ticket = s.updatePriorityTestTicket(*ticket.Id, openapi.UpdateTicketRequest{
+			Urgency: urgency(openapi.TicketUrgencyHigh),
+		}, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Equal(openapi.TicketPriority("critical"), *ticket.Priority)
+
+		reset := true
+		code, ticket = s.updatePriorityTestTicket(*ticket.Id, openapi.UpdateTicketRequest{ResetPriority: &reset}, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Equal(openapi.TicketPriority("normal"), *ticket.Priority)
+		s.False(*ticket.PriorityOverridden)
+	})
+
+	s.Run("Customers change impact and urgency but not the priority", func() {
+		ticket := s.createPriorityTestTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken)
+
+		code, updated := s.updatePriorityTestTicket(*ticket.Id, openapi.UpdateTicketRequest{
+			Impact: impact(openapi.TicketImpactHigh),
+		}, customerToken)
+		s.Require().Equal(http.StatusOK, code)
+		s.Equal(openapi.TicketPriority("high"), *updated.Priority)
+
+		critical := openapi.TicketPriority("critical")
+		code, _ = s.updatePriorityTestTicket(*ticket.Id, openapi.UpdateTicketRequest{Priority: &critical}, customerToken)
+		s.Equal(http.StatusForbidden, code)
+	})
+
+	s.Run("Organization matrix derives the priority", func() {
+		levels := []string{"low", "medium", "high"}
+		var cells []openapi.PriorityMatrixCell
+		for _, impactLevel := range levels {
+			for _, urgencyLevel := range levels {
+				cells = append(cells, openapi.PriorityMatrixCell{
+					Impact:   openapi.TicketImpact(impactLevel),
+					Urgency:  openapi.TicketUrgency(urgencyLevel),
+					Priority: openapi.TicketPriority("high"),
+				})
+			}
+		}
+		rec := s.RequestAs(http.MethodPut, fmt.Sprintf("/organizations/%s/priority-matrix", orgID),
+			openapi.UpdateOrganizationPriorityMatrixRequest{Cells: cells}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		ticket := s.createPriorityTestTicket(openapi.CreateTicketRequest{
+			Impact:         impact(openapi.TicketImpactLow),
+			Urgency:        urgency(openapi.TicketUrgencyLow),
+			OrganizationId: orgID,
+		}, customerToken)
+		s.Equal(openapi.TicketPriority("high"), *ticket.Priority)
+	})
+
+	s.Run("Invalid impact returns 400", func() {
+		rec := s.RequestAs(http.MethodPost, "/tickets", map[string]any{
+			"title":           "Printer is on fire",
+			"description":     "The office printer started smoking",
+			"impact":          "huge",
+			"organization_id": orgID,
+			"author_id":       uuid.New(),
+		}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
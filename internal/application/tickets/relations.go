@@ -0,0 +1,294 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// maxRelationTraversal limits the number of tickets visited when following parent or blocker chains
+const maxRelationTraversal = 500
+
+func (h TicketHandlers) GetTicketsIDRelations(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.readableTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleRelationError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertRelationsToResponse(ticket.Relations()))
+}
+
+func (h TicketHandlers) PostTicketsIDRelations(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.CreateTicketRelationRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	relationType := tickets.RelationType(req.Type)
+	inverseType := relationType.Inverse()
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleRelationError(c, err)
+	}
+	related, err := h.repo.GetTicket(ctx, req.TicketId)
+	if err != nil {
+		return h.handleRelationError(c, err)
+	}
+
+	// A half-stored link left by a failed request is completed instead of being reported as a conflict
+	if ticket.HasRelation(relationType, related.ID()) && related.HasRelation(inverseType, ticket.ID()) {
+		return h.handleRelationError(c, tickets.ErrRelationExists)
+	}
+	if err = canRelate(ticket, relationType, related); err != nil {
+		return h.handleRelationError(c, err)
+	}
+	if err = canRelate(related, inverseType, ticket); err != nil {
+		return h.handleRelationError(c, err)
+	}
+	if err = h.checkRelationCycle(ctx, relationType, ticket, related); err != nil {
+		return h.handleRelationError(c, err)
+	}
+
+	ticket, err = h.addRelation(ctx, ticket.ID(), relationType, related.ID(), authUserID)
+	if err != nil {
+		return h.handleRelationError(c, err)
+	}
+	if _, err = h.addRelation(ctx, related.ID(), inverseType, ticket.ID(), authUserID); err != nil {
+		return h.handleRelationError(c, err)
+	}
+
+	for _, relation := range ticket.Relations() {
+		if relation.Type == relationType && relation.TicketID == related.ID() {
+			return c.JSON(http.StatusCreated, convertRelationToResponse(relation))
+		}
+	}
+	return h.handleRelationError(c, tickets.ErrRelationNotFound)
+}
+
+func (h TicketHandlers) DeleteTicketsIDRelationsTypeRelatedID(
+	c echo.Context, id openapi_types.UUID, pType openapi.TicketRelationType, relatedID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+	relationType := tickets.RelationType(pType)
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleRelationError(c, err)
+	}
+	if !ticket.HasRelation(relationType, relatedID) {
+		return h.handleRelationError(c, tickets.ErrRelationNotFound)
+	}
+
+	// The inverse link goes first, so a retry after a failure still finds the link on this ticket
+	if err = h.removeRelation(ctx, relatedID, relationType.Inverse(), id, authUserID); err != nil &&
+		!errors.Is(err, tickets.ErrTicketNotFound) {
+		return h.handleRelationError(c, err)
+	}
+	if err = h.removeRelation(ctx, id, relationType, relatedID, authUserID); err != nil {
+		return h.handleRelationError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// canRelate checks whether the link can be stored on the ticket, ignoring a link it already has
+func canRelate(ticket *tickets.Ticket, relationType tickets.RelationType, related *tickets.Ticket) error {
+	if err := ticket.CanRelate(relationType, related); err != nil && !errors.Is(err, tickets.ErrRelationExists) {
+		return err
+	}
+	return nil
+}
+
+// checkRelationCycle rejects parent and blocker links that would close a loop,
+// such as a ticket becoming a parent of its own ancestor
+func (h TicketHandlers) checkRelationCycle(
+	ctx context.Context, relationType tickets.RelationType, ticket, related *tickets.Ticket,
+) error {
+	from, to := ticket, related
+	switch relationType {
+	case tickets.RelationParent, tickets.RelationBlocks:
+	case tickets.RelationChild, tickets.RelationBlockedBy:
+		relationType = relationType.Inverse()
+		from, to = related, ticket
+	default:
+		return nil
+	}
+
+	// The link from → to closes a loop when "from" can already be reached from "to"
+	visited := map[uuid.UUID]bool{to.ID(): true}
+	queue := to.RelatedTicketIDs(relationType)
+	for len(queue) > 0 && len(visited) < maxRelationTraversal {
+		nextID := queue[0]
+		queue = queue[1:]
+		if nextID == from.ID() {
+			return fmt.Errorf("%w: relation would create a cycle of %s links", tickets.ErrInvalidRelation, relationType)
+		}
+		if visited[nextID] {
+			continue
+		}
+		visited[nextID] = true
+
+		next, err := h.repo.GetTicket(ctx, nextID)
+		if errors.Is(err, tickets.ErrTicketNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		queue = append(queue, next.RelatedTicketIDs(relationType)...)
+	}
+	return nil
+}
+
+func (h TicketHandlers) addRelation(
+	ctx context.Context, ticketID uuid.UUID, relationType tickets.RelationType, relatedID, actorID uuid.UUID,
+) (*tickets.Ticket, error) {
+	return h.repo.UpdateTicket(ctx, ticketID, func(ticket *tickets.Ticket) (bool, error) {
+		if ticket.HasRelation(relationType, relatedID) {
+			return false, nil
+		}
+		ticket.ActAs(actorID)
+		if err := ticket.AddRelation(relationType, relatedID); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+func (h TicketHandlers) removeRelation(
+	ctx context.Context, ticketID uuid.UUID, relationType tickets.RelationType, relatedID, actorID uuid.UUID,
+) error {
+	_, err := h.repo.UpdateTicket(ctx, ticketID, func(ticket *tickets.Ticket) (bool, error) {
+		if !ticket.HasRelation(relationType, relatedID) {
+			return false, nil
+		}
+		ticket.ActAs(actorID)
+		if err := ticket.RemoveRelation(relationType, relatedID); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	return err
+}
+
+// openBlockers returns blocking tickets that are neither resolved nor closed.
+// Blockers that no longer exist do not hold the ticket back.
+func (h TicketHandlers) openBlockers(ctx context.Context, ticket *tickets.Ticket) ([]uuid.UUID, error) {
+	var open []uuid.UUID
+	for _, blockerID := range ticket.RelatedTicketIDs(tickets.RelationBlockedBy) {
+		blocker, err := h.repo.GetTicket(ctx, blockerID)
+		if errors.Is(err, tickets.ErrTicketNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if category := blocker.StatusCategory(); category != tickets.StatusResolved && category != tickets.StatusClosed {
+			open = append(open, blockerID)
+		}
+	}
+	return open, nil
+}
+
+// closeChildren closes open child tickets of a closed parent, descending into their own children.
+// Failures are logged: the parent is already closed and the remaining children are still processed.
+func (h TicketHandlers) closeChildren(ctx context.Context, parent *tickets.Ticket, actorID uuid.UUID) {
+	workflow, err := h.organizationWorkflow(ctx, parent.OrganizationID())
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load workflow for closing child tickets",
+			"ticket_id", parent.ID(), "error", err)
+		return
+	}
+
+	visited := map[uuid.UUID]bool{parent.ID(): true}
+	queue := []*tickets.Ticket{parent}
+	for len(queue) > 0 && len(visited) < maxRelationTraversal {
+		current := queue[0]
+		queue = queue[1:]
+		for _, childID := range current.RelatedTicketIDs(tickets.RelationParent) {
+			if visited[childID] {
+				continue
+			}
+			visited[childID] = true
+
+			child, updateErr := h.repo.UpdateTicket(ctx, childID, func(ticket *tickets.Ticket) (bool, error) {
+				if ticket.StatusCategory() == tickets.StatusClosed {
+					return false, nil
+				}
+				ticket.ActAs(actorID)
+				if closeErr := ticket.CloseWithParent(current.ID(), workflow); closeErr != nil {
+					return false, closeErr
+				}
+				return true, nil
+			})
+			if updateErr != nil {
+				slog.WarnContext(ctx, "failed to close child ticket",
+					"ticket_id", childID, "parent_id", current.ID(), "error", updateErr)
+				continue
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+func convertRelationsToResponse(relations []tickets.Relation) []openapi.TicketRelation {
+	response := make([]openapi.TicketRelation, 0, len(relations))
+	for _, relation := range relations {
+		response = append(response, convertRelationToResponse(relation))
+	}
+	return response
+}
+
+func convertRelationToResponse(relation tickets.Relation) openapi.TicketRelation {
+	relationType := openapi.TicketRelationType(relation.Type)
+	ticketID := relation.TicketID
+	createdAt := relation.CreatedAt
+	return openapi.TicketRelation{
+		Type:      &relationType,
+		TicketId:  &ticketID,
+		CreatedBy: relation.CreatedBy,
+		CreatedAt: &createdAt,
+	}
+}
+
+func (h TicketHandlers) handleRelationError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) || errors.Is(err, tickets.ErrRelationNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrUnauthorizedAccess) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if errors.Is(err, tickets.ErrRelationExists) || errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrInvalidRelation) || errors.Is(err, tickets.ErrTicketValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
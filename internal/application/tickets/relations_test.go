@@ -0,0 +1,181 @@
+package tickets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *TicketsSuite) linkTickets(
+	ticketID uuid.UUID, relationType openapi.TicketRelationType, relatedID uuid.UUID,
+) *httptest.ResponseRecorder {
+	return s.postTicketAction(ticketID, "relations", openapi.CreateTicketRelationRequest{
+		Type:     relationType,
+		TicketId: relatedID,
+	})
+}
+
+func (s *TicketsSuite) getTicketRelations(ticketID uuid.UUID) []openapi.TicketRelation {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s/relations", ticketID), nil)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var relations []openapi.TicketRelation
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &relations))
+	return relations
+}
+
+func (s *TicketsSuite) unlinkTickets(
+	ticketID uuid.UUID, relationType openapi.TicketRelationType, relatedID uuid.UUID,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete,
+		fmt.Sprintf("/tickets/%s/relations/%s/%s", ticketID, relationType, relatedID), nil)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) TestTicketRelations() {
+	orgID := uuid.New()
+	blocker := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Network outage"}, "").Id
+	blocked := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Cannot send email"}, "").Id
+
+	s.Run("Link is stored on both tickets", func() {
+		rec := s.linkTickets(blocker, openapi.Blocks, blocked)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		var relation openapi.TicketRelation
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &relation))
+		s.Equal(openapi.Blocks, *relation.Type)
+		s.Equal(blocked, *relation.TicketId)
+
+		blockedRelations := s.getTicketRelations(blocked)
+		s.Require().Len(blockedRelations, 1)
+		s.Equal(openapi.BlockedBy, *blockedRelations[0].Type)
+		s.Equal(blocker, *blockedRelations[0].TicketId)
+
+		ticket := s.getTicketResponse(blocker)
+		s.Require().NotNil(ticket.Relations)
+		s.Len(*ticket.Relations, 1)
+	})
+
+	s.Run("Invalid links are rejected", func() {
+		s.Equal(http.StatusConflict, s.linkTickets(blocker, openapi.Blocks, blocked).Code)
+		s.Equal(http.StatusConflict, s.linkTickets(blocked, openapi.BlockedBy, blocker).Code,
+			"the inverse link is the same relation")
+		s.Equal(http.StatusBadRequest, s.linkTickets(blocked, openapi.Blocks, blocker).Code)
+		s.Equal(http.StatusBadRequest, s.linkTickets(blocker, openapi.RelatesTo, blocker).Code)
+		s.Equal(http.StatusBadRequest,
+			s.linkTickets(blocker, openapi.RelatesTo, *s.CreateTicket(openapi.CreateTicketRequest{
+				OrganizationId: uuid.New(),
+				Title:          "Other org",
+			}, "").Id).Code)
+		s.Equal(http.StatusNotFound, s.linkTickets(blocker, openapi.RelatesTo, uuid.New()).Code)
+	})
+
+	s.Run("Ticket with open blockers cannot be resolved", func() {
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(blocked, openapi.TicketStatus("in_progress")).Code)
+		rec := s.patchTicketStatus(blocked, openapi.TicketStatus("resolved"))
+		s.Require().Equal(http.StatusConflict, rec.Code, rec.Body.String())
+		s.Contains(rec.Body.String(), blocker.String())
+
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(blocker, openapi.TicketStatus("in_progress")).Code)
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(blocker, openapi.TicketStatus("resolved")).Code)
+		s.Equal(http.StatusOK, s.patchTicketStatus(blocked, openapi.TicketStatus("resolved")).Code)
+	})
+
+	s.Run("Removing a link removes the inverse link", func() {
+		s.Require().Equal(http.StatusNoContent, s.unlinkTickets(blocked, openapi.BlockedBy, blocker).Code)
+		s.Empty(s.getTicketRelations(blocker))
+		s.Empty(s.getTicketRelations(blocked))
+		s.Equal(http.StatusNotFound, s.unlinkTickets(blocked, openapi.BlockedBy, blocker).Code)
+	})
+
+	s.Run("Purging a ticket removes its links", func() {
+		related := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Related ticket"}, "").Id
+		s.Require().Equal(http.StatusCreated, s.linkTickets(blocker, openapi.RelatesTo, related).Code)
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/tickets/%s", related), nil)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusNoContent, rec.Code)
+		// A trashed ticket keeps its links so that restoring it is lossless
+		s.Len(s.getTicketRelations(blocker), 1)
+
+		req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trash/ticket/%s", related), nil)
+		rec = httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+		s.Empty(s.getTicketRelations(blocker))
+	})
+
+	s.Run("Customers cannot link tickets", func() {
+		_, token := s.LoginAs("relations-customer@example.com", openapi.Customer)
+		body, _ := json.Marshal(openapi.CreateTicketRelationRequest{Type: openapi.RelatesTo, TicketId: blocked})
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/tickets/%s/relations", blocker), bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestTicketRelations_ParentCascade() {
+	orgID := uuid.New()
+	parent := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Office outage"}, "").Id
+	child := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "No internet on floor 2"}, "").Id
+	grandchild := *s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Printer offline on floor 2",
+	}, "").Id
+	closedChild := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Already closed"}, "").Id
+
+	s.Require().Equal(http.StatusCreated, s.linkTickets(parent, openapi.Parent, child).Code)
+	s.Require().Equal(http.StatusCreated, s.linkTickets(grandchild, openapi.Child, child).Code)
+	s.Require().Equal(http.StatusCreated, s.linkTickets(parent, openapi.Parent, closedChild).Code)
+	s.Require().Equal(http.StatusOK, s.patchTicketStatus(closedChild, openapi.TicketStatus("closed")).Code)
+
+	s.Run("Links may not form a cycle", func() {
+		rec := s.linkTickets(grandchild, openapi.Parent, parent)
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		s.Equal(http.StatusBadRequest, s.linkTickets(child, openapi.Parent, parent).Code)
+		s.Equal(http.StatusBadRequest, s.linkTickets(child, openapi.Child, grandchild).Code,
+			"a ticket has a single parent")
+	})
+
+	s.Run("Closing without cascade keeps children open", func() {
+		other := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Other parent"}, "").Id
+		otherChild := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Other child"}, "").Id
+		s.Require().Equal(http.StatusCreated, s.linkTickets(other, openapi.Parent, otherChild).Code)
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(other, openapi.TicketStatus("closed")).Code)
+		s.Equal(openapi.TicketStatus("new"), *s.getTicketResponse(otherChild).Status)
+	})
+
+	s.Run("Closing a parent with cascade closes its descendants", func() {
+		cascade := true
+		body, _ := json.Marshal(openapi.UpdateTicketStatusRequest{
+			Status:  openapi.TicketStatus("closed"),
+			Cascade: &cascade,
+		})
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/tickets/%s/status", parent), bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		for _, id := range []uuid.UUID{child, grandchild, closedChild} {
+			ticket := s.getTicketResponse(id)
+			s.Equal(openapi.TicketStatus("closed"), *ticket.Status)
+			s.NotNil(ticket.ClosedAt)
+		}
+	})
+}
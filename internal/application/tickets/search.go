@@ -0,0 +1,33 @@
+package tickets
+
+import (
+	"html"
+	"strings"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+// convertSearchHighlights converts search fragments to the response, marking matches with <mark> tags
+func convertSearchHighlights(highlights []tickets.SearchHighlight) []openapi.TicketSearchHighlight {
+	response := make([]openapi.TicketSearchHighlight, 0, len(highlights))
+	for _, highlight := range highlights {
+		var snippet strings.Builder
+		last := 0
+		for _, match := range highlight.Matches {
+			snippet.WriteString(html.EscapeString(highlight.Snippet[last:match.Start]))
+			snippet.WriteString("<mark>")
+			snippet.WriteString(html.EscapeString(highlight.Snippet[match.Start:match.End]))
+			snippet.WriteString("</mark>")
+			last = match.End
+		}
+		snippet.WriteString(html.EscapeString(highlight.Snippet[last:]))
+
+		response = append(response, openapi.TicketSearchHighlight{
+			Field:     openapi.TicketSearchHighlightField(highlight.Field),
+			CommentId: highlight.CommentID,
+			Snippet:   snippet.String(),
+		})
+	}
+	return response
+}
@@ -0,0 +1,108 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) searchTickets(orgID uuid.UUID, q, token string) []openapi.GetTicketResponse {
+	query := url.Values{"organization_id": {orgID.String()}, "q": {q}}
+	rec := s.RequestAs(http.MethodGet, "/tickets?"+query.Encode(), nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list openapi.ListTicketsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	return *list.Tickets
+}
+
+func (s *TicketsSuite) addTestComment(ticketID uuid.UUID, content string, internal bool) uuid.UUID {
+	rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/comments", ticketID), openapi.CreateCommentRequest{
+		AuthorId:   uuid.New(),
+		Content:    content,
+		IsInternal: &internal,
+	}, "")
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var comment openapi.TicketComment
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comment))
+	return *comment.Id
+}
+
+func (s *TicketsSuite) TestSearchTickets() {
+	orgID := s.createAssignmentTestOrganization("Search Org")
+	inTitle := *s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Printer <3rd floor> jams",
+	}, "").Id
+	inComment := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Paper supplies"}, "").Id
+	commentID := s.addTestComment(inComment, "Ordered paper for the printers", false)
+	s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Scanner does not start"}, "")
+
+	s.Run("Results are ordered by relevance and highlighted", func() {
+		results := s.searchTickets(orgID, "printer", "")
+		s.Require().Len(results, 2)
+		s.Equal(inTitle, *results[0].Id)
+		s.Equal(inComment, *results[1].Id)
+
+		s.Require().NotNil(results[0].Highlights)
+		title := (*results[0].Highlights)[0]
+		s.Equal(openapi.SearchFieldTitle, title.Field)
+		s.Equal("<mark>Printer</mark> &lt;3rd floor&gt; jams", title.Snippet)
+
+		s.Require().NotNil(results[1].Highlights)
+		comment := (*results[1].Highlights)[0]
+		s.Equal(openapi.SearchFieldComment, comment.Field)
+		s.Equal(&commentID, comment.CommentId)
+		s.Equal("Ordered paper for the <mark>printers</mark>", comment.Snippet)
+	})
+
+	s.Run("Results without a query carry no highlights", func() {
+		for _, ticket := range s.listTicketIDs("organization_id=" + orgID.String()) {
+			s.Nil(s.getTicketResponse(ticket).Highlights)
+		}
+	})
+
+	s.Run("Invalid queries are rejected", func() {
+		for _, q := range []string{"-printer", `"the"`} {
+			rec := s.RequestAs(http.MethodGet, "/tickets?"+url.Values{"q": {q}}.Encode(), nil, "")
+			s.Equal(http.StatusBadRequest, rec.Code, q)
+		}
+	})
+}
+
+func (s *TicketsSuite) TestSearchTicketsInternalComments() {
+	orgID := s.createAssignmentTestOrganization("Search Customers Org")
+	authorID, token := s.createOrganizationCustomer("search-author@example.com", orgID)
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "Laptop battery drains fast",
+		Description:    "The battery lasts less than an hour",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var ticket openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+	s.addTestComment(*ticket.Id, "Warranty claim filed with the vendor", true)
+
+	s.Run("Agents search internal comments", func() {
+		results := s.searchTickets(orgID, "warranty", "")
+		s.Require().Len(results, 1)
+		s.Equal(*ticket.Id, *results[0].Id)
+	})
+
+	s.Run("Customers search only public text", func() {
+		s.Empty(s.searchTickets(orgID, "warranty", token))
+		results := s.searchTickets(orgID, "battery warranty", token)
+		s.Require().Len(results, 1)
+		for _, highlight := range *results[0].Highlights {
+			s.NotEqual(openapi.SearchFieldComment, highlight.Field)
+		}
+	})
+}
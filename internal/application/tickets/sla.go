@@ -0,0 +1,90 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// organizationSLAConfig returns the SLA policies configured for the organization
+func (h TicketHandlers) organizationSLAConfig(ctx context.Context, orgID uuid.UUID) (*tickets.SLAConfig, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return tickets.DefaultSLAConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if config := org.SLAConfig(); config != nil {
+		return config, nil
+	}
+	return tickets.DefaultSLAConfig(), nil
+}
+
+// changePriority overrides the ticket priority and reselects its SLA policy, which depends on the priority
+func (h TicketHandlers) changePriority(ctx context.Context, ticket *tickets.Ticket, priority tickets.Priority) error {
+	slaConfig, err := h.organizationSLAConfig(ctx, ticket.OrganizationID())
+	if err != nil {
+		return err
+	}
+	if err = ticket.OverridePriority(priority); err != nil {
+		return err
+	}
+	ticket.ApplySLAConfig(slaConfig)
+	return nil
+}
+
+// convertSLAToResponse converts the computed SLA state of a ticket to OpenAPI response
+func convertSLAToResponse(ticket *tickets.Ticket, now time.Time) *openapi.TicketSLA {
+	status := ticket.SLAStatus(now)
+	paused := status.Paused
+
+	response := &openapi.TicketSLA{
+		PolicyId:      status.SLA.PolicyID,
+		Paused:        &paused,
+		FirstResponse: convertSLATargetToResponse(status.FirstResponse),
+		Resolution:    convertSLATargetToResponse(status.Resolution),
+	}
+	if status.SLA.PolicyName != "" {
+		policyName := status.SLA.PolicyName
+		response.PolicyName = &policyName
+	}
+	if escalations := ticket.Escalations(); len(escalations) > 0 {
+		items := make([]openapi.TicketEscalation, 0, len(escalations))
+		for _, escalation := range escalations {
+			ruleID := escalation.RuleID
+			ruleName := escalation.RuleName
+			target := openapi.SLATarget(escalation.Target)
+			escalatedAt := escalation.EscalatedAt
+			items = append(items, openapi.TicketEscalation{
+				RuleId:      &ruleID,
+				RuleName:    &ruleName,
+				Target:      &target,
+				EscalatedAt: &escalatedAt,
+			})
+		}
+		response.Escalations = &items
+	}
+	return response
+}
+
+func convertSLATargetToResponse(target tickets.SLATargetStatus) *openapi.SLATargetStatus {
+	targetMinutes := int64(target.Target / time.Minute)
+	remainingSeconds := int64(target.Remaining / time.Second)
+	breached := target.Breached
+
+	return &openapi.SLATargetStatus{
+		TargetMinutes:    &targetMinutes,
+		DueAt:            target.DueAt,
+		RemainingSeconds: &remainingSeconds,
+		CompletedAt:      target.CompletedAt,
+		Breached:         &breached,
+	}
+}
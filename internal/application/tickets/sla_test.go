@@ -0,0 +1,110 @@
+package tickets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *TicketsSuite) TestTicketSLA() {
+	orgDomain := "sla-tickets.com"
+	orgBody, _ := json.Marshal(openapi.CreateOrganizationRequest{Name: "SLA Tickets Org", Domain: &orgDomain})
+	orgReq := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewBuffer(orgBody))
+	orgReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	orgRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(orgRec, orgReq)
+	s.Require().Equal(http.StatusCreated, orgRec.Code)
+
+	var orgResp openapi.CreateOrganizationResponse
+	s.Require().NoError(json.Unmarshal(orgRec.Body.Bytes(), &orgResp))
+	orgID := *orgResp.Id
+
+	critical := openapi.TicketPriority("critical")
+	s.sendTicketRequest(http.MethodPut, fmt.Sprintf("/organizations/%s/sla", orgID),
+		openapi.UpdateOrganizationSLARequest{
+			Policies: []openapi.SLAPolicy{
+				{Name: "Critical", Priority: &critical, FirstResponseMinutes: 15, ResolutionMinutes: 120},
+			},
+		})
+
+	s.Run("Ticket without matching policy uses priority defaults", func() {
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: uuid.New(), Priority: "normal"}, "")
+
+		s.Require().NotNil(ticket.Sla)
+		s.Nil(ticket.Sla.PolicyId)
+		s.Equal(int64(24*60), *ticket.Sla.Resolution.TargetMinutes)
+		s.Equal(int64(8*60), *ticket.Sla.FirstResponse.TargetMinutes)
+		s.NotNil(ticket.Sla.Resolution.DueAt)
+		s.Positive(*ticket.Sla.Resolution.RemainingSeconds)
+		s.False(*ticket.Sla.Resolution.Breached)
+		s.False(*ticket.Sla.Paused)
+	})
+
+	s.Run("Organization policy sets targets and follows priority changes", func() {
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Priority: "critical"}, "")
+
+		s.Require().NotNil(ticket.Sla)
+		s.Require().NotNil(ticket.Sla.PolicyName)
+		s.Equal("Critical", *ticket.Sla.PolicyName)
+		s.Equal(int64(15), *ticket.Sla.FirstResponse.TargetMinutes)
+		s.Equal(int64(120), *ticket.Sla.Resolution.TargetMinutes)
+
+		high := openapi.TicketPriority("high")
+		body, _ := json.Marshal(openapi.UpdateTicketRequest{Priority: &high})
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/tickets/%s", *ticket.Id), bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var updated openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.Nil(updated.Sla.PolicyId)
+		s.Equal(int64(8*60), *updated.Sla.Resolution.TargetMinutes)
+	})
+
+	s.Run("Waiting on the customer pauses the clock", func() {
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Priority: "critical"}, "")
+
+		s.Require().Equal(http.StatusOK, s.patchTicketStatus(*ticket.Id, "in_progress").Code)
+		rec := s.patchTicketStatus(*ticket.Id, "waiting")
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var waiting openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &waiting))
+		s.True(*waiting.Sla.Paused)
+		s.Nil(waiting.Sla.Resolution.DueAt)
+
+		rec = s.patchTicketStatus(*ticket.Id, "in_progress")
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resumed openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resumed))
+		s.False(*resumed.Sla.Paused)
+		s.NotNil(resumed.Sla.Resolution.DueAt)
+	})
+
+	s.Run("Public reply from another user completes first response", func() {
+		ticket := s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Priority: "critical"}, "")
+
+		s.postComment(*ticket.Id, "We are looking into it", "")
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s", *ticket.Id), nil)
+		rec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var resp openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.NotNil(resp.Sla.FirstResponse.CompletedAt)
+		s.False(*resp.Sla.FirstResponse.Breached)
+		s.Nil(resp.Sla.Resolution.CompletedAt)
+	})
+}
@@ -1,27 +1,53 @@
 package tickets
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
 	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/pkg/etag"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h TicketHandlers) PatchTicketsIDStatus(c echo.Context, id openapi_types.UUID) error {
+func (h TicketHandlers) PatchTicketsIDStatus(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PatchTicketsIDStatusParams,
+) error {
 	ctx := c.Request().Context()
 	var req openapi.UpdateTicketStatusRequest
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
 
-	newStatus := tickets.Status(req.Status)
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+	precondition, ok := parseIfMatch(c, params.IfMatch)
+	if !ok {
+		return nil
+	}
+
+	newStatus, err := tickets.ParseWorkflowStatus(req.Status)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
 
 	ticket, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
-		if statusErr := ticket.ChangeStatus(newStatus); statusErr != nil {
+		if !precondition.Matches(ticket.Version()) {
+			return false, tickets.ErrVersionConflict
+		}
+		ticket.ActAs(authUserID)
+		if statusErr := h.changeStatus(ctx, ticket, newStatus, role); statusErr != nil {
 			return false, statusErr
 		}
 		return true, nil
@@ -32,12 +58,60 @@ func (h TicketHandlers) PatchTicketsIDStatus(c echo.Context, id openapi_types.UU
 		if errors.Is(err, tickets.ErrTicketNotFound) {
 			return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
 		}
+		if errors.Is(err, tickets.ErrTransitionNotAllowed) {
+			return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: &msg})
+		}
+		if errors.Is(err, tickets.ErrOpenBlockers) || errors.Is(err, tickets.ErrConcurrentUpdate) {
+			return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+		}
+		if errors.Is(err, tickets.ErrVersionConflict) {
+			return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+		}
 		if errors.Is(err, tickets.ErrInvalidTransition) || errors.Is(err, tickets.ErrInvalidStatus) {
 			return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
 		}
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 	}
 
-	response := convertTicketToResponse(ticket)
+	if req.Cascade != nil && *req.Cascade && ticket.StatusCategory() == tickets.StatusClosed {
+		h.closeChildren(ctx, ticket, authUserID)
+	}
+
+	etag.Set(c, ticket.Version())
+	response := convertTicketToResponse(ticket, hasElevatedTicketAccess(role))
 	return c.JSON(http.StatusOK, response)
 }
+
+// changeStatus moves the ticket to the status allowed by the workflow of its organization.
+// Open blockers are looked up first, since they prevent resolving the ticket.
+func (h TicketHandlers) changeStatus(
+	ctx context.Context,
+	ticket *tickets.Ticket,
+	status tickets.Status,
+	role userdomain.Role,
+) error {
+	workflow, err := h.organizationWorkflow(ctx, ticket.OrganizationID())
+	if err != nil {
+		return err
+	}
+	openBlockers, err := h.openBlockers(ctx, ticket)
+	if err != nil {
+		return err
+	}
+	ticket.SetOpenBlockers(openBlockers)
+	return ticket.ChangeStatusInWorkflow(workflow, status, role)
+}
+
+// organizationWorkflow returns the ticket workflow configured for the organization.
+// Organizations that are unknown to the repository fall back to the default workflow.
+func (h TicketHandlers) organizationWorkflow(ctx context.Context, orgID uuid.UUID) (*tickets.Workflow, error) {
+	org, err := h.organization(ctx, orgID)
+	if errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return tickets.DefaultWorkflow(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return org.Workflow(), nil
+}
@@ -481,3 +481,90 @@ func (s *TicketsSuite) TestUpdateTicketStatus() {
 		}
 	})
 }
+
+func (s *TicketsSuite) patchTicketStatus(ticketID uuid.UUID, status openapi.TicketStatus) *httptest.ResponseRecorder {
+	statusBody, _ := json.Marshal(openapi.UpdateTicketStatusRequest{Status: status})
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		fmt.Sprintf("/tickets/%s/status", ticketID.String()),
+		bytes.NewBuffer(statusBody),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) TestUpdateTicketStatusWithOrganizationWorkflow() {
+	orgDomain := "workflow.com"
+	orgBody, _ := json.Marshal(openapi.CreateOrganizationRequest{Name: "Workflow Org", Domain: &orgDomain})
+	orgReq := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewBuffer(orgBody))
+	orgReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	orgRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(orgRec, orgReq)
+	s.Require().Equal(http.StatusCreated, orgRec.Code)
+
+	var orgResp openapi.CreateOrganizationResponse
+	s.Require().NoError(json.Unmarshal(orgRec.Body.Bytes(), &orgResp))
+	orgID := *orgResp.Id
+
+	agentOnly := []openapi.UserRole{openapi.Agent}
+	workflowBody, _ := json.Marshal(openapi.UpdateOrganizationWorkflowRequest{
+		Statuses: []openapi.WorkflowStatus{
+			{Key: "new", Category: openapi.New},
+			{Key: "pending_vendor", Category: openapi.Waiting},
+			{Key: "in_progress", Category: openapi.InProgress},
+		},
+		Transitions: []openapi.WorkflowTransition{
+			{From: "new", To: "pending_vendor"},
+			{From: "pending_vendor", To: "in_progress", AllowedRoles: &agentOnly},
+		},
+	})
+	workflowReq := httptest.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("/organizations/%s/workflow", orgID),
+		bytes.NewBuffer(workflowBody),
+	)
+	workflowReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	workflowRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(workflowRec, workflowReq)
+	s.Require().Equal(http.StatusOK, workflowRec.Code, workflowRec.Body.String())
+
+	ticketBody, _ := json.Marshal(openapi.CreateTicketRequest{
+		Title:          "Workflow ticket",
+		Description:    "Ticket that follows the organization workflow",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       uuid.New(),
+	})
+	ticketReq := httptest.NewRequest(http.MethodPost, "/tickets", bytes.NewBuffer(ticketBody))
+	ticketReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ticketRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(ticketRec, ticketReq)
+	s.Require().Equal(http.StatusCreated, ticketRec.Code)
+
+	var ticketResp openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(ticketRec.Body.Bytes(), &ticketResp))
+	ticketID := *ticketResp.Id
+
+	s.Run("Status outside organization workflow returns 400", func() {
+		rec := s.patchTicketStatus(ticketID, "waiting")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Custom status reports its category", func() {
+		rec := s.patchTicketStatus(ticketID, "pending_vendor")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		s.Equal("pending_vendor", *resp.Status)
+		s.Require().NotNil(resp.StatusCategory)
+		s.Equal(openapi.Waiting, *resp.StatusCategory)
+	})
+
+	s.Run("Transition restricted to other roles returns 403", func() {
+		rec := s.patchTicketStatus(ticketID, "in_progress")
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+}
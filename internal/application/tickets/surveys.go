@@ -0,0 +1,123 @@
+package tickets
+
+import (
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h TicketHandlers) GetTicketsIDSurvey(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.accessibleTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleSurveyError(c, err)
+	}
+	survey := ticket.Survey()
+	if survey == nil {
+		return h.handleSurveyError(c, tickets.ErrSurveyNotFound)
+	}
+	// Whoever holds the token can rate the ticket, so only its author sees it
+	if ticket.AuthorID() != authUserID {
+		survey.Token = ""
+	}
+
+	return c.JSON(http.StatusOK, convertSurveyToResponse(*survey))
+}
+
+func (h TicketHandlers) PostTicketsIDSurvey(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, _, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.SubmitTicketSurveyRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleSurveyError(c, err)
+	}
+	// Only the customer who raised the ticket rates it; agents cannot rate on their behalf
+	if ticket.AuthorID() != authUserID {
+		return h.handleSurveyError(c, tickets.ErrUnauthorizedAccess)
+	}
+
+	comment := ""
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		if submitErr := ticket.SubmitSurvey(req.Token, req.Rating, comment); submitErr != nil {
+			return false, submitErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleSurveyError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertSurveyToResponse(*ticket.Survey()))
+}
+
+func convertSurveyToResponse(survey tickets.Survey) openapi.TicketSurvey {
+	response := openapi.TicketSurvey{
+		Status:      openapi.SurveyStatusPending,
+		AgentId:     survey.AgentID,
+		RequestedAt: survey.RequestedAt,
+	}
+	if survey.Token != "" {
+		response.Token = &survey.Token
+	}
+	if survey.IsSubmitted() {
+		response.Status = openapi.SurveyStatusSubmitted
+		response.Rating = &survey.Rating
+		response.Comment = &survey.Comment
+		response.RatedAt = survey.RatedAt
+	}
+	return response
+}
+
+// convertSatisfactionToResponse returns the submitted rating of the ticket; nil while there is none
+func convertSatisfactionToResponse(survey *tickets.Survey) *openapi.TicketSatisfaction {
+	if survey == nil || !survey.IsSubmitted() {
+		return nil
+	}
+	satisfaction := openapi.TicketSatisfaction{
+		Rating:  survey.Rating,
+		RatedAt: *survey.RatedAt,
+	}
+	if survey.Comment != "" {
+		satisfaction.Comment = &survey.Comment
+	}
+	return &satisfaction
+}
+
+func (h TicketHandlers) handleSurveyError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, tickets.ErrTicketNotFound), errors.Is(err, tickets.ErrSurveyNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrUnauthorizedAccess), errors.Is(err, tickets.ErrInvalidSurveyToken):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrSurveyAlreadySubmitted), errors.Is(err, tickets.ErrConcurrentUpdate):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrInvalidRating), errors.Is(err, tickets.ErrTicketValidation):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
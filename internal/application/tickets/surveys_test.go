@@ -0,0 +1,91 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) getTicketSurvey(ticketID uuid.UUID, token string) (int, openapi.TicketSurvey) {
+	rec := s.RequestAs(http.MethodGet, fmt.Sprintf("/tickets/%s/survey", ticketID), nil, token)
+	var survey openapi.TicketSurvey
+	if rec.Code == http.StatusOK {
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &survey))
+	}
+	return rec.Code, survey
+}
+
+func (s *TicketsSuite) submitTicketSurvey(
+	ticketID uuid.UUID, surveyToken string, rating int, token string,
+) *httptest.ResponseRecorder {
+	comment := "Fixed within the hour"
+	return s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/survey", ticketID),
+		openapi.SubmitTicketSurveyRequest{Token: surveyToken, Rating: rating, Comment: &comment}, token)
+}
+
+func (s *TicketsSuite) TestTicketSurvey() {
+	orgID := s.createAssignmentTestOrganization("Survey Org")
+	authorID, customerToken := s.createOrganizationCustomer("survey-author@example.com", orgID)
+	_, agentToken := s.LoginAs("survey-agent@example.com", openapi.Agent)
+
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "VPN does not connect",
+		Description:    "The VPN client times out",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, customerToken)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var created openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	ticketID := *created.Id
+
+	s.Run("Open tickets have no survey", func() {
+		code, _ := s.getTicketSurvey(ticketID, customerToken)
+		s.Equal(http.StatusNotFound, code)
+	})
+
+	s.Require().Equal(http.StatusOK, s.patchTicketStatus(ticketID, openapi.TicketStatus("in_progress")).Code)
+	s.Require().Equal(http.StatusOK, s.patchTicketStatus(ticketID, openapi.TicketStatus("resolved")).Code)
+
+	code, survey := s.getTicketSurvey(ticketID, customerToken)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().NotNil(survey.Token)
+	surveyToken := *survey.Token
+
+	s.Run("Only the author sees the token", func() {
+		s.Equal(openapi.SurveyStatusPending, survey.Status)
+		code, agentView := s.getTicketSurvey(ticketID, agentToken)
+		s.Require().Equal(http.StatusOK, code)
+		s.Nil(agentView.Token)
+	})
+
+	s.Run("Only the author rates the ticket with a valid token", func() {
+		s.Equal(http.StatusForbidden, s.submitTicketSurvey(ticketID, surveyToken, 5, agentToken).Code)
+		s.Equal(http.StatusForbidden, s.submitTicketSurvey(ticketID, "guessed", 5, customerToken).Code)
+		s.Equal(http.StatusBadRequest, s.submitTicketSurvey(ticketID, surveyToken, 6, customerToken).Code)
+	})
+
+	s.Run("The rating is stored on the ticket", func() {
+		rec := s.submitTicketSurvey(ticketID, surveyToken, 4, customerToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var submitted openapi.TicketSurvey
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &submitted))
+		s.Equal(openapi.SurveyStatusSubmitted, submitted.Status)
+		s.Nil(submitted.Token)
+
+		ticket := s.getTicketResponse(ticketID)
+		s.Require().NotNil(ticket.Satisfaction)
+		s.Equal(4, ticket.Satisfaction.Rating)
+		s.Equal("Fixed within the hour", *ticket.Satisfaction.Comment)
+	})
+
+	s.Run("The token works only once", func() {
+		s.Equal(http.StatusConflict, s.submitTicketSurvey(ticketID, surveyToken, 1, customerToken).Code)
+	})
+}
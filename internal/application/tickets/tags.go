@@ -0,0 +1,92 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// tagChanges are the normalized tags to put on and take off a ticket
+type tagChanges struct {
+	add    []string
+	remove []string
+}
+
+func (t tagChanges) isEmpty() bool {
+	return len(t.add) == 0 && len(t.remove) == 0
+}
+
+// resolveTagChanges normalizes the requested tags and checks that added tags are defined by the organization.
+// Removed tags are not checked, so tags whose definition was deleted can still be taken off tickets.
+func (h TicketHandlers) resolveTagChanges(
+	ctx context.Context,
+	orgID uuid.UUID,
+	add, remove *[]string,
+) (tagChanges, error) {
+	var changes tagChanges
+	var err error
+	if changes.add, err = parseTagNames(add); err != nil {
+		return tagChanges{}, err
+	}
+	if changes.remove, err = parseTagNames(remove); err != nil {
+		return tagChanges{}, err
+	}
+	if len(changes.add) == 0 {
+		return changes, nil
+	}
+
+	org, err := h.organization(ctx, orgID)
+	if err != nil && !errors.Is(err, organizations.ErrOrganizationNotFound) {
+		return tagChanges{}, err
+	}
+	for _, name := range changes.add {
+		if org == nil {
+			return tagChanges{}, fmt.Errorf("%w: %s", tickets.ErrTagNotFound, name)
+		}
+		if _, defined := org.Tag(name); !defined {
+			return tagChanges{}, fmt.Errorf("%w: %s", tickets.ErrTagNotFound, name)
+		}
+	}
+	return changes, nil
+}
+
+// applyTagChanges reports whether the ticket tags changed
+func applyTagChanges(ticket *tickets.Ticket, changes tagChanges) (bool, error) {
+	updated := false
+	for _, name := range changes.remove {
+		if ticket.HasTag(name) {
+			ticket.RemoveTag(name)
+			updated = true
+		}
+	}
+	for _, name := range changes.add {
+		if ticket.HasTag(name) {
+			continue
+		}
+		if err := ticket.AddTag(name); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	return updated, nil
+}
+
+func parseTagNames(values *[]string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	names := make([]string, 0, len(*values))
+	for _, value := range *values {
+		name, err := tickets.ParseTagName(value)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,133 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) defineTestTags(orgID uuid.UUID, names ...string) {
+	_, err := s.OrganizationsRepo.UpdateOrganization(context.Background(), orgID,
+		func(org *organizations.Organization) (bool, error) {
+			for _, name := range names {
+				tag, err := tickets.NewTag(name, "#336699", "")
+				if err != nil {
+					return false, err
+				}
+				if err = org.AddTag(tag); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+	s.Require().NoError(err)
+}
+
+func (s *TicketsSuite) listTicketIDs(query string) []uuid.UUID {
+	rec := s.RequestAs(http.MethodGet, "/tickets?"+query, nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list openapi.ListTicketsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	ids := make([]uuid.UUID, 0, len(*list.Tickets))
+	for _, ticket := range *list.Tickets {
+		ids = append(ids, *ticket.Id)
+	}
+	return ids
+}
+
+func (s *TicketsSuite) TestTicketTags() {
+	orgID := s.createAssignmentTestOrganization("Tags Org")
+	s.defineTestTags(orgID, "vip", "security", "hardware-recall")
+	vipTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "CEO laptop does not boot",
+	}, "").Id
+	securityTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+		OrganizationId: orgID,
+		Title:          "Phishing email received",
+	}, "").Id
+	ticketPath := fmt.Sprintf("/tickets/%s", vipTicket)
+
+	s.Run("Agents tag tickets", func() {
+		rec := s.RequestAs(http.MethodPut, ticketPath,
+			openapi.UpdateTicketRequest{AddTags: &[]string{"VIP", "hardware-recall"}}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var ticket openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+		s.Require().NotNil(ticket.Tags)
+		s.Equal([]string{"vip", "hardware-recall"}, *ticket.Tags)
+
+		rec = s.RequestAs(http.MethodPut, fmt.Sprintf("/tickets/%s", securityTicket),
+			openapi.UpdateTicketRequest{AddTags: &[]string{"security", "vip"}}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		code, history := s.getTicketHistory(vipTicket, "")
+		s.Require().Equal(http.StatusOK, code)
+		s.Contains(eventTypes(history.Events), openapi.TagAdded)
+	})
+
+	s.Run("Only tags defined by the organization can be added", func() {
+		rec := s.RequestAs(http.MethodPut, ticketPath, openapi.UpdateTicketRequest{AddTags: &[]string{"urgent"}}, "")
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		rec = s.RequestAs(http.MethodPut, ticketPath, openapi.UpdateTicketRequest{AddTags: &[]string{"not valid"}}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+
+		otherOrgTicket := *s.CreateTicket(openapi.CreateTicketRequest{
+			OrganizationId: s.createAssignmentTestOrganization("Untagged Org"),
+			Title:          "Other org",
+		}, "").Id
+		rec = s.RequestAs(http.MethodPut, fmt.Sprintf("/tickets/%s", otherOrgTicket),
+			openapi.UpdateTicketRequest{AddTags: &[]string{"vip"}}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Tickets are filtered by tags", func() {
+		s.ElementsMatch([]uuid.UUID{vipTicket, securityTicket}, s.listTicketIDs("tags=vip"))
+		s.Equal([]uuid.UUID{securityTicket}, s.listTicketIDs("tags=vip,security"))
+		s.ElementsMatch([]uuid.UUID{vipTicket, securityTicket}, s.listTicketIDs("tags_any=security,hardware-recall"))
+		s.Empty(s.listTicketIDs("tags=vip&tags_any=unknown"))
+
+		rec := s.RequestAs(http.MethodGet, "/tickets?tags=not%20valid", nil, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	s.Run("Removed definitions can still be taken off tickets", func() {
+		_, err := s.OrganizationsRepo.UpdateOrganization(context.Background(), orgID,
+			func(org *organizations.Organization) (bool, error) {
+				return true, org.RemoveTag("hardware-recall")
+			})
+		s.Require().NoError(err)
+
+		rec := s.RequestAs(http.MethodPut, ticketPath,
+			openapi.UpdateTicketRequest{RemoveTags: &[]string{"hardware-recall", "security"}}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal([]string{"vip"}, *s.getTicketResponse(vipTicket).Tags)
+	})
+
+	s.Run("Customers cannot change tags", func() {
+		authorID, token := s.createOrganizationCustomer("tags-author@example.com", orgID)
+		rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+			Title:          "My monitor flickers",
+			Description:    "The monitor flickers every few seconds",
+			Priority:       openapi.TicketPriority("normal"),
+			OrganizationId: orgID,
+			AuthorId:       authorID,
+		}, token)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var created openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+		rec = s.RequestAs(http.MethodPut, fmt.Sprintf("/tickets/%s", *created.Id),
+			openapi.UpdateTicketRequest{AddTags: &[]string{"vip"}}, token)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+}
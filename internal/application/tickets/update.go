@@ -6,17 +6,26 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h TicketHandlers) PutTicketsID(c echo.Context, id openapi_types.UUID) error {
+func (h TicketHandlers) PutTicketsID(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PutTicketsIDParams,
+) error {
 	ctx := c.Request().Context()
 	authUserID, role, ok := authUser(c)
 	if !ok {
 		return nil
 	}
+	precondition, ok := parseIfMatch(c, params.IfMatch)
+	if !ok {
+		return nil
+	}
 
 	existingTicket, err := h.repo.GetTicket(ctx, id)
 	if err != nil {
@@ -35,19 +44,95 @@ func (h TicketHandlers) PutTicketsID(c echo.Context, id openapi_types.UUID) erro
 		return bindErr
 	}
 
+	// Tags and priority are up to the support team, customers only state impact and urgency
+	tags, err := h.resolveTagChanges(ctx, existingTicket.OrganizationID(), req.AddTags, req.RemoveTags)
+	if !hasElevatedTicketAccess(role) && (changesPriority(req) || !tags.isEmpty() || err != nil) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if err != nil {
+		return h.handleUpdateError(c, err)
+	}
+
+	slaConfig, err := h.organizationSLAConfig(ctx, existingTicket.OrganizationID())
+	if err != nil {
+		return h.handleUpdateError(c, err)
+	}
+	matrix, err := h.updatePriorityMatrix(ctx, existingTicket.OrganizationID(), req)
+	if err != nil {
+		return h.handleUpdateError(c, err)
+	}
+
+	// Custom fields are validated against the schema of the category the ticket ends up in
+	validateCustomFields := req.CustomFields != nil || req.CategoryId != nil
+	var customFieldPatch map[string]any
+	if req.CustomFields != nil {
+		customFieldPatch = *req.CustomFields
+	}
+	categoryID := existingTicket.CategoryID()
+	if req.CategoryId != nil {
+		categoryID = req.CategoryId
+	}
+	schema, err := h.customFieldSchema(ctx, categoryID)
+	if err == nil {
+		err = h.validateCustomFieldUsers(ctx, schema, customFieldPatch)
+	}
+	if err != nil {
+		return h.handleUpdateError(c, err)
+	}
+
 	ticket, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
-		return h.applyTicketUpdates(ticket, req)
+		if !precondition.Matches(ticket.Version()) {
+			return false, tickets.ErrVersionConflict
+		}
+		ticket.ActAs(authUserID)
+		updated, updateErr := h.applyTicketUpdates(ticket, req, matrix)
+		if updateErr != nil {
+			return false, updateErr
+		}
+		tagsUpdated, tagErr := applyTagChanges(ticket, tags)
+		if tagErr != nil {
+			return false, tagErr
+		}
+		updated = updated || tagsUpdated
+		if validateCustomFields {
+			values := mergeCustomFields(ticket.CustomFields(), customFieldPatch, schema)
+			if fieldsErr := ticket.SetCustomFields(schema, values); fieldsErr != nil {
+				return false, fieldsErr
+			}
+			updated = true
+		}
+		// Priority and category select the SLA policy, so targets follow their changes
+		if changesPriority(req) || changesClassification(req) || req.CategoryId != nil {
+			ticket.ApplySLAConfig(slaConfig)
+		}
+		return updated, nil
 	})
 
 	if err != nil {
 		return h.handleUpdateError(c, err)
 	}
 
-	response := convertTicketToResponse(ticket)
+	etag.Set(c, ticket.Version())
+	response := convertTicketToResponse(ticket, hasElevatedTicketAccess(role))
 	return c.JSON(http.StatusOK, response)
 }
 
-func (h TicketHandlers) applyTicketUpdates(ticket *tickets.Ticket, req openapi.UpdateTicketRequest) (bool, error) {
+// parseIfMatch parses the If-Match header of a ticket update; an invalid header is answered with 400
+func parseIfMatch(c echo.Context, header *string) (etag.Precondition, bool) {
+	precondition, err := etag.ParseIfMatch(header)
+	if err != nil {
+		msg := err.Error()
+		_ = c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+		return etag.Precondition{}, false
+	}
+	return precondition, true
+}
+
+func (h TicketHandlers) applyTicketUpdates(
+	ticket *tickets.Ticket,
+	req openapi.UpdateTicketRequest,
+	matrix *tickets.PriorityMatrix,
+) (bool, error) {
 	updated := false
 
 	// Update title if provided
@@ -66,13 +151,12 @@ func (h TicketHandlers) applyTicketUpdates(ticket *tickets.Ticket, req openapi.U
 		updated = true
 	}
 
-	// Update priority if provided
-	if req.Priority != nil {
-		if err := h.updateTicketPriority(ticket, *req.Priority); err != nil {
-			return false, err
-		}
-		updated = true
+	// Update impact, urgency and priority if provided
+	priorityUpdated, err := applyPriorityUpdates(ticket, req, matrix)
+	if err != nil {
+		return false, err
 	}
+	updated = updated || priorityUpdated
 
 	// Update category if provided
 	if req.CategoryId != nil {
@@ -84,19 +168,25 @@ func (h TicketHandlers) applyTicketUpdates(ticket *tickets.Ticket, req openapi.U
 	return updated, nil
 }
 
-func (h TicketHandlers) updateTicketPriority(ticket *tickets.Ticket, priorityStr openapi.TicketPriority) error {
-	priority := tickets.Priority(priorityStr)
-	return ticket.UpdatePriority(priority)
-}
-
 func (h TicketHandlers) handleUpdateError(c echo.Context, err error) error {
 	msg := err.Error()
 	if errors.Is(err, tickets.ErrTicketNotFound) {
 		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
 	}
+	if errors.Is(err, tickets.ErrVersionConflict) {
+		return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
 	if errors.Is(err, tickets.ErrTicketValidation) ||
 		errors.Is(err, tickets.ErrInvalidTicket) ||
-		errors.Is(err, tickets.ErrInvalidPriority) {
+		errors.Is(err, tickets.ErrInvalidPriority) ||
+		errors.Is(err, tickets.ErrInvalidImpact) ||
+		errors.Is(err, tickets.ErrInvalidUrgency) ||
+		errors.Is(err, tickets.ErrInvalidTag) ||
+		errors.Is(err, tickets.ErrTagNotFound) ||
+		errors.Is(err, tickets.ErrInvalidCustomField) {
 		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
 	}
 	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
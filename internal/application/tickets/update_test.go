@@ -628,3 +628,68 @@ func (s *TicketsSuite) TestUpdateTicket() {
 		}
 	})
 }
+
+func (s *TicketsSuite) requestIfMatch(method, path string, payload any, ifMatch string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(method, path, bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", ifMatch)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *TicketsSuite) TestUpdateTicketIfMatch() {
+	orgID := s.createAssignmentTestOrganization("If-Match Org")
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Monitor flickers"}, "").Id
+	path := "/tickets/" + ticketID.String()
+
+	rec := s.RequestAs(http.MethodGet, path, nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code)
+	loaded := rec.Header().Get("ETag")
+	s.Equal(`"1"`, loaded)
+
+	title := "Monitor flickers after the update"
+	rec = s.requestIfMatch(http.MethodPut, path, openapi.UpdateTicketRequest{Title: &title}, loaded)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	current := rec.Header().Get("ETag")
+	s.Equal(`"2"`, current)
+
+	s.Run("Updates based on a stale version are rejected", func() {
+		staleTitle := "Lost update"
+		rec = s.requestIfMatch(http.MethodPut, path, openapi.UpdateTicketRequest{Title: &staleTitle}, loaded)
+		s.Equal(http.StatusPreconditionFailed, rec.Code)
+
+		rec = s.requestIfMatch(http.MethodPatch, path+"/status", openapi.UpdateTicketStatusRequest{
+			Status: openapi.TicketStatus("in_progress"),
+		}, loaded)
+		s.Equal(http.StatusPreconditionFailed, rec.Code)
+
+		rec = s.requestIfMatch(http.MethodPatch, path+"/assign", openapi.AssignTicketRequest{}, loaded)
+		s.Equal(http.StatusPreconditionFailed, rec.Code)
+
+		s.Equal(title, *s.getTicketResponse(ticketID).Title)
+	})
+
+	s.Run("Updates based on the current version succeed", func() {
+		rec = s.requestIfMatch(http.MethodPatch, path+"/status", openapi.UpdateTicketStatusRequest{
+			Status: openapi.TicketStatus("in_progress"),
+		}, current)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal(`"3"`, rec.Header().Get("ETag"))
+
+		rec = s.requestIfMatch(http.MethodPatch, path+"/assign", openapi.AssignTicketRequest{}, `"1", "3"`)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal(`"4"`, rec.Header().Get("ETag"))
+	})
+
+	s.Run("Wildcard matches any version", func() {
+		rec = s.requestIfMatch(http.MethodPut, path, openapi.UpdateTicketRequest{Title: &title}, "*")
+		s.Equal(http.StatusOK, rec.Code, rec.Body.String())
+	})
+
+	s.Run("Malformed If-Match is rejected", func() {
+		rec = s.requestIfMatch(http.MethodPut, path, openapi.UpdateTicketRequest{Title: &title}, "4")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,156 @@
+package tickets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetViewsIDTickets runs a saved view: lists the tickets matching its filter in its sort order
+func (h TicketHandlers) GetViewsIDTickets(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.GetViewsIDTicketsParams,
+) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	view, err := h.viewRepo.GetView(ctx, id)
+	if err == nil && !view.Sharing().IsVisibleTo(viewer) {
+		err = views.ErrViewNotFound
+	}
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	filter, err := h.viewTicketFilter(ctx, view, viewer)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	pagination, err := queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Page: params.Page, Limit: params.Limit})
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	filter.Limit = pagination.Limit
+	filter.Offset = pagination.Offset
+	if filter, err = filter.ValidateAndSetDefaults(); err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	ticketList, err := h.repo.ListTickets(ctx, filter)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	page := 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	response := h.buildListResponse(ticketList, filter.Limit, page, hasElevatedTicketAccess(role))
+	if filter.Search != nil {
+		for i, ticket := range ticketList {
+			highlights := convertSearchHighlights(ticket.SearchHighlights(*filter.Search, filter.SearchInternal))
+			(*response.Tickets)[i].Highlights = &highlights
+		}
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetViewsCounts returns the live number of tickets matching every view the user can run
+func (h TicketHandlers) GetViewsCounts(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	list, err := h.viewRepo.ListViews(ctx, queries.ViewFilter{Viewer: viewer})
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	result := make([]openapi.TicketViewCount, 0, len(list))
+	for _, view := range list {
+		filter, filterErr := h.viewTicketFilter(ctx, view, viewer)
+		if filterErr != nil {
+			return handleViewError(c, filterErr)
+		}
+		count, countErr := h.repo.CountTickets(ctx, filter)
+		if countErr != nil {
+			return handleViewError(c, countErr)
+		}
+		result = append(result, openapi.TicketViewCount{ViewId: view.ID(), Count: count})
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// viewer describes the user running views; users unknown to the repository belong to no organization
+func (h TicketHandlers) viewer(ctx context.Context, userID uuid.UUID, role userdomain.Role) (views.Viewer, error) {
+	viewer := views.Viewer{UserID: userID, Role: role}
+	if h.userRepo == nil {
+		return viewer, nil
+	}
+	user, err := h.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, userdomain.ErrUserNotFound) {
+		return viewer, nil
+	}
+	if err != nil {
+		return viewer, err
+	}
+	viewer.OrganizationID = user.OrganizationID()
+	return viewer, nil
+}
+
+// viewTicketFilter restores the stored filter of the view for the viewer.
+// Like the ticket list, the result is limited to the tickets the viewer can read.
+func (h TicketHandlers) viewTicketFilter(
+	ctx context.Context, view *views.View, viewer views.Viewer,
+) (queries.TicketFilter, error) {
+	var filter queries.TicketFilter
+	if err := json.Unmarshal(view.Filter(), &filter); err != nil {
+		return filter, err
+	}
+	if view.AssignedToViewer() {
+		filter.AssigneeID = &viewer.UserID
+	}
+
+	var err error
+	if viewer.Role == userdomain.RoleCustomer {
+		if filter, err = h.customerTicketFilter(ctx, filter, viewer.UserID); err != nil {
+			return filter, err
+		}
+	}
+	filter.SearchInternal = hasElevatedTicketAccess(viewer.Role)
+	return filter, nil
+}
+
+func handleViewError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, views.ErrViewNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
@@ -0,0 +1,117 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createTestView(req openapi.CreateTicketViewRequest, token string) uuid.UUID {
+	rec := s.RequestAs(http.MethodPost, "/views", req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var view openapi.TicketView
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &view))
+	return *view.Id
+}
+
+func (s *TicketsSuite) runView(viewID uuid.UUID, token string) []uuid.UUID {
+	rec := s.RequestAs(http.MethodGet, fmt.Sprintf("/views/%s/tickets", viewID), nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list openapi.ListTicketsResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	ids := make([]uuid.UUID, 0, len(*list.Tickets))
+	for _, ticket := range *list.Tickets {
+		ids = append(ids, *ticket.Id)
+	}
+	return ids
+}
+
+func (s *TicketsSuite) TestRunViews() {
+	orgID := s.createAssignmentTestOrganization("Views Org")
+	agentID, agentToken := s.LoginAs("views-agent@example.com", openapi.Agent)
+	_, err := s.UsersRepo.UpdateUser(context.Background(), agentID, func(user *users.User) (bool, error) {
+		return true, user.ChangeOrganization(&orgID)
+	})
+	s.Require().NoError(err)
+
+	mine := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Mine"}, "").Id
+	rec := s.RequestAs(http.MethodPatch, fmt.Sprintf("/tickets/%s/assign", mine),
+		openapi.AssignTicketRequest{AssigneeId: &agentID}, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	alpha := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Alpha"}, "").Id
+	beta := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Beta"}, "").Id
+
+	assignedToMe := true
+	sortBy := openapi.ViewSortByTitle
+	sortOrder := openapi.ViewSortOrderAsc
+	mineView := s.createTestView(openapi.CreateTicketViewRequest{
+		Name:   "Assigned to me",
+		Filter: &openapi.TicketViewFilter{AssignedToMe: &assignedToMe},
+	}, agentToken)
+	queueView := s.createTestView(openapi.CreateTicketViewRequest{
+		Name:      "Queue",
+		Filter:    &openapi.TicketViewFilter{OrganizationId: &orgID, Unassigned: &assignedToMe},
+		SortBy:    &sortBy,
+		SortOrder: &sortOrder,
+	}, agentToken)
+
+	s.Run("Views run with their filter and sort order", func() {
+		s.Equal([]uuid.UUID{mine}, s.runView(mineView, agentToken))
+		s.Equal([]uuid.UUID{alpha, beta}, s.runView(queueView, agentToken))
+	})
+
+	s.Run("Counts are live", func() {
+		counts := func() map[uuid.UUID]int64 {
+			rec := s.RequestAs(http.MethodGet, "/views/counts", nil, agentToken)
+			s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+			var list []openapi.TicketViewCount
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+			result := make(map[uuid.UUID]int64, len(list))
+			for _, count := range list {
+				result[count.ViewId] = count.Count
+			}
+			return result
+		}
+		s.Equal(map[uuid.UUID]int64{mineView: 1, queueView: 2}, counts())
+
+		rec := s.RequestAs(http.MethodPatch, fmt.Sprintf("/tickets/%s/assign", alpha),
+			openapi.AssignTicketRequest{AssigneeId: &agentID}, "")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		s.Equal(map[uuid.UUID]int64{mineView: 2, queueView: 1}, counts())
+	})
+
+	s.Run("Private views cannot be run by other users", func() {
+		rec := s.RequestAs(http.MethodGet, fmt.Sprintf("/views/%s/tickets", mineView), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestRunViewsAsCustomer() {
+	orgID := s.createAssignmentTestOrganization("Views Customers Org")
+	authorID, token := s.createOrganizationCustomer("views-customer@example.com", orgID)
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "Monitor flickers",
+		Description:    "The monitor flickers after lunch",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var own openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &own))
+	s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Someone else's ticket"}, "")
+
+	viewID := s.createTestView(openapi.CreateTicketViewRequest{
+		Name:   "Organization tickets",
+		Filter: &openapi.TicketViewFilter{OrganizationId: &orgID},
+	}, token)
+	s.Equal([]uuid.UUID{*own.Id}, s.runView(viewID, token))
+}
@@ -0,0 +1,162 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var errInvalidWatcher = errors.New("user cannot watch the ticket")
+
+func (h TicketHandlers) GetTicketsIDWatchers(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	ticket, err := h.readableTicket(ctx, id, authUserID, role)
+	if err != nil {
+		return h.handleWatcherError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, convertWatchersToResponse(ticket.Watchers()))
+}
+
+func (h TicketHandlers) PostTicketsIDWatchers(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.AddTicketWatcherRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	watcherID := authUserID
+	if req.UserId != nil {
+		watcherID = *req.UserId
+	}
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleWatcherError(c, err)
+	}
+	// Customers cannot subscribe to tickets of others; the author may CC colleagues of the organization
+	if !hasElevatedTicketAccess(role) && ticket.AuthorID() != authUserID {
+		return h.handleWatcherError(c, tickets.ErrUnauthorizedAccess)
+	}
+	if err = h.validateWatcher(ctx, ticket, watcherID); err != nil {
+		return h.handleWatcherError(c, err)
+	}
+
+	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		if addErr := ticket.AddWatcher(watcherID); addErr != nil {
+			return false, addErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWatcherError(c, err)
+	}
+
+	for _, watcher := range ticket.Watchers() {
+		if watcher.UserID == watcherID {
+			return c.JSON(http.StatusCreated, convertWatcherToResponse(watcher))
+		}
+	}
+	return h.handleWatcherError(c, tickets.ErrWatcherNotFound)
+}
+
+func (h TicketHandlers) DeleteTicketsIDWatchersUserID(
+	c echo.Context, id openapi_types.UUID, userID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+	if !hasElevatedTicketAccess(role) && userID != authUserID {
+		return h.handleWatcherError(c, tickets.ErrUnauthorizedAccess)
+	}
+
+	_, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		if removeErr := ticket.RemoveWatcher(userID); removeErr != nil {
+			return false, removeErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWatcherError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// validateWatcher checks that the user exists and is active.
+// Customers may only watch tickets of their own organization.
+func (h TicketHandlers) validateWatcher(ctx context.Context, ticket *tickets.Ticket, userID uuid.UUID) error {
+	if h.userRepo == nil {
+		return nil
+	}
+
+	user, err := h.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsActive() {
+		return fmt.Errorf("%w: user %s is inactive", errInvalidWatcher, userID)
+	}
+	if user.Role() == userdomain.RoleCustomer && !isOrganizationMember(user, ticket.OrganizationID()) {
+		return fmt.Errorf("%w: customer %s belongs to another organization", errInvalidWatcher, userID)
+	}
+	return nil
+}
+
+func convertWatchersToResponse(watchers []tickets.Watcher) []openapi.TicketWatcher {
+	response := make([]openapi.TicketWatcher, 0, len(watchers))
+	for _, watcher := range watchers {
+		response = append(response, convertWatcherToResponse(watcher))
+	}
+	return response
+}
+
+func convertWatcherToResponse(watcher tickets.Watcher) openapi.TicketWatcher {
+	userID := watcher.UserID
+	addedAt := watcher.AddedAt
+	return openapi.TicketWatcher{
+		UserId:  &userID,
+		AddedBy: watcher.AddedBy,
+		AddedAt: &addedAt,
+	}
+}
+
+func (h TicketHandlers) handleWatcherError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) || errors.Is(err, tickets.ErrWatcherNotFound) ||
+		errors.Is(err, userdomain.ErrUserNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrUnauthorizedAccess) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if errors.Is(err, tickets.ErrWatcherExists) || errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, errInvalidWatcher) || errors.Is(err, tickets.ErrTicketValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
@@ -0,0 +1,153 @@
+package tickets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+func (s *TicketsSuite) createOrganizationCustomer(email string, orgID uuid.UUID) (uuid.UUID, string) {
+	userID, token := s.LoginAs(email, openapi.Customer)
+	_, err := s.UsersRepo.UpdateUser(context.Background(), userID, func(user *users.User) (bool, error) {
+		return true, user.ChangeOrganization(&orgID)
+	})
+	s.Require().NoError(err)
+	return userID, token
+}
+
+func (s *TicketsSuite) TestTicketWatchers() {
+	orgID := s.createAssignmentTestOrganization("Watchers Org")
+	authorID, authorToken := s.createOrganizationCustomer("watchers-author@example.com", orgID)
+	colleagueID, colleagueToken := s.createOrganizationCustomer("watchers-colleague@example.com", orgID)
+	outsiderID, _ := s.createOrganizationCustomer("watchers-outsider@example.com", uuid.New())
+
+	rec := s.RequestAs(http.MethodPost, "/tickets", openapi.CreateTicketRequest{
+		Title:          "VPN does not connect",
+		Description:    "VPN client fails with a timeout",
+		Priority:       openapi.TicketPriority("normal"),
+		OrganizationId: orgID,
+		AuthorId:       authorID,
+	}, authorToken)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var created openapi.GetTicketResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	ticketPath := fmt.Sprintf("/tickets/%s", *created.Id)
+	watchersPath := ticketPath + "/watchers"
+
+	internal := true
+	s.postComment(*created.Id, "We are looking into it", "")
+	s.sendTicketRequest(http.MethodPost, ticketPath+"/comments", openapi.CreateCommentRequest{
+		Content:    "Probably the firewall",
+		IsInternal: &internal,
+	})
+
+	s.Run("Customers cannot read or subscribe to tickets of others", func() {
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, ticketPath, nil, colleagueToken).Code)
+		s.Equal(http.StatusForbidden,
+			s.RequestAs(http.MethodPost, watchersPath, openapi.AddTicketWatcherRequest{}, colleagueToken).Code)
+	})
+
+	s.Run("Author cannot add customers of another organization", func() {
+		rec := s.RequestAs(http.MethodPost, watchersPath, openapi.AddTicketWatcherRequest{UserId: &outsiderID},
+			authorToken)
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		rec = s.RequestAs(http.MethodPost, watchersPath, openapi.AddTicketWatcherRequest{UserId: ptrUUID(uuid.New())},
+			authorToken)
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Author adds a colleague to the CC list", func() {
+		rec := s.RequestAs(http.MethodPost, watchersPath, openapi.AddTicketWatcherRequest{UserId: &colleagueID},
+			authorToken)
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		var watcher openapi.TicketWatcher
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &watcher))
+		s.Equal(colleagueID, *watcher.UserId)
+		s.Equal(authorID, *watcher.AddedBy)
+
+		rec = s.RequestAs(http.MethodPost, watchersPath, openapi.AddTicketWatcherRequest{UserId: &colleagueID},
+			authorToken)
+		s.Equal(http.StatusConflict, rec.Code)
+	})
+
+	s.Run("Watcher reads the ticket and its public comments", func() {
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, ticketPath, nil, colleagueToken).Code)
+
+		rec := s.RequestAs(http.MethodGet, ticketPath+"/comments", nil, colleagueToken)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var comments []openapi.TicketComment
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &comments))
+		s.Require().Len(comments, 1)
+		s.Equal("We are looking into it", *comments[0].Content)
+
+		s.Equal(http.StatusForbidden,
+			s.RequestAs(http.MethodGet, ticketPath+"/comments?include_internal=true", nil, colleagueToken).Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodPut, ticketPath,
+			openapi.UpdateTicketRequest{Title: ptrString("Changed by a watcher")}, colleagueToken).Code,
+			"watchers have read-only access")
+	})
+
+	s.Run("Watched tickets are listed with watcher_id", func() {
+		rec := s.RequestAs(http.MethodGet, "/tickets?watcher_id="+colleagueID.String(), nil, colleagueToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var list openapi.ListTicketsResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+		s.Require().Len(*list.Tickets, 1)
+		s.Equal(*created.Id, *(*list.Tickets)[0].Id)
+
+		rec = s.RequestAs(http.MethodGet, "/tickets?watcher_id="+colleagueID.String(), nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+		s.Len(*list.Tickets, 1)
+	})
+
+	s.Run("Watcher loses access after moving to another organization", func() {
+		otherOrgID := uuid.New()
+		_, err := s.UsersRepo.UpdateUser(context.Background(), colleagueID, func(user *users.User) (bool, error) {
+			return true, user.ChangeOrganization(&otherOrgID)
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, ticketPath, nil, colleagueToken).Code)
+
+		_, err = s.UsersRepo.UpdateUser(context.Background(), colleagueID, func(user *users.User) (bool, error) {
+			return true, user.ChangeOrganization(&orgID)
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("Watchers unsubscribe themselves", func() {
+		s.Equal(http.StatusForbidden,
+			s.RequestAs(http.MethodDelete, watchersPath+"/"+authorID.String(), nil, colleagueToken).Code)
+
+		rec := s.RequestAs(http.MethodDelete, watchersPath+"/"+colleagueID.String(), nil, colleagueToken)
+		s.Require().Equal(http.StatusNoContent, rec.Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, ticketPath, nil, colleagueToken).Code)
+		s.Equal(http.StatusNotFound,
+			s.RequestAs(http.MethodDelete, watchersPath+"/"+colleagueID.String(), nil, "").Code)
+	})
+
+	s.Run("Agents subscribe themselves", func() {
+		rec := s.RequestAs(http.MethodPost, watchersPath, nil, "")
+		s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		rec = s.RequestAs(http.MethodGet, watchersPath, nil, authorToken)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var watchers []openapi.TicketWatcher
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &watchers))
+		s.Len(watchers, 1)
+	})
+}
+
+func ptrUUID(id uuid.UUID) *uuid.UUID {
+	return &id
+}
+
+func ptrString(value string) *string {
+	return &value
+}
@@ -0,0 +1,248 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
+	userdomain "simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var errInvalidWorkLogAgent = errors.New("user cannot log time")
+
+func (h TicketHandlers) GetTicketsIDWorklogs(c echo.Context, id openapi_types.UUID) error {
+	ticket, err := h.repo.GetTicket(c.Request().Context(), id)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	workLogs := ticket.WorkLogs()
+	slices.SortStableFunc(workLogs, func(a, b tickets.WorkLog) int {
+		return a.Date.Compare(b.Date)
+	})
+	response := make([]openapi.TicketWorkLog, 0, len(workLogs))
+	for _, workLog := range workLogs {
+		response = append(response, convertWorkLogToResponse(workLog))
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h TicketHandlers) PostTicketsIDWorklogs(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.WorkLogRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	entry, err := h.workLogEntryFromRequest(ctx, req, authUserID, authUserID, role)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	var workLog tickets.WorkLog
+	_, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		var addErr error
+		workLog, addErr = ticket.AddWorkLog(entry)
+		return addErr == nil, addErr
+	})
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, convertWorkLogToResponse(workLog))
+}
+
+func (h TicketHandlers) PutTicketsIDWorklogsWorklogID(
+	c echo.Context, id openapi_types.UUID, worklogID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.WorkLogRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ticket, err := h.repo.GetTicket(ctx, id)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+	workLog, err := ticket.WorkLog(worklogID)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+	if !isOwnerOrAdmin(workLog.AgentID, authUserID, role) {
+		return h.handleWorkLogError(c, tickets.ErrUnauthorizedAccess)
+	}
+	entry, err := h.workLogEntryFromRequest(ctx, req, workLog.AgentID, authUserID, role)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	ticket, err = h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		current, findErr := ticket.WorkLog(worklogID)
+		if findErr != nil {
+			return false, findErr
+		}
+		if !isOwnerOrAdmin(current.AgentID, authUserID, role) {
+			return false, tickets.ErrUnauthorizedAccess
+		}
+		if updateErr := ticket.UpdateWorkLog(worklogID, entry); updateErr != nil {
+			return false, updateErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	workLog, err = ticket.WorkLog(worklogID)
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+	return c.JSON(http.StatusOK, convertWorkLogToResponse(workLog))
+}
+
+func (h TicketHandlers) DeleteTicketsIDWorklogsWorklogID(
+	c echo.Context, id openapi_types.UUID, worklogID openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+	authUserID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	_, err := h.repo.UpdateTicket(ctx, id, func(ticket *tickets.Ticket) (bool, error) {
+		ticket.ActAs(authUserID)
+		workLog, findErr := ticket.WorkLog(worklogID)
+		if findErr != nil {
+			return false, findErr
+		}
+		if !isOwnerOrAdmin(workLog.AgentID, authUserID, role) {
+			return false, tickets.ErrUnauthorizedAccess
+		}
+		if removeErr := ticket.RemoveWorkLog(worklogID); removeErr != nil {
+			return false, removeErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return h.handleWorkLogError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// workLogEntryFromRequest builds a work log entry for defaultAgentID unless the request names another agent.
+// Only admins may log time on behalf of someone else.
+func (h TicketHandlers) workLogEntryFromRequest(
+	ctx context.Context,
+	req openapi.WorkLogRequest,
+	defaultAgentID, authUserID uuid.UUID,
+	role userdomain.Role,
+) (tickets.WorkLogEntry, error) {
+	agentID := defaultAgentID
+	if req.AgentId != nil {
+		agentID = *req.AgentId
+	}
+	if !isOwnerOrAdmin(agentID, authUserID, role) {
+		return tickets.WorkLogEntry{}, tickets.ErrUnauthorizedAccess
+	}
+	if agentID != defaultAgentID {
+		if err := h.validateWorkLogAgent(ctx, agentID); err != nil {
+			return tickets.WorkLogEntry{}, err
+		}
+	}
+
+	entry := tickets.WorkLogEntry{
+		AgentID: agentID,
+		Date:    req.Date.Time,
+		Minutes: req.Minutes,
+	}
+	if req.Billable != nil {
+		entry.Billable = *req.Billable
+	}
+	if req.Note != nil {
+		entry.Note = *req.Note
+	}
+	return entry, nil
+}
+
+// validateWorkLogAgent checks that the user is an active agent or admin
+func (h TicketHandlers) validateWorkLogAgent(ctx context.Context, userID uuid.UUID) error {
+	if h.userRepo == nil {
+		return nil
+	}
+
+	user, err := h.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsActive() || !hasElevatedTicketAccess(user.Role()) {
+		return fmt.Errorf("%w: user %s is not an active agent", errInvalidWorkLogAgent, userID)
+	}
+	return nil
+}
+
+func convertWorkLogToResponse(workLog tickets.WorkLog) openapi.TicketWorkLog {
+	response := openapi.TicketWorkLog{
+		Id:        workLog.ID,
+		AgentId:   workLog.AgentID,
+		Date:      openapi_types.Date{Time: workLog.Date},
+		Minutes:   workLog.Minutes,
+		Billable:  workLog.Billable,
+		CreatedBy: workLog.CreatedBy,
+		CreatedAt: workLog.CreatedAt,
+		UpdatedAt: workLog.UpdatedAt,
+	}
+	if workLog.Note != "" {
+		note := workLog.Note
+		response.Note = &note
+	}
+	return response
+}
+
+func convertTimeSpentToResponse(timeSpent tickets.TimeSpent) *openapi.TicketTimeSpent {
+	if timeSpent.Minutes == 0 {
+		return nil
+	}
+	return &openapi.TicketTimeSpent{
+		Minutes:         timeSpent.Minutes,
+		BillableMinutes: timeSpent.BillableMinutes,
+	}
+}
+
+func (h TicketHandlers) handleWorkLogError(c echo.Context, err error) error {
+	msg := err.Error()
+	if errors.Is(err, tickets.ErrTicketNotFound) || errors.Is(err, tickets.ErrWorkLogNotFound) ||
+		errors.Is(err, userdomain.ErrUserNotFound) {
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, tickets.ErrUnauthorizedAccess) {
+		return c.NoContent(http.StatusForbidden)
+	}
+	if errors.Is(err, tickets.ErrConcurrentUpdate) {
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, errInvalidWorkLogAgent) || errors.Is(err, tickets.ErrTicketValidation) {
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+}
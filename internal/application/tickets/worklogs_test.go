@@ -0,0 +1,184 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func workLogRequest(minutes int, billable bool) openapi.WorkLogRequest {
+	return openapi.WorkLogRequest{
+		Date:     openapi_types.Date{Time: time.Now()},
+		Minutes:  minutes,
+		Billable: &billable,
+	}
+}
+
+func (s *TicketsSuite) postWorkLog(ticketID uuid.UUID, req openapi.WorkLogRequest, token string) openapi.TicketWorkLog {
+	rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/worklogs", ticketID), req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var workLog openapi.TicketWorkLog
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &workLog))
+	return workLog
+}
+
+func (s *TicketsSuite) TestTicketWorkLogs() {
+	orgID := s.createAssignmentTestOrganization("Work Log Org")
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "Printer jams"}, "").Id
+	agentID, agentToken := s.LoginAs("worklog-agent@example.com", openapi.Agent)
+	_, otherAgentToken := s.LoginAs("worklog-other@example.com", openapi.Agent)
+	path := fmt.Sprintf("/tickets/%s/worklogs", ticketID)
+
+	note := "Cleaned the rollers"
+	req := workLogRequest(90, true)
+	req.Note = &note
+	workLog := s.postWorkLog(ticketID, req, agentToken)
+	s.Equal(agentID, workLog.AgentId)
+	s.Equal(agentID, workLog.CreatedBy)
+	s.Equal(90, workLog.Minutes)
+	s.True(workLog.Billable)
+	s.Equal(note, *workLog.Note)
+	s.postWorkLog(ticketID, workLogRequest(30, false), otherAgentToken)
+
+	s.Run("Ticket shows the totals", func() {
+		ticket := s.getTicketResponse(ticketID)
+		s.Require().NotNil(ticket.TimeSpent)
+		s.Equal(int64(120), ticket.TimeSpent.Minutes)
+		s.Equal(int64(90), ticket.TimeSpent.BillableMinutes)
+	})
+
+	s.Run("Entries are listed", func() {
+		rec := s.RequestAs(http.MethodGet, path, nil, agentToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var list []openapi.TicketWorkLog
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+		s.Len(list, 2)
+	})
+
+	s.Run("Only the agent or an admin changes an entry", func() {
+		entryPath := fmt.Sprintf("%s/%s", path, workLog.Id)
+		rec := s.RequestAs(http.MethodPut, entryPath, workLogRequest(60, true), otherAgentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+		rec = s.RequestAs(http.MethodDelete, entryPath, nil, otherAgentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+
+		rec = s.RequestAs(http.MethodPut, entryPath, workLogRequest(60, true), agentToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var updated openapi.TicketWorkLog
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &updated))
+		s.Equal(60, updated.Minutes)
+		s.Nil(updated.Note)
+		s.NotNil(updated.UpdatedAt)
+		s.Equal(int64(90), s.getTicketResponse(ticketID).TimeSpent.Minutes)
+
+		rec = s.RequestAs(http.MethodDelete, entryPath, nil, "")
+		s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+		rec = s.RequestAs(http.MethodDelete, entryPath, nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+		s.Equal(int64(30), s.getTicketResponse(ticketID).TimeSpent.Minutes)
+	})
+
+	s.Run("Work log events are internal", func() {
+		code, history := s.getTicketHistory(ticketID, "")
+		s.Require().Equal(http.StatusOK, code)
+		events := eventTypes(history.Events)
+		s.Contains(events, openapi.WorklogAdded)
+		s.Contains(events, openapi.WorklogUpdated)
+		s.Contains(events, openapi.WorklogDeleted)
+	})
+}
+
+func (s *TicketsSuite) TestTicketWorkLogsPermissions() {
+	orgID := s.createAssignmentTestOrganization("Work Log Org")
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID, Title: "VPN is down"}, "").Id
+	_, agentToken := s.LoginAs("worklog-perm-agent@example.com", openapi.Agent)
+	_, customerToken := s.createOrganizationCustomer("worklog-customer@example.com", orgID)
+	otherAgentID := s.createAssignmentTestUser(orgID, users.RoleAgent, true)
+	customerID := s.createAssignmentTestUser(orgID, users.RoleCustomer, true)
+	path := fmt.Sprintf("/tickets/%s/worklogs", ticketID)
+
+	s.Run("Customers cannot see or log time", func() {
+		rec := s.RequestAs(http.MethodGet, path, nil, customerToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+		rec = s.RequestAs(http.MethodPost, path, workLogRequest(15, true), customerToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	s.Run("Only admins log time for another agent", func() {
+		req := workLogRequest(45, true)
+		req.AgentId = &otherAgentID
+		rec := s.RequestAs(http.MethodPost, path, req, agentToken)
+		s.Equal(http.StatusForbidden, rec.Code)
+
+		workLog := s.postWorkLog(ticketID, req, "")
+		s.Equal(otherAgentID, workLog.AgentId)
+		s.NotEqual(otherAgentID, workLog.CreatedBy)
+	})
+
+	s.Run("Time is logged only for agents", func() {
+		req := workLogRequest(45, true)
+		req.AgentId = &customerID
+		rec := s.RequestAs(http.MethodPost, path, req, "")
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		missingID := uuid.New()
+		req.AgentId = &missingID
+		rec = s.RequestAs(http.MethodPost, path, req, "")
+		s.Equal(http.StatusNotFound, rec.Code, rec.Body.String())
+	})
+
+	s.Run("Invalid entries are rejected", func() {
+		rec := s.RequestAs(http.MethodPost, path, workLogRequest(0, false), agentToken)
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		future := workLogRequest(30, false)
+		future.Date = openapi_types.Date{Time: time.Now().AddDate(0, 0, 7)}
+		rec = s.RequestAs(http.MethodPost, path, future, agentToken)
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+		rec = s.RequestAs(http.MethodPost, fmt.Sprintf("/tickets/%s/worklogs", uuid.New()),
+			workLogRequest(30, false), agentToken)
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+}
+
+func (s *TicketsSuite) TestTicketTimeSpentHiddenFromCustomers() {
+	orgID := s.createAssignmentTestOrganization("Time Spent Org")
+	_, customerToken := s.createOrganizationCustomer("time-spent-customer@example.com", orgID)
+	_, agentToken := s.LoginAs("time-spent-agent@example.com", openapi.Agent)
+	ticketID := *s.CreateTicket(openapi.CreateTicketRequest{OrganizationId: orgID}, customerToken).Id
+	s.postWorkLog(ticketID, workLogRequest(45, true), agentToken)
+
+	getTicket := func(token string) openapi.GetTicketResponse {
+		rec := s.RequestAs(http.MethodGet, fmt.Sprintf("/tickets/%s", ticketID), nil, token)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var ticket openapi.GetTicketResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &ticket))
+		return ticket
+	}
+
+	s.Run("Customers do not see the totals of internal work logs", func() {
+		s.Nil(getTicket(customerToken).TimeSpent)
+
+		rec := s.RequestAs(http.MethodGet, "/tickets", nil, customerToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var list openapi.ListTicketsResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+		s.Require().Len(*list.Tickets, 1)
+		s.Nil((*list.Tickets)[0].TimeSpent)
+	})
+
+	s.Run("Agents see the totals", func() {
+		ticket := getTicket(agentToken)
+		s.Require().NotNil(ticket.TimeSpent)
+		s.Equal(int64(45), ticket.TimeSpent.Minutes)
+	})
+}
@@ -0,0 +1,67 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+type TicketRepository interface {
+	UpdateTicket(
+		ctx context.Context,
+		id uuid.UUID,
+		updateFn func(*tickets.Ticket) (bool, error),
+	) (*tickets.Ticket, error)
+	ListDeletedTickets(ctx context.Context, filter queries.TrashFilter) ([]*tickets.Ticket, error)
+	CountDeletedTickets(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreTicket(ctx context.Context, id uuid.UUID) error
+	PurgeTicket(ctx context.Context, id uuid.UUID) (*tickets.Ticket, error)
+}
+
+type CategoryRepository interface {
+	ListDeletedCategories(ctx context.Context, filter queries.TrashFilter) ([]*categories.Category, error)
+	CountDeletedCategories(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreCategory(ctx context.Context, id uuid.UUID) error
+	PurgeCategory(ctx context.Context, id uuid.UUID) error
+}
+
+type OrganizationRepository interface {
+	ListDeletedOrganizations(ctx context.Context, filter queries.TrashFilter) ([]*organizations.Organization, error)
+	CountDeletedOrganizations(ctx context.Context, filter queries.TrashFilter) (int64, error)
+	RestoreOrganization(ctx context.Context, id uuid.UUID) error
+	PurgeOrganization(ctx context.Context, id uuid.UUID) error
+}
+
+type BlobStore interface {
+	Delete(ctx context.Context, key string) error
+}
+
+type TrashHandlers struct {
+	ticketRepo   TicketRepository
+	categoryRepo CategoryRepository
+	orgRepo      OrganizationRepository
+	janitor      *Janitor
+	retention    time.Duration
+}
+
+func SetupHandlers(
+	ticketRepo TicketRepository,
+	categoryRepo CategoryRepository,
+	orgRepo OrganizationRepository,
+	blobStore BlobStore,
+	retention time.Duration,
+) TrashHandlers {
+	return TrashHandlers{
+		ticketRepo:   ticketRepo,
+		categoryRepo: categoryRepo,
+		orgRepo:      orgRepo,
+		janitor:      NewJanitor(ticketRepo, categoryRepo, orgRepo, blobStore, retention),
+		retention:    retention,
+	}
+}
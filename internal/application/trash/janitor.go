@@ -0,0 +1,180 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+// JobName identifies the trash retention job and its lease
+const JobName = "trash-retention"
+
+const pageSize = 200
+
+// Janitor permanently removes trashed items together with the data that only they reference
+type Janitor struct {
+	ticketRepo   TicketRepository
+	categoryRepo CategoryRepository
+	orgRepo      OrganizationRepository
+	blobStore    BlobStore
+	retention    time.Duration
+}
+
+func NewJanitor(
+	ticketRepo TicketRepository,
+	categoryRepo CategoryRepository,
+	orgRepo OrganizationRepository,
+	blobStore BlobStore,
+	retention time.Duration,
+) *Janitor {
+	return &Janitor{
+		ticketRepo:   ticketRepo,
+		categoryRepo: categoryRepo,
+		orgRepo:      orgRepo,
+		blobStore:    blobStore,
+		retention:    retention,
+	}
+}
+
+// PurgeExpired purges items that have stayed in the trash longer than the retention period.
+// Items are purged in the order they were deleted, so subcategories and child organizations
+// go before their parents. A parent whose children are not due yet waits for the next run.
+// A failure on one item does not stop the run; all failures are returned together.
+func (j *Janitor) PurgeExpired(ctx context.Context, now time.Time) error {
+	deletedBefore := now.Add(-j.retention)
+	filter := queries.TrashFilter{
+		BaseFilter: queries.BaseFilter{
+			Limit:     pageSize,
+			SortBy:    "deleted_at",
+			SortOrder: "asc",
+		},
+		DeletedBefore: &deletedBefore,
+	}
+
+	return errors.Join(
+		j.purgeExpiredTickets(ctx, filter),
+		j.purgeExpiredCategories(ctx, filter),
+		j.purgeExpiredOrganizations(ctx, filter),
+	)
+}
+
+func (j *Janitor) purgeExpiredTickets(ctx context.Context, filter queries.TrashFilter) error {
+	var errs []error
+	for {
+		page, err := j.ticketRepo.ListDeletedTickets(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list expired tickets: %w", err)
+		}
+
+		// Purged tickets leave the trash, so the next page starts after the ones left behind
+		for _, ticket := range page {
+			if purgeErr := j.PurgeTicket(ctx, ticket.ID()); purgeErr != nil {
+				errs = append(errs, fmt.Errorf("failed to purge ticket %s: %w", ticket.ID(), purgeErr))
+				filter.Offset++
+			}
+		}
+
+		if len(page) < filter.Limit {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+func (j *Janitor) purgeExpiredCategories(ctx context.Context, filter queries.TrashFilter) error {
+	var errs []error
+	for {
+		page, err := j.categoryRepo.ListDeletedCategories(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list expired categories: %w", err)
+		}
+
+		for _, category := range page {
+			purgeErr := j.categoryRepo.PurgeCategory(ctx, category.ID())
+			if purgeErr == nil {
+				continue
+			}
+			if !errors.Is(purgeErr, categories.ErrCategoryHasChildren) {
+				errs = append(errs, fmt.Errorf("failed to purge category %s: %w", category.ID(), purgeErr))
+			}
+			filter.Offset++
+		}
+
+		if len(page) < filter.Limit {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+func (j *Janitor) purgeExpiredOrganizations(ctx context.Context, filter queries.TrashFilter) error {
+	var errs []error
+	for {
+		page, err := j.orgRepo.ListDeletedOrganizations(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list expired organizations: %w", err)
+		}
+
+		for _, org := range page {
+			purgeErr := j.orgRepo.PurgeOrganization(ctx, org.ID())
+			if purgeErr == nil {
+				continue
+			}
+			if !errors.Is(purgeErr, organizations.ErrOrganizationHasChildren) {
+				errs = append(errs, fmt.Errorf("failed to purge organization %s: %w", org.ID(), purgeErr))
+			}
+			filter.Offset++
+		}
+
+		if len(page) < filter.Limit {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+// PurgeTicket permanently removes a trashed ticket together with its attachments
+// and the links of related tickets to it
+func (j *Janitor) PurgeTicket(ctx context.Context, id uuid.UUID) error {
+	ticket, err := j.ticketRepo.PurgeTicket(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The ticket is already gone, so leftovers are logged rather than reported
+	for _, attachment := range ticket.Attachments() {
+		deleteErr := j.blobStore.Delete(ctx, attachment.FilePath)
+		if deleteErr != nil && !errors.Is(deleteErr, tickets.ErrAttachmentNotFound) {
+			slog.WarnContext(ctx, "failed to delete attachment blob", "key", attachment.FilePath, "error", deleteErr)
+		}
+	}
+	for _, relation := range ticket.Relations() {
+		unlinkErr := j.unlinkTicket(ctx, relation.TicketID, relation.Type.Inverse(), ticket.ID())
+		if unlinkErr != nil && !errors.Is(unlinkErr, tickets.ErrTicketNotFound) {
+			slog.WarnContext(ctx, "failed to remove relation of a purged ticket",
+				"ticket_id", relation.TicketID, "related_ticket_id", ticket.ID(), "error", unlinkErr)
+		}
+	}
+	return nil
+}
+
+func (j *Janitor) unlinkTicket(
+	ctx context.Context,
+	ticketID uuid.UUID,
+	relationType tickets.RelationType,
+	relatedID uuid.UUID,
+) error {
+	_, err := j.ticketRepo.UpdateTicket(ctx, ticketID, func(ticket *tickets.Ticket) (bool, error) {
+		if !ticket.HasRelation(relationType, relatedID) {
+			return false, nil
+		}
+		return true, ticket.RemoveRelation(relationType, relatedID)
+	})
+	return err
+}
@@ -0,0 +1,22 @@
+package trash_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TrashSuite struct {
+	application.ServerSuite
+}
+
+func (s *TrashSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestTrashSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TrashSuite))
+}
@@ -0,0 +1,191 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var errUnknownType = errors.New("unknown trash item type")
+
+func (h TrashHandlers) GetTrash(c echo.Context, params openapi.GetTrashParams) error {
+	ctx := c.Request().Context()
+
+	filter, err := queries.FromOpenAPITrashParams(params)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	items, total, err := h.listItems(ctx, params.Type, filter)
+	if err != nil {
+		return h.handleTrashError(c, err)
+	}
+
+	page := 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	limit := filter.Limit
+	totalInt := int(total)
+	hasNext := filter.Offset+len(items) < totalInt
+
+	return c.JSON(http.StatusOK, openapi.ListTrashResponse{
+		Items: &items,
+		Pagination: &openapi.PaginationResponse{
+			Total:   &totalInt,
+			Page:    &page,
+			Limit:   &limit,
+			HasNext: &hasNext,
+		},
+	})
+}
+
+func (h TrashHandlers) PostTrashTypeIDRestore(
+	c echo.Context,
+	itemType openapi.TrashItemType,
+	id openapi_types.UUID,
+) error {
+	ctx := c.Request().Context()
+
+	var err error
+	switch itemType {
+	case openapi.TrashItemTicket:
+		err = h.ticketRepo.RestoreTicket(ctx, id)
+	case openapi.TrashItemCategory:
+		err = h.categoryRepo.RestoreCategory(ctx, id)
+	case openapi.TrashItemOrganization:
+		err = h.orgRepo.RestoreOrganization(ctx, id)
+	default:
+		err = fmt.Errorf("%w: %s", errUnknownType, itemType)
+	}
+	if err != nil {
+		return h.handleTrashError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h TrashHandlers) DeleteTrashTypeID(c echo.Context, itemType openapi.TrashItemType, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+
+	var err error
+	switch itemType {
+	case openapi.TrashItemTicket:
+		err = h.janitor.PurgeTicket(ctx, id)
+	case openapi.TrashItemCategory:
+		err = h.categoryRepo.PurgeCategory(ctx, id)
+	case openapi.TrashItemOrganization:
+		err = h.orgRepo.PurgeOrganization(ctx, id)
+	default:
+		err = fmt.Errorf("%w: %s", errUnknownType, itemType)
+	}
+	if err != nil {
+		return h.handleTrashError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// listItems returns a page of trashed items of the type together with their total count
+func (h TrashHandlers) listItems(
+	ctx context.Context,
+	itemType openapi.TrashItemType,
+	filter queries.TrashFilter,
+) ([]openapi.TrashItem, int64, error) {
+	items := []openapi.TrashItem{}
+	var total int64
+	switch itemType {
+	case openapi.TrashItemTicket:
+		list, err := h.ticketRepo.ListDeletedTickets(ctx, filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, ticket := range list {
+			orgID := ticket.OrganizationID()
+			items = append(items, h.toTrashItem(itemType, ticket.ID(), ticket.Title(), &orgID,
+				ticket.DeletedAt(), ticket.DeletedBy()))
+		}
+		total, err = h.ticketRepo.CountDeletedTickets(ctx, filter)
+		return items, total, err
+	case openapi.TrashItemCategory:
+		list, err := h.categoryRepo.ListDeletedCategories(ctx, filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, category := range list {
+			orgID := category.OrganizationID()
+			items = append(items, h.toTrashItem(itemType, category.ID(), category.Name(), &orgID,
+				category.DeletedAt(), category.DeletedBy()))
+		}
+		total, err = h.categoryRepo.CountDeletedCategories(ctx, filter)
+		return items, total, err
+	case openapi.TrashItemOrganization:
+		list, err := h.orgRepo.ListDeletedOrganizations(ctx, filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, org := range list {
+			items = append(items, h.toTrashItem(itemType, org.ID(), org.Name(), nil, org.DeletedAt(), org.DeletedBy()))
+		}
+		total, err = h.orgRepo.CountDeletedOrganizations(ctx, filter)
+		return items, total, err
+	}
+	return nil, 0, fmt.Errorf("%w: %s", errUnknownType, itemType)
+}
+
+func (h TrashHandlers) toTrashItem(
+	itemType openapi.TrashItemType,
+	id uuid.UUID,
+	name string,
+	orgID *uuid.UUID,
+	deletedAt *time.Time,
+	deletedBy *uuid.UUID,
+) openapi.TrashItem {
+	item := openapi.TrashItem{
+		Id:             id,
+		Type:           itemType,
+		Name:           name,
+		OrganizationId: orgID,
+		DeletedBy:      deletedBy,
+	}
+	// Listed items are always trashed; the guard keeps a broken record from crashing the listing
+	if deletedAt != nil {
+		item.DeletedAt = *deletedAt
+		item.PurgeAt = deletedAt.Add(h.retention)
+	}
+	return item
+}
+
+func (h TrashHandlers) handleTrashError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, errUnknownType):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, tickets.ErrTicketNotFound),
+		errors.Is(err, categories.ErrCategoryNotFound),
+		errors.Is(err, organizations.ErrOrganizationNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, categories.ErrCategoryHasChildren),
+		errors.Is(err, categories.ErrParentInTrash),
+		errors.Is(err, categories.ErrCategoryAlreadyExist),
+		errors.Is(err, organizations.ErrOrganizationHasChildren),
+		errors.Is(err, organizations.ErrParentInTrash),
+		errors.Is(err, organizations.ErrOrganizationAlreadyExist):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
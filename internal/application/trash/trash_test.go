@@ -0,0 +1,225 @@
+package trash_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/application/trash"
+	"simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+const testRetention = 30 * 24 * time.Hour
+
+func (s *TrashSuite) createTicket(orgID uuid.UUID, title string) *tickets.Ticket {
+	ticket, err := s.TicketsRepo.CreateTicket(context.Background(), func() (*tickets.Ticket, error) {
+		return tickets.NewTicket(
+			uuid.New(), title, "Ticket for trash tests", tickets.PriorityNormal, orgID, uuid.New(), nil,
+		)
+	})
+	s.Require().NoError(err)
+	return ticket
+}
+
+func (s *TrashSuite) createCategory(orgID uuid.UUID, name string, parentID *uuid.UUID) uuid.UUID {
+	category, err := s.CategoriesRepo.CreateCategory(context.Background(), func() (*categories.Category, error) {
+		if parentID != nil {
+			return categories.CreateSubCategory(name, "", orgID, *parentID)
+		}
+		return categories.CreateRootCategory(name, "", orgID)
+	})
+	s.Require().NoError(err)
+	return category.ID()
+}
+
+func (s *TrashSuite) listTrash(query string) openapi.ListTrashResponse {
+	rec := s.RequestAs(http.MethodGet, "/trash?"+query, nil, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list openapi.ListTrashResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	return list
+}
+
+func (s *TrashSuite) TestTicketTrash() {
+	orgID := uuid.New()
+	ticket := s.createTicket(orgID, "Printer is on fire")
+	other := s.createTicket(uuid.New(), "Another organization ticket")
+	ticketPath := fmt.Sprintf("/tickets/%s", ticket.ID())
+
+	s.Require().Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, ticketPath, nil, "").Code)
+	s.Require().Equal(http.StatusNoContent,
+		s.RequestAs(http.MethodDelete, fmt.Sprintf("/tickets/%s", other.ID()), nil, "").Code)
+
+	s.Run("Deleted tickets are hidden from regular queries", func() {
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, ticketPath, nil, "").Code)
+		rec := s.RequestAs(http.MethodGet, "/tickets", nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.NotContains(rec.Body.String(), ticket.ID().String())
+	})
+
+	s.Run("Trash lists deleted tickets with their deletion details", func() {
+		list := s.listTrash("type=ticket&organization_id=" + orgID.String())
+		s.Require().Len(*list.Items, 1)
+		item := (*list.Items)[0]
+		s.Equal(ticket.ID(), item.Id)
+		s.Equal("Printer is on fire", item.Name)
+		s.Equal(&orgID, item.OrganizationId)
+		s.Require().NotNil(item.DeletedBy)
+		s.Equal("00000000-0000-0000-0000-000000000001", item.DeletedBy.String())
+		s.Equal(item.DeletedAt.Add(testRetention), item.PurgeAt)
+		s.Equal(1, *list.Pagination.Total)
+
+		s.Len(*s.listTrash("type=ticket").Items, 2)
+	})
+
+	s.Run("Restored tickets are visible again", func() {
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/ticket/%s/restore", ticket.ID()), nil, "")
+		s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, ticketPath, nil, "").Code)
+		s.Empty(*s.listTrash("type=ticket&organization_id=" + orgID.String()).Items)
+
+		rec = s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/ticket/%s/restore", ticket.ID()), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("Purged tickets are gone for good", func() {
+		s.Require().Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, ticketPath, nil, "").Code)
+		rec := s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/ticket/%s", ticket.ID()), nil, "")
+		s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+		rec = s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/ticket/%s/restore", ticket.ID()), nil, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+		s.Equal(http.StatusNotFound,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/ticket/%s", ticket.ID()), nil, "").Code)
+	})
+}
+
+func (s *TrashSuite) TestCategoryTrash() {
+	orgID := uuid.New()
+	parentID := s.createCategory(orgID, "Hardware", nil)
+	childID := s.createCategory(orgID, "Printers", &parentID)
+
+	s.Run("Categories with subcategories cannot be deleted", func() {
+		rec := s.RequestAs(http.MethodDelete, fmt.Sprintf("/categories/%s", parentID), nil, "")
+		s.Equal(http.StatusConflict, rec.Code)
+	})
+
+	s.Require().Equal(http.StatusNoContent,
+		s.RequestAs(http.MethodDelete, fmt.Sprintf("/categories/%s", childID), nil, "").Code)
+	s.Require().Equal(http.StatusNoContent,
+		s.RequestAs(http.MethodDelete, fmt.Sprintf("/categories/%s", parentID), nil, "").Code)
+
+	s.Run("Subcategories wait for their parent to be restored", func() {
+		rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/category/%s/restore", childID), nil, "")
+		s.Equal(http.StatusConflict, rec.Code)
+
+		s.Require().Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/category/%s/restore", parentID), nil, "").Code)
+		s.Require().Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/category/%s/restore", childID), nil, "").Code)
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, fmt.Sprintf("/categories/%s", childID), nil, "").Code)
+	})
+
+	s.Run("Parents are purged after their subcategories", func() {
+		s.Require().Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/categories/%s", childID), nil, "").Code)
+		s.Require().Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/categories/%s", parentID), nil, "").Code)
+
+		s.Equal(http.StatusConflict,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/category/%s", parentID), nil, "").Code)
+		s.Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/category/%s", childID), nil, "").Code)
+		s.Equal(http.StatusNoContent,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/category/%s", parentID), nil, "").Code)
+		s.Empty(*s.listTrash("type=category").Items)
+	})
+}
+
+func (s *TrashSuite) TestOrganizationTrash() {
+	org, err := s.OrganizationsRepo.CreateOrganization(context.Background(),
+		func() (*organizations.Organization, error) {
+			return organizations.CreateRootOrganization("Trash Org", "trash.example.com")
+		})
+	s.Require().NoError(err)
+	orgPath := fmt.Sprintf("/organizations/%s", org.ID())
+
+	s.Require().Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, orgPath, nil, "").Code)
+	s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, orgPath, nil, "").Code)
+
+	list := s.listTrash("type=organization")
+	s.Require().Len(*list.Items, 1)
+	s.Equal("Trash Org", (*list.Items)[0].Name)
+	s.Nil((*list.Items)[0].OrganizationId)
+
+	rec := s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/organization/%s/restore", org.ID()), nil, "")
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+	s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, orgPath, nil, "").Code)
+}
+
+func (s *TrashSuite) TestTrashAccess() {
+	_, agentToken := s.LoginAs("trash-agent@example.com", openapi.Agent)
+
+	s.Run("Only admins manage the trash", func() {
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodGet, "/trash?type=ticket", nil, agentToken).Code)
+		s.Equal(http.StatusForbidden,
+			s.RequestAs(http.MethodPost, fmt.Sprintf("/trash/ticket/%s/restore", uuid.New()), nil, agentToken).Code)
+		s.Equal(http.StatusForbidden,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/ticket/%s", uuid.New()), nil, agentToken).Code)
+	})
+
+	s.Run("Unknown item types are rejected", func() {
+		s.Equal(http.StatusBadRequest, s.RequestAs(http.MethodGet, "/trash?type=user", nil, "").Code)
+		s.Equal(http.StatusBadRequest,
+			s.RequestAs(http.MethodDelete, fmt.Sprintf("/trash/user/%s", uuid.New()), nil, "").Code)
+	})
+}
+
+func (s *TrashSuite) TestPurgeExpired() {
+	ctx := context.Background()
+	janitor := trash.NewJanitor(s.TicketsRepo, s.CategoriesRepo, s.OrganizationsRepo, s.BlobStore, testRetention)
+
+	ticket := s.createTicket(uuid.New(), "Ticket with an attachment")
+	key := "attachments/" + ticket.ID().String()
+	_, err := s.BlobStore.Put(ctx, key, strings.NewReader("log output"))
+	s.Require().NoError(err)
+	_, err = s.TicketsRepo.UpdateTicket(ctx, ticket.ID(), func(ticket *tickets.Ticket) (bool, error) {
+		return true, ticket.AddAttachment("error.log", 10, "text/plain", key, uuid.New())
+	})
+	s.Require().NoError(err)
+
+	orgID := uuid.New()
+	parentID := s.createCategory(orgID, "Software", nil)
+	childID := s.createCategory(orgID, "Licenses", &parentID)
+
+	s.Require().NoError(s.TicketsRepo.DeleteTicket(ctx, ticket.ID(), uuid.New()))
+	s.Require().NoError(s.CategoriesRepo.DeleteCategory(ctx, childID, uuid.New()))
+	s.Require().NoError(s.CategoriesRepo.DeleteCategory(ctx, parentID, uuid.New()))
+
+	s.Run("Items within the retention period are kept", func() {
+		s.Require().NoError(janitor.PurgeExpired(ctx, time.Now().Add(testRetention-time.Hour)))
+		s.Len(*s.listTrash("type=ticket").Items, 1)
+		s.Len(*s.listTrash("type=category").Items, 2)
+
+		_, getErr := s.BlobStore.Get(ctx, key)
+		s.Require().NoError(getErr)
+	})
+
+	s.Run("Expired items are purged together with their attachments", func() {
+		s.Require().NoError(janitor.PurgeExpired(ctx, time.Now().Add(testRetention+time.Hour)))
+		s.Empty(*s.listTrash("type=ticket").Items)
+		s.Empty(*s.listTrash("type=category").Items)
+
+		_, getErr := s.BlobStore.Get(ctx, key)
+		s.Require().ErrorIs(getErr, tickets.ErrAttachmentNotFound)
+	})
+}
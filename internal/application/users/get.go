@@ -3,6 +3,8 @@ package users
 import (
 	"net/http"
 
+	"simpleservicedesk/pkg/etag"
+
 	"github.com/labstack/echo/v4"
 	openapitypes "github.com/oapi-codegen/runtime/types"
 )
@@ -17,6 +19,7 @@ func (h UserHandlers) GetUsersID(c echo.Context, id openapitypes.UUID) error {
 		return handleUserError(c, err)
 	}
 
+	etag.Set(c, user.Version())
 	response := userToResponse(user)
 	return c.JSON(http.StatusOK, response)
 }
@@ -6,6 +6,7 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -50,6 +51,14 @@ func handleUserError(c echo.Context, err error) error {
 		msg := "user already exists"
 		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
 	}
+	if errors.Is(err, users.ErrVersionConflict) {
+		msg := err.Error()
+		return c.JSON(http.StatusPreconditionFailed, openapi.ErrorResponse{Message: &msg})
+	}
+	if errors.Is(err, users.ErrConcurrentUpdate) {
+		msg := err.Error()
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{Message: &msg})
+	}
 	if errors.Is(err, users.ErrUserValidation) || errors.Is(err, users.ErrInvalidRole) {
 		msg := err.Error()
 		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
@@ -58,3 +67,14 @@ func handleUserError(c echo.Context, err error) error {
 	msg := "internal server error"
 	return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
 }
+
+// parseIfMatch parses the If-Match header of a user update; an invalid header is answered with 400
+func parseIfMatch(c echo.Context, header *string) (etag.Precondition, bool) {
+	precondition, err := etag.ParseIfMatch(header)
+	if err != nil {
+		msg := err.Error()
+		_ = c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+		return etag.Precondition{}, false
+	}
+	return precondition, true
+}
@@ -5,13 +5,22 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h UserHandlers) PatchUsersIDRole(c echo.Context, id openapi_types.UUID) error {
+func (h UserHandlers) PatchUsersIDRole(
+	c echo.Context,
+	id openapi_types.UUID,
+	params openapi.PatchUsersIDRoleParams,
+) error {
 	ctx := c.Request().Context()
+	precondition, ok := parseIfMatch(c, params.IfMatch)
+	if !ok {
+		return nil
+	}
 
 	var req openapi.UpdateUserRoleRequest
 	if err := c.Bind(&req); err != nil {
@@ -21,6 +30,9 @@ func (h UserHandlers) PatchUsersIDRole(c echo.Context, id openapi_types.UUID) er
 	role := users.Role(req.Role)
 
 	user, err := h.repo.UpdateUser(ctx, id, func(user *users.User) (bool, error) {
+		if !precondition.Matches(user.Version()) {
+			return false, users.ErrVersionConflict
+		}
 		if user.Role() == role {
 			return false, nil // Роль уже установлена
 		}
@@ -35,6 +47,7 @@ func (h UserHandlers) PatchUsersIDRole(c echo.Context, id openapi_types.UUID) er
 		return handleUserError(c, err)
 	}
 
+	etag.Set(c, user.Version())
 	response := userToResponse(user)
 	return c.JSON(http.StatusOK, response)
 }
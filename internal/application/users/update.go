@@ -5,17 +5,22 @@ import (
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h UserHandlers) PutUsersID(c echo.Context, id openapi_types.UUID) error {
+func (h UserHandlers) PutUsersID(c echo.Context, id openapi_types.UUID, params openapi.PutUsersIDParams) error {
 	ctx := c.Request().Context()
 	isAdmin, allowed := authorizeSelfOrAdmin(c, id)
 	if !allowed {
 		return nil
 	}
+	precondition, ok := parseIfMatch(c, params.IfMatch)
+	if !ok {
+		return nil
+	}
 
 	var req openapi.UpdateUserRequest
 	if err := c.Bind(&req); err != nil {
@@ -26,12 +31,16 @@ func (h UserHandlers) PutUsersID(c echo.Context, id openapi_types.UUID) error {
 	}
 
 	user, err := h.repo.UpdateUser(ctx, id, func(user *users.User) (bool, error) {
+		if !precondition.Matches(user.Version()) {
+			return false, users.ErrVersionConflict
+		}
 		return h.applyUserUpdates(&req, user)
 	})
 	if err != nil {
 		return handleUserError(c, err)
 	}
 
+	etag.Set(c, user.Version())
 	response := userToResponse(user)
 	return c.JSON(http.StatusOK, response)
 }
@@ -144,3 +144,56 @@ func (s *UsersSuite) TestUpdateUser() {
 		s.Require().Equal(http.StatusBadRequest, invalidUpdateRec.Code)
 	})
 }
+
+func (s *UsersSuite) TestUpdateUserIfMatch() {
+	userReq := openapi.CreateUserRequest{
+		Name:     "Concurrent User",
+		Email:    "concurrent@test.com",
+		Password: "password123",
+	}
+	reqBody, _ := json.Marshal(userReq)
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	var createResp openapi.CreateUserResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &createResp))
+	path := "/users/" + createResp.Id.String()
+
+	request := func(method, path string, payload any, ifMatch string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(payload)
+		updateReq := httptest.NewRequest(method, path, bytes.NewBuffer(body))
+		updateReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		updateReq.Header.Set("If-Match", ifMatch)
+		updateRec := httptest.NewRecorder()
+		s.HTTPServer.ServeHTTP(updateRec, updateReq)
+		return updateRec
+	}
+
+	getRec := httptest.NewRecorder()
+	s.HTTPServer.ServeHTTP(getRec, httptest.NewRequest(http.MethodGet, path, nil))
+	s.Require().Equal(http.StatusOK, getRec.Code)
+	loaded := getRec.Header().Get("ETag")
+	s.Require().Equal(`"1"`, loaded)
+
+	name := "Renamed User"
+	rec = request(http.MethodPut, path, openapi.UpdateUserRequest{Name: &name}, loaded)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+
+	s.Run("Stale version is rejected", func() {
+		staleName := "Lost Update"
+		staleRec := request(http.MethodPut, path, openapi.UpdateUserRequest{Name: &staleName}, loaded)
+		s.Equal(http.StatusPreconditionFailed, staleRec.Code)
+
+		staleRec = request(http.MethodPatch, path+"/role", openapi.UpdateUserRoleRequest{Role: openapi.Agent}, loaded)
+		s.Equal(http.StatusPreconditionFailed, staleRec.Code)
+	})
+
+	s.Run("Current version is accepted", func() {
+		roleRec := request(http.MethodPatch, path+"/role", openapi.UpdateUserRoleRequest{Role: openapi.Agent}, `"2"`)
+		s.Require().Equal(http.StatusOK, roleRec.Code)
+		s.Equal(`"3"`, roleRec.Header().Get("ETag"))
+	})
+}
@@ -0,0 +1,106 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"simpleservicedesk/generated/openapi"
+	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
+	"simpleservicedesk/pkg/echomiddleware"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	errSharingRequiresAgent = errors.New("only agents and admins share views")
+	errCannotManage         = errors.New("only the owner or an admin manages a shared view")
+)
+
+func authUser(c echo.Context) (uuid.UUID, userdomain.Role, bool) {
+	claims, ok := echomiddleware.GetAuthClaims(c)
+	if !ok || claims == nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, "", false
+	}
+
+	userID, err := uuid.Parse(strings.TrimSpace(claims.UserID))
+	if err != nil {
+		_ = c.NoContent(http.StatusUnauthorized)
+		return uuid.Nil, "", false
+	}
+
+	return userID, claims.Role, true
+}
+
+// viewer describes the requesting user; users unknown to the repository belong to no organization
+func (h ViewHandlers) viewer(ctx context.Context, userID uuid.UUID, role userdomain.Role) (views.Viewer, error) {
+	viewer := views.Viewer{UserID: userID, Role: role}
+	user, err := h.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, userdomain.ErrUserNotFound) {
+		return viewer, nil
+	}
+	if err != nil {
+		return viewer, err
+	}
+	viewer.OrganizationID = user.OrganizationID()
+	return viewer, nil
+}
+
+// checkVisible hides views the user cannot run as if they did not exist
+func checkVisible(view *views.View, viewer views.Viewer) error {
+	if !view.Sharing().IsVisibleTo(viewer) {
+		return views.ErrViewNotFound
+	}
+	return nil
+}
+
+// checkManage verifies that the user may change the view: its owner or, for shared views, an admin
+func checkManage(view *views.View, viewer views.Viewer) error {
+	if err := checkVisible(view, viewer); err != nil {
+		return err
+	}
+	if !view.Sharing().CanManage(viewer) {
+		return errCannotManage
+	}
+	return nil
+}
+
+// requestSharing returns the sharing of a new view: private unless requested otherwise.
+// Customers keep their views private.
+func requestSharing(
+	req openapi.CreateTicketViewRequest, userID uuid.UUID, role userdomain.Role,
+) (views.Sharing, error) {
+	sharing := views.Sharing{
+		Visibility:     views.VisibilityPrivate,
+		OrganizationID: req.OrganizationId,
+		OwnerID:        userID,
+	}
+	if req.Visibility != nil {
+		sharing.Visibility = views.Visibility(*req.Visibility)
+	}
+	if req.Role != nil {
+		sharing.Role = userdomain.Role(*req.Role)
+	}
+	if !sharing.IsPrivate() && role == userdomain.RoleCustomer {
+		return views.Sharing{}, errSharingRequiresAgent
+	}
+	return sharing, nil
+}
+
+func handleViewError(c echo.Context, err error) error {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, views.ErrViewNotFound):
+		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, errSharingRequiresAgent) || errors.Is(err, errCannotManage):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{Message: &msg})
+	case errors.Is(err, views.ErrViewValidation):
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	default:
+		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{Message: &msg})
+	}
+}
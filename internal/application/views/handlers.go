@@ -0,0 +1,35 @@
+package views
+
+import (
+	"context"
+
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/domain/views"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+)
+
+type ViewRepository interface {
+	CreateView(ctx context.Context, createFn func() (*views.View, error)) (*views.View, error)
+	UpdateView(ctx context.Context, id uuid.UUID, updateFn func(*views.View) (bool, error)) (*views.View, error)
+	GetView(ctx context.Context, id uuid.UUID) (*views.View, error)
+	ListViews(ctx context.Context, filter queries.ViewFilter) ([]*views.View, error)
+	DeleteView(ctx context.Context, id uuid.UUID) error
+}
+
+type UserRepository interface {
+	GetUser(ctx context.Context, id uuid.UUID) (*users.User, error)
+}
+
+type ViewHandlers struct {
+	repo     ViewRepository
+	userRepo UserRepository
+}
+
+func SetupHandlers(repo ViewRepository, userRepo UserRepository) ViewHandlers {
+	return ViewHandlers{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
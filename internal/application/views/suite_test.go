@@ -0,0 +1,22 @@
+package views_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/internal/application"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ViewsSuite struct {
+	application.ServerSuite
+}
+
+func (s *ViewsSuite) SetupTest() {
+	s.ServerSuite.SetupTest()
+}
+
+func TestViewsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ViewsSuite))
+}
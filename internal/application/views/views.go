@@ -0,0 +1,247 @@
+package views
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/views"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h ViewHandlers) GetViews(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	list, err := h.repo.ListViews(ctx, queries.ViewFilter{Viewer: viewer})
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	result := make([]openapi.TicketView, 0, len(list))
+	for _, view := range list {
+		response, convertErr := convertViewToResponse(view)
+		if convertErr != nil {
+			return handleViewError(c, convertErr)
+		}
+		result = append(result, response)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h ViewHandlers) PostViews(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.CreateTicketViewRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	sharing, err := requestSharing(req, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	filter, assignedToMe, err := serializeViewFilter(req.Filter, req.SortBy, req.SortOrder)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+
+	view, err := h.repo.CreateView(ctx, func() (*views.View, error) {
+		return views.CreateView(req.Name, filter, assignedToMe, convertColumnsFromRequest(req.Columns), sharing)
+	})
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	response, err := convertViewToResponse(view)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (h ViewHandlers) GetViewsID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	view, err := h.repo.GetView(ctx, id)
+	if err == nil {
+		err = checkVisible(view, viewer)
+	}
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	response, err := convertViewToResponse(view)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h ViewHandlers) PutViewsID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	var req openapi.UpdateTicketViewRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	filter, assignedToMe, err := serializeViewFilter(req.Filter, req.SortBy, req.SortOrder)
+	if err != nil {
+		msg := err.Error()
+		return c.JSON(http.StatusBadRequest, openapi.ErrorResponse{Message: &msg})
+	}
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	view, err := h.repo.UpdateView(ctx, id, func(view *views.View) (bool, error) {
+		if manageErr := checkManage(view, viewer); manageErr != nil {
+			return false, manageErr
+		}
+		columns := convertColumnsFromRequest(req.Columns)
+		if updateErr := view.Update(req.Name, filter, assignedToMe, columns); updateErr != nil {
+			return false, updateErr
+		}
+		return true, nil
+	})
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	response, err := convertViewToResponse(view)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h ViewHandlers) DeleteViewsID(c echo.Context, id openapi_types.UUID) error {
+	ctx := c.Request().Context()
+	userID, role, ok := authUser(c)
+	if !ok {
+		return nil
+	}
+
+	viewer, err := h.viewer(ctx, userID, role)
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	view, err := h.repo.GetView(ctx, id)
+	if err == nil {
+		err = checkManage(view, viewer)
+	}
+	if err == nil {
+		err = h.repo.DeleteView(ctx, id)
+	}
+	if err != nil {
+		return handleViewError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// serializeViewFilter validates the requested filter and sort order and serializes them for storage.
+// "Assigned to me" is resolved when the view runs, so it is returned separately.
+func serializeViewFilter(
+	viewFilter *openapi.TicketViewFilter,
+	sortBy *openapi.TicketViewSortBy,
+	sortOrder *openapi.TicketViewSortOrder,
+) ([]byte, bool, error) {
+	if viewFilter == nil {
+		viewFilter = &openapi.TicketViewFilter{}
+	}
+	filter, err := queries.FromOpenAPITicketViewFilter(*viewFilter, sortBy, sortOrder)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, viewFilter.AssignedToMe != nil && *viewFilter.AssignedToMe, nil
+}
+
+func convertColumnsFromRequest(columns *[]openapi.TicketViewColumn) []views.Column {
+	if columns == nil {
+		return nil
+	}
+	result := make([]views.Column, 0, len(*columns))
+	for _, column := range *columns {
+		result = append(result, views.Column(column))
+	}
+	return result
+}
+
+func convertViewToResponse(view *views.View) (openapi.TicketView, error) {
+	var filter queries.TicketFilter
+	if err := json.Unmarshal(view.Filter(), &filter); err != nil {
+		return openapi.TicketView{}, err
+	}
+
+	id := view.ID()
+	name := view.Name()
+	viewFilter := queries.ToOpenAPITicketViewFilter(filter, view.AssignedToViewer())
+	sortBy := openapi.TicketViewSortBy(filter.SortBy)
+	sortOrder := openapi.TicketViewSortOrder(filter.SortOrder)
+	sharing := view.Sharing()
+	visibility := openapi.TicketViewVisibility(sharing.Visibility)
+	ownerID := sharing.OwnerID
+	createdAt := view.CreatedAt()
+	updatedAt := view.UpdatedAt()
+
+	columns := make([]openapi.TicketViewColumn, 0, len(view.Columns()))
+	for _, column := range view.Columns() {
+		columns = append(columns, openapi.TicketViewColumn(column))
+	}
+
+	response := openapi.TicketView{
+		Id:             &id,
+		Name:           &name,
+		Visibility:     &visibility,
+		OrganizationId: sharing.OrganizationID,
+		OwnerId:        &ownerID,
+		Filter:         &viewFilter,
+		SortBy:         &sortBy,
+		SortOrder:      &sortOrder,
+		Columns:        &columns,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+	if sharing.Role != "" {
+		role := openapi.UserRole(sharing.Role)
+		response.Role = &role
+	}
+	return response, nil
+}
@@ -0,0 +1,157 @@
+package views_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"simpleservicedesk/generated/openapi"
+
+	"github.com/google/uuid"
+)
+
+func (s *ViewsSuite) createView(req openapi.CreateTicketViewRequest, token string) openapi.TicketView {
+	rec := s.RequestAs(http.MethodPost, "/views", req, token)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var view openapi.TicketView
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &view))
+	return view
+}
+
+func (s *ViewsSuite) listViewNames(token string) []string {
+	rec := s.RequestAs(http.MethodGet, "/views", nil, token)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var list []openapi.TicketView
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	names := make([]string, 0, len(list))
+	for _, view := range list {
+		names = append(names, *view.Name)
+	}
+	return names
+}
+
+func (s *ViewsSuite) TestViews() {
+	_, agentToken := s.LoginAs("views-agent@example.com", openapi.Agent)
+	status := openapi.TicketStatus("new")
+	assignedToMe := true
+	tags := []string{"VIP"}
+	sortBy := openapi.ViewSortByPriority
+
+	var viewID uuid.UUID
+	s.Run("Create a private view", func() {
+		view := s.createView(openapi.CreateTicketViewRequest{
+			Name:    "My new tickets",
+			Filter:  &openapi.TicketViewFilter{Status: &status, AssignedToMe: &assignedToMe, Tags: &tags},
+			SortBy:  &sortBy,
+			Columns: &[]openapi.TicketViewColumn{openapi.ViewColumnTitle, openapi.ViewColumnSLA},
+		}, agentToken)
+		viewID = *view.Id
+
+		s.Equal(openapi.ViewVisibilityPrivate, *view.Visibility)
+		s.Equal(status, *view.Filter.Status)
+		s.True(*view.Filter.AssignedToMe)
+		s.Equal([]string{"vip"}, *view.Filter.Tags)
+		s.Equal(openapi.ViewSortByPriority, *view.SortBy)
+		s.Equal(openapi.ViewSortOrderDesc, *view.SortOrder)
+		s.Equal([]openapi.TicketViewColumn{openapi.ViewColumnTitle, openapi.ViewColumnSLA}, *view.Columns)
+	})
+
+	s.Run("Invalid views are rejected", func() {
+		assigneeID := uuid.New()
+		invalid := []openapi.CreateTicketViewRequest{
+			{Name: " "},
+			{Name: "Conflicting", Filter: &openapi.TicketViewFilter{AssigneeId: &assigneeID, AssignedToMe: &assignedToMe}},
+			{Name: "Duplicate columns", Columns: &[]openapi.TicketViewColumn{
+				openapi.ViewColumnTitle, openapi.ViewColumnTitle,
+			}},
+		}
+		for _, req := range invalid {
+			rec := s.RequestAs(http.MethodPost, "/views", req, agentToken)
+			s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+	})
+
+	s.Run("Private views are hidden from other users", func() {
+		path := "/views/" + viewID.String()
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodGet, path, nil, agentToken).Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, path, nil, "").Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodDelete, path, nil, "").Code)
+	})
+
+	s.Run("The owner updates the view", func() {
+		rec := s.RequestAs(http.MethodPut, "/views/"+viewID.String(), openapi.UpdateTicketViewRequest{
+			Name:   "Unassigned tickets",
+			Filter: &openapi.TicketViewFilter{Unassigned: &assignedToMe},
+		}, agentToken)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var view openapi.TicketView
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &view))
+		s.Equal("Unassigned tickets", *view.Name)
+		s.Nil(view.Filter.Status)
+		s.Nil(view.Filter.AssignedToMe)
+		s.True(*view.Filter.Unassigned)
+		s.Equal(openapi.ViewSortByCreatedAt, *view.SortBy)
+		s.Len(*view.Columns, 5)
+	})
+
+	s.Run("The owner deletes the view", func() {
+		path := "/views/" + viewID.String()
+		s.Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, path, nil, agentToken).Code)
+		s.Equal(http.StatusNotFound, s.RequestAs(http.MethodGet, path, nil, agentToken).Code)
+	})
+}
+
+func (s *ViewsSuite) TestSharedViews() {
+	_, agentToken := s.LoginAs("views-shared-agent@example.com", openapi.Agent)
+	_, otherAgentToken := s.LoginAs("views-other-agent@example.com", openapi.Agent)
+	_, customerToken := s.LoginAs("views-customer@example.com", openapi.Customer)
+	role := openapi.Agent
+	roleVisibility := openapi.ViewVisibilityRole
+	orgVisibility := openapi.ViewVisibilityOrganization
+
+	shared := s.createView(openapi.CreateTicketViewRequest{
+		Name:       "Team queue",
+		Visibility: &roleVisibility,
+		Role:       &role,
+	}, agentToken)
+	s.createView(openapi.CreateTicketViewRequest{Name: "Personal"}, agentToken)
+
+	s.Run("Views shared with a role are visible to its users", func() {
+		s.Equal([]string{"Personal", "Team queue"}, s.listViewNames(agentToken))
+		s.Equal([]string{"Team queue"}, s.listViewNames(otherAgentToken))
+		s.Empty(s.listViewNames(customerToken))
+		s.Equal([]string{"Team queue"}, s.listViewNames(""))
+	})
+
+	s.Run("Only the owner or an admin manages a shared view", func() {
+		path := "/views/" + shared.Id.String()
+		update := openapi.UpdateTicketViewRequest{Name: "Renamed queue"}
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodPut, path, update, otherAgentToken).Code)
+		s.Equal(http.StatusForbidden, s.RequestAs(http.MethodDelete, path, nil, otherAgentToken).Code)
+		s.Equal(http.StatusOK, s.RequestAs(http.MethodPut, path, update, "").Code)
+		s.Equal(http.StatusNoContent, s.RequestAs(http.MethodDelete, path, nil, "").Code)
+	})
+
+	s.Run("Customers keep their views private", func() {
+		orgID := uuid.New()
+		rec := s.RequestAs(http.MethodPost, "/views", openapi.CreateTicketViewRequest{
+			Name:           "Our tickets",
+			Visibility:     &orgVisibility,
+			OrganizationId: &orgID,
+		}, customerToken)
+		s.Equal(http.StatusForbidden, rec.Code, rec.Body.String())
+
+		s.createView(openapi.CreateTicketViewRequest{Name: "My tickets"}, customerToken)
+		s.Equal([]string{"My tickets"}, s.listViewNames(customerToken))
+	})
+
+	s.Run("Shared views require their audience", func() {
+		rec := s.RequestAs(http.MethodPost, "/views", openapi.CreateTicketViewRequest{
+			Name:       "Nobody",
+			Visibility: &orgVisibility,
+		}, agentToken)
+		s.Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	})
+}
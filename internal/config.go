@@ -14,9 +14,11 @@ import (
 )
 
 type Config struct {
-	Server Server
-	Mongo  Mongo
-	Auth   Auth
+	Server  Server
+	Mongo   Mongo
+	Auth    Auth
+	Storage Storage
+	Jobs    Jobs
 }
 
 type Mongo struct {
@@ -24,6 +26,26 @@ type Mongo struct {
 	Database string
 }
 
+type Storage struct {
+	Backend   string
+	LocalPath string
+}
+
+const (
+	StorageBackendGridFS = "gridfs"
+	StorageBackendLocal  = "local"
+)
+
+type Jobs struct {
+	SLAEscalationInterval time.Duration
+	TrashRetention        time.Duration // How long deleted items stay in the trash before they are purged
+	TrashPurgeInterval    time.Duration
+	AutoCloseInterval     time.Duration // How often resolved tickets are checked against their grace period
+	// How often recurring ticket templates are checked for due runs; also bounds how late a ticket is created
+	RecurringTicketsInterval time.Duration
+	TicketSyncInterval       time.Duration // How often failed ticket search and history writes are repaired
+}
+
 type Auth struct {
 	JWTSigningKey          string
 	JWTExpiration          time.Duration
@@ -53,6 +75,16 @@ func LoadConfig() (Config, error) {
 		return config, fmt.Errorf("could not load auth config: %w", err)
 	}
 
+	config.Storage, err = LoadStorage()
+	if err != nil {
+		return config, fmt.Errorf("could not load storage config: %w", err)
+	}
+
+	config.Jobs, err = LoadJobs()
+	if err != nil {
+		return config, fmt.Errorf("could not load jobs config: %w", err)
+	}
+
 	return config, nil
 }
 
@@ -140,6 +172,48 @@ func LoadMongo() Mongo {
 	return mongo
 }
 
+func LoadStorage() (Storage, error) {
+	var storage Storage
+
+	storage.Backend = strings.ToLower(strings.TrimSpace(GetEnv("BLOB_STORAGE_BACKEND", StorageBackendGridFS)))
+	storage.LocalPath = strings.TrimSpace(GetEnv("BLOB_STORAGE_PATH", "data/attachments"))
+
+	switch storage.Backend {
+	case StorageBackendGridFS:
+	case StorageBackendLocal:
+		if storage.LocalPath == "" {
+			return storage, errors.New("blob storage path is required for local backend")
+		}
+	default:
+		return storage, fmt.Errorf("unsupported blob storage backend: %s", storage.Backend)
+	}
+
+	return storage, nil
+}
+
+func LoadJobs() (Jobs, error) {
+	var jobs Jobs
+	settings := []struct {
+		name, fallback string
+		target         *time.Duration
+	}{
+		{"SLA_ESCALATION_INTERVAL", "1m", &jobs.SLAEscalationInterval},
+		{"TRASH_RETENTION", "720h", &jobs.TrashRetention},
+		{"TRASH_PURGE_INTERVAL", "1h", &jobs.TrashPurgeInterval},
+		{"AUTO_CLOSE_INTERVAL", "15m", &jobs.AutoCloseInterval},
+		{"RECURRING_TICKETS_INTERVAL", "1m", &jobs.RecurringTicketsInterval},
+		{"TICKET_SYNC_INTERVAL", "1m", &jobs.TicketSyncInterval},
+	}
+	for _, setting := range settings {
+		value, err := getDuration(setting.name, setting.fallback)
+		if err != nil {
+			return jobs, err
+		}
+		*setting.target = value
+	}
+	return jobs, nil
+}
+
 func LoadAuth(envType environment.Type) (Auth, error) {
 	var auth Auth
 
@@ -201,6 +275,18 @@ func validateProductionJWTSecret(secret string) error {
 	return nil
 }
 
+// getDuration reads a positive duration such as a job interval; zero or negative values would stop a ticker
+func getDuration(name, fallback string) (time.Duration, error) {
+	value, err := time.ParseDuration(GetEnv(name, fallback))
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s: %w", name, err)
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("%s must be greater than zero", name)
+	}
+	return value, nil
+}
+
 func GetEnv(key, fallback string) string {
 	if value, exists := os.LookupEnv(key); exists {
 		return value
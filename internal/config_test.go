@@ -30,6 +30,9 @@ func TestLoadConfig(t *testing.T) {
 		"BOOTSTRAP_ADMIN_NAME",
 		"BOOTSTRAP_ADMIN_EMAIL",
 		"BOOTSTRAP_ADMIN_PASSWORD",
+		"BLOB_STORAGE_BACKEND",
+		"BLOB_STORAGE_PATH",
+		"SLA_ESCALATION_INTERVAL",
 	}
 
 	for _, key := range envVars {
@@ -68,6 +71,12 @@ func TestLoadConfig(t *testing.T) {
 		// Test auth defaults
 		assert.NotEmpty(t, config.Auth.JWTSigningKey)
 		assert.Equal(t, 24*time.Hour, config.Auth.JWTExpiration)
+
+		// Test storage defaults
+		assert.Equal(t, internal.StorageBackendGridFS, config.Storage.Backend)
+
+		// Test background jobs defaults
+		assert.Equal(t, time.Minute, config.Jobs.SLAEscalationInterval)
 	})
 
 	t.Run("production requires jwt secret", func(t *testing.T) {
@@ -732,3 +741,172 @@ func TestEnvironmentTypeHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadStorage(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVars     map[string]string
+		expected    internal.Storage
+		expectError bool
+	}{
+		{
+			name:    "default values",
+			envVars: map[string]string{},
+			expected: internal.Storage{
+				Backend:   internal.StorageBackendGridFS,
+				LocalPath: "data/attachments",
+			},
+		},
+		{
+			name: "local backend with custom path",
+			envVars: map[string]string{
+				"BLOB_STORAGE_BACKEND": " Local ",
+				"BLOB_STORAGE_PATH":    "/var/lib/servicedesk",
+			},
+			expected: internal.Storage{
+				Backend:   internal.StorageBackendLocal,
+				LocalPath: "/var/lib/servicedesk",
+			},
+		},
+		{
+			name: "local backend without path",
+			envVars: map[string]string{
+				"BLOB_STORAGE_BACKEND": "local",
+				"BLOB_STORAGE_PATH":    " ",
+			},
+			expectError: true,
+		},
+		{
+			name: "unsupported backend",
+			envVars: map[string]string{
+				"BLOB_STORAGE_BACKEND": "s3",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BLOB_STORAGE_BACKEND", "gridfs")
+			t.Setenv("BLOB_STORAGE_PATH", "data/attachments")
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+
+			storage, err := internal.LoadStorage()
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, storage)
+		})
+	}
+}
+
+func TestLoadJobs(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    string
+		expected    time.Duration
+		expectError bool
+	}{
+		{name: "custom interval", interval: "30s", expected: 30 * time.Second},
+		{name: "malformed interval", interval: "often", expectError: true},
+		{name: "zero interval", interval: "0s", expectError: true},
+		{name: "negative interval", interval: "-1m", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SLA_ESCALATION_INTERVAL", tt.interval)
+
+			jobs, err := internal.LoadJobs()
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, jobs.SLAEscalationInterval)
+		})
+	}
+}
+
+func TestLoadJobsTrash(t *testing.T) {
+	tests := []struct {
+		name              string
+		retention         string
+		purgeInterval     string
+		expectedRetention time.Duration
+		expectedInterval  time.Duration
+		expectError       bool
+	}{
+		{
+			name:              "custom trash settings",
+			retention:         "168h",
+			purgeInterval:     "15m",
+			expectedRetention: 168 * time.Hour,
+			expectedInterval:  15 * time.Minute,
+		},
+		{name: "malformed retention", retention: "forever", purgeInterval: "1h", expectError: true},
+		{name: "zero retention", retention: "0s", purgeInterval: "1h", expectError: true},
+		{name: "malformed purge interval", retention: "720h", purgeInterval: "hourly", expectError: true},
+		{name: "negative purge interval", retention: "720h", purgeInterval: "-1h", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRASH_RETENTION", tt.retention)
+			t.Setenv("TRASH_PURGE_INTERVAL", tt.purgeInterval)
+
+			jobs, err := internal.LoadJobs()
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRetention, jobs.TrashRetention)
+			assert.Equal(t, tt.expectedInterval, jobs.TrashPurgeInterval)
+		})
+	}
+}
+
+func TestLoadJobsAutoClose(t *testing.T) {
+	t.Setenv("AUTO_CLOSE_INTERVAL", "5m")
+	jobs, err := internal.LoadJobs()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, jobs.AutoCloseInterval)
+
+	t.Setenv("AUTO_CLOSE_INTERVAL", "0s")
+	_, err = internal.LoadJobs()
+	require.Error(t, err)
+
+	t.Setenv("AUTO_CLOSE_INTERVAL", "daily")
+	_, err = internal.LoadJobs()
+	require.Error(t, err)
+}
+
+func TestLoadJobsRecurringTickets(t *testing.T) {
+	jobs, err := internal.LoadJobs()
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, jobs.RecurringTicketsInterval)
+
+	t.Setenv("RECURRING_TICKETS_INTERVAL", "30s")
+	jobs, err = internal.LoadJobs()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, jobs.RecurringTicketsInterval)
+
+	t.Setenv("RECURRING_TICKETS_INTERVAL", "-1m")
+	_, err = internal.LoadJobs()
+	require.Error(t, err)
+}
+
+func TestLoadJobsTicketSync(t *testing.T) {
+	jobs, err := internal.LoadJobs()
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, jobs.TicketSyncInterval)
+
+	t.Setenv("TICKET_SYNC_INTERVAL", "0s")
+	_, err = internal.LoadJobs()
+	require.Error(t, err)
+}
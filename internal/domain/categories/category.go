@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"simpleservicedesk/internal/domain/tickets"
+
 	"github.com/google/uuid"
 )
 
@@ -15,6 +17,10 @@ var (
 	ErrCategoryValidation   = errors.New("category validation error")
 	ErrCategoryAlreadyExist = errors.New("category already exist")
 	ErrCircularReference    = errors.New("circular reference detected")
+	ErrVersionConflict      = errors.New("category was modified by another request")
+	ErrConcurrentUpdate     = errors.New("category is being changed by concurrent requests, try again")
+	ErrCategoryHasChildren  = errors.New("category has subcategories")
+	ErrParentInTrash        = errors.New("parent category is in the trash")
 )
 
 const (
@@ -30,8 +36,12 @@ type Category struct {
 	organizationID uuid.UUID
 	parentID       *uuid.UUID // Указатель, так как может быть nil для корневых категорий
 	isActive       bool
+	customFields   *tickets.CustomFieldSchema // nil - категория не задает дополнительных полей
 	createdAt      time.Time
 	updatedAt      time.Time
+	version        int64      // Номер сохраненной версии; 0 - категория еще не сохранена
+	deletedAt      *time.Time // Время перемещения в корзину; nil - категория не удалена
+	deletedBy      *uuid.UUID // Пользователь, удаливший категорию
 }
 
 // NewCategory создает новую категорию с указанным ID
@@ -119,6 +129,64 @@ func (c *Category) UpdatedAt() time.Time {
 	return c.updatedAt
 }
 
+// Version возвращает номер сохраненной версии категории
+func (c *Category) Version() int64 {
+	return c.version
+}
+
+// RestoreVersion устанавливает номер сохраненной версии (для восстановления данных и после записи)
+func (c *Category) RestoreVersion(version int64) {
+	c.version = version
+}
+
+// DeletedAt возвращает время перемещения категории в корзину (nil, если категория не удалена)
+func (c *Category) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+// DeletedBy возвращает ID пользователя, удалившего категорию (может быть nil)
+func (c *Category) DeletedBy() *uuid.UUID {
+	return c.deletedBy
+}
+
+// IsDeleted проверяет, находится ли категория в корзине
+func (c *Category) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// MarkDeleted перемещает категорию в корзину, откуда ее можно восстановить до окончательного удаления
+func (c *Category) MarkDeleted(deletedBy uuid.UUID, deletedAt time.Time) {
+	c.deletedAt = &deletedAt
+	c.deletedBy = &deletedBy
+}
+
+// Undelete возвращает категорию из корзины
+func (c *Category) Undelete() {
+	c.deletedAt = nil
+	c.deletedBy = nil
+}
+
+// CustomFieldSchema возвращает схему дополнительных полей заявок категории или nil, если она не задана
+func (c *Category) CustomFieldSchema() *tickets.CustomFieldSchema {
+	return c.customFields
+}
+
+// SetCustomFieldSchema устанавливает схему дополнительных полей заявок категории
+func (c *Category) SetCustomFieldSchema(schema *tickets.CustomFieldSchema) error {
+	if schema == nil {
+		return fmt.Errorf("%w: custom field schema is required", ErrCategoryValidation)
+	}
+	c.customFields = schema
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ResetCustomFieldSchema убирает дополнительные поля заявок категории
+func (c *Category) ResetCustomFieldSchema() {
+	c.customFields = nil
+	c.updatedAt = time.Now()
+}
+
 // IsRootCategory проверяет, является ли категория корневой
 func (c *Category) IsRootCategory() bool {
 	return c.parentID == nil
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	domain "simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
 )
 
 func TestNewCategory_Valid(t *testing.T) {
@@ -792,3 +793,21 @@ func TestCategory_EdgeCases_StateConsistency(t *testing.T) {
 	require.Equal(t, initialIsRoot, cat.IsRootCategory())    // Should be back to root
 	require.True(t, cat.UpdatedAt().After(initialCreatedAt)) // Should be updated
 }
+
+func TestCategory_CustomFieldSchema(t *testing.T) {
+	cat, err := domain.CreateCategory("Hardware", "", uuid.New(), nil)
+	require.NoError(t, err)
+	require.Nil(t, cat.CustomFieldSchema())
+
+	schema, err := tickets.NewCustomFieldSchema([]tickets.CustomFieldDefinition{
+		{Key: "asset_tag", Label: "Asset tag", Type: tickets.CustomFieldText, Required: true},
+	})
+	require.NoError(t, err)
+	require.NoError(t, cat.SetCustomFieldSchema(schema))
+	require.Same(t, schema, cat.CustomFieldSchema())
+
+	require.ErrorIs(t, cat.SetCustomFieldSchema(nil), domain.ErrCategoryValidation)
+
+	cat.ResetCustomFieldSchema()
+	require.Nil(t, cat.CustomFieldSchema())
+}
@@ -0,0 +1,103 @@
+package macros
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCannedResponseNotFound   = errors.New("canned response not found")
+	ErrCannedResponseValidation = errors.New("canned response validation error")
+)
+
+const (
+	MaxNameLength    = 100
+	MaxContentLength = 2000 // Совпадает с ограничением длины комментария заявки
+)
+
+// CannedResponse представляет готовый ответ агента с переменными заявки
+type CannedResponse struct {
+	id        uuid.UUID
+	name      string
+	content   string // Текст с переменными вида {{ticket.title}}
+	scope     Scope
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewCannedResponse создает готовый ответ с указанным ID
+func NewCannedResponse(id uuid.UUID, name, content string, scope Scope) (*CannedResponse, error) {
+	if err := scope.validate(ErrCannedResponseValidation); err != nil {
+		return nil, err
+	}
+
+	response := &CannedResponse{id: id, scope: scope}
+	if err := response.Update(name, content); err != nil {
+		return nil, err
+	}
+	response.createdAt = response.updatedAt
+	return response, nil
+}
+
+// CreateCannedResponse создает готовый ответ с автоматически сгенерированным ID
+func CreateCannedResponse(name, content string, scope Scope) (*CannedResponse, error) {
+	return NewCannedResponse(uuid.New(), name, content, scope)
+}
+
+func (r *CannedResponse) ID() uuid.UUID        { return r.id }
+func (r *CannedResponse) Name() string         { return r.name }
+func (r *CannedResponse) Content() string      { return r.content }
+func (r *CannedResponse) Scope() Scope         { return r.scope }
+func (r *CannedResponse) CreatedAt() time.Time { return r.createdAt }
+func (r *CannedResponse) UpdatedAt() time.Time { return r.updatedAt }
+
+// RestoreTimestamps sets the creation and modification times (for data restoration)
+func (r *CannedResponse) RestoreTimestamps(createdAt, updatedAt time.Time) {
+	r.createdAt = createdAt
+	r.updatedAt = updatedAt
+}
+
+// Update изменяет название и текст ответа
+func (r *CannedResponse) Update(name, content string) error {
+	name, err := validateName(name, ErrCannedResponseValidation)
+	if err != nil {
+		return err
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return fmt.Errorf("%w: content is required", ErrCannedResponseValidation)
+	}
+	if len(content) > MaxContentLength {
+		return fmt.Errorf("%w: content must be no more than %d characters long",
+			ErrCannedResponseValidation, MaxContentLength)
+	}
+	if err = ValidateTemplate(content); err != nil {
+		return err
+	}
+
+	r.name = name
+	r.content = content
+	r.updatedAt = time.Now()
+	return nil
+}
+
+// Render возвращает текст ответа с подставленными значениями переменных
+func (r *CannedResponse) Render(data TemplateData) string {
+	return RenderTemplate(r.content, data)
+}
+
+func validateName(name string, errKind error) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", errKind)
+	}
+	if len(name) > MaxNameLength {
+		return "", fmt.Errorf("%w: name must be no more than %d characters long", errKind, MaxNameLength)
+	}
+	return name, nil
+}
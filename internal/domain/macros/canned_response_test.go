@@ -0,0 +1,74 @@
+package macros_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/macros"
+)
+
+func TestNewCannedResponse(t *testing.T) {
+	ownerID := uuid.New()
+	orgID := uuid.New()
+
+	response, err := domain.CreateCannedResponse(" Password reset ", " Hi {{author.name}}, use the reset link. ",
+		domain.OrganizationScope(orgID, ownerID))
+	require.NoError(t, err)
+	assert.Equal(t, "Password reset", response.Name())
+	assert.Equal(t, "Hi {{author.name}}, use the reset link.", response.Content())
+	assert.False(t, response.Scope().IsPersonal())
+	assert.Equal(t, response.CreatedAt(), response.UpdatedAt())
+	assert.Equal(t, "Hi Alice, use the reset link.", response.Render(domain.TemplateData{AuthorName: "Alice"}))
+
+	invalid := []struct {
+		name, content string
+		scope         domain.Scope
+	}{
+		{"", "Content", domain.PersonalScope(ownerID)},
+		{strings.Repeat("a", domain.MaxNameLength+1), "Content", domain.PersonalScope(ownerID)},
+		{"Name", "  ", domain.PersonalScope(ownerID)},
+		{"Name", strings.Repeat("a", domain.MaxContentLength+1), domain.PersonalScope(ownerID)},
+		{"Name", "Content", domain.PersonalScope(uuid.Nil)},
+		{"Name", "Content", domain.OrganizationScope(uuid.Nil, ownerID)},
+	}
+	for _, tc := range invalid {
+		_, err = domain.CreateCannedResponse(tc.name, tc.content, tc.scope)
+		require.ErrorIs(t, err, domain.ErrCannedResponseValidation, "%q", tc.name)
+	}
+
+	_, err = domain.CreateCannedResponse("Name", "Hi {{user.name}}", domain.PersonalScope(ownerID))
+	require.ErrorIs(t, err, domain.ErrInvalidTemplate)
+}
+
+func TestCannedResponse_Update(t *testing.T) {
+	response, err := domain.CreateCannedResponse("Greeting", "Hello", domain.PersonalScope(uuid.New()))
+	require.NoError(t, err)
+
+	require.NoError(t, response.Update("Farewell", "Goodbye {{author.name}}"))
+	assert.Equal(t, "Farewell", response.Name())
+	assert.Equal(t, "Goodbye {{author.name}}", response.Content())
+
+	require.ErrorIs(t, response.Update("Farewell", "Goodbye {{author}}"), domain.ErrInvalidTemplate)
+	assert.Equal(t, "Goodbye {{author.name}}", response.Content())
+}
+
+func TestScope(t *testing.T) {
+	ownerID := uuid.New()
+	orgID := uuid.New()
+
+	personal := domain.PersonalScope(ownerID)
+	assert.True(t, personal.IsPersonal())
+	assert.True(t, personal.IsVisibleTo(ownerID))
+	assert.False(t, personal.IsVisibleTo(uuid.New()))
+	assert.True(t, personal.AppliesTo(uuid.New()))
+
+	shared := domain.OrganizationScope(orgID, ownerID)
+	assert.False(t, shared.IsPersonal())
+	assert.True(t, shared.IsVisibleTo(uuid.New()))
+	assert.True(t, shared.AppliesTo(orgID))
+	assert.False(t, shared.AppliesTo(uuid.New()))
+}
@@ -0,0 +1,182 @@
+package macros
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMacroNotFound   = errors.New("macro not found")
+	ErrMacroValidation = errors.New("macro validation error")
+)
+
+const (
+	MaxActions           = 20
+	MaxDescriptionLength = 500
+)
+
+// ActionType представляет тип шага макроса
+type ActionType string
+
+const (
+	ActionComment  ActionType = "comment"  // Добавить комментарий; текст может содержать переменные
+	ActionStatus   ActionType = "status"   // Перевести заявку в статус рабочего процесса
+	ActionPriority ActionType = "priority" // Установить приоритет
+	ActionAssign   ActionType = "assign"   // Назначить исполнителя; пустое значение снимает назначение
+)
+
+// AllActionTypes возвращает все типы шагов макроса
+func AllActionTypes() []ActionType {
+	return []ActionType{ActionComment, ActionStatus, ActionPriority, ActionAssign}
+}
+
+// String возвращает строковое представление типа шага
+func (t ActionType) String() string {
+	return string(t)
+}
+
+// IsValid проверяет, является ли тип шага допустимым
+func (t ActionType) IsValid() bool {
+	return slices.Contains(AllActionTypes(), t)
+}
+
+// Action описывает один шаг макроса
+type Action struct {
+	Type     ActionType `json:"type"`
+	Value    string     `json:"value,omitempty"`    // Текст комментария, статус, приоритет или ID исполнителя
+	Internal bool       `json:"internal,omitempty"` // Комментарий виден только агентам
+}
+
+// Macro представляет набор действий, которые агент применяет к заявке одним вызовом
+type Macro struct {
+	id          uuid.UUID
+	name        string
+	description string
+	actions     []Action // Выполняются по порядку
+	scope       Scope
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewMacro создает макрос с указанным ID
+func NewMacro(id uuid.UUID, name, description string, actions []Action, scope Scope) (*Macro, error) {
+	if err := scope.validate(ErrMacroValidation); err != nil {
+		return nil, err
+	}
+
+	macro := &Macro{id: id, scope: scope}
+	if err := macro.Update(name, description, actions); err != nil {
+		return nil, err
+	}
+	macro.createdAt = macro.updatedAt
+	return macro, nil
+}
+
+// CreateMacro создает макрос с автоматически сгенерированным ID
+func CreateMacro(name, description string, actions []Action, scope Scope) (*Macro, error) {
+	return NewMacro(uuid.New(), name, description, actions, scope)
+}
+
+func (m *Macro) ID() uuid.UUID        { return m.id }
+func (m *Macro) Name() string         { return m.name }
+func (m *Macro) Description() string  { return m.description }
+func (m *Macro) Actions() []Action    { return slices.Clone(m.actions) }
+func (m *Macro) Scope() Scope         { return m.scope }
+func (m *Macro) CreatedAt() time.Time { return m.createdAt }
+func (m *Macro) UpdatedAt() time.Time { return m.updatedAt }
+
+// RestoreTimestamps sets the creation and modification times (for data restoration)
+func (m *Macro) RestoreTimestamps(createdAt, updatedAt time.Time) {
+	m.createdAt = createdAt
+	m.updatedAt = updatedAt
+}
+
+// Update заменяет название, описание и шаги макроса
+func (m *Macro) Update(name, description string, actions []Action) error {
+	name, err := validateName(name, ErrMacroValidation)
+	if err != nil {
+		return err
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > MaxDescriptionLength {
+		return fmt.Errorf("%w: description must be no more than %d characters long",
+			ErrMacroValidation, MaxDescriptionLength)
+	}
+
+	if len(actions) == 0 || len(actions) > MaxActions {
+		return fmt.Errorf("%w: macro must have 1-%d actions", ErrMacroValidation, MaxActions)
+	}
+	validated := make([]Action, 0, len(actions))
+	for _, action := range actions {
+		action, err = validateAction(action)
+		if err != nil {
+			return err
+		}
+		validated = append(validated, action)
+	}
+
+	m.name = name
+	m.description = description
+	m.actions = validated
+	m.updatedAt = time.Now()
+	return nil
+}
+
+// AssigneeID возвращает исполнителя, которого назначает шаг; nil - шаг снимает назначение
+func (a Action) AssigneeID() *uuid.UUID {
+	assigneeID, err := uuid.Parse(a.Value)
+	if err != nil {
+		return nil
+	}
+	return &assigneeID
+}
+
+func validateAction(action Action) (Action, error) {
+	action.Value = strings.TrimSpace(action.Value)
+	if action.Internal && action.Type != ActionComment {
+		return Action{}, fmt.Errorf("%w: only comments can be internal", ErrMacroValidation)
+	}
+
+	switch action.Type {
+	case ActionComment:
+		if action.Value == "" || len(action.Value) > tickets.MaxCommentLength {
+			return Action{}, fmt.Errorf("%w: comment must be 1-%d characters long",
+				ErrMacroValidation, tickets.MaxCommentLength)
+		}
+		if err := ValidateTemplate(action.Value); err != nil {
+			return Action{}, err
+		}
+	case ActionStatus:
+		status, err := tickets.ParseWorkflowStatus(action.Value)
+		if err != nil {
+			return Action{}, fmt.Errorf("%w: %w", ErrMacroValidation, err)
+		}
+		action.Value = status.String()
+	case ActionPriority:
+		priority := tickets.Priority(strings.ToLower(action.Value))
+		if !priority.IsValid() {
+			return Action{}, fmt.Errorf("%w: unknown priority %q", ErrMacroValidation, action.Value)
+		}
+		action.Value = priority.String()
+	case ActionAssign:
+		if action.Value == "" {
+			return action, nil
+		}
+		assigneeID, err := uuid.Parse(action.Value)
+		if err != nil || assigneeID == uuid.Nil {
+			return Action{}, fmt.Errorf("%w: assignee must be a user ID", ErrMacroValidation)
+		}
+		action.Value = assigneeID.String()
+	default:
+		return Action{}, fmt.Errorf("%w: unknown action type %q", ErrMacroValidation, action.Type)
+	}
+	return action, nil
+}
@@ -0,0 +1,80 @@
+package macros_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+func TestNewMacro(t *testing.T) {
+	assigneeID := uuid.New()
+	macro, err := domain.CreateMacro("Escalate to network team", " Hand over network outages ", []domain.Action{
+		{Type: domain.ActionComment, Value: "Escalated by {{agent.name}}", Internal: true},
+		{Type: domain.ActionStatus, Value: " In_Progress "},
+		{Type: domain.ActionPriority, Value: "HIGH"},
+		{Type: domain.ActionAssign, Value: strings.ToUpper(assigneeID.String())},
+	}, domain.PersonalScope(uuid.New()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Hand over network outages", macro.Description())
+	actions := macro.Actions()
+	require.Len(t, actions, 4)
+	assert.Equal(t, "in_progress", actions[1].Value)
+	assert.Equal(t, "high", actions[2].Value)
+	assert.Equal(t, &assigneeID, actions[3].AssigneeID())
+
+	unassign := domain.Action{Type: domain.ActionAssign}
+	macro, err = domain.CreateMacro("Unassign", "", []domain.Action{unassign}, domain.PersonalScope(uuid.New()))
+	require.NoError(t, err)
+	assert.Nil(t, macro.Actions()[0].AssigneeID())
+}
+
+func TestNewMacro_InvalidActions(t *testing.T) {
+	tooMany := make([]domain.Action, domain.MaxActions+1)
+	for i := range tooMany {
+		tooMany[i] = domain.Action{Type: domain.ActionPriority, Value: "low"}
+	}
+
+	invalid := [][]domain.Action{
+		nil,
+		tooMany,
+		{{Type: "close"}},
+		{{Type: domain.ActionComment}},
+		{{Type: domain.ActionComment, Value: strings.Repeat("a", tickets.MaxCommentLength+1)}},
+		{{Type: domain.ActionStatus, Value: "not a status"}},
+		{{Type: domain.ActionPriority, Value: "urgent"}},
+		{{Type: domain.ActionAssign, Value: "someone"}},
+		{{Type: domain.ActionPriority, Value: "low", Internal: true}},
+	}
+	for _, actions := range invalid {
+		_, err := domain.CreateMacro("Macro", "", actions, domain.PersonalScope(uuid.New()))
+		require.ErrorIs(t, err, domain.ErrMacroValidation, "%+v", actions)
+	}
+
+	_, err := domain.CreateMacro("Macro", "", []domain.Action{{Type: domain.ActionComment, Value: "Hi {{name}}"}},
+		domain.PersonalScope(uuid.New()))
+	require.ErrorIs(t, err, domain.ErrInvalidTemplate)
+}
+
+func TestMacro_Update(t *testing.T) {
+	macro, err := domain.CreateMacro("Close", "", []domain.Action{{Type: domain.ActionStatus, Value: "closed"}},
+		domain.PersonalScope(uuid.New()))
+	require.NoError(t, err)
+
+	err = macro.Update("Resolve", "Resolve with a note", []domain.Action{
+		{Type: domain.ActionComment, Value: "Resolved, {{author.name}}"},
+		{Type: domain.ActionStatus, Value: "resolved"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Resolve", macro.Name())
+	assert.Len(t, macro.Actions(), 2)
+
+	require.ErrorIs(t, macro.Update("", "", macro.Actions()), domain.ErrMacroValidation)
+	assert.Equal(t, "Resolve", macro.Name())
+}
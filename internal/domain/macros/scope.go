@@ -0,0 +1,50 @@
+package macros
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Scope определяет, кому доступен ответ или макрос.
+// Общие шаблоны доступны всем агентам и применяются к заявкам своей организации,
+// личные - только владельцу, но к заявкам любой организации.
+type Scope struct {
+	OrganizationID *uuid.UUID // nil - личный шаблон владельца
+	OwnerID        uuid.UUID  // Пользователь, создавший шаблон
+}
+
+// OrganizationScope создает область видимости общего шаблона организации
+func OrganizationScope(organizationID, ownerID uuid.UUID) Scope {
+	return Scope{OrganizationID: &organizationID, OwnerID: ownerID}
+}
+
+// PersonalScope создает область видимости личного шаблона
+func PersonalScope(ownerID uuid.UUID) Scope {
+	return Scope{OwnerID: ownerID}
+}
+
+// IsPersonal проверяет, является ли шаблон личным
+func (s Scope) IsPersonal() bool {
+	return s.OrganizationID == nil
+}
+
+// IsVisibleTo проверяет, может ли пользователь видеть и применять шаблон
+func (s Scope) IsVisibleTo(userID uuid.UUID) bool {
+	return !s.IsPersonal() || s.OwnerID == userID
+}
+
+// AppliesTo проверяет, можно ли применить шаблон к заявке организации
+func (s Scope) AppliesTo(organizationID uuid.UUID) bool {
+	return s.IsPersonal() || *s.OrganizationID == organizationID
+}
+
+func (s Scope) validate(errKind error) error {
+	if s.OwnerID == uuid.Nil {
+		return fmt.Errorf("%w: owner is required", errKind)
+	}
+	if s.OrganizationID != nil && *s.OrganizationID == uuid.Nil {
+		return fmt.Errorf("%w: organization ID cannot be nil", errKind)
+	}
+	return nil
+}
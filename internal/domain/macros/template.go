@@ -0,0 +1,120 @@
+package macros
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTemplate = errors.New("invalid template")
+)
+
+const (
+	templateOpen  = "{{"
+	templateClose = "}}"
+)
+
+// Переменные, доступные в шаблонах ответов и комментариях макросов, например "{{ticket.title}}"
+const (
+	VariableTicketID         = "ticket.id"
+	VariableTicketTitle      = "ticket.title"
+	VariableTicketStatus     = "ticket.status"
+	VariableTicketPriority   = "ticket.priority"
+	VariableAuthorName       = "author.name"
+	VariableAuthorEmail      = "author.email"
+	VariableOrganizationName = "organization.name"
+	VariableAgentName        = "agent.name"
+)
+
+// AllVariables возвращает все переменные шаблонов
+func AllVariables() []string {
+	return []string{
+		VariableTicketID,
+		VariableTicketTitle,
+		VariableTicketStatus,
+		VariableTicketPriority,
+		VariableAuthorName,
+		VariableAuthorEmail,
+		VariableOrganizationName,
+		VariableAgentName,
+	}
+}
+
+// TemplateData содержит значения переменных шаблона для конкретной заявки
+type TemplateData struct {
+	TicketID         uuid.UUID
+	TicketTitle      string
+	TicketStatus     string
+	TicketPriority   string
+	AuthorName       string
+	AuthorEmail      string
+	OrganizationName string
+	AgentName        string // Агент, применяющий шаблон
+}
+
+func (d TemplateData) value(variable string) string {
+	switch variable {
+	case VariableTicketID:
+		return d.TicketID.String()
+	case VariableTicketTitle:
+		return d.TicketTitle
+	case VariableTicketStatus:
+		return d.TicketStatus
+	case VariableTicketPriority:
+		return d.TicketPriority
+	case VariableAuthorName:
+		return d.AuthorName
+	case VariableAuthorEmail:
+		return d.AuthorEmail
+	case VariableOrganizationName:
+		return d.OrganizationName
+	case VariableAgentName:
+		return d.AgentName
+	}
+	return ""
+}
+
+// ValidateTemplate проверяет, что шаблон использует только известные переменные
+func ValidateTemplate(content string) error {
+	_, err := expandTemplate(content, func(string) string { return "" })
+	return err
+}
+
+// RenderTemplate подставляет в шаблон значения переменных.
+// Шаблон должен быть предварительно проверен ValidateTemplate.
+func RenderTemplate(content string, data TemplateData) string {
+	rendered, err := expandTemplate(content, data.value)
+	if err != nil {
+		return content
+	}
+	return rendered
+}
+
+// expandTemplate заменяет каждую переменную вида {{name}} результатом expand
+func expandTemplate(content string, expand func(variable string) string) (string, error) {
+	var result strings.Builder
+	for {
+		start := strings.Index(content, templateOpen)
+		if start < 0 {
+			result.WriteString(content)
+			return result.String(), nil
+		}
+		length := strings.Index(content[start:], templateClose)
+		if length < 0 {
+			return "", fmt.Errorf("%w: unclosed %s", ErrInvalidTemplate, templateOpen)
+		}
+
+		variable := strings.TrimSpace(content[start+len(templateOpen) : start+length])
+		if !slices.Contains(AllVariables(), variable) {
+			return "", fmt.Errorf("%w: unknown variable %q, expected one of %s", ErrInvalidTemplate, variable,
+				strings.Join(AllVariables(), ", "))
+		}
+		result.WriteString(content[:start])
+		result.WriteString(expand(variable))
+		content = content[start+length+len(templateClose):]
+	}
+}
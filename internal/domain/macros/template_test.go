@@ -0,0 +1,42 @@
+package macros_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/macros"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	require.NoError(t, domain.ValidateTemplate("Hello {{author.name}}, ticket {{ ticket.id }} is {{ticket.status}}"))
+	require.NoError(t, domain.ValidateTemplate("No variables at all"))
+
+	require.ErrorIs(t, domain.ValidateTemplate("Hello {{customer.name}}"), domain.ErrInvalidTemplate)
+	require.ErrorIs(t, domain.ValidateTemplate("Hello {{author.name"), domain.ErrInvalidTemplate)
+	require.ErrorIs(t, domain.ValidateTemplate("Hello {{}}"), domain.ErrInvalidTemplate)
+}
+
+func TestRenderTemplate(t *testing.T) {
+	ticketID := uuid.New()
+	data := domain.TemplateData{
+		TicketID:         ticketID,
+		TicketTitle:      "Printer jam",
+		TicketStatus:     "in_progress",
+		TicketPriority:   "high",
+		AuthorName:       "Alice",
+		AuthorEmail:      "alice@example.com",
+		OrganizationName: "Acme",
+		AgentName:        "Bob",
+	}
+
+	rendered := domain.RenderTemplate(
+		"Hi {{ author.name }} ({{author.email}}), {{agent.name}} from {{organization.name}} is on "+
+			"\"{{ticket.title}}\" [{{ticket.id}}], now {{ticket.status}}/{{ticket.priority}}.", data)
+	assert.Equal(t, "Hi Alice (alice@example.com), Bob from Acme is on \"Printer jam\" ["+ticketID.String()+
+		"], now in_progress/high.", rendered)
+
+	assert.Equal(t, "Broken {{author.name", domain.RenderTemplate("Broken {{author.name", data))
+}
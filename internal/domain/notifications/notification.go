@@ -0,0 +1,112 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotificationNotFound   = errors.New("notification not found")
+	ErrNotificationValidation = errors.New("notification validation error")
+)
+
+// Type представляет вид уведомления
+type Type string
+
+const (
+	TypeMention Type = "mention" // Пользователя упомянули в комментарии
+)
+
+// String возвращает строковое представление вида уведомления
+func (t Type) String() string {
+	return string(t)
+}
+
+// IsValid проверяет, является ли вид уведомления допустимым
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeMention:
+		return true
+	default:
+		return false
+	}
+}
+
+// Notification представляет уведомление пользователя внутри приложения
+type Notification struct {
+	id               uuid.UUID
+	userID           uuid.UUID // Получатель уведомления
+	notificationType Type
+	actorID          uuid.UUID // Пользователь, действие которого вызвало уведомление
+	ticketID         uuid.UUID
+	commentID        *uuid.UUID
+	createdAt        time.Time
+	readAt           *time.Time // Время прочтения; nil - уведомление не прочитано
+}
+
+// NewNotification создает уведомление с указанным ID
+func NewNotification(
+	id, userID uuid.UUID,
+	notificationType Type,
+	actorID, ticketID uuid.UUID,
+	commentID *uuid.UUID,
+) (*Notification, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("%w: user_id is required", ErrNotificationValidation)
+	}
+	if ticketID == uuid.Nil {
+		return nil, fmt.Errorf("%w: ticket_id is required", ErrNotificationValidation)
+	}
+	if !notificationType.IsValid() {
+		return nil, fmt.Errorf("%w: invalid notification type %q", ErrNotificationValidation, notificationType)
+	}
+
+	return &Notification{
+		id:               id,
+		userID:           userID,
+		notificationType: notificationType,
+		actorID:          actorID,
+		ticketID:         ticketID,
+		commentID:        commentID,
+		createdAt:        time.Now(),
+	}, nil
+}
+
+// NewMentionNotification создает уведомление об упоминании пользователя в комментарии к заявке
+func NewMentionNotification(userID, actorID, ticketID, commentID uuid.UUID) (*Notification, error) {
+	return NewNotification(uuid.New(), userID, TypeMention, actorID, ticketID, &commentID)
+}
+
+func (n *Notification) ID() uuid.UUID         { return n.id }
+func (n *Notification) UserID() uuid.UUID     { return n.userID }
+func (n *Notification) Type() Type            { return n.notificationType }
+func (n *Notification) ActorID() uuid.UUID    { return n.actorID }
+func (n *Notification) TicketID() uuid.UUID   { return n.ticketID }
+func (n *Notification) CommentID() *uuid.UUID { return n.commentID }
+func (n *Notification) CreatedAt() time.Time  { return n.createdAt }
+func (n *Notification) ReadAt() *time.Time    { return n.readAt }
+func (n *Notification) IsRead() bool          { return n.readAt != nil }
+
+// BelongsTo проверяет, адресовано ли уведомление пользователю
+func (n *Notification) BelongsTo(userID uuid.UUID) bool {
+	return n.userID == userID
+}
+
+// RestoreState sets the creation and read times (for data restoration)
+func (n *Notification) RestoreState(createdAt time.Time, readAt *time.Time) {
+	n.createdAt = createdAt
+	n.readAt = readAt
+}
+
+// MarkRead отмечает уведомление прочитанным; возвращает false, если оно уже было прочитано
+func (n *Notification) MarkRead() bool {
+	if n.readAt != nil {
+		return false
+	}
+	now := time.Now()
+	n.readAt = &now
+	return true
+}
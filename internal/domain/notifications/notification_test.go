@@ -0,0 +1,52 @@
+package notifications_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"simpleservicedesk/internal/domain/notifications"
+)
+
+func TestNewMentionNotification(t *testing.T) {
+	userID, actorID, ticketID, commentID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	notification, err := notifications.NewMentionNotification(userID, actorID, ticketID, commentID)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, notification.ID())
+	assert.Equal(t, notifications.TypeMention, notification.Type())
+	assert.Equal(t, userID, notification.UserID())
+	assert.Equal(t, actorID, notification.ActorID())
+	assert.Equal(t, ticketID, notification.TicketID())
+	assert.Equal(t, &commentID, notification.CommentID())
+	assert.True(t, notification.BelongsTo(userID))
+	assert.False(t, notification.BelongsTo(actorID))
+	assert.False(t, notification.IsRead())
+}
+
+func TestNewNotificationValidation(t *testing.T) {
+	userID, ticketID := uuid.New(), uuid.New()
+
+	_, err := notifications.NewNotification(uuid.New(), uuid.Nil, notifications.TypeMention, uuid.New(), ticketID, nil)
+	require.ErrorIs(t, err, notifications.ErrNotificationValidation)
+
+	_, err = notifications.NewNotification(uuid.New(), userID, notifications.TypeMention, uuid.New(), uuid.Nil, nil)
+	require.ErrorIs(t, err, notifications.ErrNotificationValidation)
+
+	_, err = notifications.NewNotification(uuid.New(), userID, notifications.Type("digest"), uuid.New(), ticketID, nil)
+	require.ErrorIs(t, err, notifications.ErrNotificationValidation)
+}
+
+func TestNotification_MarkRead(t *testing.T) {
+	notification, err := notifications.NewMentionNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New())
+	require.NoError(t, err)
+
+	assert.True(t, notification.MarkRead())
+	require.NotNil(t, notification.ReadAt())
+	readAt := *notification.ReadAt()
+
+	assert.False(t, notification.MarkRead())
+	assert.Equal(t, readAt, *notification.ReadAt())
+}
@@ -3,9 +3,12 @@ package organizations
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"simpleservicedesk/internal/domain/tickets"
+
 	"github.com/google/uuid"
 )
 
@@ -15,6 +18,11 @@ var (
 	ErrOrganizationValidation   = errors.New("organization validation error")
 	ErrOrganizationAlreadyExist = errors.New("organization already exist")
 	ErrCircularReference        = errors.New("circular reference detected")
+	ErrVersionConflict          = errors.New("organization was modified by another request")
+	ErrConcurrentUpdate         = errors.New("organization is being changed by concurrent requests, try again")
+	ErrOrganizationHasChildren  = errors.New("organization has child organizations")
+	ErrParentInTrash            = errors.New("parent organization is in the trash")
+	ErrKeyPrefixTaken           = errors.New("ticket key prefix is used by another organization")
 )
 
 const (
@@ -23,6 +31,10 @@ const (
 	BytesInKB            = 1024
 	DefaultMaxFileSizeMB = 10
 	EmailPartsCount      = 2
+	MaxTags              = 200
+	HoursInDay           = 24
+	DefaultAutoCloseDays = 7
+	MaxAutoCloseDays     = 365
 )
 
 // OrganizationSettings представляет настройки организации
@@ -31,6 +43,10 @@ type OrganizationSettings struct {
 	DefaultTicketPriority string `json:"default_ticket_priority"`
 	EmailNotifications    bool   `json:"email_notifications"`
 	MaxFileSize           int64  `json:"max_file_size"` // в байтах
+	// Время ожидания ответа по решенной заявке до ее автоматического закрытия; 0 - не закрывать
+	AutoCloseAfter time.Duration `json:"auto_close_after"`
+	// Префикс ключей заявок, например ACME для ACME-1042; пусто - ключи не выдаются
+	TicketKeyPrefix string `json:"ticket_key_prefix"`
 }
 
 // DefaultSettings возвращает настройки по умолчанию
@@ -40,18 +56,47 @@ func DefaultSettings() OrganizationSettings {
 		DefaultTicketPriority: "normal",
 		EmailNotifications:    true,
 		MaxFileSize:           DefaultMaxFileSizeMB * BytesInKB * BytesInKB, // 10MB
+		AutoCloseAfter:        DefaultAutoCloseDays * HoursInDay * time.Hour,
 	}
 }
 
+// Validate проверяет корректность настроек организации
+func (s OrganizationSettings) Validate() error {
+	if _, err := tickets.ParsePriority(s.DefaultTicketPriority); err != nil {
+		return fmt.Errorf("%w: %w", ErrOrganizationValidation, err)
+	}
+	if s.MaxFileSize <= 0 {
+		return fmt.Errorf("%w: max file size must be positive", ErrOrganizationValidation)
+	}
+	if s.AutoCloseAfter < 0 || s.AutoCloseAfter > MaxAutoCloseDays*HoursInDay*time.Hour {
+		return fmt.Errorf("%w: auto-close period must be between 0 and %d days",
+			ErrOrganizationValidation, MaxAutoCloseDays)
+	}
+	if prefix, err := tickets.ParseKeyPrefix(s.TicketKeyPrefix); err != nil || prefix != s.TicketKeyPrefix {
+		return fmt.Errorf("%w: ticket key prefix must be %d-%d uppercase letters and digits starting with a letter",
+			ErrOrganizationValidation, tickets.MinKeyPrefixLength, tickets.MaxKeyPrefixLength)
+	}
+	return nil
+}
+
 type Organization struct {
-	id        uuid.UUID
-	name      string
-	domain    string     // Домен организации для автоматического определения пользователей
-	parentID  *uuid.UUID // Указатель, так как может быть nil для корневых организаций
-	isActive  bool
-	settings  OrganizationSettings
-	createdAt time.Time
-	updatedAt time.Time
+	id                  uuid.UUID
+	name                string
+	domain              string     // Домен организации для автоматического определения пользователей
+	parentID            *uuid.UUID // Указатель, так как может быть nil для корневых организаций
+	isActive            bool
+	settings            OrganizationSettings
+	workflow            *tickets.Workflow         // nil - используется рабочий процесс по умолчанию
+	sla                 *tickets.SLAConfig        // nil - используются сроки приоритетов по умолчанию
+	assignment          *tickets.AssignmentConfig // nil - заявки назначаются вручную
+	priorityMatrix      *tickets.PriorityMatrix   // nil - используется матрица приоритетов по умолчанию
+	autoAssignmentTurns int64                     // Число заявок, назначенных по очереди (round-robin)
+	tags                []tickets.Tag             // Определения меток заявок организации
+	createdAt           time.Time
+	updatedAt           time.Time
+	version             int64      // Номер сохраненной версии; 0 - организация еще не сохранена
+	deletedAt           *time.Time // Время перемещения в корзину; nil - организация не удалена
+	deletedBy           *uuid.UUID // Пользователь, удаливший организацию
 }
 
 // NewOrganization создает новую организацию с указанным ID
@@ -126,6 +171,43 @@ func (o *Organization) UpdatedAt() time.Time {
 	return o.updatedAt
 }
 
+// Version возвращает номер сохраненной версии организации
+func (o *Organization) Version() int64 {
+	return o.version
+}
+
+// RestoreVersion устанавливает номер сохраненной версии (для восстановления данных и после записи)
+func (o *Organization) RestoreVersion(version int64) {
+	o.version = version
+}
+
+// DeletedAt возвращает время перемещения организации в корзину (nil, если организация не удалена)
+func (o *Organization) DeletedAt() *time.Time {
+	return o.deletedAt
+}
+
+// DeletedBy возвращает ID пользователя, удалившего организацию (может быть nil)
+func (o *Organization) DeletedBy() *uuid.UUID {
+	return o.deletedBy
+}
+
+// IsDeleted проверяет, находится ли организация в корзине
+func (o *Organization) IsDeleted() bool {
+	return o.deletedAt != nil
+}
+
+// MarkDeleted перемещает организацию в корзину, откуда ее можно восстановить до окончательного удаления
+func (o *Organization) MarkDeleted(deletedBy uuid.UUID, deletedAt time.Time) {
+	o.deletedAt = &deletedAt
+	o.deletedBy = &deletedBy
+}
+
+// Undelete возвращает организацию из корзины
+func (o *Organization) Undelete() {
+	o.deletedAt = nil
+	o.deletedBy = nil
+}
+
 // ParentID возвращает ID родительской организации (может быть nil)
 func (o *Organization) ParentID() *uuid.UUID {
 	return o.parentID
@@ -167,6 +249,175 @@ func (o *Organization) UpdateSettings(settings OrganizationSettings) {
 	o.updatedAt = time.Now()
 }
 
+// Workflow возвращает рабочий процесс заявок организации
+func (o *Organization) Workflow() *tickets.Workflow {
+	if o.workflow == nil {
+		return tickets.DefaultWorkflow()
+	}
+	return o.workflow
+}
+
+// HasCustomWorkflow проверяет, настроен ли для организации собственный рабочий процесс
+func (o *Organization) HasCustomWorkflow() bool {
+	return o.workflow != nil
+}
+
+// SetWorkflow устанавливает собственный рабочий процесс организации
+func (o *Organization) SetWorkflow(workflow *tickets.Workflow) error {
+	if workflow == nil {
+		return fmt.Errorf("%w: workflow is required", ErrOrganizationValidation)
+	}
+	o.workflow = workflow
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// ResetWorkflow возвращает организации рабочий процесс по умолчанию
+func (o *Organization) ResetWorkflow() {
+	o.workflow = nil
+	o.updatedAt = time.Now()
+}
+
+// SLAConfig возвращает настройки SLA организации или nil, если они не заданы
+func (o *Organization) SLAConfig() *tickets.SLAConfig {
+	return o.sla
+}
+
+// SetSLAConfig устанавливает настройки SLA организации
+func (o *Organization) SetSLAConfig(config *tickets.SLAConfig) error {
+	if config == nil {
+		return fmt.Errorf("%w: sla config is required", ErrOrganizationValidation)
+	}
+	o.sla = config
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// ResetSLAConfig возвращает организации сроки SLA по умолчанию
+func (o *Organization) ResetSLAConfig() {
+	o.sla = nil
+	o.updatedAt = time.Now()
+}
+
+// AssignmentConfig возвращает настройки автоматического назначения заявок или nil, если оно не настроено
+func (o *Organization) AssignmentConfig() *tickets.AssignmentConfig {
+	return o.assignment
+}
+
+// SetAssignmentConfig включает автоматическое назначение новых заявок организации
+func (o *Organization) SetAssignmentConfig(config *tickets.AssignmentConfig) error {
+	if config == nil {
+		return fmt.Errorf("%w: assignment config is required", ErrOrganizationValidation)
+	}
+	o.assignment = config
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// ResetAssignmentConfig отключает автоматическое назначение заявок
+func (o *Organization) ResetAssignmentConfig() {
+	o.assignment = nil
+	o.updatedAt = time.Now()
+}
+
+// PriorityMatrix возвращает матрицу, по которой приоритет заявки вычисляется из влияния и срочности
+func (o *Organization) PriorityMatrix() *tickets.PriorityMatrix {
+	if o.priorityMatrix == nil {
+		return tickets.DefaultPriorityMatrix()
+	}
+	return o.priorityMatrix
+}
+
+// HasCustomPriorityMatrix проверяет, настроена ли для организации собственная матрица приоритетов
+func (o *Organization) HasCustomPriorityMatrix() bool {
+	return o.priorityMatrix != nil
+}
+
+// SetPriorityMatrix устанавливает собственную матрицу приоритетов организации
+func (o *Organization) SetPriorityMatrix(matrix *tickets.PriorityMatrix) error {
+	if matrix == nil {
+		return fmt.Errorf("%w: priority matrix is required", ErrOrganizationValidation)
+	}
+	o.priorityMatrix = matrix
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// ResetPriorityMatrix возвращает организации матрицу приоритетов по умолчанию
+func (o *Organization) ResetPriorityMatrix() {
+	o.priorityMatrix = nil
+	o.updatedAt = time.Now()
+}
+
+// AutoAssignmentTurns возвращает число заявок, назначенных по очереди (round-robin); очередь продолжается с него
+func (o *Organization) AutoAssignmentTurns() int64 {
+	return o.autoAssignmentTurns
+}
+
+// RestoreAutoAssignmentTurns sets the number of round-robin turns taken (for data restoration)
+func (o *Organization) RestoreAutoAssignmentTurns(turns int64) {
+	o.autoAssignmentTurns = turns
+}
+
+// Tags возвращает определения меток заявок организации
+func (o *Organization) Tags() []tickets.Tag {
+	return slices.Clone(o.tags)
+}
+
+// Tag возвращает определение метки по имени
+func (o *Organization) Tag(name string) (tickets.Tag, bool) {
+	index := o.tagIndex(name)
+	if index < 0 {
+		return tickets.Tag{}, false
+	}
+	return o.tags[index], true
+}
+
+// AddTag добавляет определение метки
+func (o *Organization) AddTag(tag tickets.Tag) error {
+	if o.tagIndex(tag.Name) >= 0 {
+		return fmt.Errorf("%w: %s", tickets.ErrTagExists, tag.Name)
+	}
+	if len(o.tags) >= MaxTags {
+		return fmt.Errorf("%w: too many tags (max %d)", ErrOrganizationValidation, MaxTags)
+	}
+	o.tags = append(o.tags, tag)
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateTag заменяет цвет и описание метки с тем же именем
+func (o *Organization) UpdateTag(tag tickets.Tag) error {
+	index := o.tagIndex(tag.Name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", tickets.ErrTagNotFound, tag.Name)
+	}
+	o.tags[index] = tag
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveTag удаляет определение метки. Заявки, уже отмеченные меткой, сохраняют ее.
+func (o *Organization) RemoveTag(name string) error {
+	index := o.tagIndex(name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", tickets.ErrTagNotFound, name)
+	}
+	o.tags = slices.Delete(o.tags, index, index+1)
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreTags sets the tag definitions (for data restoration)
+func (o *Organization) RestoreTags(tags []tickets.Tag) {
+	o.tags = tags
+}
+
+func (o *Organization) tagIndex(name string) int {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return slices.IndexFunc(o.tags, func(tag tickets.Tag) bool { return tag.Name == name })
+}
+
 // Activate активирует организацию
 func (o *Organization) Activate() {
 	o.isActive = true
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	domainOrg "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
 )
 
 func TestNewOrganization_Valid(t *testing.T) {
@@ -160,6 +161,166 @@ func TestOrganization_UpdateSettings(t *testing.T) {
 	require.True(t, org.UpdatedAt().After(originalUpdatedAt))
 }
 
+func TestOrganizationSettings_Validate(t *testing.T) {
+	require.NoError(t, domainOrg.DefaultSettings().Validate())
+	require.Equal(t, 7*24*time.Hour, domainOrg.DefaultSettings().AutoCloseAfter)
+
+	tests := []struct {
+		name   string
+		modify func(*domainOrg.OrganizationSettings)
+	}{
+		{"unknown priority", func(s *domainOrg.OrganizationSettings) { s.DefaultTicketPriority = "whenever" }},
+		{"zero max file size", func(s *domainOrg.OrganizationSettings) { s.MaxFileSize = 0 }},
+		{"negative auto-close period", func(s *domainOrg.OrganizationSettings) { s.AutoCloseAfter = -time.Hour }},
+		{"auto-close period over a year", func(s *domainOrg.OrganizationSettings) {
+			s.AutoCloseAfter = 366 * 24 * time.Hour
+		}},
+		{"malformed ticket key prefix", func(s *domainOrg.OrganizationSettings) { s.TicketKeyPrefix = "AC-ME" }},
+		{"lowercase ticket key prefix", func(s *domainOrg.OrganizationSettings) { s.TicketKeyPrefix = "acme" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := domainOrg.DefaultSettings()
+			tt.modify(&settings)
+			require.ErrorIs(t, settings.Validate(), domainOrg.ErrOrganizationValidation)
+		})
+	}
+
+	disabled := domainOrg.DefaultSettings()
+	disabled.AutoCloseAfter = 0
+	require.NoError(t, disabled.Validate())
+
+	keyed := domainOrg.DefaultSettings()
+	keyed.TicketKeyPrefix = "ACME"
+	require.NoError(t, keyed.Validate())
+}
+
+func TestOrganization_Workflow(t *testing.T) {
+	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
+	require.NoError(t, err)
+
+	require.False(t, org.HasCustomWorkflow())
+	require.Equal(t, tickets.DefaultWorkflow().Statuses(), org.Workflow().Statuses())
+
+	workflow, err := tickets.NewWorkflow(
+		[]tickets.WorkflowStatus{
+			{Key: tickets.StatusNew, Category: tickets.StatusNew},
+			{Key: tickets.StatusClosed, Category: tickets.StatusClosed},
+		},
+		[]tickets.WorkflowTransition{{From: tickets.StatusNew, To: tickets.StatusClosed}},
+	)
+	require.NoError(t, err)
+
+	originalUpdatedAt := org.UpdatedAt()
+	time.Sleep(time.Millisecond)
+
+	require.NoError(t, org.SetWorkflow(workflow))
+	require.True(t, org.HasCustomWorkflow())
+	require.Same(t, workflow, org.Workflow())
+	require.True(t, org.UpdatedAt().After(originalUpdatedAt))
+
+	require.ErrorIs(t, org.SetWorkflow(nil), domainOrg.ErrOrganizationValidation)
+	require.Same(t, workflow, org.Workflow())
+
+	org.ResetWorkflow()
+	require.False(t, org.HasCustomWorkflow())
+	require.Equal(t, tickets.DefaultWorkflow().Statuses(), org.Workflow().Statuses())
+}
+
+func TestOrganization_SLAConfig(t *testing.T) {
+	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
+	require.NoError(t, err)
+	require.Nil(t, org.SLAConfig())
+
+	config, err := tickets.NewSLAConfig(nil, []tickets.SLAPolicy{
+		{Name: "Standard", FirstResponse: time.Hour, Resolution: 8 * time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, org.SetSLAConfig(config))
+	require.Same(t, config, org.SLAConfig())
+
+	require.ErrorIs(t, org.SetSLAConfig(nil), domainOrg.ErrOrganizationValidation)
+	require.Same(t, config, org.SLAConfig())
+
+	org.ResetSLAConfig()
+	require.Nil(t, org.SLAConfig())
+}
+
+func TestOrganization_AssignmentConfig(t *testing.T) {
+	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
+	require.NoError(t, err)
+	require.Nil(t, org.AssignmentConfig())
+	require.Zero(t, org.AutoAssignmentTurns())
+
+	config, err := tickets.NewAssignmentConfig(tickets.AssignmentRoundRobin, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, org.SetAssignmentConfig(config))
+	require.Same(t, config, org.AssignmentConfig())
+	require.ErrorIs(t, org.SetAssignmentConfig(nil), domainOrg.ErrOrganizationValidation)
+
+	org.RestoreAutoAssignmentTurns(7)
+	require.Equal(t, int64(7), org.AutoAssignmentTurns())
+
+	org.ResetAssignmentConfig()
+	require.Nil(t, org.AssignmentConfig())
+}
+
+func TestOrganization_PriorityMatrix(t *testing.T) {
+	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
+	require.NoError(t, err)
+	require.False(t, org.HasCustomPriorityMatrix())
+	require.Equal(t, tickets.DefaultPriorityMatrix().Cells(), org.PriorityMatrix().Cells())
+
+	cells := tickets.DefaultPriorityMatrix().Cells()
+	for i := range cells {
+		cells[i].Priority = tickets.PriorityHigh
+	}
+	matrix, err := tickets.NewPriorityMatrix(cells)
+	require.NoError(t, err)
+
+	require.NoError(t, org.SetPriorityMatrix(matrix))
+	require.True(t, org.HasCustomPriorityMatrix())
+	require.Same(t, matrix, org.PriorityMatrix())
+
+	require.ErrorIs(t, org.SetPriorityMatrix(nil), domainOrg.ErrOrganizationValidation)
+	require.Same(t, matrix, org.PriorityMatrix())
+
+	org.ResetPriorityMatrix()
+	require.False(t, org.HasCustomPriorityMatrix())
+	require.Equal(t, tickets.DefaultPriorityMatrix().Cells(), org.PriorityMatrix().Cells())
+}
+
+func TestOrganization_Tags(t *testing.T) {
+	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
+	require.NoError(t, err)
+	require.Empty(t, org.Tags())
+
+	vip, err := tickets.NewTag("VIP", "#ff0000", "Key accounts")
+	require.NoError(t, err)
+	require.NoError(t, org.AddTag(vip))
+	require.ErrorIs(t, org.AddTag(vip), tickets.ErrTagExists)
+
+	tag, ok := org.Tag(" Vip ")
+	require.True(t, ok)
+	require.Equal(t, "#FF0000", tag.Color)
+
+	vip.Description = "Accounts with a premium contract"
+	require.NoError(t, org.UpdateTag(vip))
+	tag, _ = org.Tag("vip")
+	require.Equal(t, "Accounts with a premium contract", tag.Description)
+
+	security, err := tickets.NewTag("security", "#000000", "")
+	require.NoError(t, err)
+	require.ErrorIs(t, org.UpdateTag(security), tickets.ErrTagNotFound)
+
+	require.NoError(t, org.RemoveTag("vip"))
+	require.ErrorIs(t, org.RemoveTag("vip"), tickets.ErrTagNotFound)
+	_, ok = org.Tag("vip")
+	require.False(t, ok)
+}
+
 func TestOrganization_ActivateDeactivate(t *testing.T) {
 	org, err := domainOrg.CreateOrganization("Test Org", "test.com")
 	require.NoError(t, err)
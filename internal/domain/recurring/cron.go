@@ -0,0 +1,151 @@
+package recurring
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const cronFields = 5
+
+// cronMacros - сокращения стандартных расписаний cron
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronDayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// parseCron разбирает выражение cron из пяти полей: минута, час, день месяца, месяц, день недели.
+// Поля допускают *, списки, диапазоны и шаг (*/15, 1-5, MON-FRI); воскресенье - 0 или 7.
+// Как и в cron, если ограничены и день месяца, и день недели, достаточно совпадения одного из них.
+func parseCron(expression string) (*rule, error) {
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != cronFields {
+		return nil, fmt.Errorf("%w: cron expression must have %d fields", ErrInvalidSchedule, cronFields)
+	}
+
+	minutes, _, err := parseCronField(fields[0], 0, minutesInHour-1, nil)
+	if err != nil {
+		return nil, err
+	}
+	hours, _, err := parseCronField(fields[1], 0, hoursInDay-1, nil)
+	if err != nil {
+		return nil, err
+	}
+	monthDays, monthDaysRestricted, err := parseCronField(fields[2], 1, maxMonthDay, nil)
+	if err != nil {
+		return nil, err
+	}
+	months, _, err := parseCronField(fields[3], 1, monthsInYear, cronMonthNames)
+	if err != nil {
+		return nil, err
+	}
+	weekdays, weekdaysRestricted, err := parseCronField(fields[4], 0, daysInWeek, cronDayNames)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rule{minutes: minutes, hours: hours, anyOfDays: true, interval: 1}
+	for _, month := range months {
+		result.months[month] = true
+	}
+	if monthDaysRestricted {
+		result.monthDays = monthDays
+	}
+	if weekdaysRestricted {
+		for _, day := range weekdays {
+			weekday := weekdayRule{day: time.Weekday(day % daysInWeek)}
+			if !slices.Contains(result.weekdays, weekday) {
+				result.weekdays = append(result.weekdays, weekday)
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseCronField возвращает отсортированные значения поля и признак того, что поле ограничено (не начинается с *)
+func parseCronField(field string, low, high int, names map[string]int) ([]int, bool, error) {
+	selected := make([]bool, high+1)
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return nil, false, fmt.Errorf("%w: invalid step in cron field %q", ErrInvalidSchedule, field)
+			}
+		}
+
+		first, last, err := parseCronRange(rangePart, low, high, names)
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: invalid cron field %q", ErrInvalidSchedule, field)
+		}
+		if hasStep && !strings.Contains(rangePart, "-") {
+			last = high
+		}
+		for value := first; value <= last; value += step {
+			selected[value] = true
+		}
+	}
+
+	var values []int
+	for value, ok := range selected {
+		if ok {
+			values = append(values, value)
+		}
+	}
+	return values, !strings.HasPrefix(field, "*"), nil
+}
+
+func parseCronRange(rangePart string, low, high int, names map[string]int) (int, int, error) {
+	if rangePart == "*" {
+		return low, high, nil
+	}
+
+	firstPart, lastPart, isRange := strings.Cut(rangePart, "-")
+	first, err := parseCronValue(firstPart, low, high, names)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return first, first, nil
+	}
+	last, err := parseCronValue(lastPart, low, high, names)
+	if err != nil {
+		return 0, 0, err
+	}
+	if last < first {
+		return 0, 0, fmt.Errorf("%w: range %q is reversed", ErrInvalidSchedule, rangePart)
+	}
+	return first, last, nil
+}
+
+func parseCronValue(value string, low, high int, names map[string]int) (int, error) {
+	if named, ok := names[strings.ToUpper(value)]; ok {
+		return named, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < low || number > high {
+		return 0, fmt.Errorf("%w: value %q is out of range %d-%d", ErrInvalidSchedule, value, low, high)
+	}
+	return number, nil
+}
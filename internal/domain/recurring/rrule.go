@@ -0,0 +1,192 @@
+package recurring
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxInterval = 1000
+
+var rruleDayNames = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+const (
+	rruleDateLayout     = "20060102"
+	rruleDateTimeLayout = "20060102T150405"
+)
+
+// parseRRule разбирает правило повторения RFC 5545 с частотой DAILY, WEEKLY, MONTHLY или YEARLY.
+// Поддерживаются INTERVAL, BYMONTH, BYMONTHDAY, BYDAY (с порядковым номером для MONTHLY и YEARLY),
+// BYHOUR, BYMINUTE и UNTIL. Неуказанные время, день недели, день месяца и месяц берутся из DTSTART.
+func parseRRule(expression string, start time.Time) (*rule, error) {
+	expression = strings.TrimPrefix(strings.ToUpper(expression), "RRULE:")
+	parts := make(map[string]string)
+	for part := range strings.SplitSeq(expression, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed rule part %q", ErrInvalidSchedule, part)
+		}
+		if _, duplicate := parts[key]; duplicate {
+			return nil, fmt.Errorf("%w: duplicate rule part %s", ErrInvalidSchedule, key)
+		}
+		parts[key] = value
+	}
+
+	result := &rule{freq: frequency(parts["FREQ"]), interval: 1, anchor: start}
+	switch result.freq {
+	case freqDaily, freqWeekly, freqMonthly, freqYearly:
+	default:
+		return nil, fmt.Errorf("%w: FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY", ErrInvalidSchedule)
+	}
+	delete(parts, "FREQ")
+
+	for key, value := range parts {
+		if err := result.applyRRulePart(key, value, start.Location()); err != nil {
+			return nil, err
+		}
+	}
+	result.applyRRuleDefaults(start)
+	return result, nil
+}
+
+func (r *rule) applyRRulePart(key, value string, location *time.Location) error {
+	var err error
+	switch key {
+	case "INTERVAL":
+		r.interval, err = strconv.Atoi(value)
+		if err != nil || r.interval < 1 || r.interval > maxInterval {
+			return fmt.Errorf("%w: INTERVAL must be between 1 and %d", ErrInvalidSchedule, maxInterval)
+		}
+	case "BYMONTH":
+		var months []int
+		months, err = parseRRuleNumbers(key, value, 1, monthsInYear, false)
+		for _, month := range months {
+			r.months[month] = true
+		}
+	case "BYMONTHDAY":
+		r.monthDays, err = parseRRuleNumbers(key, value, 1, maxMonthDay, true)
+	case "BYDAY":
+		r.weekdays, err = parseRRuleWeekdays(value, r.freq == freqMonthly || r.freq == freqYearly)
+	case "BYHOUR":
+		r.hours, err = parseRRuleNumbers(key, value, 0, hoursInDay-1, false)
+	case "BYMINUTE":
+		r.minutes, err = parseRRuleNumbers(key, value, 0, minutesInHour-1, false)
+	case "UNTIL":
+		r.until, err = parseRRuleUntil(value, location)
+	case "WKST":
+		if value != "MO" {
+			return fmt.Errorf("%w: only WKST=MO is supported", ErrInvalidSchedule)
+		}
+	case "COUNT":
+		return fmt.Errorf("%w: COUNT is not supported, use UNTIL", ErrInvalidSchedule)
+	default:
+		return fmt.Errorf("%w: unsupported rule part %s", ErrInvalidSchedule, key)
+	}
+	return err
+}
+
+// applyRRuleDefaults дополняет правило значениями из DTSTART, как того требует RFC 5545
+func (r *rule) applyRRuleDefaults(start time.Time) {
+	if len(r.hours) == 0 {
+		r.hours = []int{start.Hour()}
+	}
+	if len(r.minutes) == 0 {
+		r.minutes = []int{start.Minute()}
+	}
+
+	hasMonths := slices.Contains(r.months[:], true)
+	switch r.freq {
+	case freqDaily:
+	case freqWeekly:
+		if len(r.weekdays) == 0 {
+			r.weekdays = []weekdayRule{{day: start.Weekday()}}
+		}
+	case freqMonthly:
+		if len(r.monthDays) == 0 && len(r.weekdays) == 0 {
+			r.monthDays = []int{start.Day()}
+		}
+	case freqYearly:
+		if !hasMonths {
+			r.months[start.Month()] = true
+			hasMonths = true
+		}
+		if len(r.monthDays) == 0 && len(r.weekdays) == 0 {
+			r.monthDays = []int{start.Day()}
+		}
+	}
+	if !hasMonths {
+		for month := 1; month <= monthsInYear; month++ {
+			r.months[month] = true
+		}
+	}
+}
+
+// parseRRuleNumbers разбирает список чисел из диапазона low..high; negative допускает отсчет с конца (-1 - последний)
+func parseRRuleNumbers(key, value string, low, high int, negative bool) ([]int, error) {
+	var numbers []int
+	for item := range strings.SplitSeq(value, ",") {
+		number, err := strconv.Atoi(item)
+		valid := err == nil && ((number >= low && number <= high) || (negative && number <= -low && number >= -high))
+		if !valid {
+			return nil, fmt.Errorf("%w: invalid %s value %q", ErrInvalidSchedule, key, item)
+		}
+		if !slices.Contains(numbers, number) {
+			numbers = append(numbers, number)
+		}
+	}
+	slices.Sort(numbers)
+	return numbers, nil
+}
+
+// parseRRuleWeekdays разбирает BYDAY: MO, TU или с порядковым номером в месяце - 1MO, -1FR
+func parseRRuleWeekdays(value string, allowOrdinal bool) ([]weekdayRule, error) {
+	var weekdays []weekdayRule
+	for item := range strings.SplitSeq(value, ",") {
+		if len(item) < len("MO") {
+			return nil, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidSchedule, item)
+		}
+		prefix, name := item[:len(item)-2], item[len(item)-2:]
+		day, ok := rruleDayNames[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidSchedule, item)
+		}
+
+		weekday := weekdayRule{day: day}
+		if prefix != "" {
+			ordinal, err := strconv.Atoi(prefix)
+			if err != nil || !allowOrdinal || ordinal == 0 || ordinal > maxWeekdayOrdinal ||
+				ordinal < -maxWeekdayOrdinal {
+				return nil, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidSchedule, item)
+			}
+			weekday.ordinal = ordinal
+		}
+		if !slices.Contains(weekdays, weekday) {
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	return weekdays, nil
+}
+
+// parseRRuleUntil разбирает UNTIL: время в UTC (с суффиксом Z), местное время расписания или дату, включительно
+func parseRRuleUntil(value string, location *time.Location) (*time.Time, error) {
+	var until time.Time
+	var err error
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		until, err = time.Parse(rruleDateTimeLayout+"Z", value)
+	case len(value) == len(rruleDateLayout):
+		until, err = time.ParseInLocation(rruleDateLayout, value, location)
+		until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	default:
+		until, err = time.ParseInLocation(rruleDateTimeLayout, value, location)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid UNTIL value %q", ErrInvalidSchedule, value)
+	}
+	return &until, nil
+}
@@ -0,0 +1,283 @@
+package recurring
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSchedule = errors.New("invalid schedule")
+)
+
+const (
+	MaxExpressionLength = 500
+	MaxUpcomingRuns     = 20
+	maxSearchYears      = 10 // Расписание без срабатываний в ближайшие 10 лет считается завершенным
+	hoursInDay          = 24
+	minutesInHour       = 60
+	daysInWeek          = 7
+	monthsInYear        = 12
+	maxMonthDay         = 31
+	maxWeekdayOrdinal   = 5
+)
+
+// ScheduleType определяет формат выражения расписания
+type ScheduleType string
+
+const (
+	ScheduleCron  ScheduleType = "cron"  // Пять полей cron: минута, час, день месяца, месяц, день недели
+	ScheduleRRule ScheduleType = "rrule" // Правило повторения RFC 5545
+)
+
+// AllScheduleTypes возвращает все форматы расписаний
+func AllScheduleTypes() []ScheduleType {
+	return []ScheduleType{ScheduleCron, ScheduleRRule}
+}
+
+// String возвращает строковое представление формата
+func (t ScheduleType) String() string {
+	return string(t)
+}
+
+// IsValid проверяет, является ли формат расписания известным
+func (t ScheduleType) IsValid() bool {
+	return slices.Contains(AllScheduleTypes(), t)
+}
+
+// Schedule описывает, когда шаблон создает заявки.
+// Время срабатывания вычисляется в часовом поясе расписания с точностью до минуты.
+type Schedule struct {
+	scheduleType ScheduleType
+	expression   string
+	location     *time.Location
+	startAt      time.Time // Начало действия; для RRULE это DTSTART, от которого отсчитывается INTERVAL
+	rule         *rule
+}
+
+// NewSchedule разбирает выражение расписания; пустой часовой пояс означает UTC
+func NewSchedule(scheduleType ScheduleType, expression, timezone string, startAt time.Time) (Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return Schedule{}, fmt.Errorf("%w: expression is required", ErrInvalidSchedule)
+	}
+	if len(expression) > MaxExpressionLength {
+		return Schedule{}, fmt.Errorf("%w: expression must be no more than %d characters long",
+			ErrInvalidSchedule, MaxExpressionLength)
+	}
+	if startAt.IsZero() {
+		return Schedule{}, fmt.Errorf("%w: start time is required", ErrInvalidSchedule)
+	}
+
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, timezone)
+	}
+
+	var scheduleRule *rule
+	switch scheduleType {
+	case ScheduleCron:
+		scheduleRule, err = parseCron(expression)
+	case ScheduleRRule:
+		scheduleRule, err = parseRRule(expression, startAt.In(location))
+	default:
+		return Schedule{}, fmt.Errorf("%w: unknown schedule type %q", ErrInvalidSchedule, scheduleType)
+	}
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	return Schedule{
+		scheduleType: scheduleType,
+		expression:   expression,
+		location:     location,
+		startAt:      startAt,
+		rule:         scheduleRule,
+	}, nil
+}
+
+func (s Schedule) Type() ScheduleType { return s.scheduleType }
+func (s Schedule) Expression() string { return s.expression }
+func (s Schedule) Timezone() string   { return s.location.String() }
+func (s Schedule) StartAt() time.Time { return s.startAt }
+func (s Schedule) IsZero() bool       { return s.rule == nil }
+
+// Equal проверяет, совпадают ли расписания
+func (s Schedule) Equal(other Schedule) bool {
+	return s.scheduleType == other.scheduleType && s.expression == other.expression &&
+		s.Timezone() == other.Timezone() && s.startAt.Equal(other.startAt)
+}
+
+// Next возвращает первое срабатывание строго после after и не раньше начала действия расписания.
+// false - расписание больше не срабатывает.
+func (s Schedule) Next(after time.Time) (time.Time, bool) {
+	if s.rule == nil {
+		return time.Time{}, false
+	}
+
+	from := after
+	if s.startAt.After(after) {
+		from = s.startAt.Add(-time.Nanosecond)
+	}
+	local := from.In(s.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.location)
+	limit := day.AddDate(maxSearchYears, 0, 0)
+
+	for ; day.Before(limit); day = day.AddDate(0, 0, 1) {
+		if s.rule.until != nil && day.After(*s.rule.until) {
+			break
+		}
+		if !s.rule.matchesDay(day) {
+			continue
+		}
+		if next, ok := s.rule.firstTimeOfDay(day, from); ok {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Upcoming возвращает до n ближайших срабатываний после after
+func (s Schedule) Upcoming(after time.Time, n int) []time.Time {
+	n = min(n, MaxUpcomingRuns)
+	runs := make([]time.Time, 0, n)
+	for len(runs) < n {
+		next, ok := s.Next(after)
+		if !ok {
+			break
+		}
+		runs = append(runs, next)
+		after = next
+	}
+	return runs
+}
+
+// frequency определяет шаг повторения RRULE
+type frequency string
+
+const (
+	freqDaily   frequency = "DAILY"
+	freqWeekly  frequency = "WEEKLY"
+	freqMonthly frequency = "MONTHLY"
+	freqYearly  frequency = "YEARLY"
+)
+
+// weekdayRule задает день недели; ordinal 1..5 или -1..-5 выбирает n-й такой день месяца, 0 - каждый
+type weekdayRule struct {
+	day     time.Weekday
+	ordinal int
+}
+
+// rule - разобранное расписание: множества допустимых значений и шаг повторения
+type rule struct {
+	minutes   []int
+	hours     []int
+	months    [monthsInYear + 1]bool
+	monthDays []int         // 1..31 или -31..-1 от конца месяца; пусто - любой день
+	weekdays  []weekdayRule // Пусто - любой день недели
+	anyOfDays bool          // Cron: день подходит, если совпал день месяца или день недели
+	freq      frequency     // Пусто для cron
+	interval  int
+	anchor    time.Time // DTSTART в часовом поясе расписания
+	until     *time.Time
+}
+
+func (r *rule) matchesDay(day time.Time) bool {
+	if !r.months[day.Month()] {
+		return false
+	}
+
+	monthDayMatch := len(r.monthDays) == 0 || r.matchesMonthDay(day)
+	weekdayMatch := len(r.weekdays) == 0 || r.matchesWeekday(day)
+	if r.anyOfDays && len(r.monthDays) > 0 && len(r.weekdays) > 0 {
+		if !monthDayMatch && !weekdayMatch {
+			return false
+		}
+	} else if !monthDayMatch || !weekdayMatch {
+		return false
+	}
+	return r.matchesInterval(day)
+}
+
+func (r *rule) matchesMonthDay(day time.Time) bool {
+	last := daysInMonth(day)
+	for _, monthDay := range r.monthDays {
+		if monthDay == day.Day() || (monthDay < 0 && last+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rule) matchesWeekday(day time.Time) bool {
+	fromStart := (day.Day()-1)/daysInWeek + 1
+	fromEnd := -((daysInMonth(day)-day.Day())/daysInWeek + 1)
+	for _, weekday := range r.weekdays {
+		if weekday.day != day.Weekday() {
+			continue
+		}
+		if weekday.ordinal == 0 || weekday.ordinal == fromStart || weekday.ordinal == fromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesInterval проверяет, что день попадает в период, кратный INTERVAL от DTSTART
+func (r *rule) matchesInterval(day time.Time) bool {
+	if r.interval <= 1 {
+		return true
+	}
+
+	var periods int
+	switch r.freq {
+	case freqDaily:
+		periods = civilDays(day) - civilDays(r.anchor)
+	case freqWeekly:
+		periods = (civilDays(startOfWeek(day)) - civilDays(startOfWeek(r.anchor))) / daysInWeek
+	case freqMonthly:
+		periods = (day.Year()-r.anchor.Year())*monthsInYear + int(day.Month()) - int(r.anchor.Month())
+	case freqYearly:
+		periods = day.Year() - r.anchor.Year()
+	}
+	return periods%r.interval == 0
+}
+
+// firstTimeOfDay возвращает первое время срабатывания в течение дня строго после from
+func (r *rule) firstTimeOfDay(day, from time.Time) (time.Time, bool) {
+	for _, hour := range r.hours {
+		for _, minute := range r.minutes {
+			next := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+			if !next.After(from) {
+				continue
+			}
+			if r.until != nil && next.After(*r.until) {
+				return time.Time{}, false
+			}
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func daysInMonth(day time.Time) int {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// civilDays возвращает номер календарного дня независимо от часового пояса и перехода на летнее время
+func civilDays(day time.Time) int {
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	return int(midnight.Unix() / int64(hoursInDay*time.Hour/time.Second))
+}
+
+// startOfWeek возвращает понедельник недели, в которую попадает день
+func startOfWeek(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + daysInWeek - 1) % daysInWeek
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,155 @@
+package recurring_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"simpleservicedesk/internal/domain/recurring"
+)
+
+func mustSchedule(t *testing.T, scheduleType recurring.ScheduleType, expression, timezone string) recurring.Schedule {
+	t.Helper()
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := recurring.NewSchedule(scheduleType, expression, timezone, start)
+	require.NoError(t, err, expression)
+	return schedule
+}
+
+func TestSchedule_Cron(t *testing.T) {
+	from := time.Date(2026, time.March, 4, 10, 7, 0, 0, time.UTC) // Среда
+
+	cases := []struct {
+		expression string
+		expected   []time.Time
+	}{
+		{"*/15 * * * *", []time.Time{
+			time.Date(2026, time.March, 4, 10, 15, 0, 0, time.UTC),
+			time.Date(2026, time.March, 4, 10, 30, 0, 0, time.UTC),
+		}},
+		{"0 9 * * MON-FRI", []time.Time{
+			time.Date(2026, time.March, 5, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 6, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 9, 9, 0, 0, 0, time.UTC),
+		}},
+		{"30 8 1 */3 *", []time.Time{
+			time.Date(2026, time.April, 1, 8, 30, 0, 0, time.UTC),
+			time.Date(2026, time.July, 1, 8, 30, 0, 0, time.UTC),
+		}},
+		// День месяца и день недели объединяются по ИЛИ
+		{"0 0 13 * 5", []time.Time{
+			time.Date(2026, time.March, 6, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 13, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC),
+		}},
+		{"0 12 * * 7", []time.Time{time.Date(2026, time.March, 8, 12, 0, 0, 0, time.UTC)}},
+		{"@monthly", []time.Time{time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)}},
+		{"0 0 29 FEB *", []time.Time{time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)}},
+	}
+	for _, tc := range cases {
+		schedule := mustSchedule(t, recurring.ScheduleCron, tc.expression, "")
+		assert.Equal(t, tc.expected, schedule.Upcoming(from, len(tc.expected)), tc.expression)
+	}
+}
+
+func TestSchedule_RRule(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 9, 30, 0, 0, time.UTC) // Понедельник
+	from := time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expression string
+		expected   []time.Time
+	}{
+		{"FREQ=DAILY", []time.Time{
+			time.Date(2026, time.March, 5, 9, 30, 0, 0, time.UTC),
+			time.Date(2026, time.March, 6, 9, 30, 0, 0, time.UTC),
+		}},
+		// Каждая вторая неделя, начиная с недели DTSTART
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", []time.Time{
+			time.Date(2026, time.March, 5, 9, 30, 0, 0, time.UTC),
+			time.Date(2026, time.March, 16, 9, 30, 0, 0, time.UTC),
+			time.Date(2026, time.March, 19, 9, 30, 0, 0, time.UTC),
+		}},
+		{"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0", []time.Time{
+			time.Date(2026, time.March, 27, 17, 0, 0, 0, time.UTC),
+			time.Date(2026, time.April, 24, 17, 0, 0, 0, time.UTC),
+		}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", []time.Time{
+			time.Date(2026, time.March, 31, 9, 30, 0, 0, time.UTC),
+			time.Date(2026, time.April, 30, 9, 30, 0, 0, time.UTC),
+		}},
+		{"FREQ=YEARLY", []time.Time{time.Date(2027, time.January, 5, 9, 30, 0, 0, time.UTC)}},
+	}
+	for _, tc := range cases {
+		schedule, err := recurring.NewSchedule(recurring.ScheduleRRule, tc.expression, "", start)
+		require.NoError(t, err, tc.expression)
+		assert.Equal(t, tc.expected, schedule.Upcoming(from, len(tc.expected)), tc.expression)
+	}
+
+	// UNTIL в виде даты включает весь день
+	schedule, err := recurring.NewSchedule(recurring.ScheduleRRule, "FREQ=DAILY;UNTIL=20260305", "", start)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{time.Date(2026, time.March, 5, 9, 30, 0, 0, time.UTC)},
+		schedule.Upcoming(from, recurring.MaxUpcomingRuns))
+}
+
+func TestSchedule_StartAtAndUntil(t *testing.T) {
+	start := time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC)
+	schedule, err := recurring.NewSchedule(recurring.ScheduleCron, "0 8 * * *", "", start)
+	require.NoError(t, err)
+
+	next, ok := schedule.Next(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, time.June, 2, 8, 0, 0, 0, time.UTC), next)
+
+	finished, err := recurring.NewSchedule(recurring.ScheduleRRule, "FREQ=DAILY;UNTIL=20260101T000000Z", "", start)
+	require.NoError(t, err)
+	_, ok = finished.Next(start)
+	assert.False(t, ok)
+}
+
+func TestSchedule_Timezone(t *testing.T) {
+	schedule := mustSchedule(t, recurring.ScheduleCron, "0 9 * * *", "Europe/Berlin")
+	assert.Equal(t, "Europe/Berlin", schedule.Timezone())
+
+	// 9:00 по Берлину - 8:00 UTC зимой и 7:00 UTC после перехода на летнее время 29 марта 2026
+	runs := schedule.Upcoming(time.Date(2026, time.March, 28, 0, 0, 0, 0, time.UTC), 2)
+	require.Len(t, runs, 2)
+	assert.Equal(t, time.Date(2026, time.March, 28, 8, 0, 0, 0, time.UTC), runs[0].UTC())
+	assert.Equal(t, time.Date(2026, time.March, 29, 7, 0, 0, 0, time.UTC), runs[1].UTC())
+}
+
+func TestNewSchedule_Invalid(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		scheduleType     recurring.ScheduleType
+		expression, zone string
+	}{
+		{recurring.ScheduleCron, "", ""},
+		{recurring.ScheduleCron, strings.Repeat("*", recurring.MaxExpressionLength+1), ""},
+		{recurring.ScheduleCron, "* * * *", ""},
+		{recurring.ScheduleCron, "60 * * * *", ""},
+		{recurring.ScheduleCron, "0 9 * * MON-SUNDAY", ""},
+		{recurring.ScheduleCron, "0 9 5-1 * *", ""},
+		{recurring.ScheduleCron, "*/0 * * * *", ""},
+		{recurring.ScheduleCron, "0 9 * * *", "Mars/Olympus"},
+		{recurring.ScheduleRRule, "FREQ=HOURLY", ""},
+		{recurring.ScheduleRRule, "FREQ=DAILY;COUNT=5", ""},
+		{recurring.ScheduleRRule, "FREQ=WEEKLY;BYDAY=1MO", ""},
+		{recurring.ScheduleRRule, "FREQ=MONTHLY;BYMONTHDAY=32", ""},
+		{recurring.ScheduleRRule, "FREQ=DAILY;INTERVAL=0", ""},
+		{recurring.ScheduleRRule, "FREQ=DAILY;FREQ=WEEKLY", ""},
+		{recurring.ScheduleRRule, "FREQ=DAILY;UNTIL=tomorrow", ""},
+		{"hourly", "0 * * * *", ""},
+	}
+	for _, tc := range cases {
+		_, err := recurring.NewSchedule(tc.scheduleType, tc.expression, tc.zone, start)
+		require.ErrorIs(t, err, recurring.ErrInvalidSchedule, "%s %q", tc.scheduleType, tc.expression)
+	}
+
+	_, err := recurring.NewSchedule(recurring.ScheduleCron, "0 9 * * *", "", time.Time{})
+	require.ErrorIs(t, err, recurring.ErrInvalidSchedule)
+}
@@ -0,0 +1,257 @@
+package recurring
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTemplateNotFound   = errors.New("recurring template not found")
+	ErrTemplateValidation = errors.New("recurring template validation error")
+	ErrConcurrentUpdate   = errors.New("recurring template is being changed by concurrent requests, try again")
+)
+
+var errNoUpcomingRuns = fmt.Errorf("%w: schedule has no upcoming runs", ErrTemplateValidation)
+
+const MaxNameLength = 100
+
+// TicketDraft описывает заявку, которую создает шаблон
+type TicketDraft struct {
+	Title          string
+	Description    string
+	Priority       tickets.Priority
+	OrganizationID uuid.UUID
+	CategoryID     *uuid.UUID
+	AssigneeID     *uuid.UUID // Исполнитель по умолчанию; nil - заявка создается без исполнителя
+}
+
+// Template представляет шаблон повторяющейся заявки, создаваемой по расписанию
+type Template struct {
+	id           uuid.UUID
+	name         string
+	draft        TicketDraft
+	schedule     Schedule
+	isActive     bool
+	nextRunAt    *time.Time // Плановое время следующей заявки; nil - шаблон приостановлен или расписание завершено
+	lastRunAt    *time.Time // Плановое время последней созданной заявки
+	lastTicketID *uuid.UUID
+	createdBy    uuid.UUID // Автор создаваемых заявок
+	createdAt    time.Time
+	updatedAt    time.Time
+	version      int64 // Номер сохраненной версии; 0 - шаблон еще не сохранен
+}
+
+// NewTemplate создает активный шаблон с указанным ID; следующее срабатывание вычисляется от текущего времени.
+// Если расписание больше не срабатывает, шаблон создается неактивным.
+func NewTemplate(
+	id uuid.UUID,
+	name string,
+	draft TicketDraft,
+	schedule Schedule,
+	createdBy uuid.UUID,
+) (*Template, error) {
+	if createdBy == uuid.Nil {
+		return nil, fmt.Errorf("%w: author is required", ErrTemplateValidation)
+	}
+	if schedule.IsZero() {
+		return nil, fmt.Errorf("%w: schedule is required", ErrTemplateValidation)
+	}
+
+	template := &Template{id: id, createdBy: createdBy, schedule: schedule}
+	if err := template.Update(name, draft); err != nil {
+		return nil, err
+	}
+	if next, ok := schedule.Next(template.updatedAt); ok {
+		template.isActive = true
+		template.nextRunAt = &next
+	}
+	template.createdAt = template.updatedAt
+	return template, nil
+}
+
+// CreateTemplate создает шаблон с автоматически сгенерированным ID; расписание должно иметь предстоящие срабатывания
+func CreateTemplate(name string, draft TicketDraft, schedule Schedule, createdBy uuid.UUID) (*Template, error) {
+	template, err := NewTemplate(uuid.New(), name, draft, schedule, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if !template.isActive {
+		return nil, errNoUpcomingRuns
+	}
+	return template, nil
+}
+
+func (t *Template) ID() uuid.UUID            { return t.id }
+func (t *Template) Name() string             { return t.name }
+func (t *Template) Draft() TicketDraft       { return t.draft }
+func (t *Template) Schedule() Schedule       { return t.schedule }
+func (t *Template) IsActive() bool           { return t.isActive }
+func (t *Template) NextRunAt() *time.Time    { return t.nextRunAt }
+func (t *Template) LastRunAt() *time.Time    { return t.lastRunAt }
+func (t *Template) LastTicketID() *uuid.UUID { return t.lastTicketID }
+func (t *Template) CreatedBy() uuid.UUID     { return t.createdBy }
+func (t *Template) CreatedAt() time.Time     { return t.createdAt }
+func (t *Template) UpdatedAt() time.Time     { return t.updatedAt }
+func (t *Template) Version() int64           { return t.version }
+
+// RestoreTimestamps sets the creation and modification times (for data restoration)
+func (t *Template) RestoreTimestamps(createdAt, updatedAt time.Time) {
+	t.createdAt = createdAt
+	t.updatedAt = updatedAt
+}
+
+// RestoreRunState восстанавливает состояние запусков шаблона (для восстановления данных)
+func (t *Template) RestoreRunState(isActive bool, nextRunAt, lastRunAt *time.Time, lastTicketID *uuid.UUID) {
+	t.isActive = isActive
+	t.nextRunAt = nextRunAt
+	t.lastRunAt = lastRunAt
+	t.lastTicketID = lastTicketID
+}
+
+// RestoreVersion устанавливает номер сохраненной версии (для восстановления данных и после записи)
+func (t *Template) RestoreVersion(version int64) { t.version = version }
+
+// Update изменяет название шаблона и содержимое создаваемых заявок
+func (t *Template) Update(name string, draft TicketDraft) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrTemplateValidation)
+	}
+	if len(name) > MaxNameLength {
+		return fmt.Errorf("%w: name must be no more than %d characters long", ErrTemplateValidation, MaxNameLength)
+	}
+	if draft.AssigneeID != nil && *draft.AssigneeID == uuid.Nil {
+		return fmt.Errorf("%w: assignee_id cannot be empty", ErrTemplateValidation)
+	}
+
+	// Заявка собирается заранее, чтобы ошибки в содержимом обнаруживались при сохранении шаблона, а не по расписанию
+	if _, err := newDraftTicket(uuid.New(), draft, t.createdBy); err != nil {
+		return err
+	}
+
+	t.name = name
+	t.draft = draft
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// Reschedule заменяет расписание; у активного шаблона следующее срабатывание вычисляется от now
+func (t *Template) Reschedule(schedule Schedule, now time.Time) error {
+	if schedule.IsZero() {
+		return fmt.Errorf("%w: schedule is required", ErrTemplateValidation)
+	}
+	next, err := firstRun(schedule, now)
+	if err != nil {
+		return err
+	}
+
+	t.schedule = schedule
+	if t.isActive {
+		t.nextRunAt = &next
+	}
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// Activate возобновляет создание заявок; пропущенные за время паузы срабатывания не наверстываются
+func (t *Template) Activate(now time.Time) error {
+	if t.isActive {
+		return nil
+	}
+	next, err := firstRun(t.schedule, now)
+	if err != nil {
+		return err
+	}
+
+	t.isActive = true
+	t.nextRunAt = &next
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// Deactivate приостанавливает создание заявок
+func (t *Template) Deactivate() {
+	t.isActive = false
+	t.nextRunAt = nil
+	t.updatedAt = time.Now()
+}
+
+// IsDue проверяет, наступило ли время создать очередную заявку
+func (t *Template) IsDue(now time.Time) bool {
+	return t.isActive && t.nextRunAt != nil && !now.Before(*t.nextRunAt)
+}
+
+// OccurrenceTicketID возвращает ID заявки для срабатывания scheduledAt.
+// ID однозначно определяется шаблоном и плановым временем, поэтому повторная попытка создать ту же заявку
+// (с другой реплики или после сбоя) обнаруживается как дубликат.
+func (t *Template) OccurrenceTicketID(scheduledAt time.Time) uuid.UUID {
+	return uuid.NewSHA1(t.id, []byte(scheduledAt.UTC().Format(time.RFC3339)))
+}
+
+// NewTicket собирает заявку для срабатывания scheduledAt от имени автора шаблона
+func (t *Template) NewTicket(scheduledAt time.Time) (*tickets.Ticket, error) {
+	return newDraftTicket(t.OccurrenceTicketID(scheduledAt), t.draft, t.createdBy)
+}
+
+// RecordRun отмечает создание заявки для срабатывания scheduledAt и переводит шаблон к следующему.
+// Срабатывания, пропущенные, пока сервер был недоступен, объединяются в одну заявку: следующее
+// вычисляется от now. false - срабатывание уже отмечено другим запуском.
+func (t *Template) RecordRun(scheduledAt time.Time, ticketID uuid.UUID, now time.Time) bool {
+	if !t.isActive || t.nextRunAt == nil || !t.nextRunAt.Equal(scheduledAt) {
+		return false
+	}
+
+	t.lastRunAt = &scheduledAt
+	t.lastTicketID = &ticketID
+	if next, ok := t.schedule.Next(later(scheduledAt, now)); ok {
+		t.nextRunAt = &next
+	} else {
+		// Расписание завершено (например, наступил UNTIL)
+		t.isActive = false
+		t.nextRunAt = nil
+	}
+	t.updatedAt = time.Now()
+	return true
+}
+
+func newDraftTicket(id uuid.UUID, draft TicketDraft, authorID uuid.UUID) (*tickets.Ticket, error) {
+	ticket, err := tickets.NewTicket(
+		id,
+		draft.Title,
+		draft.Description,
+		draft.Priority,
+		draft.OrganizationID,
+		authorID,
+		draft.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if draft.AssigneeID != nil {
+		if err = ticket.AssignTo(*draft.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+	return ticket, nil
+}
+
+func firstRun(schedule Schedule, now time.Time) (time.Time, error) {
+	next, ok := schedule.Next(now)
+	if !ok {
+		return time.Time{}, errNoUpcomingRuns
+	}
+	return next, nil
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,153 @@
+package recurring_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+func testDraft() recurring.TicketDraft {
+	assigneeID := uuid.New()
+	categoryID := uuid.New()
+	return recurring.TicketDraft{
+		Title:          "Rotate backup tapes",
+		Description:    "Swap the weekly backup tapes in the server room",
+		Priority:       tickets.PriorityHigh,
+		OrganizationID: uuid.New(),
+		CategoryID:     &categoryID,
+		AssigneeID:     &assigneeID,
+	}
+}
+
+func TestCreateTemplate(t *testing.T) {
+	authorID := uuid.New()
+	schedule := mustSchedule(t, recurring.ScheduleCron, "0 9 * * MON", "")
+
+	template, err := recurring.CreateTemplate(" Backups ", testDraft(), schedule, authorID)
+	require.NoError(t, err)
+	assert.Equal(t, "Backups", template.Name())
+	assert.True(t, template.IsActive())
+	require.NotNil(t, template.NextRunAt())
+	assert.Equal(t, time.Monday, template.NextRunAt().Weekday())
+	assert.Nil(t, template.LastRunAt())
+	assert.Equal(t, template.CreatedAt(), template.UpdatedAt())
+
+	invalid := []struct {
+		name  string
+		draft func(*recurring.TicketDraft)
+	}{
+		{"", func(*recurring.TicketDraft) {}},
+		{strings.Repeat("a", recurring.MaxNameLength+1), func(*recurring.TicketDraft) {}},
+		{"Title", func(d *recurring.TicketDraft) { d.Title = " " }},
+		{"Priority", func(d *recurring.TicketDraft) { d.Priority = "urgent" }},
+		{"Organization", func(d *recurring.TicketDraft) { d.OrganizationID = uuid.Nil }},
+		{"Assignee", func(d *recurring.TicketDraft) { d.AssigneeID = &uuid.Nil }},
+	}
+	for _, tc := range invalid {
+		draft := testDraft()
+		tc.draft(&draft)
+		_, err = recurring.CreateTemplate(tc.name, draft, schedule, authorID)
+		require.Error(t, err, tc.name)
+	}
+
+	_, err = recurring.CreateTemplate("Backups", testDraft(), schedule, uuid.Nil)
+	require.ErrorIs(t, err, recurring.ErrTemplateValidation)
+	_, err = recurring.CreateTemplate("Backups", testDraft(), recurring.Schedule{}, authorID)
+	require.ErrorIs(t, err, recurring.ErrTemplateValidation)
+
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	finished, err := recurring.NewSchedule(recurring.ScheduleRRule, "FREQ=DAILY;UNTIL=20260110", "", start)
+	require.NoError(t, err)
+	_, err = recurring.CreateTemplate("Backups", testDraft(), finished, authorID)
+	require.ErrorIs(t, err, recurring.ErrTemplateValidation)
+}
+
+func TestTemplate_NewTicket(t *testing.T) {
+	draft := testDraft()
+	authorID := uuid.New()
+	template, err := recurring.CreateTemplate("Backups", draft, mustSchedule(t, recurring.ScheduleCron, "@daily", ""),
+		authorID)
+	require.NoError(t, err)
+
+	scheduledAt := *template.NextRunAt()
+	ticket, err := template.NewTicket(scheduledAt)
+	require.NoError(t, err)
+	assert.Equal(t, template.OccurrenceTicketID(scheduledAt), ticket.ID())
+	assert.Equal(t, draft.Title, ticket.Title())
+	assert.Equal(t, draft.Priority, ticket.Priority())
+	assert.Equal(t, draft.OrganizationID, ticket.OrganizationID())
+	assert.Equal(t, draft.CategoryID, ticket.CategoryID())
+	assert.Equal(t, draft.AssigneeID, ticket.AssigneeID())
+	assert.Equal(t, authorID, ticket.AuthorID())
+
+	// Одно и то же срабатывание всегда дает тот же ID, независимо от часового пояса
+	assert.Equal(t, ticket.ID(), template.OccurrenceTicketID(scheduledAt.In(time.FixedZone("UTC+3", 3*60*60))))
+	assert.NotEqual(t, ticket.ID(), template.OccurrenceTicketID(scheduledAt.Add(24*time.Hour)))
+}
+
+func TestTemplate_RecordRun(t *testing.T) {
+	template, err := recurring.CreateTemplate("Backups", testDraft(),
+		mustSchedule(t, recurring.ScheduleCron, "0 * * * *", ""), uuid.New())
+	require.NoError(t, err)
+
+	scheduledAt := *template.NextRunAt()
+	assert.False(t, template.IsDue(scheduledAt.Add(-time.Minute)))
+	assert.True(t, template.IsDue(scheduledAt))
+
+	ticketID := template.OccurrenceTicketID(scheduledAt)
+	require.True(t, template.RecordRun(scheduledAt, ticketID, scheduledAt.Add(time.Second)))
+	assert.Equal(t, scheduledAt, *template.LastRunAt())
+	assert.Equal(t, ticketID, *template.LastTicketID())
+	assert.Equal(t, scheduledAt.Add(time.Hour), *template.NextRunAt())
+
+	// Повторная отметка того же срабатывания ничего не меняет
+	assert.False(t, template.RecordRun(scheduledAt, ticketID, scheduledAt.Add(time.Second)))
+
+	// После простоя пропущенные срабатывания не наверстываются
+	next := *template.NextRunAt()
+	require.True(t, template.RecordRun(next, template.OccurrenceTicketID(next), next.Add(5*time.Hour+time.Minute)))
+	assert.Equal(t, next.Add(6*time.Hour), *template.NextRunAt())
+}
+
+func TestTemplate_RecordRunFinishesSchedule(t *testing.T) {
+	start := time.Now().Add(-24 * time.Hour).UTC()
+	until := start.Add(72 * time.Hour).Format("20060102")
+	schedule, err := recurring.NewSchedule(recurring.ScheduleRRule, "FREQ=DAILY;INTERVAL=2;UNTIL="+until, "", start)
+	require.NoError(t, err)
+
+	template, err := recurring.CreateTemplate("Backups", testDraft(), schedule, uuid.New())
+	require.NoError(t, err)
+	scheduledAt := *template.NextRunAt()
+	require.True(t, template.RecordRun(scheduledAt, uuid.New(), scheduledAt))
+	assert.False(t, template.IsActive())
+	assert.Nil(t, template.NextRunAt())
+}
+
+func TestTemplate_ActivateAndReschedule(t *testing.T) {
+	template, err := recurring.CreateTemplate("Backups", testDraft(),
+		mustSchedule(t, recurring.ScheduleCron, "0 9 * * *", ""), uuid.New())
+	require.NoError(t, err)
+
+	template.Deactivate()
+	assert.False(t, template.IsActive())
+	assert.Nil(t, template.NextRunAt())
+	assert.False(t, template.IsDue(time.Now().Add(48*time.Hour)))
+
+	// Новое расписание приостановленного шаблона не возобновляет его
+	require.NoError(t, template.Reschedule(mustSchedule(t, recurring.ScheduleCron, "30 18 * * *", ""), time.Now()))
+	assert.Nil(t, template.NextRunAt())
+
+	require.NoError(t, template.Activate(time.Now()))
+	require.NotNil(t, template.NextRunAt())
+	assert.Equal(t, 18, template.NextRunAt().Hour())
+	assert.Equal(t, 30, template.NextRunAt().Minute())
+
+	require.ErrorIs(t, template.Reschedule(recurring.Schedule{}, time.Now()), recurring.ErrTemplateValidation)
+}
@@ -0,0 +1,208 @@
+package tickets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAssignmentConfig = errors.New("invalid assignment config")
+)
+
+const (
+	MaxAssignmentAgents        = 200
+	MaxAssignmentCategoryRules = 100
+)
+
+// AssignmentStrategy определяет способ автоматического выбора исполнителя новой заявки
+type AssignmentStrategy string
+
+const (
+	AssignmentRoundRobin AssignmentStrategy = "round_robin" // По очереди
+	AssignmentLeastOpen  AssignmentStrategy = "least_open"  // Агенту с наименьшим числом открытых заявок
+	AssignmentCategory   AssignmentStrategy = "category"    // Агентам категории с наименьшей загрузкой
+)
+
+// AllAssignmentStrategies возвращает все стратегии автоматического назначения
+func AllAssignmentStrategies() []AssignmentStrategy {
+	return []AssignmentStrategy{AssignmentRoundRobin, AssignmentLeastOpen, AssignmentCategory}
+}
+
+// String возвращает строковое представление стратегии
+func (s AssignmentStrategy) String() string {
+	return string(s)
+}
+
+// IsValid проверяет, является ли стратегия известной
+func (s AssignmentStrategy) IsValid() bool {
+	return slices.Contains(AllAssignmentStrategies(), s)
+}
+
+// CategoryAssignment закрепляет агентов за категорией заявок
+type CategoryAssignment struct {
+	CategoryID uuid.UUID   `json:"category_id"`
+	AgentIDs   []uuid.UUID `json:"agent_ids"`
+}
+
+// AssignmentCandidate описывает активного агента, которому может быть назначена заявка
+type AssignmentCandidate struct {
+	AgentID     uuid.UUID
+	OpenTickets int64
+}
+
+// AssignmentConfig представляет настройки автоматического назначения заявок организации
+type AssignmentConfig struct {
+	strategy      AssignmentStrategy
+	agentIDs      []uuid.UUID // Пул агентов; пустой список - все активные агенты организации
+	categoryRules []CategoryAssignment
+}
+
+// NewAssignmentConfig создает настройки автоматического назначения с проверкой стратегии и правил
+func NewAssignmentConfig(
+	strategy AssignmentStrategy,
+	agentIDs []uuid.UUID,
+	categoryRules []CategoryAssignment,
+) (*AssignmentConfig, error) {
+	if !strategy.IsValid() {
+		return nil, fmt.Errorf("%w: unknown strategy %q", ErrInvalidAssignmentConfig, strategy)
+	}
+
+	agents, err := normalizeAgentIDs(agentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categoryRules) > MaxAssignmentCategoryRules {
+		return nil, fmt.Errorf("%w: too many category rules (max %d)",
+			ErrInvalidAssignmentConfig, MaxAssignmentCategoryRules)
+	}
+	if strategy == AssignmentCategory && len(categoryRules) == 0 {
+		return nil, fmt.Errorf("%w: category strategy requires category rules", ErrInvalidAssignmentConfig)
+	}
+
+	rules := make([]CategoryAssignment, 0, len(categoryRules))
+	for _, rule := range categoryRules {
+		if rule.CategoryID == uuid.Nil {
+			return nil, fmt.Errorf("%w: category rule requires category_id", ErrInvalidAssignmentConfig)
+		}
+		if slices.ContainsFunc(rules, func(r CategoryAssignment) bool { return r.CategoryID == rule.CategoryID }) {
+			return nil, fmt.Errorf("%w: duplicate rule for category %s", ErrInvalidAssignmentConfig, rule.CategoryID)
+		}
+		ruleAgents, agentsErr := normalizeAgentIDs(rule.AgentIDs)
+		if agentsErr != nil {
+			return nil, agentsErr
+		}
+		if len(ruleAgents) == 0 {
+			return nil, fmt.Errorf("%w: category %s has no agents", ErrInvalidAssignmentConfig, rule.CategoryID)
+		}
+		rules = append(rules, CategoryAssignment{CategoryID: rule.CategoryID, AgentIDs: ruleAgents})
+	}
+
+	return &AssignmentConfig{
+		strategy:      strategy,
+		agentIDs:      agents,
+		categoryRules: rules,
+	}, nil
+}
+
+func (c *AssignmentConfig) Strategy() AssignmentStrategy { return c.strategy }
+func (c *AssignmentConfig) AgentIDs() []uuid.UUID        { return slices.Clone(c.agentIDs) }
+
+func (c *AssignmentConfig) CategoryRules() []CategoryAssignment {
+	rules := make([]CategoryAssignment, 0, len(c.categoryRules))
+	for _, rule := range c.categoryRules {
+		rules = append(rules, CategoryAssignment{CategoryID: rule.CategoryID, AgentIDs: slices.Clone(rule.AgentIDs)})
+	}
+	return rules
+}
+
+// AgentPool возвращает агентов, из которых выбирается исполнитель заявки указанной категории.
+// Для стратегии по категориям используются агенты правила категории, иначе - общий пул.
+// Пустой результат означает всех активных агентов организации.
+func (c *AssignmentConfig) AgentPool(categoryID *uuid.UUID) []uuid.UUID {
+	if c.strategy == AssignmentCategory && categoryID != nil {
+		for _, rule := range c.categoryRules {
+			if rule.CategoryID == *categoryID {
+				return slices.Clone(rule.AgentIDs)
+			}
+		}
+	}
+	return slices.Clone(c.agentIDs)
+}
+
+// NeedsWorkload проверяет, требуется ли стратегии число открытых заявок кандидатов
+func (c *AssignmentConfig) NeedsWorkload() bool {
+	return c.strategy != AssignmentRoundRobin
+}
+
+// Pick выбирает исполнителя среди кандидатов согласно стратегии.
+// turn - номер очереди назначения: по очереди заявку получает агент с этим номером по кругу,
+// при равной загрузке порядок очереди разрешает выбор. false - кандидатов нет.
+func (c *AssignmentConfig) Pick(candidates []AssignmentCandidate, turn int64) (uuid.UUID, bool) {
+	if len(candidates) == 0 {
+		return uuid.Nil, false
+	}
+
+	ordered := slices.Clone(candidates)
+	slices.SortFunc(ordered, func(a, b AssignmentCandidate) int {
+		return bytes.Compare(a.AgentID[:], b.AgentID[:])
+	})
+
+	// Очередь начинается с агента, чей номер совпадает с номером очереди
+	start := int(turn % int64(len(ordered)))
+	if start < 0 {
+		start += len(ordered)
+	}
+	queue := slices.Concat(ordered[start:], ordered[:start])
+
+	if !c.NeedsWorkload() {
+		return queue[0].AgentID, true
+	}
+
+	best := queue[0]
+	for _, candidate := range queue[1:] {
+		if candidate.OpenTickets < best.OpenTickets {
+			best = candidate
+		}
+	}
+	return best.AgentID, true
+}
+
+// AssignmentStrategy возвращает стратегию, назначившую текущего исполнителя; пустое значение - ручное назначение
+func (t *Ticket) AssignmentStrategy() AssignmentStrategy { return t.assignmentStrategy }
+
+// SetAssignmentStrategy sets the strategy that made the current assignment (for data restoration)
+func (t *Ticket) SetAssignmentStrategy(strategy AssignmentStrategy) { t.assignmentStrategy = strategy }
+
+// AutoAssign назначает заявку агенту, выбранному стратегией автоматического назначения
+func (t *Ticket) AutoAssign(agentID uuid.UUID, strategy AssignmentStrategy) error {
+	if !strategy.IsValid() {
+		return fmt.Errorf("%w: unknown assignment strategy %q", ErrTicketValidation, strategy)
+	}
+	if err := t.AssignTo(agentID); err != nil {
+		return err
+	}
+	t.assignmentStrategy = strategy
+	return nil
+}
+
+func normalizeAgentIDs(agentIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(agentIDs) > MaxAssignmentAgents {
+		return nil, fmt.Errorf("%w: too many agents (max %d)", ErrInvalidAssignmentConfig, MaxAssignmentAgents)
+	}
+
+	normalized := make([]uuid.UUID, 0, len(agentIDs))
+	for _, id := range agentIDs {
+		if id == uuid.Nil {
+			return nil, fmt.Errorf("%w: agent id cannot be empty", ErrInvalidAssignmentConfig)
+		}
+		if !slices.Contains(normalized, id) {
+			normalized = append(normalized, id)
+		}
+	}
+	return normalized, nil
+}
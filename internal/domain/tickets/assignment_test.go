@@ -0,0 +1,143 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+// sortedAgentIDs returns n agent IDs in the queue order used by Pick
+func sortedAgentIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.UUID{15: byte(i + 1)}
+	}
+	return ids
+}
+
+func TestNewAssignmentConfig(t *testing.T) {
+	categoryID := uuid.New()
+	agentID := uuid.New()
+
+	tests := []struct {
+		name     string
+		strategy domain.AssignmentStrategy
+		agents   []uuid.UUID
+		rules    []domain.CategoryAssignment
+	}{
+		{"unknown strategy", "random", nil, nil},
+		{"empty agent id", domain.AssignmentRoundRobin, []uuid.UUID{uuid.Nil}, nil},
+		{"category strategy without rules", domain.AssignmentCategory, nil, nil},
+		{"rule without category", domain.AssignmentCategory, nil, []domain.CategoryAssignment{
+			{AgentIDs: []uuid.UUID{agentID}},
+		}},
+		{"rule without agents", domain.AssignmentCategory, nil, []domain.CategoryAssignment{
+			{CategoryID: categoryID},
+		}},
+		{"duplicate category", domain.AssignmentCategory, nil, []domain.CategoryAssignment{
+			{CategoryID: categoryID, AgentIDs: []uuid.UUID{agentID}},
+			{CategoryID: categoryID, AgentIDs: []uuid.UUID{agentID}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewAssignmentConfig(tt.strategy, tt.agents, tt.rules)
+			require.ErrorIs(t, err, domain.ErrInvalidAssignmentConfig)
+		})
+	}
+
+	t.Run("deduplicates agents", func(t *testing.T) {
+		config, err := domain.NewAssignmentConfig(domain.AssignmentLeastOpen, []uuid.UUID{agentID, agentID}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{agentID}, config.AgentIDs())
+		assert.True(t, config.NeedsWorkload())
+	})
+}
+
+func TestAssignmentConfig_AgentPool(t *testing.T) {
+	poolAgent, categoryAgent := uuid.New(), uuid.New()
+	categoryID, otherCategoryID := uuid.New(), uuid.New()
+
+	config, err := domain.NewAssignmentConfig(domain.AssignmentCategory, []uuid.UUID{poolAgent},
+		[]domain.CategoryAssignment{{CategoryID: categoryID, AgentIDs: []uuid.UUID{categoryAgent}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []uuid.UUID{categoryAgent}, config.AgentPool(&categoryID))
+	assert.Equal(t, []uuid.UUID{poolAgent}, config.AgentPool(&otherCategoryID))
+	assert.Equal(t, []uuid.UUID{poolAgent}, config.AgentPool(nil))
+}
+
+func TestAssignmentConfig_Pick(t *testing.T) {
+	agents := sortedAgentIDs(3)
+	candidates := []domain.AssignmentCandidate{
+		{AgentID: agents[2], OpenTickets: 1},
+		{AgentID: agents[0], OpenTickets: 3},
+		{AgentID: agents[1], OpenTickets: 1},
+	}
+
+	t.Run("round robin rotates by turn", func(t *testing.T) {
+		config, err := domain.NewAssignmentConfig(domain.AssignmentRoundRobin, nil, nil)
+		require.NoError(t, err)
+
+		var picked []uuid.UUID
+		for turn := range int64(4) {
+			agentID, ok := config.Pick(candidates, turn)
+			require.True(t, ok)
+			picked = append(picked, agentID)
+		}
+		assert.Equal(t, []uuid.UUID{agents[0], agents[1], agents[2], agents[0]}, picked)
+	})
+
+	t.Run("round robin wraps the turn around a smaller pool", func(t *testing.T) {
+		config, err := domain.NewAssignmentConfig(domain.AssignmentRoundRobin, nil, nil)
+		require.NoError(t, err)
+
+		agentID, ok := config.Pick(candidates[1:], 5)
+		require.True(t, ok)
+		assert.Equal(t, agents[1], agentID)
+	})
+
+	t.Run("least open picks the least loaded agent", func(t *testing.T) {
+		config, err := domain.NewAssignmentConfig(domain.AssignmentLeastOpen, nil, nil)
+		require.NoError(t, err)
+
+		agentID, ok := config.Pick(candidates, 0)
+		require.True(t, ok)
+		assert.Equal(t, agents[1], agentID)
+
+		// Равная загрузка распределяется по очереди
+		agentID, ok = config.Pick(candidates, 2)
+		require.True(t, ok)
+		assert.Equal(t, agents[2], agentID)
+	})
+
+	t.Run("no candidates", func(t *testing.T) {
+		config, err := domain.NewAssignmentConfig(domain.AssignmentLeastOpen, nil, nil)
+		require.NoError(t, err)
+
+		_, ok := config.Pick(nil, 0)
+		assert.False(t, ok)
+	})
+}
+
+func TestTicket_AutoAssign(t *testing.T) {
+	ticket := createTestTicket(t)
+	agentID := uuid.New()
+
+	require.ErrorIs(t, ticket.AutoAssign(agentID, "random"), domain.ErrTicketValidation)
+	assert.Nil(t, ticket.AssigneeID())
+
+	require.NoError(t, ticket.AutoAssign(agentID, domain.AssignmentLeastOpen))
+	require.NotNil(t, ticket.AssigneeID())
+	assert.Equal(t, agentID, *ticket.AssigneeID())
+	assert.Equal(t, domain.AssignmentLeastOpen, ticket.AssignmentStrategy())
+
+	// Ручное назначение сбрасывает стратегию
+	require.NoError(t, ticket.AssignTo(uuid.New()))
+	assert.Empty(t, ticket.AssignmentStrategy())
+}
@@ -0,0 +1,89 @@
+package tickets
+
+import (
+	"fmt"
+	"time"
+)
+
+const hoursInDay = 24
+
+// AutoCloseDue проверяет, ждет ли решенная заявка ответа дольше after; after = 0 отключает автозакрытие
+func (t *Ticket) AutoCloseDue(after time.Duration, now time.Time) bool {
+	if after <= 0 || t.statusCategory != StatusResolved || t.resolvedAt == nil {
+		return false
+	}
+	return !now.Before(t.resolvedAt.Add(after))
+}
+
+// AutoClose закрывает решенную заявку, по которой не было ответа дольше after, и оставляет системный комментарий.
+// Переход выполняется от имени системы по рабочему процессу организации; false - заявка еще не подлежит закрытию.
+func (t *Ticket) AutoClose(workflow *Workflow, after time.Duration, now time.Time) (bool, error) {
+	if !t.AutoCloseDue(after, now) {
+		return false, nil
+	}
+	if workflow == nil {
+		workflow = DefaultWorkflow()
+	}
+
+	closed, ok := workflow.transitionTo(t.status, StatusClosed)
+	if !ok {
+		return false, fmt.Errorf("%w: no transition from %s to a closed status", ErrInvalidTransition, t.status)
+	}
+	if err := t.ChangeStatusInWorkflow(workflow, closed, ""); err != nil {
+		return false, err
+	}
+
+	comment := fmt.Sprintf("Ticket closed automatically: no reply was received within %s after it was resolved.",
+		formatGracePeriod(after))
+	if err := t.AddComment(SystemAuthorID(), comment, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReopenOnReply возвращает решенную заявку в работу после ответа клиента.
+// false - заявка не решена или рабочий процесс не допускает возврата в работу.
+func (t *Ticket) ReopenOnReply(workflow *Workflow) (bool, error) {
+	if t.statusCategory != StatusResolved {
+		return false, nil
+	}
+	if workflow == nil {
+		workflow = DefaultWorkflow()
+	}
+
+	inProgress, ok := workflow.transitionTo(t.status, StatusInProgress)
+	if !ok {
+		return false, nil
+	}
+	if err := t.ChangeStatusInWorkflow(workflow, inProgress, ""); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// transitionTo возвращает первый статус категории category, в который рабочий процесс допускает переход из from
+func (w *Workflow) transitionTo(from, category Status) (Status, bool) {
+	for _, to := range w.AvailableTransitions(from, "") {
+		if toCategory, ok := w.CategoryOf(to); ok && toCategory == category {
+			return to, true
+		}
+	}
+	return "", false
+}
+
+// formatGracePeriod выводит срок в днях, если он кратен суткам, иначе в часах
+func formatGracePeriod(period time.Duration) string {
+	hours := int(period / time.Hour)
+	switch {
+	case hours == 0:
+		return period.String()
+	case hours == 1:
+		return "1 hour"
+	case hours%hoursInDay != 0:
+		return fmt.Sprintf("%d hours", hours)
+	case hours == hoursInDay:
+		return "1 day"
+	default:
+		return fmt.Sprintf("%d days", hours/hoursInDay)
+	}
+}
@@ -0,0 +1,96 @@
+package tickets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+const gracePeriod = 7 * 24 * time.Hour
+
+func TestTicket_AutoClose(t *testing.T) {
+	ticket := createTestTicket(t)
+	now := time.Now()
+
+	closed, err := ticket.AutoClose(nil, gracePeriod, now.Add(2*gracePeriod))
+	require.NoError(t, err)
+	assert.False(t, closed, "only resolved tickets are closed")
+
+	resolveTestTicket(t, ticket)
+
+	t.Run("tickets within the grace period stay resolved", func(t *testing.T) {
+		assert.False(t, ticket.AutoCloseDue(gracePeriod, now.Add(gracePeriod-time.Hour)))
+		assert.False(t, ticket.AutoCloseDue(0, now.Add(2*gracePeriod)), "zero grace period disables auto-close")
+
+		closed, err := ticket.AutoClose(nil, gracePeriod, now.Add(gracePeriod-time.Hour))
+		require.NoError(t, err)
+		assert.False(t, closed)
+		assert.Equal(t, domain.StatusResolved, ticket.Status())
+	})
+
+	t.Run("stale tickets are closed with a system comment", func(t *testing.T) {
+		closed, err := ticket.AutoClose(nil, gracePeriod, now.Add(gracePeriod+time.Minute))
+		require.NoError(t, err)
+		assert.True(t, closed)
+		assert.Equal(t, domain.StatusClosed, ticket.Status())
+		assert.NotNil(t, ticket.ClosedAt())
+
+		comments := ticket.Comments()
+		require.Len(t, comments, 1)
+		assert.Equal(t, domain.SystemAuthorID(), comments[0].AuthorID)
+		assert.False(t, comments[0].IsInternal)
+		assert.Contains(t, comments[0].Content, "7 days")
+		assert.Nil(t, ticket.FirstRespondedAt(), "system comments are not a support response")
+	})
+}
+
+func TestTicket_AutoCloseInWorkflow(t *testing.T) {
+	statuses := []domain.WorkflowStatus{
+		{Key: domain.StatusNew, Category: domain.StatusNew},
+		{Key: domain.StatusResolved, Category: domain.StatusResolved},
+		{Key: "archived", Category: domain.StatusClosed},
+	}
+	workflow, err := domain.NewWorkflow(statuses, []domain.WorkflowTransition{
+		{From: domain.StatusNew, To: domain.StatusResolved},
+		{From: domain.StatusResolved, To: "archived"},
+	})
+	require.NoError(t, err)
+
+	ticket := createTestTicket(t)
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, domain.StatusResolved, ""))
+
+	closed, err := ticket.AutoClose(workflow, time.Hour, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, closed)
+	assert.Equal(t, domain.Status("archived"), ticket.Status())
+	assert.Equal(t, domain.StatusClosed, ticket.StatusCategory())
+
+	dead, err := domain.NewWorkflow(statuses, []domain.WorkflowTransition{
+		{From: domain.StatusNew, To: domain.StatusResolved},
+	})
+	require.NoError(t, err)
+	stuck := createTestTicket(t)
+	require.NoError(t, stuck.ChangeStatusInWorkflow(dead, domain.StatusResolved, ""))
+	_, err = stuck.AutoClose(dead, time.Hour, time.Now().Add(2*time.Hour))
+	require.ErrorIs(t, err, domain.ErrInvalidTransition)
+}
+
+func TestTicket_ReopenOnReply(t *testing.T) {
+	ticket := createTestTicket(t)
+
+	reopened, err := ticket.ReopenOnReply(nil)
+	require.NoError(t, err)
+	assert.False(t, reopened, "only resolved tickets are reopened")
+	assert.Equal(t, domain.StatusNew, ticket.Status())
+
+	resolveTestTicket(t, ticket)
+	reopened, err = ticket.ReopenOnReply(nil)
+	require.NoError(t, err)
+	assert.True(t, reopened)
+	assert.Equal(t, domain.StatusInProgress, ticket.Status())
+	assert.False(t, ticket.AutoCloseDue(time.Nanosecond, time.Now().Add(time.Hour)))
+}
@@ -0,0 +1,230 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidCalendar = errors.New("invalid business calendar")
+)
+
+const (
+	HolidayDateLayout   = time.DateOnly
+	MaxCalendarHolidays = 366
+	hoursPerDay         = 24
+	// maxCalendarScanDays ограничивает перебор дней при расчете сроков
+	maxCalendarScanDays = 3660
+)
+
+// WorkingHours описывает рабочий интервал в течение дня недели
+type WorkingHours struct {
+	Weekday time.Weekday  `json:"weekday"`
+	Start   time.Duration `json:"start"` // Смещение от начала дня
+	End     time.Duration `json:"end"`   // Смещение от начала дня, не больше 24 часов
+}
+
+// BusinessCalendar представляет рабочий календарь с часовым поясом и праздничными днями.
+// Nil-календарь означает круглосуточную работу без выходных.
+type BusinessCalendar struct {
+	timezone     string
+	location     *time.Location
+	workingHours []WorkingHours
+	holidays     []string // Даты в формате HolidayDateLayout
+}
+
+// NewBusinessCalendar создает рабочий календарь с проверкой часового пояса, интервалов и праздников
+func NewBusinessCalendar(timezone string, workingHours []WorkingHours, holidays []string) (*BusinessCalendar, error) {
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidCalendar, timezone)
+	}
+
+	hours, err := validateWorkingHours(workingHours)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := validateHolidays(holidays)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BusinessCalendar{
+		timezone:     timezone,
+		location:     location,
+		workingHours: hours,
+		holidays:     days,
+	}, nil
+}
+
+func (c *BusinessCalendar) Timezone() string             { return c.timezone }
+func (c *BusinessCalendar) WorkingHours() []WorkingHours { return slices.Clone(c.workingHours) }
+func (c *BusinessCalendar) Holidays() []string           { return slices.Clone(c.holidays) }
+
+// IsHoliday проверяет, является ли день праздничным в часовом поясе календаря
+func (c *BusinessCalendar) IsHoliday(t time.Time) bool {
+	if c == nil {
+		return false
+	}
+	_, found := slices.BinarySearch(c.holidays, t.In(c.location).Format(HolidayDateLayout))
+	return found
+}
+
+// Duration возвращает рабочее время между двумя моментами
+func (c *BusinessCalendar) Duration(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	if c == nil {
+		return to.Sub(from)
+	}
+
+	var total time.Duration
+	day := c.startOfDay(from)
+	for range maxCalendarScanDays {
+		if !day.Before(to) {
+			break
+		}
+		for _, interval := range c.intervalsOn(day) {
+			start, end := maxTime(interval[0], from), minTime(interval[1], to)
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		day = c.nextDay(day)
+	}
+	return total
+}
+
+// Add возвращает момент, в который от начальной точки пройдет указанное рабочее время
+func (c *BusinessCalendar) Add(from time.Time, d time.Duration) time.Time {
+	if c == nil {
+		return from.Add(d)
+	}
+	if d <= 0 {
+		return from
+	}
+
+	remaining := d
+	day := c.startOfDay(from)
+	for range maxCalendarScanDays {
+		for _, interval := range c.intervalsOn(day) {
+			start, end := maxTime(interval[0], from), interval[1]
+			if !end.After(start) {
+				continue
+			}
+			available := end.Sub(start)
+			if available >= remaining {
+				return start.Add(remaining)
+			}
+			remaining -= available
+		}
+		day = c.nextDay(day)
+	}
+	return day
+}
+
+// intervalsOn возвращает рабочие интервалы дня в абсолютном времени
+func (c *BusinessCalendar) intervalsOn(day time.Time) [][2]time.Time {
+	if c.IsHoliday(day) {
+		return nil
+	}
+
+	var intervals [][2]time.Time
+	for _, wh := range c.workingHours {
+		if wh.Weekday != day.Weekday() {
+			continue
+		}
+		intervals = append(intervals, [2]time.Time{c.atOffset(day, wh.Start), c.atOffset(day, wh.End)})
+	}
+	return intervals
+}
+
+// atOffset переводит смещение от начала дня в момент времени с учетом перехода на летнее время
+func (c *BusinessCalendar) atOffset(day time.Time, offset time.Duration) time.Time {
+	if offset >= hoursPerDay*time.Hour {
+		return c.nextDay(day)
+	}
+	hour, minute := int(offset/time.Hour), int(offset%time.Hour/time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, c.location)
+}
+
+func (c *BusinessCalendar) startOfDay(t time.Time) time.Time {
+	local := t.In(c.location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+}
+
+func (c *BusinessCalendar) nextDay(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, c.location)
+}
+
+func validateWorkingHours(workingHours []WorkingHours) ([]WorkingHours, error) {
+	if len(workingHours) == 0 {
+		return nil, fmt.Errorf("%w: at least one working hours interval is required", ErrInvalidCalendar)
+	}
+
+	hours := slices.Clone(workingHours)
+	for _, wh := range hours {
+		if wh.Weekday < time.Sunday || wh.Weekday > time.Saturday {
+			return nil, fmt.Errorf("%w: invalid weekday %d", ErrInvalidCalendar, wh.Weekday)
+		}
+		if wh.Start < 0 || wh.End > hoursPerDay*time.Hour || wh.Start >= wh.End {
+			return nil, fmt.Errorf("%w: invalid working hours on %s", ErrInvalidCalendar, wh.Weekday)
+		}
+		if wh.Start%time.Minute != 0 || wh.End%time.Minute != 0 {
+			return nil, fmt.Errorf("%w: working hours must be whole minutes", ErrInvalidCalendar)
+		}
+	}
+
+	slices.SortFunc(hours, func(a, b WorkingHours) int {
+		if a.Weekday != b.Weekday {
+			return int(a.Weekday) - int(b.Weekday)
+		}
+		return int(a.Start - b.Start)
+	})
+	for i := 1; i < len(hours); i++ {
+		if hours[i].Weekday == hours[i-1].Weekday && hours[i].Start < hours[i-1].End {
+			return nil, fmt.Errorf("%w: overlapping working hours on %s", ErrInvalidCalendar, hours[i].Weekday)
+		}
+	}
+
+	return hours, nil
+}
+
+func validateHolidays(holidays []string) ([]string, error) {
+	if len(holidays) > MaxCalendarHolidays {
+		return nil, fmt.Errorf("%w: too many holidays (max %d)", ErrInvalidCalendar, MaxCalendarHolidays)
+	}
+
+	days := make([]string, 0, len(holidays))
+	for _, holiday := range holidays {
+		date, err := time.Parse(HolidayDateLayout, holiday)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid holiday date %q", ErrInvalidCalendar, holiday)
+		}
+		days = append(days, date.Format(HolidayDateLayout))
+	}
+
+	slices.Sort(days)
+	return slices.Compact(days), nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,129 @@
+package tickets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func newOfficeCalendar(t *testing.T, holidays ...string) *domain.BusinessCalendar {
+	t.Helper()
+
+	var hours []domain.WorkingHours
+	for day := time.Monday; day <= time.Friday; day++ {
+		hours = append(hours, domain.WorkingHours{Weekday: day, Start: 9 * time.Hour, End: 18 * time.Hour})
+	}
+
+	calendar, err := domain.NewBusinessCalendar("Europe/Moscow", hours, holidays)
+	require.NoError(t, err)
+	return calendar
+}
+
+func moscowTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	location, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	parsed, err := time.ParseInLocation(time.DateTime, value, location)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestNewBusinessCalendar_Validation(t *testing.T) {
+	validHours := []domain.WorkingHours{{Weekday: time.Monday, Start: 9 * time.Hour, End: 18 * time.Hour}}
+
+	tests := []struct {
+		name     string
+		timezone string
+		hours    []domain.WorkingHours
+		holidays []string
+	}{
+		{"unknown timezone", "Mars/Olympus", validHours, nil},
+		{"no working hours", "UTC", nil, nil},
+		{"start after end", "UTC", []domain.WorkingHours{{Weekday: time.Monday, Start: 18 * time.Hour, End: 9 * time.Hour}}, nil},
+		{"beyond end of day", "UTC", []domain.WorkingHours{{Weekday: time.Monday, Start: 0, End: 25 * time.Hour}}, nil},
+		{"invalid weekday", "UTC", []domain.WorkingHours{{Weekday: 7, Start: 0, End: time.Hour}}, nil},
+		{"overlapping intervals", "UTC", []domain.WorkingHours{
+			{Weekday: time.Monday, Start: 9 * time.Hour, End: 13 * time.Hour},
+			{Weekday: time.Monday, Start: 12 * time.Hour, End: 18 * time.Hour},
+		}, nil},
+		{"invalid holiday", "UTC", validHours, []string{"2026-13-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewBusinessCalendar(tt.timezone, tt.hours, tt.holidays)
+			require.ErrorIs(t, err, domain.ErrInvalidCalendar)
+		})
+	}
+
+	t.Run("defaults to UTC and normalizes holidays", func(t *testing.T) {
+		calendar, err := domain.NewBusinessCalendar("", validHours, []string{"2026-05-09", "2026-01-01", "2026-05-09"})
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", calendar.Timezone())
+		assert.Equal(t, []string{"2026-01-01", "2026-05-09"}, calendar.Holidays())
+	})
+}
+
+func TestBusinessCalendar_Duration(t *testing.T) {
+	calendar := newOfficeCalendar(t, "2026-11-04")
+
+	tests := []struct {
+		name     string
+		from, to string
+		expected time.Duration
+	}{
+		{"within one working day", "2026-10-12 10:00:00", "2026-10-12 12:30:00", 150 * time.Minute},
+		{"before and after working hours", "2026-10-12 07:00:00", "2026-10-12 20:00:00", 9 * time.Hour},
+		{"over the weekend", "2026-10-16 17:00:00", "2026-10-19 10:00:00", 2 * time.Hour},
+		{"holiday is skipped", "2026-11-03 17:00:00", "2026-11-05 10:00:00", 2 * time.Hour},
+		{"whole weekend", "2026-10-17 00:00:00", "2026-10-19 00:00:00", 0},
+		{"reversed range", "2026-10-12 12:00:00", "2026-10-12 10:00:00", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, calendar.Duration(moscowTime(t, tt.from), moscowTime(t, tt.to)))
+		})
+	}
+
+	t.Run("nil calendar counts around the clock", func(t *testing.T) {
+		var around *domain.BusinessCalendar
+		from := moscowTime(t, "2026-10-17 00:00:00")
+		assert.Equal(t, 48*time.Hour, around.Duration(from, from.Add(48*time.Hour)))
+	})
+}
+
+func TestBusinessCalendar_Add(t *testing.T) {
+	calendar := newOfficeCalendar(t, "2026-11-04")
+
+	tests := []struct {
+		name     string
+		from     string
+		add      time.Duration
+		expected string
+	}{
+		{"within working day", "2026-10-12 10:00:00", 2 * time.Hour, "2026-10-12 12:00:00"},
+		{"starts before opening", "2026-10-12 06:00:00", time.Hour, "2026-10-12 10:00:00"},
+		{"rolls over the weekend", "2026-10-16 17:00:00", 4 * time.Hour, "2026-10-19 12:00:00"},
+		{"skips holiday", "2026-11-03 17:00:00", 2 * time.Hour, "2026-11-05 10:00:00"},
+		{"exact end of day", "2026-10-12 09:00:00", 9 * time.Hour, "2026-10-12 18:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due := calendar.Add(moscowTime(t, tt.from), tt.add)
+			assert.True(t, moscowTime(t, tt.expected).Equal(due), "expected %s, got %s", tt.expected, due)
+		})
+	}
+
+	t.Run("add is the inverse of duration", func(t *testing.T) {
+		from := moscowTime(t, "2026-10-15 16:20:00")
+		due := calendar.Add(from, 13*time.Hour)
+		assert.Equal(t, 13*time.Hour, calendar.Duration(from, due))
+	})
+}
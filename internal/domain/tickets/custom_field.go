@@ -0,0 +1,296 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCustomField = errors.New("invalid custom field")
+
+const (
+	MaxCustomFields          = 50   // Ограничение количества полей в схеме категории
+	MaxCustomFieldOptions    = 100  // Ограничение количества вариантов поля-списка
+	MaxCustomFieldTextLength = 1000 // Ограничение длины текстового значения
+	maxCustomFieldKeyLength  = 50
+	maxCustomFieldLabel      = 100
+)
+
+// CustomFieldType представляет тип значения дополнительного поля заявки
+type CustomFieldType string
+
+const (
+	CustomFieldText   CustomFieldType = "text"   // Произвольный текст
+	CustomFieldNumber CustomFieldType = "number" // Число
+	CustomFieldDate   CustomFieldType = "date"   // Дата в формате YYYY-MM-DD
+	CustomFieldEnum   CustomFieldType = "enum"   // Один из вариантов, заданных в схеме
+	CustomFieldUser   CustomFieldType = "user"   // Ссылка на пользователя
+)
+
+// AllCustomFieldTypes возвращает все типы дополнительных полей
+func AllCustomFieldTypes() []CustomFieldType {
+	return []CustomFieldType{CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldEnum, CustomFieldUser}
+}
+
+// String возвращает строковое представление типа поля
+func (f CustomFieldType) String() string {
+	return string(f)
+}
+
+// IsValid проверяет, является ли тип поля допустимым
+func (f CustomFieldType) IsValid() bool {
+	return slices.Contains(AllCustomFieldTypes(), f)
+}
+
+// CustomFields содержит значения дополнительных полей заявки по ключам полей.
+// Числа хранятся как float64, значения остальных типов - как строки.
+type CustomFields map[string]any
+
+// CustomFieldDefinition описывает дополнительное поле заявки в схеме категории
+type CustomFieldDefinition struct {
+	Key      string          `json:"key"` // Ключ значения в заявке, например "asset_tag"
+	Label    string          `json:"label"`
+	Type     CustomFieldType `json:"type"`
+	Required bool            `json:"required"`
+	Options  []string        `json:"options,omitempty"` // Допустимые значения поля-списка
+}
+
+// CustomFieldSchema представляет набор дополнительных полей заявок категории
+type CustomFieldSchema struct {
+	fields []CustomFieldDefinition
+}
+
+// NewCustomFieldSchema создает схему дополнительных полей
+func NewCustomFieldSchema(fields []CustomFieldDefinition) (*CustomFieldSchema, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: schema must define at least one field", ErrInvalidCustomField)
+	}
+	if len(fields) > MaxCustomFields {
+		return nil, fmt.Errorf("%w: too many fields (max %d)", ErrInvalidCustomField, MaxCustomFields)
+	}
+
+	validated := make([]CustomFieldDefinition, 0, len(fields))
+	keys := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		field, err := validateCustomFieldDefinition(field)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := keys[field.Key]; exists {
+			return nil, fmt.Errorf("%w: duplicate field %s", ErrInvalidCustomField, field.Key)
+		}
+		keys[field.Key] = struct{}{}
+		validated = append(validated, field)
+	}
+
+	return &CustomFieldSchema{fields: validated}, nil
+}
+
+// Fields возвращает поля схемы в порядке объявления
+func (s *CustomFieldSchema) Fields() []CustomFieldDefinition {
+	fields := make([]CustomFieldDefinition, 0, len(s.fields))
+	for _, field := range s.fields {
+		field.Options = slices.Clone(field.Options)
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Field возвращает поле схемы по ключу
+func (s *CustomFieldSchema) Field(key string) (CustomFieldDefinition, bool) {
+	if s == nil {
+		return CustomFieldDefinition{}, false
+	}
+	for _, field := range s.fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return CustomFieldDefinition{}, false
+}
+
+// ParseCustomFieldKey приводит ключ поля к нижнему регистру и проверяет его формат
+func ParseCustomFieldKey(key string) (string, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if !Status(key).IsWellFormed() || len(key) > maxCustomFieldKeyLength {
+		return "", fmt.Errorf("%w: key must start with a letter and contain only a-z, 0-9 or '_', got %q",
+			ErrInvalidCustomField, key)
+	}
+	return key, nil
+}
+
+// normalizeValue проверяет значение поля и приводит его к хранимому виду
+func (f CustomFieldDefinition) normalizeValue(value any) (any, error) {
+	if f.Type == CustomFieldNumber {
+		number, ok := value.(float64)
+		if !ok {
+			if integer, isInt := value.(int); isInt {
+				return float64(integer), nil
+			}
+			return nil, fmt.Errorf("%w: %s must be a number", ErrInvalidCustomField, f.Key)
+		}
+		return number, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a string", ErrInvalidCustomField, f.Key)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return text, nil
+	}
+
+	switch f.Type {
+	case CustomFieldText:
+		if len(text) > MaxCustomFieldTextLength {
+			return nil, fmt.Errorf("%w: %s must be no more than %d characters long",
+				ErrInvalidCustomField, f.Key, MaxCustomFieldTextLength)
+		}
+	case CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, text); err != nil {
+			return nil, fmt.Errorf("%w: %s must be a date in YYYY-MM-DD format", ErrInvalidCustomField, f.Key)
+		}
+	case CustomFieldEnum:
+		if !slices.Contains(f.Options, text) {
+			return nil, fmt.Errorf("%w: %s must be one of %s", ErrInvalidCustomField, f.Key,
+				strings.Join(f.Options, ", "))
+		}
+	case CustomFieldUser:
+		userID, err := uuid.Parse(text)
+		if err != nil || userID == uuid.Nil {
+			return nil, fmt.Errorf("%w: %s must be a user ID", ErrInvalidCustomField, f.Key)
+		}
+		text = userID.String()
+	case CustomFieldNumber:
+	}
+	return text, nil
+}
+
+func (t *Ticket) CustomFields() CustomFields { return maps.Clone(t.customFields) }
+
+// RestoreCustomFields sets the custom field values (for data restoration)
+func (t *Ticket) RestoreCustomFields(values CustomFields) { t.customFields = values }
+
+// SetCustomFields проверяет значения по схеме категории заявки и заменяет ими текущие значения.
+// nil и пустые строки означают отсутствие значения; schema == nil - категория не задает полей.
+func (t *Ticket) SetCustomFields(schema *CustomFieldSchema, values CustomFields) error {
+	normalized := make(CustomFields, len(values))
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		field, ok := schema.Field(key)
+		if !ok {
+			return fmt.Errorf("%w: field %s is not defined by the ticket category", ErrInvalidCustomField, key)
+		}
+		value, err := field.normalizeValue(value)
+		if err != nil {
+			return err
+		}
+		if value != "" {
+			normalized[key] = value
+		}
+	}
+	if schema != nil {
+		for _, field := range schema.fields {
+			if _, ok := normalized[field.Key]; field.Required && !ok {
+				return fmt.Errorf("%w: %s is required", ErrInvalidCustomField, field.Key)
+			}
+		}
+	}
+
+	t.recordCustomFieldChanges(normalized)
+	if len(normalized) == 0 {
+		normalized = nil
+	}
+	t.customFields = normalized
+	return nil
+}
+
+func (t *Ticket) recordCustomFieldChanges(values CustomFields) {
+	keys := slices.Collect(maps.Keys(values))
+	for key := range t.customFields {
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changed := false
+	for _, key := range keys {
+		oldValue, hadOld := t.customFields[key]
+		newValue, hasNew := values[key]
+		if hadOld == hasNew && oldValue == newValue {
+			continue
+		}
+		t.recordEvent(EventCustomFieldChanged, customFieldEventValue(key, oldValue, hadOld),
+			customFieldEventValue(key, newValue, hasNew))
+		changed = true
+	}
+	if changed {
+		t.updatedAt = time.Now()
+	}
+}
+
+// FormatCustomFieldValue возвращает строковое представление хранимого значения поля
+func FormatCustomFieldValue(value any) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+func customFieldEventValue(key string, value any, present bool) string {
+	if !present {
+		return ""
+	}
+	return key + "=" + FormatCustomFieldValue(value)
+}
+
+func validateCustomFieldDefinition(field CustomFieldDefinition) (CustomFieldDefinition, error) {
+	key, err := ParseCustomFieldKey(field.Key)
+	if err != nil {
+		return CustomFieldDefinition{}, err
+	}
+	field.Key = key
+
+	field.Label = strings.TrimSpace(field.Label)
+	if field.Label == "" || len(field.Label) > maxCustomFieldLabel {
+		return CustomFieldDefinition{}, fmt.Errorf("%w: %s label must be 1-%d characters long",
+			ErrInvalidCustomField, key, maxCustomFieldLabel)
+	}
+	if !field.Type.IsValid() {
+		return CustomFieldDefinition{}, fmt.Errorf("%w: %s has unknown type %q", ErrInvalidCustomField, key, field.Type)
+	}
+
+	if field.Type != CustomFieldEnum {
+		if len(field.Options) > 0 {
+			return CustomFieldDefinition{}, fmt.Errorf("%w: only enum fields have options", ErrInvalidCustomField)
+		}
+		field.Options = nil
+		return field, nil
+	}
+	if len(field.Options) == 0 || len(field.Options) > MaxCustomFieldOptions {
+		return CustomFieldDefinition{}, fmt.Errorf("%w: enum %s must have 1-%d options",
+			ErrInvalidCustomField, key, MaxCustomFieldOptions)
+	}
+	options := make([]string, 0, len(field.Options))
+	for _, option := range field.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || slices.Contains(options, option) {
+			return CustomFieldDefinition{}, fmt.Errorf("%w: enum %s has an empty or duplicate option",
+				ErrInvalidCustomField, key)
+		}
+		options = append(options, option)
+	}
+	field.Options = options
+	return field, nil
+}
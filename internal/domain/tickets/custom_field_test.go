@@ -0,0 +1,121 @@
+package tickets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func createTestCustomFieldSchema(t *testing.T) *domain.CustomFieldSchema {
+	schema, err := domain.NewCustomFieldSchema([]domain.CustomFieldDefinition{
+		{Key: " Asset_Tag ", Label: "Asset tag", Type: domain.CustomFieldText, Required: true},
+		{Key: "cost", Label: "Cost", Type: domain.CustomFieldNumber},
+		{Key: "purchased_on", Label: "Purchased on", Type: domain.CustomFieldDate},
+		{Key: "os", Label: "Operating system", Type: domain.CustomFieldEnum, Options: []string{"linux", " macos "}},
+		{Key: "owner", Label: "Owner", Type: domain.CustomFieldUser},
+	})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestNewCustomFieldSchema(t *testing.T) {
+	schema := createTestCustomFieldSchema(t)
+	fields := schema.Fields()
+	require.Len(t, fields, 5)
+	assert.Equal(t, "asset_tag", fields[0].Key)
+	assert.Equal(t, []string{"linux", "macos"}, fields[3].Options)
+
+	field, ok := schema.Field("cost")
+	require.True(t, ok)
+	assert.Equal(t, domain.CustomFieldNumber, field.Type)
+	_, ok = schema.Field("unknown")
+	assert.False(t, ok)
+
+	invalid := [][]domain.CustomFieldDefinition{
+		nil,
+		{{Key: "1st", Label: "First", Type: domain.CustomFieldText}},
+		{{Key: "asset tag", Label: "Asset tag", Type: domain.CustomFieldText}},
+		{{Key: "asset", Label: "", Type: domain.CustomFieldText}},
+		{{Key: "asset", Label: "Asset", Type: "color"}},
+		{{Key: "asset", Label: "Asset", Type: domain.CustomFieldEnum}},
+		{{Key: "asset", Label: "Asset", Type: domain.CustomFieldEnum, Options: []string{"a", "a"}}},
+		{{Key: "asset", Label: "Asset", Type: domain.CustomFieldText, Options: []string{"a"}}},
+		{
+			{Key: "asset", Label: "Asset", Type: domain.CustomFieldText},
+			{Key: "ASSET", Label: "Asset", Type: domain.CustomFieldNumber},
+		},
+	}
+	for _, fields := range invalid {
+		_, err := domain.NewCustomFieldSchema(fields)
+		require.ErrorIs(t, err, domain.ErrInvalidCustomField, "%+v", fields)
+	}
+}
+
+func TestTicket_SetCustomFields(t *testing.T) {
+	schema := createTestCustomFieldSchema(t)
+	ownerID := uuid.New()
+
+	t.Run("values are normalized", func(t *testing.T) {
+		ticket := createTestTicket(t)
+		err := ticket.SetCustomFields(schema, domain.CustomFields{
+			"asset_tag":    " LT-1042 ",
+			"cost":         1299.5,
+			"purchased_on": "2026-03-01",
+			"os":           "macos",
+			"owner":        strings.ToUpper(ownerID.String()),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.CustomFields{
+			"asset_tag":    "LT-1042",
+			"cost":         1299.5,
+			"purchased_on": "2026-03-01",
+			"os":           "macos",
+			"owner":        ownerID.String(),
+		}, ticket.CustomFields())
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		invalid := []domain.CustomFields{
+			{},
+			{"asset_tag": "   "},
+			{"asset_tag": "LT-1", "cost": "12"},
+			{"asset_tag": "LT-1", "purchased_on": "01.03.2026"},
+			{"asset_tag": "LT-1", "os": "windows"},
+			{"asset_tag": "LT-1", "owner": "someone"},
+			{"asset_tag": strings.Repeat("a", domain.MaxCustomFieldTextLength+1)},
+			{"asset_tag": "LT-1", "color": "red"},
+		}
+		for _, values := range invalid {
+			ticket := createTestTicket(t)
+			require.ErrorIs(t, ticket.SetCustomFields(schema, values), domain.ErrInvalidCustomField, "%v", values)
+			assert.Empty(t, ticket.CustomFields())
+		}
+	})
+
+	t.Run("tickets without schema accept no values", func(t *testing.T) {
+		ticket := createTestTicket(t)
+		require.NoError(t, ticket.SetCustomFields(nil, nil))
+		require.ErrorIs(t, ticket.SetCustomFields(nil, domain.CustomFields{"asset_tag": "LT-1"}),
+			domain.ErrInvalidCustomField)
+	})
+
+	t.Run("changes are recorded", func(t *testing.T) {
+		ticket := createTestTicket(t)
+		require.NoError(t, ticket.SetCustomFields(schema, domain.CustomFields{"asset_tag": "LT-1", "cost": 10}))
+		ticket.ClearPendingEvents()
+		require.NoError(t, ticket.SetCustomFields(schema, domain.CustomFields{"asset_tag": "LT-2", "cost": nil}))
+
+		events := ticket.PendingEvents()
+		require.Len(t, events, 2)
+		assert.Equal(t, domain.EventCustomFieldChanged, events[0].Type)
+		assert.Equal(t, "asset_tag=LT-1", events[0].OldValue)
+		assert.Equal(t, "asset_tag=LT-2", events[0].NewValue)
+		assert.Equal(t, "cost=10", events[1].OldValue)
+		assert.Empty(t, events[1].NewValue)
+	})
+}
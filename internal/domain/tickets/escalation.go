@@ -0,0 +1,230 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidEscalationRule = errors.New("invalid escalation rule")
+)
+
+const (
+	MaxEscalationRules    = 50
+	MaxEscalationRuleName = 100
+)
+
+// SystemAuthorID возвращает идентификатор автора комментариев, добавленных системой без участия пользователя
+func SystemAuthorID() uuid.UUID {
+	return uuid.Max
+}
+
+// SLATarget определяет целевой срок SLA, за которым следит правило эскалации
+type SLATarget string
+
+const (
+	SLATargetFirstResponse SLATarget = "first_response" // Срок первого ответа
+	SLATargetResolution    SLATarget = "resolution"     // Срок решения
+)
+
+// IsValid проверяет, является ли целевой срок известным
+func (t SLATarget) IsValid() bool {
+	return t == SLATargetFirstResponse || t == SLATargetResolution
+}
+
+// EscalationRule описывает действия, выполняемые при приближении или нарушении срока SLA
+type EscalationRule struct {
+	ID            uuid.UUID     `json:"id"`
+	Name          string        `json:"name"`
+	Target        SLATarget     `json:"target"`
+	Before        time.Duration `json:"before"`              // За сколько до срока срабатывать; 0 - после нарушения
+	RaisePriority bool          `json:"raise_priority"`      // Повысить приоритет на одну ступень
+	AssignTo      *uuid.UUID    `json:"assign_to,omitempty"` // Переназначить заявку (например, руководителю группы)
+	Comment       string        `json:"comment,omitempty"`   // Внутренний комментарий от имени системы
+}
+
+// Escalation описывает сработавшее для заявки правило эскалации
+type Escalation struct {
+	RuleID      uuid.UUID `json:"rule_id"`
+	RuleName    string    `json:"rule_name"`
+	Target      SLATarget `json:"target"`
+	EscalatedAt time.Time `json:"escalated_at"`
+}
+
+// EscalationRules возвращает правила эскалации; у nil-настроек правил нет
+func (c *SLAConfig) EscalationRules() []EscalationRule {
+	if c == nil {
+		return nil
+	}
+	return slices.Clone(c.escalations)
+}
+
+// IsDue проверяет, наступил ли момент срабатывания правила для состояния SLA заявки
+func (r EscalationRule) IsDue(status SLAStatus) bool {
+	if status.Paused {
+		return false
+	}
+
+	target := status.Resolution
+	if r.Target == SLATargetFirstResponse {
+		target = status.FirstResponse
+	}
+	if target.CompletedAt != nil {
+		return false
+	}
+	if r.Before == 0 {
+		return target.Breached
+	}
+	return target.Remaining <= r.Before
+}
+
+func (t *Ticket) Escalations() []Escalation { return slices.Clone(t.escalations) }
+
+// RestoreEscalations sets the escalations already applied to the ticket (for data restoration)
+func (t *Ticket) RestoreEscalations(escalations []Escalation) { t.escalations = escalations }
+
+// IsEscalated проверяет, срабатывало ли уже правило эскалации для заявки
+func (t *Ticket) IsEscalated(ruleID uuid.UUID) bool {
+	return slices.ContainsFunc(t.escalations, func(e Escalation) bool { return e.RuleID == ruleID })
+}
+
+// DueEscalations возвращает правила эскалации, срок которых наступил и которые еще не срабатывали для заявки
+func (t *Ticket) DueEscalations(config *SLAConfig, now time.Time) []EscalationRule {
+	status := t.SLAStatus(now)
+
+	var due []EscalationRule
+	for _, rule := range config.EscalationRules() {
+		if !t.IsEscalated(rule.ID) && rule.IsDue(status) {
+			due = append(due, rule)
+		}
+	}
+	return due
+}
+
+// ApplyEscalations выполняет правила эскалации организации, срок которых наступил.
+// Каждое правило срабатывает для заявки не более одного раза; выполненные цели и приостановленный отсчет
+// (ожидание клиента) эскалацию не вызывают. Правила отбираются до выполнения первого из них, поэтому новые
+// сроки SLA после повышения приоритета учитываются только при следующей проверке.
+// Возвращает сработавшие правила в порядке их объявления.
+func (t *Ticket) ApplyEscalations(config *SLAConfig, now time.Time) ([]EscalationRule, error) {
+	var applied []EscalationRule
+	for _, rule := range t.DueEscalations(config, now) {
+		if err := t.escalate(rule, config, now); err != nil {
+			return applied, err
+		}
+		applied = append(applied, rule)
+	}
+	return applied, nil
+}
+
+func (t *Ticket) escalate(rule EscalationRule, config *SLAConfig, now time.Time) error {
+	if rule.RaisePriority {
+		// Повышенный приоритет переопределяет вычисленный по матрице, иначе новая оценка влияния
+		// и срочности отменила бы эскалацию
+		if raised := t.priority.Raised(); raised != t.priority {
+			if err := t.OverridePriority(raised); err != nil {
+				return err
+			}
+			t.ApplySLAConfig(config)
+		}
+	}
+	if rule.AssignTo != nil {
+		if err := t.AssignTo(*rule.AssignTo); err != nil {
+			return err
+		}
+	}
+	if rule.Comment != "" {
+		if err := t.AddComment(SystemAuthorID(), rule.Comment, true); err != nil {
+			return err
+		}
+	}
+
+	t.escalations = append(t.escalations, Escalation{
+		RuleID:      rule.ID,
+		RuleName:    rule.Name,
+		Target:      rule.Target,
+		EscalatedAt: now,
+	})
+	t.recordEventBy(nil, EventEscalated, "", rule.Name, true)
+	t.updatedAt = now
+	return nil
+}
+
+// InheritEscalationRuleIDs присваивает правилам без идентификатора идентификаторы текущих правил с тем же
+// названием. Так правило, уже сработавшее для заявки, не срабатывает повторно после сохранения новых настроек.
+func InheritEscalationRuleIDs(rules, current []EscalationRule) []EscalationRule {
+	inherited := slices.Clone(rules)
+	for i, rule := range inherited {
+		if rule.ID != uuid.Nil {
+			continue
+		}
+		for _, existing := range current {
+			taken := slices.ContainsFunc(inherited, func(r EscalationRule) bool { return r.ID == existing.ID })
+			if !taken && existing.Name == strings.TrimSpace(rule.Name) {
+				inherited[i].ID = existing.ID
+				break
+			}
+		}
+	}
+	return inherited
+}
+
+func validateEscalationRules(rules []EscalationRule) ([]EscalationRule, error) {
+	if len(rules) > MaxEscalationRules {
+		return nil, fmt.Errorf("%w: too many rules (max %d)", ErrInvalidEscalationRule, MaxEscalationRules)
+	}
+
+	normalized := make([]EscalationRule, 0, len(rules))
+	for _, rule := range rules {
+		validated, err := validateEscalationRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range normalized {
+			if existing.ID == validated.ID {
+				return nil, fmt.Errorf("%w: duplicate rule id %s", ErrInvalidEscalationRule, validated.ID)
+			}
+		}
+		normalized = append(normalized, validated)
+	}
+	return normalized, nil
+}
+
+func validateEscalationRule(rule EscalationRule) (EscalationRule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return EscalationRule{}, fmt.Errorf("%w: rule name is required", ErrInvalidEscalationRule)
+	}
+	if len(rule.Name) > MaxEscalationRuleName {
+		return EscalationRule{}, fmt.Errorf("%w: rule name too long (max %d characters)",
+			ErrInvalidEscalationRule, MaxEscalationRuleName)
+	}
+	if !rule.Target.IsValid() {
+		return EscalationRule{}, fmt.Errorf("%w: rule %q has unknown target %q",
+			ErrInvalidEscalationRule, rule.Name, rule.Target)
+	}
+	if rule.Before < 0 {
+		return EscalationRule{}, fmt.Errorf("%w: rule %q threshold must not be negative",
+			ErrInvalidEscalationRule, rule.Name)
+	}
+	if rule.AssignTo != nil && *rule.AssignTo == uuid.Nil {
+		return EscalationRule{}, fmt.Errorf("%w: rule %q has empty assignee", ErrInvalidEscalationRule, rule.Name)
+	}
+	rule.Comment = strings.TrimSpace(rule.Comment)
+	if len(rule.Comment) > MaxCommentLength {
+		return EscalationRule{}, fmt.Errorf("%w: rule %q comment too long (max %d characters)",
+			ErrInvalidEscalationRule, rule.Name, MaxCommentLength)
+	}
+	if !rule.RaisePriority && rule.AssignTo == nil && rule.Comment == "" {
+		return EscalationRule{}, fmt.Errorf("%w: rule %q has no actions", ErrInvalidEscalationRule, rule.Name)
+	}
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	return rule, nil
+}
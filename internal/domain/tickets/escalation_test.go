@@ -0,0 +1,246 @@
+package tickets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestNewSLAConfig_EscalationRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule domain.EscalationRule
+	}{
+		{"empty name", domain.EscalationRule{Target: domain.SLATargetResolution, RaisePriority: true}},
+		{"unknown target", domain.EscalationRule{Name: "Rule", Target: "reply", RaisePriority: true}},
+		{"negative threshold", domain.EscalationRule{
+			Name: "Rule", Target: domain.SLATargetResolution, Before: -time.Minute, RaisePriority: true,
+		}},
+		{"no actions", domain.EscalationRule{Name: "Rule", Target: domain.SLATargetResolution}},
+		{"empty assignee", domain.EscalationRule{
+			Name: "Rule", Target: domain.SLATargetResolution, AssignTo: &uuid.Nil,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{tt.rule})
+			require.ErrorIs(t, err, domain.ErrInvalidEscalationRule)
+		})
+	}
+
+	t.Run("generates rule ids", func(t *testing.T) {
+		config, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{
+			{Name: " Breach ", Target: domain.SLATargetResolution, Comment: "Resolution SLA breached"},
+		})
+		require.NoError(t, err)
+		require.Len(t, config.EscalationRules(), 1)
+		assert.NotEqual(t, uuid.Nil, config.EscalationRules()[0].ID)
+		assert.Equal(t, "Breach", config.EscalationRules()[0].Name)
+	})
+}
+
+func TestEscalationRule_IsDue(t *testing.T) {
+	respondedAt := time.Now()
+
+	tests := []struct {
+		name     string
+		rule     domain.EscalationRule
+		status   domain.SLAStatus
+		expected bool
+	}{
+		{
+			name:     "approaching threshold reached",
+			rule:     domain.EscalationRule{Target: domain.SLATargetResolution, Before: time.Hour},
+			status:   domain.SLAStatus{Resolution: domain.SLATargetStatus{Remaining: 30 * time.Minute}},
+			expected: true,
+		},
+		{
+			name:     "approaching threshold not reached",
+			rule:     domain.EscalationRule{Target: domain.SLATargetResolution, Before: time.Hour},
+			status:   domain.SLAStatus{Resolution: domain.SLATargetStatus{Remaining: 2 * time.Hour}},
+			expected: false,
+		},
+		{
+			name: "breach rule waits for the breach",
+			rule: domain.EscalationRule{Target: domain.SLATargetFirstResponse},
+			status: domain.SLAStatus{
+				FirstResponse: domain.SLATargetStatus{Remaining: time.Minute},
+				Resolution:    domain.SLATargetStatus{Remaining: -time.Hour, Breached: true},
+			},
+			expected: false,
+		},
+		{
+			name: "breached first response",
+			rule: domain.EscalationRule{Target: domain.SLATargetFirstResponse},
+			status: domain.SLAStatus{
+				FirstResponse: domain.SLATargetStatus{Remaining: -time.Minute, Breached: true},
+			},
+			expected: true,
+		},
+		{
+			name: "completed target",
+			rule: domain.EscalationRule{Target: domain.SLATargetFirstResponse},
+			status: domain.SLAStatus{FirstResponse: domain.SLATargetStatus{
+				Remaining: -time.Minute, Breached: true, CompletedAt: &respondedAt,
+			}},
+			expected: false,
+		},
+		{
+			name: "paused clock",
+			rule: domain.EscalationRule{Target: domain.SLATargetResolution},
+			status: domain.SLAStatus{
+				Paused:     true,
+				Resolution: domain.SLATargetStatus{Remaining: -time.Minute, Breached: true},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rule.IsDue(tt.status))
+		})
+	}
+}
+
+func TestTicket_ApplyEscalations(t *testing.T) {
+	teamLeadID := uuid.New()
+	config, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{
+		{Name: "Warn", Target: domain.SLATargetResolution, Before: 2 * time.Hour, Comment: "Resolution is due soon"},
+		{Name: "Breach", Target: domain.SLATargetResolution, RaisePriority: true, AssignTo: &teamLeadID},
+	})
+	require.NoError(t, err)
+
+	ticket := createTestTicketWithPriority(t, domain.PriorityHigh) // 8 часов на решение
+	ticket.SetCreatedAt(time.Now().Add(-7 * time.Hour))
+
+	applied, err := ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, "Warn", applied[0].Name)
+	require.Len(t, ticket.Comments(), 1)
+	assert.True(t, ticket.Comments()[0].IsInternal)
+	assert.Equal(t, domain.SystemAuthorID(), ticket.Comments()[0].AuthorID)
+	assert.Nil(t, ticket.FirstRespondedAt(), "system comments are not a response to the customer")
+
+	// Повторная проверка не выполняет сработавшее правило еще раз
+	applied, err = ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	ticket.SetCreatedAt(time.Now().Add(-9 * time.Hour))
+	assert.Len(t, ticket.DueEscalations(config, time.Now()), 1)
+
+	applied, err = ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, domain.PriorityCritical, ticket.Priority())
+	require.NotNil(t, ticket.AssigneeID())
+	assert.Equal(t, teamLeadID, *ticket.AssigneeID())
+
+	escalations := ticket.Escalations()
+	require.Len(t, escalations, 2)
+	assert.Equal(t, "Breach", escalations[1].RuleName)
+	assert.Equal(t, domain.SLATargetResolution, escalations[1].Target)
+	assert.True(t, ticket.IsEscalated(escalations[0].RuleID))
+
+	var escalatedEvents int
+	for _, event := range ticket.PendingEvents() {
+		if event.Type == domain.EventEscalated {
+			escalatedEvents++
+			assert.Nil(t, event.ActorID)
+			assert.True(t, event.IsInternal)
+		}
+	}
+	assert.Equal(t, 2, escalatedEvents)
+}
+
+func TestTicket_ApplyEscalations_DoesNotCascade(t *testing.T) {
+	config, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{
+		{Name: "Raise", Target: domain.SLATargetResolution, Before: 16 * time.Hour, RaisePriority: true},
+		{Name: "Breach", Target: domain.SLATargetResolution, Comment: "Resolution SLA breached"},
+	})
+	require.NoError(t, err)
+
+	ticket := createTestTicketWithPriority(t, domain.PriorityNormal) // 24 часа на решение
+	ticket.SetCreatedAt(time.Now().Add(-12 * time.Hour))
+
+	// С высоким приоритетом срок решения уже нарушен, но правило нарушения ждет следующей проверки
+	applied, err := ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, "Raise", applied[0].Name)
+	assert.Equal(t, domain.PriorityHigh, ticket.Priority())
+	assert.Empty(t, ticket.Comments())
+
+	applied, err = ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, "Breach", applied[0].Name)
+}
+
+func TestInheritEscalationRuleIDs(t *testing.T) {
+	breachID, warnID, explicitID := uuid.New(), uuid.New(), uuid.New()
+	current := []domain.EscalationRule{
+		{ID: breachID, Name: "Breach"},
+		{ID: warnID, Name: "Warn"},
+	}
+
+	rules := domain.InheritEscalationRuleIDs([]domain.EscalationRule{
+		{Name: " Breach "},
+		{Name: "Breach"},
+		{ID: explicitID, Name: "Warn"},
+		{Name: "New"},
+	}, current)
+
+	require.Len(t, rules, 4)
+	assert.Equal(t, breachID, rules[0].ID)
+	assert.Equal(t, uuid.Nil, rules[1].ID, "an inherited ID is given to one rule only")
+	assert.Equal(t, explicitID, rules[2].ID)
+	assert.Equal(t, uuid.Nil, rules[3].ID)
+}
+
+func TestTicket_ApplyEscalations_SkipsResolvedTickets(t *testing.T) {
+	config, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{
+		{Name: "Breach", Target: domain.SLATargetResolution, RaisePriority: true},
+	})
+	require.NoError(t, err)
+
+	ticket := createTestTicketWithPriority(t, domain.PriorityNormal)
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+	ticket.SetCreatedAt(time.Now().Add(-48 * time.Hour))
+
+	applied, err := ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Equal(t, domain.PriorityNormal, ticket.Priority())
+}
+
+func TestTicket_ApplyEscalations_KeepsRaisedPriority(t *testing.T) {
+	config, err := domain.NewSLAConfig(nil, nil, []domain.EscalationRule{
+		{Name: "Breach", Target: domain.SLATargetResolution, RaisePriority: true},
+	})
+	require.NoError(t, err)
+	matrix := domain.DefaultPriorityMatrix()
+
+	ticket := createTestTicketWithPriority(t, domain.PriorityNormal)
+	require.NoError(t, ticket.Classify(matrix, domain.ImpactMedium, domain.UrgencyMedium))
+	ticket.SetCreatedAt(time.Now().Add(-48 * time.Hour))
+
+	applied, err := ticket.ApplyEscalations(config, time.Now())
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, domain.PriorityHigh, ticket.Priority())
+	assert.True(t, ticket.IsPriorityOverridden())
+
+	// Новая оценка клиента не отменяет эскалацию
+	require.NoError(t, ticket.Classify(matrix, domain.ImpactLow, domain.UrgencyLow))
+	assert.Equal(t, domain.PriorityHigh, ticket.Priority())
+}
@@ -0,0 +1,101 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType представляет тип события в истории заявки
+type EventType string
+
+const (
+	EventTitleChanged       EventType = "title_changed"       // Изменен заголовок
+	EventDescriptionChanged EventType = "description_changed" // Изменено описание
+	EventPriorityChanged    EventType = "priority_changed"    // Изменен приоритет
+	EventPriorityOverridden EventType = "priority_overridden" // Агент переопределил приоритет из матрицы
+	EventImpactChanged      EventType = "impact_changed"      // Изменено влияние
+	EventUrgencyChanged     EventType = "urgency_changed"     // Изменена срочность
+	EventStatusChanged      EventType = "status_changed"      // Изменен статус
+	EventAssigned           EventType = "assigned"            // Назначен исполнитель
+	EventUnassigned         EventType = "unassigned"          // Снято назначение
+	EventCategoryChanged    EventType = "category_changed"    // Изменена категория
+	EventCommentAdded       EventType = "comment_added"       // Добавлен комментарий
+	EventCommentEdited      EventType = "comment_edited"      // Отредактирован комментарий
+	EventCommentDeleted     EventType = "comment_deleted"     // Удален комментарий
+	EventAttachmentAdded    EventType = "attachment_added"    // Добавлено вложение
+	EventAttachmentDeleted  EventType = "attachment_deleted"  // Удалено вложение
+	EventEscalated          EventType = "escalated"           // Сработало правило эскалации SLA
+	EventMerged             EventType = "merged"              // Присоединена другая заявка
+	EventMergedInto         EventType = "merged_into"         // Заявка объединена с другой
+	EventSplit              EventType = "split"               // Комментарии выделены в новую заявку
+	EventSplitFrom          EventType = "split_from"          // Заявка выделена из другой
+	EventRelationAdded      EventType = "relation_added"      // Добавлена связь с другой заявкой
+	EventRelationRemoved    EventType = "relation_removed"    // Удалена связь с другой заявкой
+	EventWatcherAdded       EventType = "watcher_added"       // Добавлен наблюдатель
+	EventWatcherRemoved     EventType = "watcher_removed"     // Удален наблюдатель
+	EventTagAdded           EventType = "tag_added"           // Добавлена метка
+	EventTagRemoved         EventType = "tag_removed"         // Снята метка
+	EventCustomFieldChanged EventType = "field_changed"       // Изменено дополнительное поле
+	EventSurveySubmitted    EventType = "survey_submitted"    // Автор оценил решение заявки
+	EventWorkLogAdded       EventType = "worklog_added"       // Добавлена запись о затраченном времени
+	EventWorkLogUpdated     EventType = "worklog_updated"     // Изменена запись о затраченном времени
+	EventWorkLogDeleted     EventType = "worklog_deleted"     // Удалена запись о затраченном времени
+)
+
+// String возвращает строковое представление типа события
+func (t EventType) String() string {
+	return string(t)
+}
+
+// Event представляет запись в истории изменений заявки
+type Event struct {
+	ID         uuid.UUID  `json:"id"`
+	TicketID   uuid.UUID  `json:"ticket_id"`
+	Type       EventType  `json:"type"`
+	ActorID    *uuid.UUID `json:"actor_id,omitempty"` // nil - системное действие
+	OldValue   string     `json:"old_value,omitempty"`
+	NewValue   string     `json:"new_value,omitempty"`
+	IsInternal bool       `json:"is_internal"` // Событие видно только агентам (например, внутренний комментарий)
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ActAs устанавливает пользователя, от имени которого выполняются последующие изменения
+func (t *Ticket) ActAs(actorID uuid.UUID) {
+	t.actorID = &actorID
+}
+
+// PendingEvents возвращает события, еще не сохраненные в хранилище
+func (t *Ticket) PendingEvents() []Event {
+	return t.events
+}
+
+// ClearPendingEvents очищает список несохраненных событий
+func (t *Ticket) ClearPendingEvents() {
+	t.events = nil
+}
+
+// recordEvent добавляет событие от имени текущего пользователя
+func (t *Ticket) recordEvent(eventType EventType, oldValue, newValue string) {
+	t.recordEventBy(t.actorID, eventType, oldValue, newValue, false)
+}
+
+func (t *Ticket) recordEventBy(actorID *uuid.UUID, eventType EventType, oldValue, newValue string, internal bool) {
+	t.events = append(t.events, Event{
+		ID:         uuid.New(),
+		TicketID:   t.id,
+		Type:       eventType,
+		ActorID:    actorID,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		IsInternal: internal,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
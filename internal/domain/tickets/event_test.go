@@ -0,0 +1,99 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestTicket_RecordsEvents(t *testing.T) {
+	ticket := createTestTicket(t)
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	categoryID := uuid.New()
+
+	assert.Empty(t, ticket.PendingEvents())
+
+	ticket.ActAs(actorID)
+	require.NoError(t, ticket.UpdateTitle("Updated title"))
+	require.NoError(t, ticket.UpdatePriority(domain.PriorityHigh))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.AssignTo(assigneeID))
+	ticket.SetCategory(&categoryID)
+	ticket.Unassign()
+
+	events := ticket.PendingEvents()
+	require.Len(t, events, 6)
+
+	expected := []struct {
+		eventType domain.EventType
+		oldValue  string
+		newValue  string
+	}{
+		{domain.EventTitleChanged, "Test ticket", "Updated title"},
+		{domain.EventPriorityChanged, "normal", "high"},
+		{domain.EventStatusChanged, "new", "in_progress"},
+		{domain.EventAssigned, "", assigneeID.String()},
+		{domain.EventCategoryChanged, "", categoryID.String()},
+		{domain.EventUnassigned, assigneeID.String(), ""},
+	}
+	for i, exp := range expected {
+		assert.Equal(t, exp.eventType, events[i].Type)
+		assert.Equal(t, exp.oldValue, events[i].OldValue)
+		assert.Equal(t, exp.newValue, events[i].NewValue)
+		assert.Equal(t, ticket.ID(), events[i].TicketID)
+		require.NotNil(t, events[i].ActorID)
+		assert.Equal(t, actorID, *events[i].ActorID)
+		assert.False(t, events[i].IsInternal)
+	}
+
+	ticket.ClearPendingEvents()
+	assert.Empty(t, ticket.PendingEvents())
+}
+
+func TestTicket_NoEventsWithoutChanges(t *testing.T) {
+	ticket := createTestTicket(t)
+
+	require.NoError(t, ticket.UpdateTitle(ticket.Title()))
+	require.NoError(t, ticket.UpdatePriority(ticket.Priority()))
+	ticket.SetCategory(nil)
+	ticket.Unassign()
+
+	assert.Empty(t, ticket.PendingEvents())
+}
+
+func TestTicket_RecordsEventsWithoutActor(t *testing.T) {
+	ticket := createTestTicket(t)
+
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+
+	events := ticket.PendingEvents()
+	require.Len(t, events, 1)
+	assert.Nil(t, events[0].ActorID)
+}
+
+func TestTicket_CommentEvents(t *testing.T) {
+	ticket := createTestTicket(t)
+	agentID := uuid.New()
+
+	require.NoError(t, ticket.AddComment(ticket.AuthorID(), "Public comment", false))
+	require.NoError(t, ticket.AddComment(agentID, "Internal note", true))
+
+	events := ticket.PendingEvents()
+	comments := ticket.Comments()
+	require.Len(t, events, 2)
+	require.Len(t, comments, 2)
+
+	assert.Equal(t, domain.EventCommentAdded, events[0].Type)
+	assert.Equal(t, ticket.AuthorID(), *events[0].ActorID)
+	assert.Equal(t, comments[0].ID.String(), events[0].NewValue)
+	assert.False(t, events[0].IsInternal)
+
+	assert.Equal(t, agentID, *events[1].ActorID)
+	assert.Equal(t, comments[1].ID.String(), events[1].NewValue)
+	assert.True(t, events[1].IsInternal)
+}
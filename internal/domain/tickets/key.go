@@ -0,0 +1,85 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidTicketKey = errors.New("invalid ticket key")
+
+const (
+	MinKeyPrefixLength = 2
+	MaxKeyPrefixLength = 10
+	keySeparator       = "-"
+)
+
+// FormatTicketKey составляет ключ заявки из префикса организации и номера, например ACME-1042
+func FormatTicketKey(prefix string, number int64) string {
+	return prefix + keySeparator + strconv.FormatInt(number, 10)
+}
+
+// ParseTicketKey приводит ключ заявки к верхнему регистру и проверяет его формат.
+// Второе значение ложно, если строка не является ключом заявки.
+func ParseTicketKey(key string) (string, bool) {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	prefix, number, found := strings.Cut(key, keySeparator)
+	if !found || !isWellFormedKeyPrefix(prefix) {
+		return "", false
+	}
+	if number == "" || number[0] == '0' || strings.ContainsFunc(number, isNotDigit) {
+		return "", false
+	}
+	if _, err := strconv.ParseInt(number, 10, 64); err != nil {
+		return "", false
+	}
+	return key, true
+}
+
+func isNotDigit(char rune) bool {
+	return char < '0' || char > '9'
+}
+
+// ParseKeyPrefix приводит префикс ключей заявок к верхнему регистру и проверяет его формат.
+// Пустой префикс допустим и означает, что заявкам организации ключи не выдаются.
+func ParseKeyPrefix(prefix string) (string, error) {
+	prefix = strings.ToUpper(strings.TrimSpace(prefix))
+	if prefix != "" && !isWellFormedKeyPrefix(prefix) {
+		return "", fmt.Errorf("%w: prefix must be %d-%d characters of A-Z and 0-9 starting with a letter, got %q",
+			ErrInvalidTicketKey, MinKeyPrefixLength, MaxKeyPrefixLength, prefix)
+	}
+	return prefix, nil
+}
+
+func isWellFormedKeyPrefix(prefix string) bool {
+	if len(prefix) < MinKeyPrefixLength || len(prefix) > MaxKeyPrefixLength || prefix[0] < 'A' || prefix[0] > 'Z' {
+		return false
+	}
+	for _, char := range prefix {
+		if (char < 'A' || char > 'Z') && isNotDigit(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// Key возвращает ключ заявки; пусто, если организация не выдает ключи
+func (t *Ticket) Key() string { return t.key }
+
+// RestoreKey sets the ticket key (for data restoration)
+func (t *Ticket) RestoreKey(key string) { t.key = key }
+
+// AssignKey присваивает заявке ключ. Ключ выдается один раз и больше не меняется,
+// даже если организация сменит префикс.
+func (t *Ticket) AssignKey(key string) error {
+	parsed, ok := ParseTicketKey(key)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidTicketKey, key)
+	}
+	if t.key != "" {
+		return fmt.Errorf("%w: ticket already has key %s", ErrInvalidTicketKey, t.key)
+	}
+	t.key = parsed
+	return nil
+}
@@ -0,0 +1,53 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestParseTicketKey(t *testing.T) {
+	key, ok := domain.ParseTicketKey(" acme-1042 ")
+	require.True(t, ok)
+	assert.Equal(t, "ACME-1042", key)
+	assert.Equal(t, "IT2-7", domain.FormatTicketKey("IT2", 7))
+
+	for _, invalid := range []string{
+		"", "ACME", "ACME-", "-1042", "A-1", "2ACME-1", "AC_ME-1", "ACME-0", "ACME-042", "ACME-1-2",
+		"ACME-+1", "VERYLONGPREFIX-1", "ACME-99999999999999999999", "3f9c2b1e-6d4a-4e8b-9c1d-2a3b4c5d6e7f",
+	} {
+		_, ok = domain.ParseTicketKey(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestParseKeyPrefix(t *testing.T) {
+	prefix, err := domain.ParseKeyPrefix(" acme ")
+	require.NoError(t, err)
+	assert.Equal(t, "ACME", prefix)
+
+	prefix, err = domain.ParseKeyPrefix("")
+	require.NoError(t, err)
+	assert.Empty(t, prefix)
+
+	for _, invalid := range []string{"A", "1T", "AC-ME", "ÄCME", "TOOLONGPREFIX"} {
+		_, err = domain.ParseKeyPrefix(invalid)
+		require.ErrorIs(t, err, domain.ErrInvalidTicketKey, invalid)
+	}
+}
+
+func TestTicket_AssignKey(t *testing.T) {
+	ticket := createTestTicket(t)
+	assert.Empty(t, ticket.Key())
+
+	require.ErrorIs(t, ticket.AssignKey("ACME"), domain.ErrInvalidTicketKey)
+	require.NoError(t, ticket.AssignKey("acme-1"))
+	assert.Equal(t, "ACME-1", ticket.Key())
+
+	// Ключ выдается один раз
+	require.ErrorIs(t, ticket.AssignKey("ACME-2"), domain.ErrInvalidTicketKey)
+	assert.Equal(t, "ACME-1", ticket.Key())
+}
@@ -0,0 +1,112 @@
+package tickets
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxMentions ограничивает количество дескрипторов, распознаваемых в одном комментарии
+const MaxMentions = 20
+
+// mentionPattern находит упоминания @email и @username, не являющиеся частью слова или адреса
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@(\w[\w.+-]*(?:@[\w-]+(?:\.[\w-]+)+)?)`)
+
+// MentionedUser описывает пользователя, на которого указывает упоминание
+type MentionedUser struct {
+	ID      uuid.UUID
+	IsAgent bool // Агент или администратор
+}
+
+// MentionDirectory сопоставляет дескрипторы упоминаний пользователям, которых автор комментария может упомянуть
+type MentionDirectory map[string]MentionedUser
+
+// ParseMentions возвращает дескрипторы пользователей, упомянутых в тексте как @email или @username:
+// в нижнем регистре, без повторов, в порядке появления и не более MaxMentions
+func ParseMentions(content string) []string {
+	var handles []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		handle := strings.ToLower(strings.TrimRight(match[1], ".-+"))
+		if slices.Contains(handles, handle) {
+			continue
+		}
+		handles = append(handles, handle)
+		if len(handles) == MaxMentions {
+			break
+		}
+	}
+	return handles
+}
+
+// AddMentioningComment добавляет комментарий и сохраняет упомянутых в нем пользователей.
+// Дескрипторы, которых нет в справочнике, остаются обычным текстом; упоминание самого себя не учитывается.
+// Во внутренних комментариях можно упоминать только агентов.
+func (t *Ticket) AddMentioningComment(
+	authorID uuid.UUID,
+	content string,
+	isInternal bool,
+	directory MentionDirectory,
+) (Comment, error) {
+	mentions, err := mentionedUsers(authorID, content, isInternal, directory)
+	if err != nil {
+		return Comment{}, err
+	}
+	return t.addComment(authorID, content, isInternal, mentions)
+}
+
+// EditMentioningComment изменяет текст комментария и заново определяет упомянутых в нем пользователей
+// по тем же правилам, что и AddMentioningComment. Возвращает пользователей, впервые упомянутых при правке.
+func (t *Ticket) EditMentioningComment(
+	commentID, editorID uuid.UUID,
+	content string,
+	directory MentionDirectory,
+) ([]uuid.UUID, error) {
+	comment, err := t.Comment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := mentionedUsers(comment.AuthorID, content, comment.IsInternal, directory)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.EditComment(commentID, editorID, content); err != nil {
+		return nil, err
+	}
+
+	var added []uuid.UUID
+	for _, userID := range mentions {
+		if userID != editorID && !slices.Contains(comment.Mentions, userID) {
+			added = append(added, userID)
+		}
+	}
+	for i := range t.comments {
+		if t.comments[i].ID == commentID {
+			t.comments[i].Mentions = mentions
+		}
+	}
+	return added, nil
+}
+
+func mentionedUsers(
+	authorID uuid.UUID,
+	content string,
+	isInternal bool,
+	directory MentionDirectory,
+) ([]uuid.UUID, error) {
+	var mentions []uuid.UUID
+	for _, handle := range ParseMentions(content) {
+		user, found := directory[handle]
+		if !found || user.ID == authorID || slices.Contains(mentions, user.ID) {
+			continue
+		}
+		if isInternal && !user.IsAgent {
+			return nil, fmt.Errorf("%w: internal comments may only mention agents, @%s is not an agent",
+				ErrTicketValidation, handle)
+		}
+		mentions = append(mentions, user.ID)
+	}
+	return mentions, nil
+}
@@ -0,0 +1,96 @@
+package tickets_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestParseMentions(t *testing.T) {
+	cases := map[string][]string{
+		"@alice can you help?":                     {"alice"},
+		"Ask @Bob@Example.com.":                    {"bob@example.com"},
+		"cc @alice, @carol.smith and @ALICE again": {"alice", "carol.smith"},
+		"(@dave) said hi":                          {"dave"},
+		"mail support@example.com or a@@b":         nil,
+		"no mentions here":                         nil,
+		"price is 5@ and @ alone":                  nil,
+	}
+	for content, expected := range cases {
+		assert.Equal(t, expected, domain.ParseMentions(content), content)
+	}
+
+	var many []string
+	for i := range domain.MaxMentions + 5 {
+		many = append(many, fmt.Sprintf("@user%d", i))
+	}
+	assert.Len(t, domain.ParseMentions(strings.Join(many, " ")), domain.MaxMentions)
+}
+
+func TestTicket_AddMentioningComment(t *testing.T) {
+	ticket := createTestTicket(t)
+	authorID, agentID, customerID := uuid.New(), uuid.New(), uuid.New()
+	directory := domain.MentionDirectory{
+		"me":                   {ID: authorID, IsAgent: true},
+		"alice":                {ID: agentID, IsAgent: true},
+		"alice@example.com":    {ID: agentID, IsAgent: true},
+		"customer@example.com": {ID: customerID},
+	}
+
+	comment, err := ticket.AddMentioningComment(authorID,
+		"@alice and @alice@example.com, please check; @me @unknown", false, directory)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{agentID}, comment.Mentions)
+	assert.Equal(t, comment, ticket.Comments()[0])
+
+	comment, err = ticket.AddMentioningComment(authorID, "@customer@example.com see above", false, directory)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{customerID}, comment.Mentions)
+
+	t.Run("Internal comments mention only agents", func(t *testing.T) {
+		_, err = ticket.AddMentioningComment(authorID, "@customer@example.com internal", true, directory)
+		require.ErrorIs(t, err, domain.ErrTicketValidation)
+
+		comment, err = ticket.AddMentioningComment(authorID, "@alice internal", true, directory)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{agentID}, comment.Mentions)
+	})
+
+	t.Run("Plain comments have no mentions", func(t *testing.T) {
+		require.NoError(t, ticket.AddComment(authorID, "@alice without a directory", false))
+		comments := ticket.Comments()
+		assert.Empty(t, comments[len(comments)-1].Mentions)
+	})
+}
+
+func TestTicket_EditMentioningComment(t *testing.T) {
+	ticket := createTestTicket(t)
+	authorID, aliceID, bobID := uuid.New(), uuid.New(), uuid.New()
+	directory := domain.MentionDirectory{
+		"alice": {ID: aliceID, IsAgent: true},
+		"bob":   {ID: bobID, IsAgent: true},
+	}
+
+	comment, err := ticket.AddMentioningComment(authorID, "@alice please check", false, directory)
+	require.NoError(t, err)
+
+	added, err := ticket.EditMentioningComment(comment.ID, authorID, "@alice and @bob please check", directory)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bobID}, added)
+	assert.Equal(t, []uuid.UUID{aliceID, bobID}, ticket.Comments()[0].Mentions)
+	assert.Equal(t, "@alice and @bob please check", ticket.Comments()[0].Content)
+
+	added, err = ticket.EditMentioningComment(comment.ID, authorID, "@bob only", directory)
+	require.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Equal(t, []uuid.UUID{bobID}, ticket.Comments()[0].Mentions)
+
+	_, err = ticket.EditMentioningComment(uuid.New(), authorID, "@alice", directory)
+	require.Error(t, err)
+}
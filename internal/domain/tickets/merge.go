@@ -0,0 +1,189 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidMerge = errors.New("invalid ticket merge")
+	ErrInvalidSplit = errors.New("invalid ticket split")
+)
+
+const (
+	MaxMergeSources  = 20
+	MaxSplitComments = 100
+)
+
+// MergedIntoID возвращает заявку, в которую объединена эта заявка; nil - заявка не объединялась
+func (t *Ticket) MergedIntoID() *uuid.UUID { return t.mergedIntoID }
+
+// SplitFromID возвращает заявку, из которой выделена эта заявка; nil - заявка создана напрямую
+func (t *Ticket) SplitFromID() *uuid.UUID { return t.splitFromID }
+
+// SetMergedIntoID sets the ticket this ticket was merged into (for data restoration)
+func (t *Ticket) SetMergedIntoID(targetID *uuid.UUID) { t.mergedIntoID = targetID }
+
+// SetSplitFromID sets the ticket this ticket was split from (for data restoration)
+func (t *Ticket) SetSplitFromID(sourceID *uuid.UUID) { t.splitFromID = sourceID }
+
+// IsMerged проверяет, объединена ли заявка с другой
+func (t *Ticket) IsMerged() bool {
+	return t.mergedIntoID != nil
+}
+
+// CanAbsorb проверяет, можно ли присоединить заявку source к этой заявке
+func (t *Ticket) CanAbsorb(source *Ticket) error {
+	if source.id == t.id {
+		return fmt.Errorf("%w: ticket cannot be merged into itself", ErrInvalidMerge)
+	}
+	if t.mergedIntoID != nil {
+		return t.mergedError(ErrInvalidMerge)
+	}
+	if source.mergedIntoID != nil {
+		return source.mergedError(ErrInvalidMerge)
+	}
+	if source.organizationID != t.organizationID {
+		return fmt.Errorf("%w: ticket %s belongs to another organization", ErrInvalidMerge, source.id)
+	}
+	return nil
+}
+
+// AbsorbTicket переносит комментарии и вложения заявки source, сохраняя авторов и время создания.
+// Уже перенесенные элементы пропускаются, поэтому повторное присоединение безопасно.
+func (t *Ticket) AbsorbTicket(source *Ticket) error {
+	if err := t.CanAbsorb(source); err != nil {
+		return err
+	}
+
+	for _, comment := range source.comments {
+		if slices.ContainsFunc(t.comments, func(c Comment) bool { return c.ID == comment.ID }) {
+			continue
+		}
+		comment.TicketID = t.id
+		comment.Revisions = slices.Clone(comment.Revisions)
+		t.comments = append(t.comments, comment)
+	}
+	slices.SortStableFunc(t.comments, func(a, b Comment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	for _, attachment := range source.attachments {
+		if slices.ContainsFunc(t.attachments, func(a Attachment) bool { return a.ID == attachment.ID }) {
+			continue
+		}
+		attachment.TicketID = t.id
+		t.attachments = append(t.attachments, attachment)
+	}
+	slices.SortStableFunc(t.attachments, func(a, b Attachment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	t.recordEvent(EventMerged, "", source.id.String())
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// MergeInto закрывает заявку как объединенную с target, передавая ей комментарии и вложения.
+// Заявка закрывается первым закрытым статусом рабочего процесса без проверки перехода.
+// Если target еще не содержит какой-либо комментарий или вложение заявки, они появились после
+// присоединения: возвращается ErrConcurrentUpdate, и заявку нужно присоединить заново.
+func (t *Ticket) MergeInto(target *Ticket, workflow *Workflow) error {
+	if err := validateUUID(target.id, "target_id"); err != nil {
+		return err
+	}
+	if target.id == t.id {
+		return fmt.Errorf("%w: ticket cannot be merged into itself", ErrInvalidMerge)
+	}
+	if t.mergedIntoID != nil {
+		return t.mergedError(ErrInvalidMerge)
+	}
+	for _, comment := range t.comments {
+		if !slices.ContainsFunc(target.comments, func(c Comment) bool { return c.ID == comment.ID }) {
+			return fmt.Errorf("%w: comment %s has not moved to ticket %s", ErrConcurrentUpdate, comment.ID, target.id)
+		}
+	}
+	for _, attachment := range t.attachments {
+		if !slices.ContainsFunc(target.attachments, func(a Attachment) bool { return a.ID == attachment.ID }) {
+			return fmt.Errorf("%w: attachment %s has not moved to ticket %s",
+				ErrConcurrentUpdate, attachment.ID, target.id)
+		}
+	}
+
+	t.comments = make([]Comment, 0)
+	t.attachments = make([]Attachment, 0)
+	targetID := target.id
+	t.mergedIntoID = &targetID
+	t.recordEvent(EventMergedInto, "", targetID.String())
+
+	if t.statusCategory != StatusClosed {
+		t.forceClose(workflow)
+	}
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// CommentsForSplit возвращает выбранные комментарии для переноса в новую заявку
+func (t *Ticket) CommentsForSplit(commentIDs []uuid.UUID) ([]Comment, error) {
+	if len(commentIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one comment is required", ErrInvalidSplit)
+	}
+	if len(commentIDs) > MaxSplitComments {
+		return nil, fmt.Errorf("%w: too many comments (max %d)", ErrInvalidSplit, MaxSplitComments)
+	}
+	if t.mergedIntoID != nil {
+		return nil, t.mergedError(ErrInvalidSplit)
+	}
+
+	comments := make([]Comment, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		if slices.ContainsFunc(comments, func(c Comment) bool { return c.ID == id }) {
+			return nil, fmt.Errorf("%w: duplicate comment %s", ErrInvalidSplit, id)
+		}
+		comment, err := t.Comment(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSplit, err)
+		}
+		comment.Revisions = slices.Clone(comment.Revisions)
+		comments = append(comments, comment)
+	}
+	slices.SortStableFunc(comments, func(a, b Comment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return comments, nil
+}
+
+// AdoptSplitComments добавляет в новую заявку комментарии, выделенные из заявки sourceID
+func (t *Ticket) AdoptSplitComments(sourceID uuid.UUID, comments []Comment) error {
+	if err := validateUUID(sourceID, "source_id"); err != nil {
+		return err
+	}
+	if sourceID == t.id {
+		return fmt.Errorf("%w: ticket cannot be split into itself", ErrInvalidSplit)
+	}
+
+	for _, comment := range comments {
+		comment.TicketID = t.id
+		t.comments = append(t.comments, comment)
+	}
+	t.splitFromID = &sourceID
+	t.recordEvent(EventSplitFrom, "", sourceID.String())
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SplitOff удаляет комментарии, перенесенные в новую заявку newTicketID
+func (t *Ticket) SplitOff(newTicketID uuid.UUID, commentIDs []uuid.UUID) error {
+	if _, err := t.CommentsForSplit(commentIDs); err != nil {
+		return err
+	}
+
+	t.comments = slices.DeleteFunc(t.comments, func(c Comment) bool {
+		return slices.Contains(commentIDs, c.ID)
+	})
+	t.recordEvent(EventSplit, "", newTicketID.String())
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Ticket) mergedError(base error) error {
+	return fmt.Errorf("%w: ticket %s is merged into %s", base, t.id, *t.mergedIntoID)
+}
@@ -0,0 +1,133 @@
+package tickets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+)
+
+func createTestTicketInOrganization(t *testing.T, orgID uuid.UUID) *domain.Ticket {
+	ticket, err := domain.NewTicket(uuid.New(), "Test ticket", "Test description", domain.PriorityNormal,
+		orgID, uuid.New(), nil)
+	require.NoError(t, err)
+	return ticket
+}
+
+func TestTicket_Merge(t *testing.T) {
+	orgID := uuid.New()
+	target := createTestTicketInOrganization(t, orgID)
+	source := createTestTicketInOrganization(t, orgID)
+
+	customerID := uuid.New()
+	require.NoError(t, target.AddComment(target.AuthorID(), "Printer does not work", false))
+	require.NoError(t, source.AddComment(customerID, "Printer is broken again", false))
+	require.NoError(t, source.AddComment(uuid.New(), "Checked the cable", true))
+	require.NoError(t, source.AddAttachment("photo.jpg", 1024, "image/jpeg", "tickets/source/photo", customerID))
+
+	// Комментарий источника написан раньше комментария целевой заявки
+	comments := source.Comments()
+	comments[0].CreatedAt = time.Now().Add(-time.Hour)
+	source.RestoreComments(comments)
+	sourceComment := source.Comments()[0]
+
+	require.NoError(t, target.AbsorbTicket(source))
+	require.Len(t, target.Comments(), 3)
+	assert.Equal(t, sourceComment.ID, target.Comments()[0].ID, "comments are ordered by creation time")
+	assert.Equal(t, customerID, target.Comments()[0].AuthorID)
+	assert.Equal(t, sourceComment.CreatedAt, target.Comments()[0].CreatedAt)
+	assert.Equal(t, target.ID(), target.Comments()[0].TicketID)
+	require.Len(t, target.Attachments(), 1)
+	assert.Equal(t, "tickets/source/photo", target.Attachments()[0].FilePath)
+
+	require.NoError(t, target.AbsorbTicket(source))
+	assert.Len(t, target.Comments(), 3, "absorbing the same ticket again does not duplicate comments")
+
+	require.NoError(t, source.AddComment(customerID, "Written after the merge started", false))
+	require.ErrorIs(t, source.MergeInto(target, nil), domain.ErrConcurrentUpdate,
+		"comments that have not moved to the target are not dropped")
+	require.NoError(t, target.AbsorbTicket(source))
+
+	require.NoError(t, source.MergeInto(target, nil))
+	assert.Empty(t, source.Comments())
+	assert.Empty(t, source.Attachments())
+	assert.True(t, source.IsMerged())
+	assert.Equal(t, target.ID(), *source.MergedIntoID())
+	assert.Equal(t, domain.StatusClosed, source.Status())
+	assert.NotNil(t, source.ClosedAt())
+
+	var mergedIntoEvents int
+	for _, event := range source.PendingEvents() {
+		if event.Type == domain.EventMergedInto {
+			mergedIntoEvents++
+			assert.Equal(t, target.ID().String(), event.NewValue)
+		}
+	}
+	assert.Equal(t, 1, mergedIntoEvents)
+
+	require.ErrorIs(t, target.CanAbsorb(source), domain.ErrInvalidMerge, "merged tickets cannot be merged again")
+	require.ErrorIs(t, source.MergeInto(target, nil), domain.ErrInvalidMerge)
+}
+
+func TestTicket_CanAbsorb(t *testing.T) {
+	target := createTestTicket(t)
+
+	require.ErrorIs(t, target.CanAbsorb(target), domain.ErrInvalidMerge)
+	require.ErrorIs(t, target.CanAbsorb(createTestTicket(t)), domain.ErrInvalidMerge,
+		"tickets of another organization cannot be merged")
+}
+
+func TestTicket_MergeInto_UsesWorkflowClosedStatus(t *testing.T) {
+	workflow, err := domain.NewWorkflow([]domain.WorkflowStatus{
+		{Key: "new", Name: "New", Category: domain.StatusNew},
+		{Key: "archived", Name: "Archived", Category: domain.StatusClosed},
+	}, []domain.WorkflowTransition{
+		{From: "new", To: "archived", AllowedRoles: []users.Role{users.RoleAdmin}},
+	})
+	require.NoError(t, err)
+
+	ticket := createTestTicket(t)
+	require.NoError(t, ticket.MergeInto(createTestTicket(t), workflow))
+	assert.Equal(t, domain.Status("archived"), ticket.Status())
+	assert.Equal(t, domain.StatusClosed, ticket.StatusCategory())
+}
+
+func TestTicket_Split(t *testing.T) {
+	source := createTestTicket(t)
+	require.NoError(t, source.AddComment(source.AuthorID(), "Printer is broken", false))
+	require.NoError(t, source.AddComment(source.AuthorID(), "Also my monitor flickers", false))
+	require.NoError(t, source.AddComment(uuid.New(), "Monitor needs a new cable", true))
+	monitorIDs := []uuid.UUID{source.Comments()[2].ID, source.Comments()[1].ID}
+
+	_, err := source.CommentsForSplit(nil)
+	require.ErrorIs(t, err, domain.ErrInvalidSplit)
+	_, err = source.CommentsForSplit([]uuid.UUID{uuid.New()})
+	require.ErrorIs(t, err, domain.ErrInvalidSplit)
+	_, err = source.CommentsForSplit([]uuid.UUID{monitorIDs[0], monitorIDs[0]})
+	require.ErrorIs(t, err, domain.ErrInvalidSplit)
+
+	comments, err := source.CommentsForSplit(monitorIDs)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, monitorIDs[1], comments[0].ID, "comments keep their chronological order")
+
+	split := createTestTicket(t)
+	require.NoError(t, split.AdoptSplitComments(source.ID(), comments))
+	require.NoError(t, source.SplitOff(split.ID(), monitorIDs))
+
+	require.Len(t, source.Comments(), 1)
+	assert.Equal(t, "Printer is broken", source.Comments()[0].Content)
+	require.Len(t, split.Comments(), 2)
+	assert.Equal(t, split.ID(), split.Comments()[0].TicketID)
+	assert.True(t, split.Comments()[1].IsInternal)
+	require.NotNil(t, split.SplitFromID())
+	assert.Equal(t, source.ID(), *split.SplitFromID())
+
+	require.ErrorIs(t, source.SplitOff(split.ID(), monitorIDs), domain.ErrInvalidSplit,
+		"moved comments cannot be split again")
+}
@@ -37,6 +37,15 @@ const (
 	SLADefaultHours  = 24 // по умолчанию 24 часа
 )
 
+// Константы для SLA первого ответа (в часах)
+const (
+	SLAFirstResponseLowHours      = 24
+	SLAFirstResponseNormalHours   = 8
+	SLAFirstResponseHighHours     = 2
+	SLAFirstResponseCriticalHours = 1
+	SLAFirstResponseDefaultHours  = 8
+)
+
 // AllPriorities возвращает все возможные приоритеты
 func AllPriorities() []Priority {
 	return []Priority{
@@ -82,6 +91,16 @@ func (p Priority) Weight() int {
 	return 0
 }
 
+// Raised возвращает следующий по важности приоритет; критический приоритет остается критическим
+func (p Priority) Raised() Priority {
+	priorities := AllPriorities()
+	index := slices.Index(priorities, p)
+	if index < 0 || index == len(priorities)-1 {
+		return p
+	}
+	return priorities[index+1]
+}
+
 // DisplayName возвращает человекочитаемое название приоритета
 func (p Priority) DisplayName() string {
 	names := map[Priority]string{
@@ -127,6 +146,21 @@ func (p Priority) SLA() int {
 	return SLADefaultHours
 }
 
+// FirstResponseSLA возвращает целевое время первого ответа в часах для данного приоритета
+func (p Priority) FirstResponseSLA() int {
+	sla := map[Priority]int{
+		PriorityLow:      SLAFirstResponseLowHours,
+		PriorityNormal:   SLAFirstResponseNormalHours,
+		PriorityHigh:     SLAFirstResponseHighHours,
+		PriorityCritical: SLAFirstResponseCriticalHours,
+	}
+
+	if hours, exists := sla[p]; exists {
+		return hours
+	}
+	return SLAFirstResponseDefaultHours
+}
+
 // GetSLAHours возвращает количество часов SLA для приоритета
 func (p Priority) GetSLAHours() int {
 	return p.SLA()
@@ -0,0 +1,260 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidImpact         = errors.New("invalid ticket impact")
+	ErrInvalidUrgency        = errors.New("invalid ticket urgency")
+	ErrInvalidPriorityMatrix = errors.New("invalid priority matrix")
+)
+
+// Impact описывает масштаб последствий проблемы для клиента
+type Impact string
+
+const (
+	ImpactLow    Impact = "low"    // Затронут один пользователь, есть обходной путь
+	ImpactMedium Impact = "medium" // Затронута группа пользователей или важная функция
+	ImpactHigh   Impact = "high"   // Затронута вся организация или ключевой сервис
+)
+
+// AllImpacts возвращает все уровни влияния
+func AllImpacts() []Impact {
+	return []Impact{ImpactLow, ImpactMedium, ImpactHigh}
+}
+
+// String возвращает строковое представление влияния
+func (i Impact) String() string {
+	return string(i)
+}
+
+// IsValid проверяет, является ли влияние допустимым
+func (i Impact) IsValid() bool {
+	return slices.Contains(AllImpacts(), i)
+}
+
+// ParseImpact создает влияние из строки
+func ParseImpact(s string) (Impact, error) {
+	impact := Impact(s)
+	if !impact.IsValid() {
+		return "", fmt.Errorf(formatError, ErrInvalidImpact, s)
+	}
+	return impact, nil
+}
+
+// Urgency описывает, насколько быстро клиенту нужно решение
+type Urgency string
+
+const (
+	UrgencyLow    Urgency = "low"    // Решение может подождать
+	UrgencyMedium Urgency = "medium" // Работа затруднена
+	UrgencyHigh   Urgency = "high"   // Работа остановлена
+)
+
+// AllUrgencies возвращает все уровни срочности
+func AllUrgencies() []Urgency {
+	return []Urgency{UrgencyLow, UrgencyMedium, UrgencyHigh}
+}
+
+// String возвращает строковое представление срочности
+func (u Urgency) String() string {
+	return string(u)
+}
+
+// IsValid проверяет, является ли срочность допустимой
+func (u Urgency) IsValid() bool {
+	return slices.Contains(AllUrgencies(), u)
+}
+
+// ParseUrgency создает срочность из строки
+func ParseUrgency(s string) (Urgency, error) {
+	urgency := Urgency(s)
+	if !urgency.IsValid() {
+		return "", fmt.Errorf(formatError, ErrInvalidUrgency, s)
+	}
+	return urgency, nil
+}
+
+// PriorityMatrixCell задает приоритет заявки для сочетания влияния и срочности
+type PriorityMatrixCell struct {
+	Impact   Impact   `json:"impact"`
+	Urgency  Urgency  `json:"urgency"`
+	Priority Priority `json:"priority"`
+}
+
+// PriorityMatrix определяет приоритет заявки по ее влиянию и срочности
+type PriorityMatrix struct {
+	cells []PriorityMatrixCell // Все сочетания влияния и срочности в порядке AllImpacts и AllUrgencies
+}
+
+// NewPriorityMatrix создает матрицу приоритетов; каждое сочетание влияния и срочности задается ровно один раз
+func NewPriorityMatrix(cells []PriorityMatrixCell) (*PriorityMatrix, error) {
+	ordered := make([]PriorityMatrixCell, 0, len(AllImpacts())*len(AllUrgencies()))
+	for _, impact := range AllImpacts() {
+		for _, urgency := range AllUrgencies() {
+			ordered = append(ordered, PriorityMatrixCell{Impact: impact, Urgency: urgency})
+		}
+	}
+
+	for _, cell := range cells {
+		if !cell.Impact.IsValid() {
+			return nil, fmt.Errorf("%w: unknown impact %q", ErrInvalidPriorityMatrix, cell.Impact)
+		}
+		if !cell.Urgency.IsValid() {
+			return nil, fmt.Errorf("%w: unknown urgency %q", ErrInvalidPriorityMatrix, cell.Urgency)
+		}
+		if !cell.Priority.IsValid() {
+			return nil, fmt.Errorf("%w: unknown priority %q", ErrInvalidPriorityMatrix, cell.Priority)
+		}
+		i := slices.IndexFunc(ordered, func(c PriorityMatrixCell) bool {
+			return c.Impact == cell.Impact && c.Urgency == cell.Urgency
+		})
+		if ordered[i].Priority != "" {
+			return nil, fmt.Errorf("%w: duplicate cell for %s impact and %s urgency",
+				ErrInvalidPriorityMatrix, cell.Impact, cell.Urgency)
+		}
+		ordered[i].Priority = cell.Priority
+	}
+
+	for _, cell := range ordered {
+		if cell.Priority == "" {
+			return nil, fmt.Errorf("%w: missing cell for %s impact and %s urgency",
+				ErrInvalidPriorityMatrix, cell.Impact, cell.Urgency)
+		}
+	}
+
+	return &PriorityMatrix{cells: ordered}, nil
+}
+
+// DefaultPriorityMatrix возвращает матрицу, в которой критический приоритет получают только
+// заявки с высоким влиянием и высокой срочностью
+func DefaultPriorityMatrix() *PriorityMatrix {
+	return &PriorityMatrix{cells: []PriorityMatrixCell{
+		{Impact: ImpactLow, Urgency: UrgencyLow, Priority: PriorityLow},
+		{Impact: ImpactLow, Urgency: UrgencyMedium, Priority: PriorityLow},
+		{Impact: ImpactLow, Urgency: UrgencyHigh, Priority: PriorityNormal},
+		{Impact: ImpactMedium, Urgency: UrgencyLow, Priority: PriorityLow},
+		{Impact: ImpactMedium, Urgency: UrgencyMedium, Priority: PriorityNormal},
+		{Impact: ImpactMedium, Urgency: UrgencyHigh, Priority: PriorityHigh},
+		{Impact: ImpactHigh, Urgency: UrgencyLow, Priority: PriorityNormal},
+		{Impact: ImpactHigh, Urgency: UrgencyMedium, Priority: PriorityHigh},
+		{Impact: ImpactHigh, Urgency: UrgencyHigh, Priority: PriorityCritical},
+	}}
+}
+
+// Cells возвращает все ячейки матрицы
+func (m *PriorityMatrix) Cells() []PriorityMatrixCell {
+	return slices.Clone(m.cells)
+}
+
+// Priority возвращает приоритет для сочетания влияния и срочности; для недопустимых значений - обычный приоритет
+func (m *PriorityMatrix) Priority(impact Impact, urgency Urgency) Priority {
+	for _, cell := range m.cells {
+		if cell.Impact == impact && cell.Urgency == urgency {
+			return cell.Priority
+		}
+	}
+	return PriorityNormal
+}
+
+// Impact возвращает влияние заявки; пустое значение - заявка создана до появления матрицы приоритетов
+func (t *Ticket) Impact() Impact { return t.impact }
+
+// Urgency возвращает срочность заявки; пустое значение - заявка создана до появления матрицы приоритетов
+func (t *Ticket) Urgency() Urgency { return t.urgency }
+
+// IsClassified проверяет, заданы ли для заявки влияние и срочность
+func (t *Ticket) IsClassified() bool {
+	return t.impact != "" && t.urgency != ""
+}
+
+// IsPriorityOverridden проверяет, задал ли агент приоритет вместо вычисленного по матрице
+func (t *Ticket) IsPriorityOverridden() bool { return t.priorityOverridden }
+
+// RestoreClassification sets the impact, urgency and override flag (for data restoration)
+func (t *Ticket) RestoreClassification(impact Impact, urgency Urgency, priorityOverridden bool) {
+	t.impact = impact
+	t.urgency = urgency
+	t.priorityOverridden = priorityOverridden
+}
+
+// Classify задает влияние и срочность заявки. Если агент не переопределил приоритет,
+// он вычисляется заново по матрице организации. В историю попадают изменения, но не первоначальная оценка.
+func (t *Ticket) Classify(matrix *PriorityMatrix, impact Impact, urgency Urgency) error {
+	if !impact.IsValid() {
+		return fmt.Errorf(formatError, ErrInvalidImpact, impact)
+	}
+	if !urgency.IsValid() {
+		return fmt.Errorf(formatError, ErrInvalidUrgency, urgency)
+	}
+
+	changed := false
+	if impact != t.impact {
+		if t.impact != "" {
+			t.recordEvent(EventImpactChanged, t.impact.String(), impact.String())
+		}
+		t.impact = impact
+		changed = true
+	}
+	if urgency != t.urgency {
+		if t.urgency != "" {
+			t.recordEvent(EventUrgencyChanged, t.urgency.String(), urgency.String())
+		}
+		t.urgency = urgency
+		changed = true
+	}
+	if !t.priorityOverridden {
+		if priority := matrix.Priority(impact, urgency); priority != t.priority {
+			t.recordEvent(EventPriorityChanged, t.priority.String(), priority.String())
+			t.priority = priority
+			changed = true
+		}
+	}
+	if changed {
+		t.updatedAt = time.Now()
+	}
+	return nil
+}
+
+// OverridePriority задает приоритет вместо вычисленного по матрице; переопределение попадает в историю заявки.
+// У заявок без влияния и срочности приоритет просто изменяется.
+func (t *Ticket) OverridePriority(priority Priority) error {
+	if !t.IsClassified() {
+		return t.UpdatePriority(priority)
+	}
+	if !priority.IsValid() {
+		return fmt.Errorf(formatError, ErrInvalidPriority, priority)
+	}
+	if priority == t.priority {
+		return nil
+	}
+
+	t.recordEvent(EventPriorityOverridden, t.priority.String(), priority.String())
+	t.priority = priority
+	t.priorityOverridden = true
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// ResetPriorityOverride отменяет переопределение и возвращает заявке приоритет, вычисленный по матрице
+func (t *Ticket) ResetPriorityOverride(matrix *PriorityMatrix) error {
+	if !t.IsClassified() {
+		return fmt.Errorf("%w: ticket has no impact and urgency to derive the priority from", ErrTicketValidation)
+	}
+	if !t.priorityOverridden {
+		return nil
+	}
+
+	t.priorityOverridden = false
+	priority := matrix.Priority(t.impact, t.urgency)
+	if priority != t.priority {
+		t.recordEvent(EventPriorityChanged, t.priority.String(), priority.String())
+		t.priority = priority
+	}
+	t.updatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,143 @@
+package tickets_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestParseImpactAndUrgency(t *testing.T) {
+	impact, err := domain.ParseImpact("high")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ImpactHigh, impact)
+	_, err = domain.ParseImpact("critical")
+	require.ErrorIs(t, err, domain.ErrInvalidImpact)
+
+	urgency, err := domain.ParseUrgency("low")
+	require.NoError(t, err)
+	assert.Equal(t, domain.UrgencyLow, urgency)
+	_, err = domain.ParseUrgency("")
+	require.ErrorIs(t, err, domain.ErrInvalidUrgency)
+}
+
+func TestNewPriorityMatrix(t *testing.T) {
+	cells := domain.DefaultPriorityMatrix().Cells()
+	require.Len(t, cells, 9)
+
+	tests := []struct {
+		name  string
+		cells []domain.PriorityMatrixCell
+	}{
+		{"missing cell", cells[1:]},
+		{"duplicate cell", append([]domain.PriorityMatrixCell{cells[0]}, cells...)},
+		{"unknown impact", slices.Concat(cells[1:], []domain.PriorityMatrixCell{{
+			Impact: "huge", Urgency: domain.UrgencyLow, Priority: domain.PriorityLow,
+		}})},
+		{"unknown urgency", slices.Concat(cells[1:], []domain.PriorityMatrixCell{{
+			Impact: domain.ImpactLow, Urgency: "now", Priority: domain.PriorityLow,
+		}})},
+		{"unknown priority", slices.Concat(cells[1:], []domain.PriorityMatrixCell{{
+			Impact: domain.ImpactLow, Urgency: domain.UrgencyLow, Priority: "urgent",
+		}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewPriorityMatrix(tt.cells)
+			require.ErrorIs(t, err, domain.ErrInvalidPriorityMatrix)
+		})
+	}
+
+	t.Run("orders cells", func(t *testing.T) {
+		reversed := make([]domain.PriorityMatrixCell, 0, len(cells))
+		for i := len(cells) - 1; i >= 0; i-- {
+			cell := cells[i]
+			cell.Priority = domain.PriorityHigh
+			reversed = append(reversed, cell)
+		}
+		matrix, err := domain.NewPriorityMatrix(reversed)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ImpactLow, matrix.Cells()[0].Impact)
+		assert.Equal(t, domain.UrgencyLow, matrix.Cells()[0].Urgency)
+		assert.Equal(t, domain.PriorityHigh, matrix.Priority(domain.ImpactLow, domain.UrgencyLow))
+	})
+}
+
+func TestDefaultPriorityMatrix(t *testing.T) {
+	matrix := domain.DefaultPriorityMatrix()
+	assert.Equal(t, domain.PriorityLow, matrix.Priority(domain.ImpactLow, domain.UrgencyLow))
+	assert.Equal(t, domain.PriorityNormal, matrix.Priority(domain.ImpactMedium, domain.UrgencyMedium))
+	assert.Equal(t, domain.PriorityHigh, matrix.Priority(domain.ImpactHigh, domain.UrgencyMedium))
+	assert.Equal(t, domain.PriorityCritical, matrix.Priority(domain.ImpactHigh, domain.UrgencyHigh))
+}
+
+func TestTicket_Classify(t *testing.T) {
+	matrix := domain.DefaultPriorityMatrix()
+	ticket := createTestTicket(t)
+	assert.False(t, ticket.IsClassified())
+
+	require.NoError(t, ticket.Classify(matrix, domain.ImpactHigh, domain.UrgencyMedium))
+	assert.True(t, ticket.IsClassified())
+	assert.Equal(t, domain.PriorityHigh, ticket.Priority())
+	assert.Equal(t, []domain.EventType{domain.EventPriorityChanged}, eventTypes(ticket.PendingEvents()))
+	ticket.ClearPendingEvents()
+
+	require.NoError(t, ticket.Classify(matrix, domain.ImpactHigh, domain.UrgencyHigh))
+	assert.Equal(t, domain.PriorityCritical, ticket.Priority())
+	assert.Equal(t, []domain.EventType{
+		domain.EventUrgencyChanged, domain.EventPriorityChanged,
+	}, eventTypes(ticket.PendingEvents()))
+
+	require.ErrorIs(t, ticket.Classify(matrix, "huge", domain.UrgencyLow), domain.ErrInvalidImpact)
+	require.ErrorIs(t, ticket.Classify(matrix, domain.ImpactLow, "now"), domain.ErrInvalidUrgency)
+	assert.Equal(t, domain.ImpactHigh, ticket.Impact())
+}
+
+func TestTicket_OverridePriority(t *testing.T) {
+	matrix := domain.DefaultPriorityMatrix()
+
+	t.Run("Unclassified ticket changes priority", func(t *testing.T) {
+		ticket := createTestTicket(t)
+		require.NoError(t, ticket.OverridePriority(domain.PriorityHigh))
+		assert.False(t, ticket.IsPriorityOverridden())
+		assert.Equal(t, []domain.EventType{domain.EventPriorityChanged}, eventTypes(ticket.PendingEvents()))
+		require.ErrorIs(t, ticket.ResetPriorityOverride(matrix), domain.ErrTicketValidation)
+	})
+
+	t.Run("Override survives reclassification until reset", func(t *testing.T) {
+		ticket := createTestTicket(t)
+		require.NoError(t, ticket.Classify(matrix, domain.ImpactMedium, domain.UrgencyMedium))
+		ticket.ClearPendingEvents()
+
+		require.ErrorIs(t, ticket.OverridePriority("urgent"), domain.ErrInvalidPriority)
+		require.NoError(t, ticket.OverridePriority(domain.PriorityNormal))
+		assert.False(t, ticket.IsPriorityOverridden())
+
+		require.NoError(t, ticket.OverridePriority(domain.PriorityCritical))
+		assert.True(t, ticket.IsPriorityOverridden())
+		events := ticket.PendingEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.EventPriorityOverridden, events[0].Type)
+		assert.Equal(t, "normal", events[0].OldValue)
+		assert.Equal(t, "critical", events[0].NewValue)
+
+		require.NoError(t, ticket.Classify(matrix, domain.ImpactLow, domain.UrgencyLow))
+		assert.Equal(t, domain.PriorityCritical, ticket.Priority())
+
+		require.NoError(t, ticket.ResetPriorityOverride(matrix))
+		assert.False(t, ticket.IsPriorityOverridden())
+		assert.Equal(t, domain.PriorityLow, ticket.Priority())
+	})
+}
+
+func eventTypes(events []domain.Event) []domain.EventType {
+	types := make([]domain.EventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
@@ -46,6 +46,25 @@ func TestPriority_Weight(t *testing.T) {
 	}
 }
 
+func TestPriority_Raised(t *testing.T) {
+	tests := []struct {
+		priority domain.Priority
+		raised   domain.Priority
+	}{
+		{domain.PriorityLow, domain.PriorityNormal},
+		{domain.PriorityNormal, domain.PriorityHigh},
+		{domain.PriorityHigh, domain.PriorityCritical},
+		{domain.PriorityCritical, domain.PriorityCritical},
+		{domain.Priority("unknown"), domain.Priority("unknown")},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.priority), func(t *testing.T) {
+			require.Equal(t, tt.raised, tt.priority.Raised())
+		})
+	}
+}
+
 func TestPriority_SLA(t *testing.T) {
 	tests := []struct {
 		priority domain.Priority
@@ -0,0 +1,225 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRelation  = errors.New("invalid ticket relation")
+	ErrRelationExists   = errors.New("ticket relation already exists")
+	ErrRelationNotFound = errors.New("ticket relation not found")
+	ErrOpenBlockers     = errors.New("ticket has open blockers")
+)
+
+// MaxRelations ограничивает количество связей одной заявки
+const MaxRelations = 200
+
+// RelationType представляет тип связи заявки со связанной заявкой.
+// Тип читается от лица заявки: "A blocks B" - заявка A блокирует заявку B.
+type RelationType string
+
+const (
+	RelationDuplicateOf  RelationType = "duplicate_of"  // Заявка дублирует связанную
+	RelationDuplicatedBy RelationType = "duplicated_by" // Связанная заявка дублирует эту
+	RelationBlocks       RelationType = "blocks"        // Заявка блокирует связанную
+	RelationBlockedBy    RelationType = "blocked_by"    // Заявка заблокирована связанной
+	RelationParent       RelationType = "parent"        // Заявка является родительской для связанной
+	RelationChild        RelationType = "child"         // Заявка является дочерней для связанной
+	RelationRelatesTo    RelationType = "relates_to"    // Заявки просто связаны
+)
+
+// AllRelationTypes возвращает все типы связей
+func AllRelationTypes() []RelationType {
+	return []RelationType{
+		RelationDuplicateOf,
+		RelationDuplicatedBy,
+		RelationBlocks,
+		RelationBlockedBy,
+		RelationParent,
+		RelationChild,
+		RelationRelatesTo,
+	}
+}
+
+// String возвращает строковое представление типа связи
+func (r RelationType) String() string {
+	return string(r)
+}
+
+// IsValid проверяет, является ли тип связи допустимым
+func (r RelationType) IsValid() bool {
+	return slices.Contains(AllRelationTypes(), r)
+}
+
+// Inverse возвращает тип связи, хранимый у связанной заявки
+func (r RelationType) Inverse() RelationType {
+	switch r {
+	case RelationDuplicateOf:
+		return RelationDuplicatedBy
+	case RelationDuplicatedBy:
+		return RelationDuplicateOf
+	case RelationBlocks:
+		return RelationBlockedBy
+	case RelationBlockedBy:
+		return RelationBlocks
+	case RelationParent:
+		return RelationChild
+	case RelationChild:
+		return RelationParent
+	case RelationRelatesTo:
+		return RelationRelatesTo
+	}
+	return r
+}
+
+// isSingle проверяет, может ли у заявки быть только одна связь этого типа
+func (r RelationType) isSingle() bool {
+	return r == RelationDuplicateOf || r == RelationChild
+}
+
+// Relation представляет связь заявки с другой заявкой
+type Relation struct {
+	Type      RelationType `json:"type"`
+	TicketID  uuid.UUID    `json:"ticket_id"`            // Связанная заявка
+	CreatedBy *uuid.UUID   `json:"created_by,omitempty"` // nil - связь создана системой
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+func (t *Ticket) Relations() []Relation { return slices.Clone(t.relations) }
+
+// RestoreRelations sets the ticket relations (for data restoration)
+func (t *Ticket) RestoreRelations(relations []Relation) { t.relations = relations }
+
+// HasRelation проверяет наличие связи указанного типа со связанной заявкой
+func (t *Ticket) HasRelation(relationType RelationType, ticketID uuid.UUID) bool {
+	return slices.ContainsFunc(t.relations, func(r Relation) bool {
+		return r.Type == relationType && r.TicketID == ticketID
+	})
+}
+
+// RelatedTicketIDs возвращает связанные заявки указанного типа
+func (t *Ticket) RelatedTicketIDs(relationType RelationType) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, relation := range t.relations {
+		if relation.Type == relationType {
+			ids = append(ids, relation.TicketID)
+		}
+	}
+	return ids
+}
+
+// ParentID возвращает родительскую заявку; nil - у заявки нет родителя
+func (t *Ticket) ParentID() *uuid.UUID {
+	for _, relation := range t.relations {
+		if relation.Type == RelationChild {
+			parentID := relation.TicketID
+			return &parentID
+		}
+	}
+	return nil
+}
+
+// CanRelate проверяет, можно ли связать заявку с заявкой other.
+// Ограничения связанной заявки проверяются вызовом other.CanRelate с обратным типом.
+func (t *Ticket) CanRelate(relationType RelationType, other *Ticket) error {
+	if other.id == t.id {
+		return fmt.Errorf("%w: ticket cannot be related to itself", ErrInvalidRelation)
+	}
+	if other.organizationID != t.organizationID {
+		return fmt.Errorf("%w: ticket %s belongs to another organization", ErrInvalidRelation, other.id)
+	}
+	return t.checkRelation(relationType, other.id)
+}
+
+// AddRelation добавляет связь со связанной заявкой.
+// Обратную связь у связанной заявки нужно добавить отдельно.
+func (t *Ticket) AddRelation(relationType RelationType, ticketID uuid.UUID) error {
+	if err := validateUUID(ticketID, "ticket_id"); err != nil {
+		return err
+	}
+	if err := t.checkRelation(relationType, ticketID); err != nil {
+		return err
+	}
+
+	t.relations = append(t.relations, Relation{
+		Type:      relationType,
+		TicketID:  ticketID,
+		CreatedBy: t.actorID,
+		CreatedAt: time.Now(),
+	})
+	t.recordEvent(EventRelationAdded, "", relationValue(relationType, ticketID))
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveRelation удаляет связь со связанной заявкой
+func (t *Ticket) RemoveRelation(relationType RelationType, ticketID uuid.UUID) error {
+	if !t.HasRelation(relationType, ticketID) {
+		return fmt.Errorf(formatError, ErrRelationNotFound, relationValue(relationType, ticketID))
+	}
+
+	t.relations = slices.DeleteFunc(t.relations, func(r Relation) bool {
+		return r.Type == relationType && r.TicketID == ticketID
+	})
+	t.recordEvent(EventRelationRemoved, relationValue(relationType, ticketID), "")
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SetOpenBlockers задает незавершенные заявки, блокирующие эту заявку.
+// Значение не сохраняется и используется при проверке перехода в решенный статус.
+func (t *Ticket) SetOpenBlockers(blockerIDs []uuid.UUID) {
+	t.openBlockers = blockerIDs
+}
+
+// CloseWithParent закрывает дочернюю заявку вместе с родительской без проверки перехода
+func (t *Ticket) CloseWithParent(parentID uuid.UUID, workflow *Workflow) error {
+	if !t.HasRelation(RelationChild, parentID) {
+		return fmt.Errorf(formatError, ErrRelationNotFound, relationValue(RelationChild, parentID))
+	}
+	if t.statusCategory == StatusClosed {
+		return nil
+	}
+	t.forceClose(workflow)
+	return nil
+}
+
+func (t *Ticket) checkRelation(relationType RelationType, ticketID uuid.UUID) error {
+	if !relationType.IsValid() {
+		return fmt.Errorf(formatError, ErrInvalidRelation, relationType)
+	}
+	if ticketID == t.id {
+		return fmt.Errorf("%w: ticket cannot be related to itself", ErrInvalidRelation)
+	}
+	if t.HasRelation(relationType, ticketID) {
+		return fmt.Errorf(formatError, ErrRelationExists, relationValue(relationType, ticketID))
+	}
+	if inverse := relationType.Inverse(); inverse != relationType && t.HasRelation(inverse, ticketID) {
+		return fmt.Errorf("%w: ticket %s is already related as %s", ErrInvalidRelation, ticketID, inverse)
+	}
+	if relationType.isSingle() && len(t.RelatedTicketIDs(relationType)) > 0 {
+		return fmt.Errorf("%w: ticket %s can have only one %s relation", ErrInvalidRelation, t.id, relationType)
+	}
+	if len(t.relations) >= MaxRelations {
+		return fmt.Errorf("%w: too many relations (max %d)", ErrInvalidRelation, MaxRelations)
+	}
+	return nil
+}
+
+func relationValue(relationType RelationType, ticketID uuid.UUID) string {
+	return relationType.String() + ":" + ticketID.String()
+}
+
+func joinUUIDs(ids []uuid.UUID) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return strings.Join(values, ", ")
+}
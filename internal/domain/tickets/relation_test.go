@@ -0,0 +1,106 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestRelationType_Inverse(t *testing.T) {
+	for _, relationType := range domain.AllRelationTypes() {
+		assert.True(t, relationType.Inverse().IsValid())
+		assert.Equal(t, relationType, relationType.Inverse().Inverse())
+	}
+	assert.Equal(t, domain.RelationBlockedBy, domain.RelationBlocks.Inverse())
+	assert.Equal(t, domain.RelationChild, domain.RelationParent.Inverse())
+	assert.Equal(t, domain.RelationDuplicatedBy, domain.RelationDuplicateOf.Inverse())
+	assert.Equal(t, domain.RelationRelatesTo, domain.RelationRelatesTo.Inverse())
+	assert.False(t, domain.RelationType("depends_on").IsValid())
+}
+
+func TestTicket_AddRelation(t *testing.T) {
+	orgID := uuid.New()
+	ticket := createTestTicketInOrganization(t, orgID)
+	other := createTestTicketInOrganization(t, orgID)
+	actorID := uuid.New()
+	ticket.ActAs(actorID)
+
+	require.NoError(t, ticket.CanRelate(domain.RelationBlocks, other))
+	require.NoError(t, ticket.AddRelation(domain.RelationBlocks, other.ID()))
+	require.True(t, ticket.HasRelation(domain.RelationBlocks, other.ID()))
+	require.Len(t, ticket.Relations(), 1)
+	assert.Equal(t, &actorID, ticket.Relations()[0].CreatedBy)
+	assert.Equal(t, []uuid.UUID{other.ID()}, ticket.RelatedTicketIDs(domain.RelationBlocks))
+
+	events := ticket.PendingEvents()
+	require.NotEmpty(t, events)
+	assert.Equal(t, domain.EventRelationAdded, events[len(events)-1].Type)
+	assert.Equal(t, "blocks:"+other.ID().String(), events[len(events)-1].NewValue)
+
+	require.ErrorIs(t, ticket.AddRelation(domain.RelationBlocks, other.ID()), domain.ErrRelationExists)
+	require.ErrorIs(t, ticket.AddRelation(domain.RelationBlockedBy, other.ID()), domain.ErrInvalidRelation,
+		"a ticket cannot block and be blocked by the same ticket")
+	require.NoError(t, ticket.AddRelation(domain.RelationRelatesTo, other.ID()))
+
+	require.ErrorIs(t, ticket.AddRelation(domain.RelationType("depends_on"), other.ID()), domain.ErrInvalidRelation)
+	require.ErrorIs(t, ticket.AddRelation(domain.RelationBlocks, ticket.ID()), domain.ErrInvalidRelation)
+	require.ErrorIs(t, ticket.CanRelate(domain.RelationBlocks, createTestTicket(t)), domain.ErrInvalidRelation,
+		"tickets of another organization cannot be related")
+}
+
+func TestTicket_SingleParent(t *testing.T) {
+	child := createTestTicket(t)
+	parentID := uuid.New()
+
+	assert.Nil(t, child.ParentID())
+	require.NoError(t, child.AddRelation(domain.RelationChild, parentID))
+	require.NotNil(t, child.ParentID())
+	assert.Equal(t, parentID, *child.ParentID())
+	require.ErrorIs(t, child.AddRelation(domain.RelationChild, uuid.New()), domain.ErrInvalidRelation)
+
+	require.NoError(t, child.AddRelation(domain.RelationDuplicateOf, uuid.New()))
+	require.ErrorIs(t, child.AddRelation(domain.RelationDuplicateOf, uuid.New()), domain.ErrInvalidRelation)
+}
+
+func TestTicket_RemoveRelation(t *testing.T) {
+	ticket := createTestTicket(t)
+	otherID := uuid.New()
+	require.NoError(t, ticket.AddRelation(domain.RelationRelatesTo, otherID))
+
+	require.ErrorIs(t, ticket.RemoveRelation(domain.RelationBlocks, otherID), domain.ErrRelationNotFound)
+	require.NoError(t, ticket.RemoveRelation(domain.RelationRelatesTo, otherID))
+	assert.Empty(t, ticket.Relations())
+	require.ErrorIs(t, ticket.RemoveRelation(domain.RelationRelatesTo, otherID), domain.ErrRelationNotFound)
+}
+
+func TestTicket_ChangeStatus_OpenBlockers(t *testing.T) {
+	ticket := createTestTicket(t)
+	blockerID := uuid.New()
+	require.NoError(t, ticket.AddRelation(domain.RelationBlockedBy, blockerID))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+
+	ticket.SetOpenBlockers([]uuid.UUID{blockerID})
+	require.ErrorIs(t, ticket.ChangeStatus(domain.StatusResolved), domain.ErrOpenBlockers)
+	assert.Equal(t, domain.StatusInProgress, ticket.Status())
+	require.NoError(t, ticket.ChangeStatus(domain.StatusWaiting), "only resolving is blocked")
+
+	ticket.SetOpenBlockers(nil)
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+}
+
+func TestTicket_CloseWithParent(t *testing.T) {
+	child := createTestTicket(t)
+	parentID := uuid.New()
+
+	require.ErrorIs(t, child.CloseWithParent(parentID, nil), domain.ErrRelationNotFound)
+
+	require.NoError(t, child.AddRelation(domain.RelationChild, parentID))
+	require.NoError(t, child.CloseWithParent(parentID, nil))
+	assert.Equal(t, domain.StatusClosed, child.Status())
+	assert.NotNil(t, child.ClosedAt())
+}
@@ -0,0 +1,301 @@
+package tickets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidSearch = errors.New("invalid search query")
+
+const (
+	MaxSearchQueryLength = 200
+
+	// Веса совпадений при ранжировании результатов поиска
+	SearchWeightTitle       = 10
+	SearchWeightDescription = 5
+	SearchWeightComment     = 1
+
+	maxSearchHighlights = 5   // Ограничение количества фрагментов одной заявки
+	maxSnippetLength    = 160 // Длина фрагмента описания или комментария в байтах
+	snippetLeadLength   = 60  // Длина контекста перед первым совпадением
+	snippetEllipsis     = "…"
+	minSearchStemLength = 3
+)
+
+// SearchField представляет поле заявки, в котором найдено совпадение
+type SearchField string
+
+const (
+	SearchFieldTitle       SearchField = "title"
+	SearchFieldDescription SearchField = "description"
+	SearchFieldComment     SearchField = "comment"
+)
+
+// SearchQuery представляет разобранный поисковый запрос.
+// Синтаксис совпадает с текстовым поиском MongoDB: слова, "фразы" в кавычках и -исключенные слова.
+// Заявка подходит, если содержит все фразы (или хотя бы одно слово, если фраз нет) и ни одного исключенного слова.
+type SearchQuery struct {
+	text     string
+	terms    []string // Основы искомых слов, включая слова фраз
+	phrases  []string // Фразы в нижнем регистре
+	excluded []string // Основы исключенных слов
+}
+
+// SearchMatch представляет совпадение во фрагменте как диапазон байтов [Start, End)
+type SearchMatch struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// SearchHighlight представляет фрагмент поля заявки с отмеченными совпадениями
+type SearchHighlight struct {
+	Field     SearchField   `json:"field"`
+	CommentID *uuid.UUID    `json:"comment_id,omitempty"` // Для совпадений в комментариях
+	Snippet   string        `json:"snippet"`
+	Matches   []SearchMatch `json:"matches"`
+}
+
+// ParseSearchQuery разбирает поисковый запрос
+func ParseSearchQuery(text string) (SearchQuery, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || len(text) > MaxSearchQueryLength {
+		return SearchQuery{}, fmt.Errorf("%w: query must be 1-%d characters long", ErrInvalidSearch,
+			MaxSearchQueryLength)
+	}
+
+	query := SearchQuery{text: text}
+	for i, part := range strings.Split(text, `"`) {
+		if i%2 == 1 {
+			if phrase := strings.ToLower(strings.TrimSpace(part)); phrase != "" {
+				query.phrases = append(query.phrases, phrase)
+				query.terms = appendSearchStems(query.terms, phrase)
+			}
+			continue
+		}
+		for _, token := range strings.Fields(part) {
+			if excluded, ok := strings.CutPrefix(token, "-"); ok {
+				query.excluded = appendSearchStems(query.excluded, excluded)
+			} else {
+				query.terms = appendSearchStems(query.terms, token)
+			}
+		}
+	}
+
+	if len(query.terms) == 0 {
+		return SearchQuery{}, fmt.Errorf("%w: query must contain a word to search for", ErrInvalidSearch)
+	}
+	return query, nil
+}
+
+// String возвращает исходный текст запроса
+func (q SearchQuery) String() string {
+	return q.text
+}
+
+// MarshalJSON сохраняет запрос как исходный текст
+func (q SearchQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.text)
+}
+
+// UnmarshalJSON разбирает запрос из исходного текста
+func (q *SearchQuery) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	query, err := ParseSearchQuery(text)
+	if err != nil {
+		return err
+	}
+	*q = query
+	return nil
+}
+
+// SearchScore оценивает релевантность заявки запросу с учетом весов полей; 0 - заявка не подходит.
+// Внутренние комментарии учитываются только при includeInternal.
+func (t *Ticket) SearchScore(query SearchQuery, includeInternal bool) float64 {
+	fields := t.searchFields(includeInternal)
+	text := make([]string, 0, len(fields))
+	for _, field := range fields {
+		text = append(text, strings.ToLower(field.text))
+	}
+	combined := strings.Join(text, "\n")
+	for _, phrase := range query.phrases {
+		if !strings.Contains(combined, phrase) {
+			return 0
+		}
+	}
+
+	score := 0.0
+	for _, field := range fields {
+		for _, word := range searchWords(field.text) {
+			stem := searchStem(field.text[word.Start:word.End])
+			if slices.Contains(query.excluded, stem) {
+				return 0
+			}
+			if slices.Contains(query.terms, stem) {
+				score += field.weight
+			}
+		}
+	}
+	return score
+}
+
+// SearchHighlights возвращает фрагменты полей заявки с совпадениями запроса: заголовок целиком,
+// фрагменты описания и комментариев. Внутренние комментарии учитываются только при includeInternal.
+func (t *Ticket) SearchHighlights(query SearchQuery, includeInternal bool) []SearchHighlight {
+	var highlights []SearchHighlight
+	for _, field := range t.searchFields(includeInternal) {
+		var matches []SearchMatch
+		for _, word := range searchWords(field.text) {
+			if slices.Contains(query.terms, searchStem(field.text[word.Start:word.End])) {
+				matches = append(matches, word)
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		highlight := SearchHighlight{Field: field.field, CommentID: field.commentID}
+		if field.field == SearchFieldTitle {
+			highlight.Snippet, highlight.Matches = field.text, matches
+		} else {
+			highlight.Snippet, highlight.Matches = searchSnippet(field.text, matches)
+		}
+		highlights = append(highlights, highlight)
+		if len(highlights) == maxSearchHighlights {
+			break
+		}
+	}
+	return highlights
+}
+
+type searchField struct {
+	field     SearchField
+	commentID *uuid.UUID
+	text      string
+	weight    float64
+}
+
+func (t *Ticket) searchFields(includeInternal bool) []searchField {
+	fields := []searchField{
+		{field: SearchFieldTitle, text: t.title, weight: SearchWeightTitle},
+		{field: SearchFieldDescription, text: t.description, weight: SearchWeightDescription},
+	}
+	for _, comment := range t.comments {
+		if comment.IsInternal && !includeInternal {
+			continue
+		}
+		fields = append(fields, searchField{
+			field:     SearchFieldComment,
+			commentID: &comment.ID,
+			text:      comment.Content,
+			weight:    SearchWeightComment,
+		})
+	}
+	return fields
+}
+
+// searchSnippet вырезает из текста фрагмент вокруг первого совпадения по границам слов
+func searchSnippet(text string, matches []SearchMatch) (string, []SearchMatch) {
+	if len(text) <= maxSnippetLength {
+		return text, matches
+	}
+
+	start := max(0, matches[0].Start-snippetLeadLength)
+	if start > 0 {
+		if space := strings.IndexFunc(text[start:matches[0].Start], unicode.IsSpace); space >= 0 {
+			start += space + 1
+		}
+		for !utf8.RuneStart(text[start]) {
+			start++
+		}
+	}
+	end := min(len(text), max(start+maxSnippetLength, matches[0].End))
+	if end < len(text) {
+		if space := strings.LastIndexFunc(text[matches[0].End:end], unicode.IsSpace); space >= 0 {
+			end = matches[0].End + space
+		}
+		for end < len(text) && !utf8.RuneStart(text[end]) {
+			end--
+		}
+	}
+
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = snippetEllipsis
+	}
+	if end < len(text) {
+		suffix = snippetEllipsis
+	}
+	var snippetMatches []SearchMatch
+	for _, match := range matches {
+		if match.Start >= start && match.End <= end {
+			offset := len(prefix) - start
+			snippetMatches = append(snippetMatches, SearchMatch{Start: match.Start + offset, End: match.End + offset})
+		}
+	}
+	return prefix + text[start:end] + suffix, snippetMatches
+}
+
+// searchWords возвращает границы слов текста
+func searchWords(text string) []SearchMatch {
+	var words []SearchMatch
+	start := -1
+	for i, char := range text {
+		isWordChar := unicode.IsLetter(char) || unicode.IsDigit(char)
+		switch {
+		case isWordChar && start < 0:
+			start = i
+		case !isWordChar && start >= 0:
+			words = append(words, SearchMatch{Start: start, End: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, SearchMatch{Start: start, End: len(text)})
+	}
+	return words
+}
+
+func appendSearchStems(stems []string, text string) []string {
+	for _, word := range searchWords(text) {
+		stem := searchStem(text[word.Start:word.End])
+		if !isSearchStopWord(stem) && !slices.Contains(stems, stem) {
+			stems = append(stems, stem)
+		}
+	}
+	return stems
+}
+
+// searchStem приводит слово к упрощенной основе, чтобы "printers" находило "printer"
+func searchStem(word string) string {
+	word = strings.ToLower(word)
+	for _, suffix := range []string{"ing", "ed", "es", "s"} {
+		if stem, ok := strings.CutSuffix(word, suffix); ok && utf8.RuneCountInString(stem) >= minSearchStemLength {
+			word = stem
+			break
+		}
+	}
+	if stem, ok := strings.CutSuffix(word, "e"); ok && utf8.RuneCountInString(stem) >= minSearchStemLength {
+		word = stem
+	}
+	return word
+}
+
+// isSearchStopWord проверяет, является ли слово служебным, которое текстовый поиск не учитывает
+func isSearchStopWord(word string) bool {
+	switch word {
+	case "a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "in", "is", "it",
+		"of", "on", "or", "the", "to", "with":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package tickets_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func createSearchTestTicket(t *testing.T) *domain.Ticket {
+	ticket, err := domain.NewTicket(uuid.New(), "Printer on the third floor jams",
+		"The office printer jams on every second page since Monday", domain.PriorityNormal,
+		uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	require.NoError(t, ticket.AddComment(uuid.New(), "Replaced the toner cartridge", false))
+	require.NoError(t, ticket.AddComment(uuid.New(), "Vendor warranty expired, check the invoice", true))
+	return ticket
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	query, err := domain.ParseSearchQuery(`  printer "second page" -toner `)
+	require.NoError(t, err)
+	assert.Equal(t, `printer "second page" -toner`, query.String())
+
+	invalid := []string{"", "   ", `""`, "-printer", "the of", strings.Repeat("a", domain.MaxSearchQueryLength+1)}
+	for _, text := range invalid {
+		_, err = domain.ParseSearchQuery(text)
+		require.ErrorIs(t, err, domain.ErrInvalidSearch, "%q", text)
+	}
+}
+
+func TestSearchQuery_JSON(t *testing.T) {
+	query, err := domain.ParseSearchQuery(`printer "second page"`)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(query)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"printer \"second page\""`, string(data))
+
+	var restored domain.SearchQuery
+	require.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, query, restored)
+
+	require.ErrorIs(t, json.Unmarshal([]byte(`"-printer"`), &restored), domain.ErrInvalidSearch)
+}
+
+func TestTicket_SearchScore(t *testing.T) {
+	ticket := createSearchTestTicket(t)
+
+	score := func(text string, includeInternal bool) float64 {
+		query, err := domain.ParseSearchQuery(text)
+		require.NoError(t, err)
+		return ticket.SearchScore(query, includeInternal)
+	}
+
+	t.Run("words match case-insensitively by their stems", func(t *testing.T) {
+		assert.Positive(t, score("PRINTERS", false))
+		assert.Positive(t, score("jamming printer", false))
+		assert.Zero(t, score("scanner", false))
+	})
+
+	t.Run("title matches outweigh comment matches", func(t *testing.T) {
+		assert.Greater(t, score("printer", false), score("toner", false))
+	})
+
+	t.Run("phrases are required and excluded words reject the ticket", func(t *testing.T) {
+		assert.Positive(t, score(`printer "second page"`, false))
+		assert.Zero(t, score(`printer "third page"`, false))
+		assert.Zero(t, score("printer -toner", false))
+	})
+
+	t.Run("internal comments are searched only on request", func(t *testing.T) {
+		assert.Zero(t, score("warranty", false))
+		assert.Positive(t, score("warranty", true))
+	})
+}
+
+func TestTicket_SearchHighlights(t *testing.T) {
+	ticket := createSearchTestTicket(t)
+	query, err := domain.ParseSearchQuery("printer warranty")
+	require.NoError(t, err)
+
+	highlights := ticket.SearchHighlights(query, false)
+	require.Len(t, highlights, 2)
+	assert.Equal(t, domain.SearchFieldTitle, highlights[0].Field)
+	assert.Equal(t, "Printer on the third floor jams", highlights[0].Snippet)
+	assert.Equal(t, []domain.SearchMatch{{Start: 0, End: 7}}, highlights[0].Matches)
+	assert.Equal(t, domain.SearchFieldDescription, highlights[1].Field)
+
+	highlights = ticket.SearchHighlights(query, true)
+	require.Len(t, highlights, 3)
+	comment := highlights[2]
+	assert.Equal(t, domain.SearchFieldComment, comment.Field)
+	require.NotNil(t, comment.CommentID)
+	assert.Equal(t, ticket.Comments()[1].ID, *comment.CommentID)
+	assert.Equal(t, "warranty", comment.Snippet[comment.Matches[0].Start:comment.Matches[0].End])
+}
+
+func TestTicket_SearchHighlightsSnippet(t *testing.T) {
+	description := strings.Repeat("Нет связи с сервером. ", 10) + "Принтер в бухгалтерии не печатает. " +
+		strings.Repeat("Перезагрузка не помогла. ", 10)
+	ticket, err := domain.NewTicket(uuid.New(), "Проблема", description, domain.PriorityNormal,
+		uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	query, err := domain.ParseSearchQuery("принтер")
+	require.NoError(t, err)
+
+	highlights := ticket.SearchHighlights(query, false)
+	require.Len(t, highlights, 1)
+	snippet := highlights[0].Snippet
+	assert.Less(t, len(snippet), len(description))
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+	require.Len(t, highlights[0].Matches, 1)
+	match := highlights[0].Matches[0]
+	assert.Equal(t, "Принтер", snippet[match.Start:match.End])
+}
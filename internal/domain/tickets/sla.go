@@ -0,0 +1,376 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSLAPolicy = errors.New("invalid sla policy")
+)
+
+const (
+	MaxSLAPolicies       = 100
+	MaxSLAPolicyName     = 100
+	policyMatchCategory  = 2
+	policyMatchPriority  = 1
+	policyNoMatchPenalty = -1
+)
+
+// SLAPolicy описывает целевые сроки реакции и решения для заявок организации
+type SLAPolicy struct {
+	ID             uuid.UUID     `json:"id"`
+	Name           string        `json:"name"`
+	CategoryID     *uuid.UUID    `json:"category_id,omitempty"` // nil - любая категория
+	Priority       Priority      `json:"priority,omitempty"`    // Пустой приоритет - любой приоритет
+	FirstResponse  time.Duration `json:"first_response"`
+	Resolution     time.Duration `json:"resolution"`
+	AroundTheClock bool          `json:"around_the_clock"` // Сроки считаются круглосуточно, без рабочего календаря
+}
+
+// SLAConfig представляет настройки SLA организации: рабочий календарь, набор политик и правила эскалации
+type SLAConfig struct {
+	calendar    *BusinessCalendar // nil - сроки считаются круглосуточно
+	policies    []SLAPolicy
+	escalations []EscalationRule
+}
+
+// NewSLAConfig создает настройки SLA с проверкой политик и правил эскалации
+func NewSLAConfig(
+	calendar *BusinessCalendar,
+	policies []SLAPolicy,
+	escalations []EscalationRule,
+) (*SLAConfig, error) {
+	if len(policies) > MaxSLAPolicies {
+		return nil, fmt.Errorf("%w: too many policies (max %d)", ErrInvalidSLAPolicy, MaxSLAPolicies)
+	}
+
+	normalized := make([]SLAPolicy, 0, len(policies))
+	for _, policy := range policies {
+		validated, err := validateSLAPolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, existing := range normalized {
+			if existing.ID == validated.ID {
+				return nil, fmt.Errorf("%w: duplicate policy id %s", ErrInvalidSLAPolicy, validated.ID)
+			}
+			if sameOptionalUUID(existing.CategoryID, validated.CategoryID) && existing.Priority == validated.Priority {
+				return nil, fmt.Errorf("%w: policies %q and %q have the same conditions",
+					ErrInvalidSLAPolicy, existing.Name, validated.Name)
+			}
+		}
+		normalized = append(normalized, validated)
+	}
+
+	rules, err := validateEscalationRules(escalations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SLAConfig{
+		calendar:    calendar,
+		policies:    normalized,
+		escalations: rules,
+	}, nil
+}
+
+// DefaultSLAConfig возвращает настройки SLA без политик: заявки используют сроки приоритетов по умолчанию
+func DefaultSLAConfig() *SLAConfig {
+	return &SLAConfig{}
+}
+
+// Calendar возвращает рабочий календарь организации; nil-настройки означают круглосуточный отсчет
+func (c *SLAConfig) Calendar() *BusinessCalendar {
+	if c == nil {
+		return nil
+	}
+	return c.calendar
+}
+
+// Policies возвращает политики SLA; у nil-настроек политик нет
+func (c *SLAConfig) Policies() []SLAPolicy {
+	if c == nil {
+		return nil
+	}
+	return slices.Clone(c.policies)
+}
+
+// Match выбирает наиболее точную политику для категории и приоритета заявки.
+// Совпадение по категории важнее совпадения по приоритету; false означает отсутствие подходящей политики.
+func (c *SLAConfig) Match(categoryID *uuid.UUID, priority Priority) (TicketSLA, bool) {
+	if c == nil {
+		return TicketSLA{}, false
+	}
+
+	bestScore := policyNoMatchPenalty
+	var best *SLAPolicy
+	for i := range c.policies {
+		score := c.policies[i].matchScore(categoryID, priority)
+		if score > bestScore {
+			bestScore = score
+			best = &c.policies[i]
+		}
+	}
+	if best == nil {
+		return TicketSLA{}, false
+	}
+
+	policyID := best.ID
+	sla := TicketSLA{
+		PolicyID:            &policyID,
+		PolicyName:          best.Name,
+		FirstResponseTarget: best.FirstResponse,
+		ResolutionTarget:    best.Resolution,
+	}
+	if !best.AroundTheClock {
+		sla.Calendar = c.calendar
+	}
+	return sla, true
+}
+
+// matchScore возвращает степень совпадения политики или policyNoMatchPenalty, если политика не подходит
+func (p SLAPolicy) matchScore(categoryID *uuid.UUID, priority Priority) int {
+	score := 0
+	if p.CategoryID != nil {
+		if !sameOptionalUUID(p.CategoryID, categoryID) {
+			return policyNoMatchPenalty
+		}
+		score += policyMatchCategory
+	}
+	if p.Priority != "" {
+		if p.Priority != priority {
+			return policyNoMatchPenalty
+		}
+		score += policyMatchPriority
+	}
+	return score
+}
+
+// TicketSLA представляет SLA, примененное к заявке в момент создания или изменения приоритета и категории
+type TicketSLA struct {
+	PolicyID            *uuid.UUID // nil - сроки приоритета по умолчанию
+	PolicyName          string
+	FirstResponseTarget time.Duration
+	ResolutionTarget    time.Duration
+	Calendar            *BusinessCalendar // nil - сроки считаются круглосуточно
+}
+
+// DefaultTicketSLA возвращает круглосуточное SLA по умолчанию для приоритета
+func DefaultTicketSLA(priority Priority) TicketSLA {
+	return TicketSLA{
+		FirstResponseTarget: time.Duration(priority.FirstResponseSLA()) * time.Hour,
+		ResolutionTarget:    time.Duration(priority.SLA()) * time.Hour,
+	}
+}
+
+// SLAPause описывает период ожидания клиента, в течение которого отсчет SLA приостановлен
+type SLAPause struct {
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"` // nil - ожидание продолжается
+}
+
+// SLATargetStatus описывает состояние одного целевого срока
+type SLATargetStatus struct {
+	Target      time.Duration
+	DueAt       *time.Time    // nil, пока отсчет приостановлен до наступления срока
+	Remaining   time.Duration // Отрицательное значение - срок нарушен
+	CompletedAt *time.Time    // Момент выполнения цели, nil - цель еще не выполнена
+	Breached    bool
+}
+
+// SLAStatus описывает вычисленное состояние SLA заявки
+type SLAStatus struct {
+	SLA           TicketSLA
+	Paused        bool
+	FirstResponse SLATargetStatus
+	Resolution    SLATargetStatus
+}
+
+// SLA возвращает примененное к заявке SLA или SLA приоритета по умолчанию
+func (t *Ticket) SLA() TicketSLA {
+	if t.sla == nil {
+		return DefaultTicketSLA(t.priority)
+	}
+	return *t.sla
+}
+
+// AppliedSLA возвращает явно примененное SLA или nil, если используются сроки по умолчанию
+func (t *Ticket) AppliedSLA() *TicketSLA { return t.sla }
+
+// RestoreSLA sets the applied SLA snapshot (for data restoration)
+func (t *Ticket) RestoreSLA(sla TicketSLA) {
+	t.sla = &sla
+}
+
+// ApplySLAConfig применяет к заявке подходящую политику SLA организации.
+// Без подходящей политики заявка использует сроки своего приоритета по умолчанию.
+func (t *Ticket) ApplySLAConfig(config *SLAConfig) {
+	sla, ok := config.Match(t.categoryID, t.priority)
+	if !ok {
+		t.sla = nil
+		return
+	}
+	t.sla = &sla
+}
+
+func (t *Ticket) SLAPauses() []SLAPause        { return slices.Clone(t.slaPauses) }
+func (t *Ticket) FirstRespondedAt() *time.Time { return t.firstRespondedAt }
+
+// RestoreSLAPauses sets SLA pause periods (for data restoration)
+func (t *Ticket) RestoreSLAPauses(pauses []SLAPause) { t.slaPauses = pauses }
+
+// SetFirstRespondedAt sets the first response time (for data restoration)
+func (t *Ticket) SetFirstRespondedAt(respondedAt *time.Time) { t.firstRespondedAt = respondedAt }
+
+// IsSLAPaused проверяет, приостановлен ли отсчет SLA ожиданием клиента
+func (t *Ticket) IsSLAPaused() bool {
+	if t.IsResolved() || len(t.slaPauses) == 0 {
+		return false
+	}
+	return t.slaPauses[len(t.slaPauses)-1].EndedAt == nil
+}
+
+// SLAStatus вычисляет сроки и оставшееся время SLA на указанный момент
+func (t *Ticket) SLAStatus(now time.Time) SLAStatus {
+	sla := t.SLA()
+
+	resolvedAt := t.resolutionCompletedAt()
+	respondedAt := t.firstRespondedAt
+	if respondedAt == nil {
+		// Решение заявки без публичного ответа считается и первым ответом
+		respondedAt = resolvedAt
+	}
+
+	return SLAStatus{
+		SLA:           sla,
+		Paused:        t.IsSLAPaused(),
+		FirstResponse: t.slaTargetStatus(sla.Calendar, sla.FirstResponseTarget, respondedAt, now),
+		Resolution:    t.slaTargetStatus(sla.Calendar, sla.ResolutionTarget, resolvedAt, now),
+	}
+}
+
+func (t *Ticket) resolutionCompletedAt() *time.Time {
+	if !t.IsResolved() {
+		return nil
+	}
+	if t.resolvedAt != nil {
+		return t.resolvedAt
+	}
+	if t.closedAt != nil {
+		return t.closedAt
+	}
+	updatedAt := t.updatedAt
+	return &updatedAt
+}
+
+func (t *Ticket) slaTargetStatus(
+	calendar *BusinessCalendar,
+	target time.Duration,
+	completedAt *time.Time,
+	now time.Time,
+) SLATargetStatus {
+	until := now
+	if completedAt != nil {
+		until = *completedAt
+	}
+
+	remaining := target - t.slaActiveTime(calendar, until)
+	return SLATargetStatus{
+		Target:      target,
+		DueAt:       t.slaDueAt(calendar, target),
+		Remaining:   remaining,
+		CompletedAt: completedAt,
+		Breached:    remaining < 0,
+	}
+}
+
+// slaActiveTime возвращает рабочее время с момента создания заявки без периодов ожидания
+func (t *Ticket) slaActiveTime(calendar *BusinessCalendar, until time.Time) time.Duration {
+	var total time.Duration
+	start := t.createdAt
+	for _, pause := range t.slaPauses {
+		if !pause.StartedAt.Before(until) {
+			break
+		}
+		total += calendar.Duration(start, pause.StartedAt)
+		if pause.EndedAt == nil {
+			return total
+		}
+		start = *pause.EndedAt
+	}
+	return total + calendar.Duration(start, until)
+}
+
+// slaDueAt возвращает момент наступления срока с учетом периодов ожидания
+func (t *Ticket) slaDueAt(calendar *BusinessCalendar, target time.Duration) *time.Time {
+	remaining := target
+	start := t.createdAt
+	for _, pause := range t.slaPauses {
+		active := calendar.Duration(start, pause.StartedAt)
+		if active >= remaining {
+			due := calendar.Add(start, remaining)
+			return &due
+		}
+		remaining -= active
+		if pause.EndedAt == nil {
+			return nil
+		}
+		start = *pause.EndedAt
+	}
+
+	due := calendar.Add(start, remaining)
+	return &due
+}
+
+// updateSLAPause приостанавливает или возобновляет отсчет SLA при входе в ожидание и выходе из него
+func (t *Ticket) updateSLAPause(oldCategory, newCategory Status, now time.Time) {
+	if newCategory == StatusWaiting && oldCategory != StatusWaiting {
+		t.slaPauses = append(t.slaPauses, SLAPause{StartedAt: now})
+		return
+	}
+	if oldCategory == StatusWaiting && newCategory != StatusWaiting && len(t.slaPauses) > 0 {
+		last := &t.slaPauses[len(t.slaPauses)-1]
+		if last.EndedAt == nil {
+			last.EndedAt = &now
+		}
+	}
+}
+
+func validateSLAPolicy(policy SLAPolicy) (SLAPolicy, error) {
+	policy.Name = strings.TrimSpace(policy.Name)
+	if policy.Name == "" {
+		return SLAPolicy{}, fmt.Errorf("%w: policy name is required", ErrInvalidSLAPolicy)
+	}
+	if len(policy.Name) > MaxSLAPolicyName {
+		return SLAPolicy{}, fmt.Errorf("%w: policy name too long (max %d characters)",
+			ErrInvalidSLAPolicy, MaxSLAPolicyName)
+	}
+	if policy.Priority != "" && !policy.Priority.IsValid() {
+		return SLAPolicy{}, fmt.Errorf(formatError, ErrInvalidPriority, policy.Priority)
+	}
+	if policy.FirstResponse <= 0 || policy.Resolution <= 0 {
+		return SLAPolicy{}, fmt.Errorf("%w: policy %q targets must be positive", ErrInvalidSLAPolicy, policy.Name)
+	}
+	if policy.FirstResponse > policy.Resolution {
+		return SLAPolicy{}, fmt.Errorf("%w: policy %q first response target exceeds resolution target",
+			ErrInvalidSLAPolicy, policy.Name)
+	}
+	if policy.ID == uuid.Nil {
+		policy.ID = uuid.New()
+	}
+	return policy, nil
+}
+
+func sameOptionalUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
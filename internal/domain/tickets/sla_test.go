@@ -0,0 +1,196 @@
+package tickets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestNewSLAConfig_Validation(t *testing.T) {
+	categoryID := uuid.New()
+
+	tests := []struct {
+		name     string
+		policies []domain.SLAPolicy
+	}{
+		{"empty name", []domain.SLAPolicy{{Name: " ", FirstResponse: time.Hour, Resolution: 2 * time.Hour}}},
+		{"invalid priority", []domain.SLAPolicy{
+			{Name: "Urgent", Priority: "urgent", FirstResponse: time.Hour, Resolution: 2 * time.Hour},
+		}},
+		{"non positive target", []domain.SLAPolicy{{Name: "Zero", FirstResponse: 0, Resolution: time.Hour}}},
+		{"response after resolution", []domain.SLAPolicy{
+			{Name: "Slow", FirstResponse: 3 * time.Hour, Resolution: 2 * time.Hour},
+		}},
+		{"duplicate conditions", []domain.SLAPolicy{
+			{Name: "A", CategoryID: &categoryID, FirstResponse: time.Hour, Resolution: 2 * time.Hour},
+			{Name: "B", CategoryID: &categoryID, FirstResponse: time.Hour, Resolution: 4 * time.Hour},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewSLAConfig(nil, tt.policies, nil)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("generates policy ids", func(t *testing.T) {
+		config, err := domain.NewSLAConfig(nil, []domain.SLAPolicy{
+			{Name: "Default", FirstResponse: time.Hour, Resolution: 8 * time.Hour},
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, config.Policies(), 1)
+		assert.NotEqual(t, uuid.Nil, config.Policies()[0].ID)
+	})
+}
+
+func TestSLAConfig_Match(t *testing.T) {
+	networkID := uuid.New()
+	calendar := newOfficeCalendar(t)
+
+	config, err := domain.NewSLAConfig(calendar, []domain.SLAPolicy{
+		{Name: "Any", FirstResponse: 4 * time.Hour, Resolution: 40 * time.Hour},
+		{Name: "Critical", Priority: domain.PriorityCritical, FirstResponse: 15 * time.Minute,
+			Resolution: 4 * time.Hour, AroundTheClock: true},
+		{Name: "Network", CategoryID: &networkID, FirstResponse: 2 * time.Hour, Resolution: 16 * time.Hour},
+		{Name: "Network critical", CategoryID: &networkID, Priority: domain.PriorityCritical,
+			FirstResponse: 10 * time.Minute, Resolution: 2 * time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		categoryID   *uuid.UUID
+		priority     domain.Priority
+		expected     string
+		withCalendar bool
+	}{
+		{"catch-all policy", nil, domain.PriorityLow, "Any", true},
+		{"priority policy around the clock", nil, domain.PriorityCritical, "Critical", false},
+		{"category beats priority", &networkID, domain.PriorityHigh, "Network", true},
+		{"category and priority", &networkID, domain.PriorityCritical, "Network critical", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sla, ok := config.Match(tt.categoryID, tt.priority)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, sla.PolicyName)
+			assert.NotNil(t, sla.PolicyID)
+			assert.Equal(t, tt.withCalendar, sla.Calendar != nil)
+		})
+	}
+
+	t.Run("no matching policy", func(t *testing.T) {
+		narrow, narrowErr := domain.NewSLAConfig(nil, []domain.SLAPolicy{
+			{Name: "Network", CategoryID: &networkID, FirstResponse: time.Hour, Resolution: 8 * time.Hour},
+		}, nil)
+		require.NoError(t, narrowErr)
+
+		_, ok := narrow.Match(nil, domain.PriorityNormal)
+		assert.False(t, ok)
+	})
+}
+
+func TestTicket_ApplySLAConfig(t *testing.T) {
+	ticket := createTestTicketWithPriority(t, domain.PriorityHigh)
+
+	config, err := domain.NewSLAConfig(nil, []domain.SLAPolicy{
+		{Name: "High", Priority: domain.PriorityHigh, FirstResponse: 30 * time.Minute, Resolution: 4 * time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	ticket.ApplySLAConfig(config)
+	require.NotNil(t, ticket.AppliedSLA())
+	assert.Equal(t, "High", ticket.SLA().PolicyName)
+	assert.Equal(t, 4, ticket.GetSLAHours())
+
+	// Without a matching policy the ticket falls back to the priority defaults
+	require.NoError(t, ticket.UpdatePriority(domain.PriorityLow))
+	ticket.ApplySLAConfig(config)
+	assert.Nil(t, ticket.AppliedSLA())
+	assert.Equal(t, domain.SLALowHours, ticket.GetSLAHours())
+}
+
+func TestTicket_SLAStatus_BusinessHours(t *testing.T) {
+	calendar := newOfficeCalendar(t)
+	ticket := createTestTicket(t)
+	ticket.SetCreatedAt(moscowTime(t, "2026-10-16 16:00:00")) // пятница
+	ticket.RestoreSLA(domain.TicketSLA{
+		FirstResponseTarget: time.Hour,
+		ResolutionTarget:    4 * time.Hour,
+		Calendar:            calendar,
+	})
+
+	status := ticket.SLAStatus(moscowTime(t, "2026-10-17 12:00:00")) // суббота
+
+	require.NotNil(t, status.FirstResponse.DueAt)
+	assert.True(t, moscowTime(t, "2026-10-16 17:00:00").Equal(*status.FirstResponse.DueAt))
+	assert.True(t, status.FirstResponse.Breached)
+	assert.Equal(t, -time.Hour, status.FirstResponse.Remaining)
+
+	require.NotNil(t, status.Resolution.DueAt)
+	assert.True(t, moscowTime(t, "2026-10-19 11:00:00").Equal(*status.Resolution.DueAt))
+	assert.False(t, status.Resolution.Breached)
+	assert.Equal(t, 2*time.Hour, status.Resolution.Remaining)
+}
+
+func TestTicket_SLAStatus_WaitingPausesClock(t *testing.T) {
+	ticket := createTestTicketWithPriority(t, domain.PriorityHigh) // 8 часов на решение
+	ticket.SetCreatedAt(time.Now().Add(-2 * time.Hour))
+
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusWaiting))
+	require.True(t, ticket.IsSLAPaused())
+	require.Len(t, ticket.SLAPauses(), 1)
+
+	status := ticket.SLAStatus(time.Now())
+	assert.True(t, status.Paused)
+	assert.Nil(t, status.Resolution.DueAt, "due date is unknown while the clock is paused")
+
+	// Ожидание длилось 4 часа из 10: активное время 6 часов, SLA не нарушено
+	createdAt := time.Now().Add(-10 * time.Hour)
+	ticket.SetCreatedAt(createdAt)
+	pauseStart := createdAt.Add(2 * time.Hour)
+	pauseEnd := createdAt.Add(6 * time.Hour)
+	ticket.RestoreSLAPauses([]domain.SLAPause{{StartedAt: pauseStart, EndedAt: &pauseEnd}})
+	ticket.SetStatus(domain.StatusInProgress)
+
+	now := createdAt.Add(10 * time.Hour)
+	status = ticket.SLAStatus(now)
+	assert.False(t, status.Paused)
+	assert.Equal(t, 2*time.Hour, status.Resolution.Remaining)
+	require.NotNil(t, status.Resolution.DueAt)
+	assert.True(t, createdAt.Add(12*time.Hour).Equal(*status.Resolution.DueAt))
+	assert.False(t, ticket.IsOverdue())
+
+	// Выход из ожидания закрывает открытый период
+	require.NoError(t, ticket.ChangeStatus(domain.StatusWaiting))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	pauses := ticket.SLAPauses()
+	require.Len(t, pauses, 2)
+	assert.NotNil(t, pauses[1].EndedAt)
+	assert.False(t, ticket.IsSLAPaused())
+}
+
+func TestTicket_SLAStatus_FirstResponse(t *testing.T) {
+	ticket := createTestTicket(t)
+
+	// Внутренние комментарии и ответы автора не считаются первым ответом
+	require.NoError(t, ticket.AddComment(uuid.New(), "Internal note", true))
+	require.NoError(t, ticket.AddComment(ticket.AuthorID(), "Any news?", false))
+	assert.Nil(t, ticket.FirstRespondedAt())
+
+	require.NoError(t, ticket.AddComment(uuid.New(), "We are on it", false))
+	require.NotNil(t, ticket.FirstRespondedAt())
+
+	status := ticket.SLAStatus(time.Now())
+	assert.Equal(t, ticket.FirstRespondedAt(), status.FirstResponse.CompletedAt)
+	assert.False(t, status.FirstResponse.Breached)
+	assert.Nil(t, status.Resolution.CompletedAt)
+}
@@ -48,8 +48,18 @@ func (s Status) CanTransitionTo(newStatus Status) bool {
 		return false
 	}
 
-	// Определяем допустимые переходы статусов согласно бизнес-логике
-	allowedTransitions := map[Status][]Status{
+	allowedStatuses, exists := defaultTransitions()[s]
+	if !exists {
+		return false
+	}
+
+	return slices.Contains(allowedStatuses, newStatus)
+}
+
+// defaultTransitions определяет допустимые переходы статусов согласно бизнес-логике
+// и служит основой рабочего процесса по умолчанию
+func defaultTransitions() map[Status][]Status {
+	return map[Status][]Status{
 		StatusNew: {
 			StatusInProgress,
 			StatusWaiting,
@@ -73,13 +83,6 @@ func (s Status) CanTransitionTo(newStatus Status) bool {
 			StatusInProgress, // Переоткрытие закрытой заявки
 		},
 	}
-
-	allowedStatuses, exists := allowedTransitions[s]
-	if !exists {
-		return false
-	}
-
-	return slices.Contains(allowedStatuses, newStatus)
 }
 
 // ParseStatus преобразует строку в статус
@@ -0,0 +1,141 @@
+package tickets
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSurveyNotFound         = errors.New("satisfaction survey not found")
+	ErrSurveyAlreadySubmitted = errors.New("satisfaction survey already submitted")
+	ErrInvalidSurveyToken     = errors.New("invalid satisfaction survey token")
+	ErrInvalidRating          = errors.New("invalid satisfaction rating")
+)
+
+const (
+	MinRating       = 1
+	MaxRating       = 5
+	SatisfiedRating = 4 // Минимальная оценка довольного клиента
+)
+
+// Survey представляет опрос удовлетворенности автора заявки после ее решения
+type Survey struct {
+	Token       string     `json:"token,omitempty"`    // Одноразовый токен; очищается после ответа
+	AgentID     *uuid.UUID `json:"agent_id,omitempty"` // Исполнитель на момент решения заявки
+	RequestedAt time.Time  `json:"requested_at"`
+	Rating      int        `json:"rating,omitempty"` // Оценка от 1 до 5; 0 - ответа еще нет
+	Comment     string     `json:"comment,omitempty"`
+	RatedAt     *time.Time `json:"rated_at,omitempty"`
+}
+
+// IsSubmitted проверяет, ответил ли автор на опрос
+func (s Survey) IsSubmitted() bool {
+	return s.RatedAt != nil
+}
+
+// Survey возвращает опрос удовлетворенности; nil - заявка еще не решалась
+func (t *Ticket) Survey() *Survey {
+	if t.survey == nil {
+		return nil
+	}
+	survey := *t.survey
+	return &survey
+}
+
+// RestoreSurvey sets the satisfaction survey (for data restoration)
+func (t *Ticket) RestoreSurvey(survey *Survey) { t.survey = survey }
+
+// requestSurvey выдает автору новый токен опроса при решении заявки.
+// Заявка оценивается один раз: после ответа повторное решение опрос не создает.
+func (t *Ticket) requestSurvey(now time.Time) {
+	if t.survey != nil && t.survey.IsSubmitted() {
+		return
+	}
+
+	var agentID *uuid.UUID
+	if t.assigneeID != nil {
+		assignee := *t.assigneeID
+		agentID = &assignee
+	}
+	t.survey = &Survey{
+		Token:       rand.Text(),
+		AgentID:     agentID,
+		RequestedAt: now,
+	}
+}
+
+// SubmitSurvey сохраняет оценку и комментарий автора; токен опроса можно использовать только один раз
+func (t *Ticket) SubmitSurvey(token string, rating int, comment string) error {
+	if t.survey == nil {
+		return ErrSurveyNotFound
+	}
+	if t.survey.IsSubmitted() {
+		return ErrSurveyAlreadySubmitted
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(t.survey.Token)) != 1 {
+		return ErrInvalidSurveyToken
+	}
+	if rating < MinRating || rating > MaxRating {
+		return fmt.Errorf("%w: rating must be between %d and %d", ErrInvalidRating, MinRating, MaxRating)
+	}
+	comment = strings.TrimSpace(comment)
+	if len(comment) > MaxCommentLength {
+		return fmt.Errorf("%w: comment too long (max %d characters)", ErrTicketValidation, MaxCommentLength)
+	}
+
+	now := time.Now()
+	t.survey.Token = ""
+	t.survey.Rating = rating
+	t.survey.Comment = comment
+	t.survey.RatedAt = &now
+	t.recordEvent(EventSurveySubmitted, "", strconv.Itoa(rating))
+	t.updatedAt = now
+	return nil
+}
+
+// SatisfactionStats содержит сводку оценок удовлетворенности группы заявок
+type SatisfactionStats struct {
+	GroupID   *uuid.UUID // Исполнитель, категория или организация; nil - заявки без исполнителя или категории
+	Responses int64      // Количество оценок
+	RatingSum int64      // Сумма оценок
+	Satisfied int64      // Количество оценок не ниже SatisfiedRating
+}
+
+// Add учитывает в сводке еще одну оценку
+func (s *SatisfactionStats) Add(rating int) {
+	s.Responses++
+	s.RatingSum += int64(rating)
+	if rating >= SatisfiedRating {
+		s.Satisfied++
+	}
+}
+
+// Merge добавляет к сводке оценки другой группы
+func (s *SatisfactionStats) Merge(other SatisfactionStats) {
+	s.Responses += other.Responses
+	s.RatingSum += other.RatingSum
+	s.Satisfied += other.Satisfied
+}
+
+// AverageRating возвращает среднюю оценку; 0 - оценок нет
+func (s SatisfactionStats) AverageRating() float64 {
+	if s.Responses == 0 {
+		return 0
+	}
+	return float64(s.RatingSum) / float64(s.Responses)
+}
+
+// Score возвращает CSAT - долю довольных клиентов в процентах; 0 - оценок нет
+func (s SatisfactionStats) Score() float64 {
+	if s.Responses == 0 {
+		return 0
+	}
+	return float64(s.Satisfied) * 100 / float64(s.Responses)
+}
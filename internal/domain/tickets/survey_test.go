@@ -0,0 +1,88 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func resolveTestTicket(t *testing.T, ticket *domain.Ticket) {
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+}
+
+func TestTicket_SurveyRequestedOnResolve(t *testing.T) {
+	ticket := createTestTicket(t)
+	agentID := uuid.New()
+	require.NoError(t, ticket.AssignTo(agentID))
+
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	assert.Nil(t, ticket.Survey(), "open tickets have no survey")
+
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+	survey := ticket.Survey()
+	require.NotNil(t, survey)
+	assert.NotEmpty(t, survey.Token)
+	assert.Equal(t, &agentID, survey.AgentID)
+	assert.False(t, survey.IsSubmitted())
+
+	// Reopening and resolving again replaces the unused token
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+	assert.NotEqual(t, survey.Token, ticket.Survey().Token)
+}
+
+func TestTicket_SubmitSurvey(t *testing.T) {
+	ticket := createTestTicket(t)
+	require.ErrorIs(t, ticket.SubmitSurvey("token", 5, ""), domain.ErrSurveyNotFound)
+
+	resolveTestTicket(t, ticket)
+	token := ticket.Survey().Token
+
+	require.ErrorIs(t, ticket.SubmitSurvey("wrong", 5, ""), domain.ErrInvalidSurveyToken)
+	require.ErrorIs(t, ticket.SubmitSurvey(token, 0, ""), domain.ErrInvalidRating)
+	require.ErrorIs(t, ticket.SubmitSurvey(token, 6, ""), domain.ErrInvalidRating)
+
+	require.NoError(t, ticket.SubmitSurvey(token, 4, "  Quick and helpful  "))
+	survey := ticket.Survey()
+	assert.True(t, survey.IsSubmitted())
+	assert.Equal(t, 4, survey.Rating)
+	assert.Equal(t, "Quick and helpful", survey.Comment)
+	assert.Empty(t, survey.Token, "the token is used up")
+
+	require.ErrorIs(t, ticket.SubmitSurvey(token, 1, ""), domain.ErrSurveyAlreadySubmitted)
+
+	events := ticket.PendingEvents()
+	require.NotEmpty(t, events)
+	last := events[len(events)-1]
+	assert.Equal(t, domain.EventSurveySubmitted, last.Type)
+	assert.Equal(t, "4", last.NewValue)
+
+	// A rated ticket is not surveyed again after it is reopened and resolved
+	require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+	assert.Equal(t, 4, ticket.Survey().Rating)
+}
+
+func TestSatisfactionStats(t *testing.T) {
+	var stats domain.SatisfactionStats
+	assert.Zero(t, stats.AverageRating())
+	assert.Zero(t, stats.Score())
+
+	for _, rating := range []int{5, 4, 2, 1} {
+		stats.Add(rating)
+	}
+	assert.Equal(t, int64(4), stats.Responses)
+	assert.Equal(t, int64(2), stats.Satisfied)
+	assert.InDelta(t, 3.0, stats.AverageRating(), 0.001)
+	assert.InDelta(t, 50.0, stats.Score(), 0.001)
+
+	other := domain.SatisfactionStats{Responses: 1, RatingSum: 5, Satisfied: 1}
+	stats.Merge(other)
+	assert.Equal(t, int64(5), stats.Responses)
+	assert.InDelta(t, 60.0, stats.Score(), 0.001)
+}
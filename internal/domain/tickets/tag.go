@@ -0,0 +1,127 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTag  = errors.New("invalid tag")
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag already exists")
+)
+
+const (
+	MaxTagNameLength        = 50
+	MaxTagDescriptionLength = 500
+	MaxTicketTags           = 20 // Ограничение количества меток одной заявки
+	hexColorLength          = 7  // #RRGGBB
+)
+
+// Tag представляет определение метки организации.
+// Метки не образуют иерархию и дополняют категорию заявки (например, "vip" или "security").
+type Tag struct {
+	Name        string `json:"name"`  // Уникальное в организации имя в нижнем регистре
+	Color       string `json:"color"` // Цвет в формате #RRGGBB
+	Description string `json:"description,omitempty"`
+}
+
+// NewTag создает определение метки
+func NewTag(name, color, description string) (Tag, error) {
+	name, err := ParseTagName(name)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	color = strings.ToUpper(strings.TrimSpace(color))
+	if !isHexColor(color) {
+		return Tag{}, fmt.Errorf("%w: color must be in #RRGGBB format, got %q", ErrInvalidTag, color)
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > MaxTagDescriptionLength {
+		return Tag{}, fmt.Errorf("%w: description must be no more than %d characters long",
+			ErrInvalidTag, MaxTagDescriptionLength)
+	}
+
+	return Tag{Name: name, Color: color, Description: description}, nil
+}
+
+// ParseTagName приводит имя метки к нижнему регистру и проверяет его формат
+func ParseTagName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !isWellFormedTagName(name) {
+		return "", fmt.Errorf("%w: name must be 1-%d characters of a-z, 0-9, '-' or '_', got %q",
+			ErrInvalidTag, MaxTagNameLength, name)
+	}
+	return name, nil
+}
+
+func isWellFormedTagName(name string) bool {
+	if len(name) == 0 || len(name) > MaxTagNameLength || name[0] == '-' || name[0] == '_' {
+		return false
+	}
+	for _, char := range name {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && char != '-' && char != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexColor(color string) bool {
+	if len(color) != hexColorLength || color[0] != '#' {
+		return false
+	}
+	for _, char := range color[1:] {
+		if (char < '0' || char > '9') && (char < 'A' || char > 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Ticket) Tags() []string { return slices.Clone(t.tags) }
+
+// RestoreTags sets the ticket tags (for data restoration)
+func (t *Ticket) RestoreTags(tags []string) { t.tags = tags }
+
+// HasTag проверяет, отмечена ли заявка меткой
+func (t *Ticket) HasTag(name string) bool {
+	return slices.Contains(t.tags, name)
+}
+
+// AddTag отмечает заявку меткой; повторное добавление ничего не меняет.
+// Наличие определения метки в организации проверяется вызывающей стороной.
+func (t *Ticket) AddTag(name string) error {
+	name, err := ParseTagName(name)
+	if err != nil {
+		return err
+	}
+	if t.HasTag(name) {
+		return nil
+	}
+	if len(t.tags) >= MaxTicketTags {
+		return fmt.Errorf("%w: too many tags (max %d)", ErrTicketValidation, MaxTicketTags)
+	}
+
+	t.tags = append(t.tags, name)
+	t.recordEvent(EventTagAdded, "", name)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveTag снимает метку с заявки; снятие отсутствующей метки ничего не меняет
+func (t *Ticket) RemoveTag(name string) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !t.HasTag(name) {
+		return
+	}
+
+	t.tags = slices.DeleteFunc(t.tags, func(tag string) bool { return tag == name })
+	t.recordEvent(EventTagRemoved, name, "")
+	t.updatedAt = time.Now()
+}
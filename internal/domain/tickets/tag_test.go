@@ -0,0 +1,66 @@
+package tickets_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestNewTag(t *testing.T) {
+	tag, err := domain.NewTag(" Hardware-Recall ", "#a1b2c3", " Affected by the 2026 recall ")
+	require.NoError(t, err)
+	assert.Equal(t, "hardware-recall", tag.Name)
+	assert.Equal(t, "#A1B2C3", tag.Color)
+	assert.Equal(t, "Affected by the 2026 recall", tag.Description)
+
+	invalid := []struct {
+		name, color, description string
+	}{
+		{"", "#FFFFFF", ""},
+		{"two words", "#FFFFFF", ""},
+		{"-leading", "#FFFFFF", ""},
+		{strings.Repeat("a", domain.MaxTagNameLength+1), "#FFFFFF", ""},
+		{"vip", "red", ""},
+		{"vip", "#FFF", ""},
+		{"vip", "#FFFFFF", strings.Repeat("a", domain.MaxTagDescriptionLength+1)},
+	}
+	for _, tc := range invalid {
+		_, err = domain.NewTag(tc.name, tc.color, tc.description)
+		require.ErrorIs(t, err, domain.ErrInvalidTag, "%q %q", tc.name, tc.color)
+	}
+}
+
+func TestTicket_Tags(t *testing.T) {
+	ticket := createTestTicket(t)
+	ticket.ActAs(uuid.New())
+
+	require.NoError(t, ticket.AddTag("VIP"))
+	require.NoError(t, ticket.AddTag("vip"), "adding a tag twice changes nothing")
+	require.NoError(t, ticket.AddTag("security"))
+	assert.Equal(t, []string{"vip", "security"}, ticket.Tags())
+	assert.True(t, ticket.HasTag("vip"))
+	require.ErrorIs(t, ticket.AddTag("not valid"), domain.ErrInvalidTag)
+
+	ticket.RemoveTag("VIP")
+	ticket.RemoveTag("vip")
+	assert.Equal(t, []string{"security"}, ticket.Tags())
+
+	var types []domain.EventType
+	for _, event := range ticket.PendingEvents() {
+		if event.Type == domain.EventTagAdded || event.Type == domain.EventTagRemoved {
+			types = append(types, event.Type)
+		}
+	}
+	assert.Equal(t, []domain.EventType{domain.EventTagAdded, domain.EventTagAdded, domain.EventTagRemoved}, types)
+
+	for i := len(ticket.Tags()); i < domain.MaxTicketTags; i++ {
+		require.NoError(t, ticket.AddTag(fmt.Sprintf("tag-%d", i)))
+	}
+	require.ErrorIs(t, ticket.AddTag("one-more"), domain.ErrTicketValidation)
+}
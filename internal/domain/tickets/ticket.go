@@ -6,15 +6,22 @@ import (
 	"strings"
 	"time"
 
+	"simpleservicedesk/internal/domain/users"
+
 	"github.com/google/uuid"
 )
 
 var (
 	ErrTicketNotFound     = errors.New("ticket not found")
+	ErrTicketExists       = errors.New("ticket already exists")
 	ErrInvalidTicket      = errors.New("invalid ticket")
 	ErrTicketValidation   = errors.New("ticket validation error")
 	ErrUnauthorizedAccess = errors.New("unauthorized access to ticket")
 	ErrInvalidTransition  = errors.New("invalid status transition")
+	ErrCommentNotFound    = errors.New("comment not found")
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrVersionConflict    = errors.New("ticket was modified by another request")
+	ErrConcurrentUpdate   = errors.New("ticket is being changed by concurrent requests, try again")
 )
 
 const (
@@ -27,31 +34,65 @@ const (
 
 // Ticket представляет заявку в системе
 type Ticket struct {
-	id             uuid.UUID
-	title          string
-	description    string
-	status         Status
-	priority       Priority
-	organizationID uuid.UUID
-	categoryID     *uuid.UUID // Может быть nil, если категория не указана
-	authorID       uuid.UUID  // ID создателя заявки
-	assigneeID     *uuid.UUID // ID исполнителя, может быть nil
-	comments       []Comment
-	attachments    []Attachment
-	createdAt      time.Time
-	updatedAt      time.Time
-	resolvedAt     *time.Time // Время решения заявки
-	closedAt       *time.Time // Время закрытия заявки
+	id                 uuid.UUID
+	key                string // Читаемый ключ вида ACME-1042; пусто, если организация не выдает ключи
+	title              string
+	description        string
+	status             Status
+	statusCategory     Status // Базовый статус для статусов рабочего процесса организации
+	priority           Priority
+	impact             Impact // Влияние, указанное при создании; пусто у заявок без матрицы приоритетов
+	urgency            Urgency
+	priorityOverridden bool // Приоритет задан агентом, а не вычислен по матрице
+	organizationID     uuid.UUID
+	categoryID         *uuid.UUID         // Может быть nil, если категория не указана
+	authorID           uuid.UUID          // ID создателя заявки
+	assigneeID         *uuid.UUID         // ID исполнителя, может быть nil
+	assignmentStrategy AssignmentStrategy // Стратегия автоматического назначения; пусто - назначено вручную
+	comments           []Comment
+	attachments        []Attachment
+	createdAt          time.Time
+	updatedAt          time.Time
+	resolvedAt         *time.Time   // Время решения заявки
+	closedAt           *time.Time   // Время закрытия заявки
+	sla                *TicketSLA   // Примененное SLA, nil - сроки приоритета по умолчанию
+	slaPauses          []SLAPause   // Периоды ожидания клиента, исключаемые из отсчета SLA
+	firstRespondedAt   *time.Time   // Время первого публичного комментария не от автора заявки
+	escalations        []Escalation // Сработавшие правила эскалации SLA
+	mergedIntoID       *uuid.UUID   // Заявка, в которую объединена эта заявка
+	splitFromID        *uuid.UUID   // Заявка, из которой выделена эта заявка
+	relations          []Relation   // Связи с другими заявками
+	watchers           []Watcher    // Пользователи, подписанные на заявку
+	tags               []string     // Метки организации, которыми отмечена заявка
+	customFields       CustomFields // Значения дополнительных полей категории
+	openBlockers       []uuid.UUID  // Незавершенные блокирующие заявки, известные при изменении статуса
+	actorID            *uuid.UUID   // Пользователь, выполняющий текущие изменения
+	events             []Event      // Несохраненные события истории
+	survey             *Survey      // Опрос удовлетворенности, создаваемый при решении заявки
+	workLogs           []WorkLog    // Записи о затраченном агентами времени
+	version            int64        // Номер сохраненной версии; 0 - заявка еще не сохранена
+	deletedAt          *time.Time   // Время перемещения в корзину; nil - заявка не удалена
+	deletedBy          *uuid.UUID   // Пользователь, удаливший заявку
 }
 
 // Comment представляет комментарий к заявке
 type Comment struct {
-	ID         uuid.UUID `json:"id"`
-	TicketID   uuid.UUID `json:"ticket_id"`
-	AuthorID   uuid.UUID `json:"author_id"`
-	Content    string    `json:"content"`
-	IsInternal bool      `json:"is_internal"` // Внутренний комментарий (не видим клиенту)
-	CreatedAt  time.Time `json:"created_at"`
+	ID         uuid.UUID         `json:"id"`
+	TicketID   uuid.UUID         `json:"ticket_id"`
+	AuthorID   uuid.UUID         `json:"author_id"`
+	Content    string            `json:"content"`
+	IsInternal bool              `json:"is_internal"`        // Внутренний комментарий (не видим клиенту)
+	Mentions   []uuid.UUID       `json:"mentions,omitempty"` // Упомянутые пользователи
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  *time.Time        `json:"updated_at,omitempty"` // Время последнего редактирования
+	Revisions  []CommentRevision `json:"revisions,omitempty"`  // Предыдущие версии, от старых к новым
+}
+
+// CommentRevision представляет предыдущую версию отредактированного комментария
+type CommentRevision struct {
+	Content  string    `json:"content"`
+	EditedBy uuid.UUID `json:"edited_by"`
+	EditedAt time.Time `json:"edited_at"`
 }
 
 // Attachment представляет вложение к заявке
@@ -61,7 +102,7 @@ type Attachment struct {
 	FileName   string    `json:"file_name"`
 	FileSize   int64     `json:"file_size"`
 	MimeType   string    `json:"mime_type"`
-	FilePath   string    `json:"file_path"` // Путь к файлу в хранилище
+	FilePath   string    `json:"file_path"` // Ключ файла в хранилище вложений
 	UploadedBy uuid.UUID `json:"uploaded_by"`
 	CreatedAt  time.Time `json:"created_at"`
 }
@@ -102,6 +143,7 @@ func NewTicket(
 		title:          title,
 		description:    description,
 		status:         StatusNew, // Новые заявки всегда имеют статус "new"
+		statusCategory: StatusNew,
 		priority:       priority,
 		organizationID: organizationID,
 		categoryID:     categoryID,
@@ -122,6 +164,7 @@ func (t *Ticket) ID() uuid.UUID             { return t.id }
 func (t *Ticket) Title() string             { return t.title }
 func (t *Ticket) Description() string       { return t.description }
 func (t *Ticket) Status() Status            { return t.status }
+func (t *Ticket) StatusCategory() Status    { return t.statusCategory }
 func (t *Ticket) Priority() Priority        { return t.priority }
 func (t *Ticket) OrganizationID() uuid.UUID { return t.organizationID }
 func (t *Ticket) CategoryID() *uuid.UUID    { return t.categoryID }
@@ -133,18 +176,40 @@ func (t *Ticket) CreatedAt() time.Time      { return t.createdAt }
 func (t *Ticket) UpdatedAt() time.Time      { return t.updatedAt }
 func (t *Ticket) ResolvedAt() *time.Time    { return t.resolvedAt }
 func (t *Ticket) ClosedAt() *time.Time      { return t.closedAt }
+func (t *Ticket) Version() int64            { return t.version }
+func (t *Ticket) DeletedAt() *time.Time     { return t.deletedAt }
+func (t *Ticket) DeletedBy() *uuid.UUID     { return t.deletedBy }
+func (t *Ticket) IsDeleted() bool           { return t.deletedAt != nil }
 
 func (t *Ticket) SetCreatedAt(createdAt time.Time)    { t.createdAt = createdAt }
 func (t *Ticket) SetResolvedAt(resolvedAt *time.Time) { t.resolvedAt = resolvedAt }
 func (t *Ticket) SetClosedAt(closedAt *time.Time)     { t.closedAt = closedAt }
 func (t *Ticket) SetUpdatedAt(updatedAt time.Time)    { t.updatedAt = updatedAt }
 
+// RestoreVersion устанавливает номер сохраненной версии (для восстановления данных и после записи)
+func (t *Ticket) RestoreVersion(version int64) { t.version = version }
+
+// MarkDeleted перемещает заявку в корзину, откуда ее можно восстановить до окончательного удаления
+func (t *Ticket) MarkDeleted(deletedBy uuid.UUID, deletedAt time.Time) {
+	t.deletedAt = &deletedAt
+	t.deletedBy = &deletedBy
+}
+
+// Undelete возвращает заявку из корзины
+func (t *Ticket) Undelete() {
+	t.deletedAt = nil
+	t.deletedBy = nil
+}
+
 // UpdateTitle обновляет заголовок заявки
 func (t *Ticket) UpdateTitle(title string) error {
 	validatedTitle, err := validateTitle(title)
 	if err != nil {
 		return err
 	}
+	if validatedTitle != t.title {
+		t.recordEvent(EventTitleChanged, t.title, validatedTitle)
+	}
 	t.title = validatedTitle
 	t.updatedAt = time.Now()
 	return nil
@@ -156,6 +221,9 @@ func (t *Ticket) UpdateDescription(description string) error {
 	if err != nil {
 		return err
 	}
+	if validatedDescription != t.description {
+		t.recordEvent(EventDescriptionChanged, t.description, validatedDescription)
+	}
 	t.description = validatedDescription
 	t.updatedAt = time.Now()
 	return nil
@@ -166,41 +234,77 @@ func (t *Ticket) UpdatePriority(priority Priority) error {
 	if !priority.IsValid() {
 		return fmt.Errorf(formatError, ErrInvalidPriority, priority)
 	}
+	if priority != t.priority {
+		t.recordEvent(EventPriorityChanged, t.priority.String(), priority.String())
+	}
 	t.priority = priority
 	t.updatedAt = time.Now()
 	return nil
 }
 
-// ChangeStatus изменяет статус заявки с проверкой валидности перехода
+// ChangeStatus изменяет статус заявки с проверкой валидности перехода по рабочему процессу по умолчанию
 func (t *Ticket) ChangeStatus(newStatus Status) error {
-	if !newStatus.IsValid() {
-		return fmt.Errorf(formatError, ErrInvalidStatus, newStatus)
+	return t.ChangeStatusInWorkflow(DefaultWorkflow(), newStatus, "")
+}
+
+// ChangeStatusInWorkflow изменяет статус заявки согласно рабочему процессу организации.
+// Пустая роль означает системное действие без проверки ролей перехода.
+func (t *Ticket) ChangeStatusInWorkflow(workflow *Workflow, newStatus Status, role users.Role) error {
+	if workflow == nil {
+		workflow = DefaultWorkflow()
 	}
 
-	if !t.status.CanTransitionTo(newStatus) {
-		return fmt.Errorf("%w: cannot transition from %s to %s",
-			ErrInvalidTransition, t.status, newStatus)
+	if err := workflow.CanTransition(t.status, newStatus, role); err != nil {
+		return err
+	}
+
+	newCategory, _ := workflow.CategoryOf(newStatus)
+	if newCategory == StatusResolved && len(t.openBlockers) > 0 {
+		return fmt.Errorf(formatError, ErrOpenBlockers, joinUUIDs(t.openBlockers))
+	}
+	t.applyStatus(newStatus, newCategory)
+	return nil
+}
+
+// forceClose закрывает заявку первым закрытым статусом рабочего процесса без проверки перехода
+func (t *Ticket) forceClose(workflow *Workflow) {
+	if workflow == nil {
+		workflow = DefaultWorkflow()
 	}
+	closed := StatusClosed
+	for _, status := range workflow.statuses {
+		if status.Category == StatusClosed {
+			closed = status.Key
+			break
+		}
+	}
+	t.applyStatus(closed, StatusClosed)
+}
 
-	oldStatus := t.status
+// applyStatus устанавливает статус без проверки перехода и обновляет время решения/закрытия
+func (t *Ticket) applyStatus(newStatus, newCategory Status) {
+	oldCategory := t.statusCategory
+	t.recordEvent(EventStatusChanged, t.status.String(), newStatus.String())
 	t.status = newStatus
+	t.statusCategory = newCategory
 	t.updatedAt = time.Now()
 
 	// Устанавливаем время решения/закрытия
 	now := time.Now()
-	if newStatus == StatusResolved && oldStatus != StatusResolved {
+	t.updateSLAPause(oldCategory, newCategory, now)
+	if newCategory == StatusResolved && oldCategory != StatusResolved {
 		t.resolvedAt = &now
+		t.requestSurvey(now)
 	}
-	if newStatus == StatusClosed && oldStatus != StatusClosed {
+	if newCategory == StatusClosed && oldCategory != StatusClosed {
 		t.closedAt = &now
 	}
-
-	return nil
 }
 
 // ResetToInitialStatus устанавливает начальный статус заявки
 func (t *Ticket) ResetToInitialStatus(status Status) {
 	t.status = status
+	t.statusCategory = status
 	t.resolvedAt = nil
 	t.closedAt = nil
 	t.updatedAt = time.Now()
@@ -208,7 +312,13 @@ func (t *Ticket) ResetToInitialStatus(status Status) {
 
 // SetStatus sets the status without resetting resolved/closed timestamps (for data restoration)
 func (t *Ticket) SetStatus(status Status) {
+	t.RestoreStatus(status, status)
+}
+
+// RestoreStatus sets a workflow status together with its base category (for data restoration)
+func (t *Ticket) RestoreStatus(status, category Status) {
 	t.status = status
+	t.statusCategory = category
 }
 
 // AssignTo назначает заявку исполнителю
@@ -216,36 +326,54 @@ func (t *Ticket) AssignTo(assigneeID uuid.UUID) error {
 	if err := validateUUID(assigneeID, "assignee_id"); err != nil {
 		return err
 	}
+	if t.assigneeID == nil || *t.assigneeID != assigneeID {
+		t.recordEvent(EventAssigned, optionalUUIDString(t.assigneeID), assigneeID.String())
+	}
 	t.assigneeID = &assigneeID
+	t.assignmentStrategy = ""
 	t.updatedAt = time.Now()
 	return nil
 }
 
 // Unassign снимает назначение с заявки
 func (t *Ticket) Unassign() {
+	if t.assigneeID != nil {
+		t.recordEvent(EventUnassigned, t.assigneeID.String(), "")
+	}
 	t.assigneeID = nil
+	t.assignmentStrategy = ""
 	t.updatedAt = time.Now()
 }
 
 // SetCategory устанавливает категорию заявки
 func (t *Ticket) SetCategory(categoryID *uuid.UUID) {
+	oldValue, newValue := optionalUUIDString(t.categoryID), optionalUUIDString(categoryID)
+	if oldValue != newValue {
+		t.recordEvent(EventCategoryChanged, oldValue, newValue)
+	}
 	t.categoryID = categoryID
 	t.updatedAt = time.Now()
 }
 
 // AddComment добавляет комментарий к заявке
 func (t *Ticket) AddComment(authorID uuid.UUID, content string, isInternal bool) error {
+	_, err := t.addComment(authorID, content, isInternal, nil)
+	return err
+}
+
+func (t *Ticket) addComment(
+	authorID uuid.UUID,
+	content string,
+	isInternal bool,
+	mentions []uuid.UUID,
+) (Comment, error) {
 	if err := validateUUID(authorID, "author_id"); err != nil {
-		return err
+		return Comment{}, err
 	}
 
-	content = strings.TrimSpace(content)
-	if len(content) == 0 {
-		return fmt.Errorf("%w: comment content cannot be empty", ErrTicketValidation)
-	}
-	if len(content) > MaxCommentLength {
-		return fmt.Errorf("%w: comment content too long (max %d characters)",
-			ErrTicketValidation, MaxCommentLength)
+	content, err := validateCommentContent(content)
+	if err != nil {
+		return Comment{}, err
 	}
 
 	comment := Comment{
@@ -254,12 +382,91 @@ func (t *Ticket) AddComment(authorID uuid.UUID, content string, isInternal bool)
 		AuthorID:   authorID,
 		Content:    content,
 		IsInternal: isInternal,
+		Mentions:   mentions,
 		CreatedAt:  time.Now(),
 	}
 
 	t.comments = append(t.comments, comment)
+	t.recordEventBy(&comment.AuthorID, EventCommentAdded, "", comment.ID.String(), isInternal)
+	// Системные комментарии не считаются ответом поддержки
+	if !isInternal && authorID != t.authorID && authorID != SystemAuthorID() && t.firstRespondedAt == nil {
+		respondedAt := comment.CreatedAt
+		t.firstRespondedAt = &respondedAt
+	}
 	t.updatedAt = time.Now()
-	return nil
+	return comment, nil
+}
+
+// Comment возвращает комментарий по идентификатору
+func (t *Ticket) Comment(commentID uuid.UUID) (Comment, error) {
+	for _, comment := range t.comments {
+		if comment.ID == commentID {
+			return comment, nil
+		}
+	}
+	return Comment{}, fmt.Errorf(formatError, ErrCommentNotFound, commentID)
+}
+
+// EditComment изменяет текст комментария, сохраняя предыдущую версию в истории правок
+func (t *Ticket) EditComment(commentID, editorID uuid.UUID, content string) error {
+	if err := validateUUID(editorID, "editor_id"); err != nil {
+		return err
+	}
+
+	content, err := validateCommentContent(content)
+	if err != nil {
+		return err
+	}
+
+	for i := range t.comments {
+		comment := &t.comments[i]
+		if comment.ID != commentID {
+			continue
+		}
+		if comment.Content == content {
+			return nil
+		}
+
+		now := time.Now()
+		comment.Revisions = append(comment.Revisions, CommentRevision{
+			Content:  comment.Content,
+			EditedBy: editorID,
+			EditedAt: now,
+		})
+		comment.Content = content
+		comment.UpdatedAt = &now
+		t.recordEventBy(&editorID, EventCommentEdited, "", commentID.String(), comment.IsInternal)
+		t.updatedAt = now
+		return nil
+	}
+
+	return fmt.Errorf(formatError, ErrCommentNotFound, commentID)
+}
+
+// DeleteComment удаляет комментарий из заявки
+func (t *Ticket) DeleteComment(commentID uuid.UUID) error {
+	for i, comment := range t.comments {
+		if comment.ID != commentID {
+			continue
+		}
+
+		t.comments = append(t.comments[:i], t.comments[i+1:]...)
+		t.recordEventBy(t.actorID, EventCommentDeleted, commentID.String(), "", comment.IsInternal)
+		t.updatedAt = time.Now()
+		return nil
+	}
+
+	return fmt.Errorf(formatError, ErrCommentNotFound, commentID)
+}
+
+// RestoreComments sets comments without generating new identities (for data restoration)
+func (t *Ticket) RestoreComments(comments []Comment) {
+	t.comments = comments
+}
+
+// RestoreAttachments sets attachments without generating new identities (for data restoration)
+func (t *Ticket) RestoreAttachments(attachments []Attachment) {
+	t.attachments = attachments
 }
 
 // AddAttachment добавляет вложение к заявке
@@ -289,10 +496,37 @@ func (t *Ticket) AddAttachment(fileName string, fileSize int64, mimeType, filePa
 	}
 
 	t.attachments = append(t.attachments, attachment)
+	t.recordEventBy(&attachment.UploadedBy, EventAttachmentAdded, "", attachment.FileName, false)
 	t.updatedAt = time.Now()
 	return nil
 }
 
+// Attachment возвращает вложение по идентификатору
+func (t *Ticket) Attachment(attachmentID uuid.UUID) (Attachment, error) {
+	for _, attachment := range t.attachments {
+		if attachment.ID == attachmentID {
+			return attachment, nil
+		}
+	}
+	return Attachment{}, fmt.Errorf(formatError, ErrAttachmentNotFound, attachmentID)
+}
+
+// RemoveAttachment удаляет вложение из заявки
+func (t *Ticket) RemoveAttachment(attachmentID uuid.UUID) error {
+	for i, attachment := range t.attachments {
+		if attachment.ID != attachmentID {
+			continue
+		}
+
+		t.attachments = append(t.attachments[:i], t.attachments[i+1:]...)
+		t.recordEvent(EventAttachmentDeleted, attachment.FileName, "")
+		t.updatedAt = time.Now()
+		return nil
+	}
+
+	return fmt.Errorf(formatError, ErrAttachmentNotFound, attachmentID)
+}
+
 // IsAssigned проверяет, назначена ли заявка
 func (t *Ticket) IsAssigned() bool {
 	return t.assigneeID != nil
@@ -300,28 +534,26 @@ func (t *Ticket) IsAssigned() bool {
 
 // IsResolved проверяет, решена ли заявка
 func (t *Ticket) IsResolved() bool {
-	return t.status == StatusResolved || t.status == StatusClosed
+	return t.statusCategory == StatusResolved || t.statusCategory == StatusClosed
 }
 
 // IsClosed проверяет, закрыта ли заявка
 func (t *Ticket) IsClosed() bool {
-	return t.status == StatusClosed
+	return t.statusCategory == StatusClosed
 }
 
-// GetSLAHours возвращает количество часов SLA для данного приоритета
+// GetSLAHours возвращает целевое время решения заявки в часах
 func (t *Ticket) GetSLAHours() int {
-	return t.priority.GetSLAHours()
+	return int(t.SLA().ResolutionTarget / time.Hour)
 }
 
-// IsOverdue проверяет, просрочена ли заявка
+// IsOverdue проверяет, нарушен ли срок решения заявки с учетом рабочего календаря и ожидания клиента
 func (t *Ticket) IsOverdue() bool {
 	if t.IsResolved() {
 		return false
 	}
 
-	slaHours := t.GetSLAHours()
-	deadline := t.createdAt.Add(time.Duration(slaHours) * time.Hour)
-	return time.Now().After(deadline)
+	return t.SLAStatus(time.Now()).Resolution.Breached
 }
 
 // GetPublicComments возвращает только публичные комментарии
@@ -358,6 +590,18 @@ func validateDescription(description string) (string, error) {
 	return description, nil
 }
 
+func validateCommentContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if len(content) == 0 {
+		return "", fmt.Errorf("%w: comment content cannot be empty", ErrTicketValidation)
+	}
+	if len(content) > MaxCommentLength {
+		return "", fmt.Errorf("%w: comment content too long (max %d characters)",
+			ErrTicketValidation, MaxCommentLength)
+	}
+	return content, nil
+}
+
 func validateUUID(id uuid.UUID, fieldName string) error {
 	if id == uuid.Nil {
 		return fmt.Errorf("%w: %s cannot be empty", ErrTicketValidation, fieldName)
@@ -364,6 +364,86 @@ func TestTicket_GetPublicComments(t *testing.T) {
 }
 
 // Helper functions for tests
+func TestTicket_EditComment(t *testing.T) {
+	ticket := createTestTicket(t)
+	editorID := uuid.New()
+	require.NoError(t, ticket.AddComment(ticket.AuthorID(), "Original", false))
+	commentID := ticket.Comments()[0].ID
+	ticket.ClearPendingEvents()
+
+	require.NoError(t, ticket.EditComment(commentID, editorID, "  Edited  "))
+
+	comment, err := ticket.Comment(commentID)
+	require.NoError(t, err)
+	assert.Equal(t, "Edited", comment.Content)
+	require.NotNil(t, comment.UpdatedAt)
+	require.Len(t, comment.Revisions, 1)
+	assert.Equal(t, "Original", comment.Revisions[0].Content)
+	assert.Equal(t, editorID, comment.Revisions[0].EditedBy)
+
+	events := ticket.PendingEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.EventCommentEdited, events[0].Type)
+	assert.Equal(t, editorID, *events[0].ActorID)
+
+	// Повторное сохранение того же текста не создает ревизию
+	require.NoError(t, ticket.EditComment(commentID, editorID, "Edited"))
+	comment, _ = ticket.Comment(commentID)
+	assert.Len(t, comment.Revisions, 1)
+
+	require.ErrorIs(t, ticket.EditComment(commentID, editorID, " "), domain.ErrTicketValidation)
+	require.ErrorIs(t, ticket.EditComment(uuid.New(), editorID, "Text"), domain.ErrCommentNotFound)
+}
+
+func TestTicket_DeleteComment(t *testing.T) {
+	ticket := createTestTicket(t)
+	agentID := uuid.New()
+	require.NoError(t, ticket.AddComment(agentID, "Internal", true))
+	require.NoError(t, ticket.AddComment(ticket.AuthorID(), "Public", false))
+	internalID := ticket.Comments()[0].ID
+	ticket.ClearPendingEvents()
+
+	ticket.ActAs(agentID)
+	require.NoError(t, ticket.DeleteComment(internalID))
+
+	comments := ticket.Comments()
+	require.Len(t, comments, 1)
+	assert.Equal(t, "Public", comments[0].Content)
+
+	events := ticket.PendingEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.EventCommentDeleted, events[0].Type)
+	assert.Equal(t, internalID.String(), events[0].OldValue)
+	assert.True(t, events[0].IsInternal)
+
+	_, err := ticket.Comment(internalID)
+	require.ErrorIs(t, err, domain.ErrCommentNotFound)
+	require.ErrorIs(t, ticket.DeleteComment(internalID), domain.ErrCommentNotFound)
+}
+
+func TestTicket_RemoveAttachment(t *testing.T) {
+	ticket := createTestTicket(t)
+	require.NoError(t, ticket.AddAttachment("report.pdf", 1024, "application/pdf", "tickets/a/b", ticket.AuthorID()))
+	attachmentID := ticket.Attachments()[0].ID
+	ticket.ClearPendingEvents()
+
+	attachment, err := ticket.Attachment(attachmentID)
+	require.NoError(t, err)
+	assert.Equal(t, "report.pdf", attachment.FileName)
+
+	require.NoError(t, ticket.RemoveAttachment(attachmentID))
+	assert.Empty(t, ticket.Attachments())
+
+	events := ticket.PendingEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.EventAttachmentDeleted, events[0].Type)
+	assert.Equal(t, "report.pdf", events[0].OldValue)
+
+	_, err = ticket.Attachment(attachmentID)
+	require.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+	require.ErrorIs(t, ticket.RemoveAttachment(attachmentID), domain.ErrAttachmentNotFound)
+}
+
 func createTestTicket(t *testing.T) *domain.Ticket {
 	return createTestTicketWithPriority(t, domain.PriorityNormal)
 }
@@ -0,0 +1,69 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWatcherExists   = errors.New("user already watches the ticket")
+	ErrWatcherNotFound = errors.New("user does not watch the ticket")
+)
+
+// MaxWatchers ограничивает количество наблюдателей одной заявки
+const MaxWatchers = 100
+
+// Watcher представляет пользователя, подписанного на заявку
+type Watcher struct {
+	UserID  uuid.UUID  `json:"user_id"`
+	AddedBy *uuid.UUID `json:"added_by,omitempty"` // nil - подписан системой
+	AddedAt time.Time  `json:"added_at"`
+}
+
+func (t *Ticket) Watchers() []Watcher { return slices.Clone(t.watchers) }
+
+// RestoreWatchers sets the ticket watchers (for data restoration)
+func (t *Ticket) RestoreWatchers(watchers []Watcher) { t.watchers = watchers }
+
+// IsWatcher проверяет, подписан ли пользователь на заявку
+func (t *Ticket) IsWatcher(userID uuid.UUID) bool {
+	return slices.ContainsFunc(t.watchers, func(w Watcher) bool { return w.UserID == userID })
+}
+
+// AddWatcher подписывает пользователя на заявку
+func (t *Ticket) AddWatcher(userID uuid.UUID) error {
+	if err := validateUUID(userID, "user_id"); err != nil {
+		return err
+	}
+	if t.IsWatcher(userID) {
+		return fmt.Errorf(formatError, ErrWatcherExists, userID)
+	}
+	if len(t.watchers) >= MaxWatchers {
+		return fmt.Errorf("%w: too many watchers (max %d)", ErrTicketValidation, MaxWatchers)
+	}
+
+	t.watchers = append(t.watchers, Watcher{
+		UserID:  userID,
+		AddedBy: t.actorID,
+		AddedAt: time.Now(),
+	})
+	t.recordEvent(EventWatcherAdded, "", userID.String())
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveWatcher отписывает пользователя от заявки
+func (t *Ticket) RemoveWatcher(userID uuid.UUID) error {
+	if !t.IsWatcher(userID) {
+		return fmt.Errorf(formatError, ErrWatcherNotFound, userID)
+	}
+
+	t.watchers = slices.DeleteFunc(t.watchers, func(w Watcher) bool { return w.UserID == userID })
+	t.recordEvent(EventWatcherRemoved, userID.String(), "")
+	t.updatedAt = time.Now()
+	return nil
+}
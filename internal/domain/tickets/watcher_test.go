@@ -0,0 +1,46 @@
+package tickets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestTicket_Watchers(t *testing.T) {
+	ticket := createTestTicket(t)
+	actorID := uuid.New()
+	watcherID := uuid.New()
+	ticket.ActAs(actorID)
+
+	assert.False(t, ticket.IsWatcher(watcherID))
+	require.NoError(t, ticket.AddWatcher(watcherID))
+	assert.True(t, ticket.IsWatcher(watcherID))
+	require.Len(t, ticket.Watchers(), 1)
+	assert.Equal(t, &actorID, ticket.Watchers()[0].AddedBy)
+
+	require.ErrorIs(t, ticket.AddWatcher(watcherID), domain.ErrWatcherExists)
+	require.ErrorIs(t, ticket.AddWatcher(uuid.Nil), domain.ErrTicketValidation)
+
+	require.NoError(t, ticket.RemoveWatcher(watcherID))
+	assert.False(t, ticket.IsWatcher(watcherID))
+	require.ErrorIs(t, ticket.RemoveWatcher(watcherID), domain.ErrWatcherNotFound)
+
+	var types []domain.EventType
+	for _, event := range ticket.PendingEvents() {
+		types = append(types, event.Type)
+	}
+	assert.Contains(t, types, domain.EventWatcherAdded)
+	assert.Contains(t, types, domain.EventWatcherRemoved)
+}
+
+func TestTicket_MaxWatchers(t *testing.T) {
+	ticket := createTestTicket(t)
+	for range domain.MaxWatchers {
+		require.NoError(t, ticket.AddWatcher(uuid.New()))
+	}
+	require.ErrorIs(t, ticket.AddWatcher(uuid.New()), domain.ErrTicketValidation)
+}
@@ -0,0 +1,246 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"simpleservicedesk/internal/domain/users"
+)
+
+var (
+	ErrInvalidWorkflow      = errors.New("invalid ticket workflow")
+	ErrTransitionNotAllowed = errors.New("status transition not allowed for role")
+)
+
+const (
+	MaxWorkflowStatuses   = 30
+	MaxWorkflowStatusName = 100
+	maxWorkflowStatusKey  = 50
+)
+
+// WorkflowStatus описывает статус рабочего процесса организации
+type WorkflowStatus struct {
+	Key      Status `json:"key"`
+	Name     string `json:"name"`
+	Category Status `json:"category"` // Базовый статус, определяющий поведение (открыта, решена, закрыта)
+}
+
+// WorkflowTransition описывает допустимый переход между статусами
+type WorkflowTransition struct {
+	From         Status       `json:"from"`
+	To           Status       `json:"to"`
+	AllowedRoles []users.Role `json:"allowed_roles,omitempty"` // Пустой список - переход доступен всем ролям
+}
+
+// Workflow представляет рабочий процесс заявок организации
+type Workflow struct {
+	statuses    []WorkflowStatus
+	transitions []WorkflowTransition
+}
+
+// NewWorkflow создает рабочий процесс с проверкой статусов и переходов
+func NewWorkflow(statuses []WorkflowStatus, transitions []WorkflowTransition) (*Workflow, error) {
+	normalized, err := validateWorkflowStatuses(statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateWorkflowTransitions(normalized, transitions); err != nil {
+		return nil, err
+	}
+
+	return &Workflow{
+		statuses:    normalized,
+		transitions: slices.Clone(transitions),
+	}, nil
+}
+
+// DefaultWorkflow возвращает рабочий процесс по умолчанию, совпадающий с CanTransitionTo
+func DefaultWorkflow() *Workflow {
+	statuses := make([]WorkflowStatus, 0, len(AllStatuses()))
+	for _, status := range AllStatuses() {
+		statuses = append(statuses, WorkflowStatus{
+			Key:      status,
+			Name:     status.DisplayName(),
+			Category: status,
+		})
+	}
+
+	var transitions []WorkflowTransition
+	for _, from := range AllStatuses() {
+		for _, to := range defaultTransitions()[from] {
+			transitions = append(transitions, WorkflowTransition{From: from, To: to})
+		}
+	}
+
+	return &Workflow{
+		statuses:    statuses,
+		transitions: transitions,
+	}
+}
+
+func (w *Workflow) Statuses() []WorkflowStatus        { return slices.Clone(w.statuses) }
+func (w *Workflow) Transitions() []WorkflowTransition { return slices.Clone(w.transitions) }
+
+// HasStatus проверяет, объявлен ли статус в рабочем процессе
+func (w *Workflow) HasStatus(status Status) bool {
+	_, ok := w.findStatus(status)
+	return ok
+}
+
+// CategoryOf возвращает базовый статус для статуса рабочего процесса
+func (w *Workflow) CategoryOf(status Status) (Status, bool) {
+	ws, ok := w.findStatus(status)
+	if !ok {
+		return "", false
+	}
+	return ws.Category, true
+}
+
+// CanTransition проверяет, разрешен ли переход для роли.
+// Пустая роль означает системное действие и проходит любые ограничения по ролям.
+func (w *Workflow) CanTransition(from, to Status, role users.Role) error {
+	if !w.HasStatus(to) {
+		return fmt.Errorf(formatError, ErrInvalidStatus, to)
+	}
+
+	for _, transition := range w.transitions {
+		if transition.From != from || transition.To != to {
+			continue
+		}
+		if role == "" || len(transition.AllowedRoles) == 0 || slices.Contains(transition.AllowedRoles, role) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s cannot transition from %s to %s", ErrTransitionNotAllowed, role, from, to)
+	}
+
+	return fmt.Errorf("%w: cannot transition from %s to %s", ErrInvalidTransition, from, to)
+}
+
+// AvailableTransitions возвращает статусы, в которые роль может перевести заявку из текущего статуса
+func (w *Workflow) AvailableTransitions(from Status, role users.Role) []Status {
+	var result []Status
+	for _, transition := range w.transitions {
+		if transition.From == from && w.CanTransition(from, transition.To, role) == nil {
+			result = append(result, transition.To)
+		}
+	}
+	return result
+}
+
+func (w *Workflow) findStatus(status Status) (WorkflowStatus, bool) {
+	for _, ws := range w.statuses {
+		if ws.Key == status {
+			return ws, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// ParseWorkflowStatus преобразует строку в ключ статуса рабочего процесса.
+// В отличие от ParseStatus допускает статусы, объявленные организацией.
+func ParseWorkflowStatus(s string) (Status, error) {
+	status := Status(strings.ToLower(strings.TrimSpace(s)))
+	if !status.IsWellFormed() {
+		return "", fmt.Errorf(formatError, ErrInvalidStatus, s)
+	}
+	return status, nil
+}
+
+// IsWellFormed проверяет формат ключа статуса (встроенного или пользовательского)
+func (s Status) IsWellFormed() bool {
+	if len(s) == 0 || len(s) > maxWorkflowStatusKey {
+		return false
+	}
+	if s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for _, char := range s {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && char != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func validateWorkflowStatuses(statuses []WorkflowStatus) ([]WorkflowStatus, error) {
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("%w: at least one status is required", ErrInvalidWorkflow)
+	}
+	if len(statuses) > MaxWorkflowStatuses {
+		return nil, fmt.Errorf("%w: too many statuses (max %d)", ErrInvalidWorkflow, MaxWorkflowStatuses)
+	}
+
+	normalized := make([]WorkflowStatus, 0, len(statuses))
+	seen := make(map[Status]bool, len(statuses))
+	for _, ws := range statuses {
+		key, err := ParseWorkflowStatus(string(ws.Key))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidWorkflow, err)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate status %s", ErrInvalidWorkflow, key)
+		}
+		seen[key] = true
+
+		if !ws.Category.IsValid() {
+			return nil, fmt.Errorf("%w: status %s has invalid category %s", ErrInvalidWorkflow, key, ws.Category)
+		}
+		if key.IsValid() && key != ws.Category {
+			return nil, fmt.Errorf("%w: built-in status %s cannot change its category", ErrInvalidWorkflow, key)
+		}
+
+		name := strings.TrimSpace(ws.Name)
+		if name == "" {
+			name = key.DisplayName()
+		}
+		if len(name) > MaxWorkflowStatusName {
+			return nil, fmt.Errorf("%w: status name too long (max %d characters)",
+				ErrInvalidWorkflow, MaxWorkflowStatusName)
+		}
+
+		normalized = append(normalized, WorkflowStatus{Key: key, Name: name, Category: ws.Category})
+	}
+
+	// Новые заявки всегда создаются в статусе "new"
+	if !seen[StatusNew] {
+		return nil, fmt.Errorf("%w: status %s is required", ErrInvalidWorkflow, StatusNew)
+	}
+
+	return normalized, nil
+}
+
+func validateWorkflowTransitions(statuses []WorkflowStatus, transitions []WorkflowTransition) error {
+	declared := make(map[Status]bool, len(statuses))
+	for _, ws := range statuses {
+		declared[ws.Key] = true
+	}
+
+	seen := make(map[[2]Status]bool, len(transitions))
+	for _, transition := range transitions {
+		if !declared[transition.From] || !declared[transition.To] {
+			return fmt.Errorf("%w: transition %s -> %s references unknown status",
+				ErrInvalidWorkflow, transition.From, transition.To)
+		}
+		if transition.From == transition.To {
+			return fmt.Errorf("%w: transition %s -> %s is a no-op", ErrInvalidWorkflow, transition.From, transition.To)
+		}
+
+		pair := [2]Status{transition.From, transition.To}
+		if seen[pair] {
+			return fmt.Errorf("%w: duplicate transition %s -> %s", ErrInvalidWorkflow, transition.From, transition.To)
+		}
+		seen[pair] = true
+
+		for _, role := range transition.AllowedRoles {
+			if !role.IsValid() {
+				return fmt.Errorf("%w: transition %s -> %s has invalid role %s",
+					ErrInvalidWorkflow, transition.From, transition.To, role)
+			}
+		}
+	}
+
+	return nil
+}
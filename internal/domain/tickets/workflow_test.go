@@ -0,0 +1,285 @@
+package tickets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+)
+
+func newVendorWorkflow(t *testing.T) *domain.Workflow {
+	t.Helper()
+
+	workflow, err := domain.NewWorkflow(
+		[]domain.WorkflowStatus{
+			{Key: domain.StatusNew, Category: domain.StatusNew},
+			{Key: domain.StatusInProgress, Category: domain.StatusInProgress},
+			{Key: "pending_vendor", Name: "Ожидание поставщика", Category: domain.StatusWaiting},
+			{Key: domain.StatusResolved, Category: domain.StatusResolved},
+			{Key: domain.StatusClosed, Category: domain.StatusClosed},
+		},
+		[]domain.WorkflowTransition{
+			{From: domain.StatusNew, To: domain.StatusInProgress},
+			{From: domain.StatusInProgress, To: "pending_vendor"},
+			{From: "pending_vendor", To: domain.StatusInProgress},
+			{From: domain.StatusInProgress, To: domain.StatusResolved},
+			{
+				From:         domain.StatusResolved,
+				To:           domain.StatusClosed,
+				AllowedRoles: []users.Role{users.RoleAdmin},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return workflow
+}
+
+func TestDefaultWorkflow_MatchesStatusTransitions(t *testing.T) {
+	workflow := domain.DefaultWorkflow()
+
+	for _, from := range domain.AllStatuses() {
+		for _, to := range domain.AllStatuses() {
+			err := workflow.CanTransition(from, to, users.RoleCustomer)
+			assert.Equal(t, from.CanTransitionTo(to), err == nil, "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestNewWorkflow_Validation(t *testing.T) {
+	validStatuses := []domain.WorkflowStatus{
+		{Key: domain.StatusNew, Category: domain.StatusNew},
+		{Key: domain.StatusClosed, Category: domain.StatusClosed},
+	}
+
+	tests := []struct {
+		name        string
+		statuses    []domain.WorkflowStatus
+		transitions []domain.WorkflowTransition
+		expectError bool
+	}{
+		{
+			name:        "valid minimal workflow",
+			statuses:    validStatuses,
+			transitions: []domain.WorkflowTransition{{From: domain.StatusNew, To: domain.StatusClosed}},
+		},
+		{
+			name:        "no statuses",
+			expectError: true,
+		},
+		{
+			name:        "missing new status",
+			statuses:    []domain.WorkflowStatus{{Key: domain.StatusClosed, Category: domain.StatusClosed}},
+			expectError: true,
+		},
+		{
+			name: "duplicate status",
+			statuses: append(validStatuses,
+				domain.WorkflowStatus{Key: domain.StatusNew, Category: domain.StatusNew}),
+			expectError: true,
+		},
+		{
+			name: "malformed status key",
+			statuses: append(validStatuses,
+				domain.WorkflowStatus{Key: "pending vendor", Category: domain.StatusWaiting}),
+			expectError: true,
+		},
+		{
+			name: "custom status with unknown category",
+			statuses: append(validStatuses,
+				domain.WorkflowStatus{Key: "pending_vendor", Category: "paused"}),
+			expectError: true,
+		},
+		{
+			name: "built-in status with different category",
+			statuses: append(validStatuses,
+				domain.WorkflowStatus{Key: domain.StatusResolved, Category: domain.StatusClosed}),
+			expectError: true,
+		},
+		{
+			name: "status name too long",
+			statuses: append(validStatuses,
+				domain.WorkflowStatus{
+					Key:      "pending_vendor",
+					Name:     strings.Repeat("a", domain.MaxWorkflowStatusName+1),
+					Category: domain.StatusWaiting,
+				}),
+			expectError: true,
+		},
+		{
+			name:        "transition to undeclared status",
+			statuses:    validStatuses,
+			transitions: []domain.WorkflowTransition{{From: domain.StatusNew, To: domain.StatusResolved}},
+			expectError: true,
+		},
+		{
+			name:        "self transition",
+			statuses:    validStatuses,
+			transitions: []domain.WorkflowTransition{{From: domain.StatusNew, To: domain.StatusNew}},
+			expectError: true,
+		},
+		{
+			name:     "duplicate transition",
+			statuses: validStatuses,
+			transitions: []domain.WorkflowTransition{
+				{From: domain.StatusNew, To: domain.StatusClosed},
+				{From: domain.StatusNew, To: domain.StatusClosed},
+			},
+			expectError: true,
+		},
+		{
+			name:     "invalid role",
+			statuses: validStatuses,
+			transitions: []domain.WorkflowTransition{
+				{From: domain.StatusNew, To: domain.StatusClosed, AllowedRoles: []users.Role{"manager"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := domain.NewWorkflow(tt.statuses, tt.transitions)
+			if tt.expectError {
+				require.ErrorIs(t, err, domain.ErrInvalidWorkflow)
+				require.Nil(t, workflow)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, workflow)
+		})
+	}
+}
+
+func TestNewWorkflow_NormalizesStatuses(t *testing.T) {
+	workflow, err := domain.NewWorkflow(
+		[]domain.WorkflowStatus{
+			{Key: " NEW ", Category: domain.StatusNew},
+			{Key: "Pending_Vendor", Name: "  Vendor  ", Category: domain.StatusWaiting},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	statuses := workflow.Statuses()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, domain.StatusNew, statuses[0].Key)
+	assert.Equal(t, domain.StatusNew.DisplayName(), statuses[0].Name)
+	assert.Equal(t, domain.Status("pending_vendor"), statuses[1].Key)
+	assert.Equal(t, "Vendor", statuses[1].Name)
+}
+
+func TestWorkflow_CanTransition(t *testing.T) {
+	workflow := newVendorWorkflow(t)
+
+	tests := []struct {
+		name        string
+		from        domain.Status
+		to          domain.Status
+		role        users.Role
+		expectedErr error
+	}{
+		{"open transition for customer", domain.StatusNew, domain.StatusInProgress, users.RoleCustomer, nil},
+		{"custom status", domain.StatusInProgress, "pending_vendor", users.RoleAgent, nil},
+		{"restricted transition for admin", domain.StatusResolved, domain.StatusClosed, users.RoleAdmin, nil},
+		{
+			"restricted transition for agent", domain.StatusResolved, domain.StatusClosed, users.RoleAgent,
+			domain.ErrTransitionNotAllowed,
+		},
+		{"system action bypasses roles", domain.StatusResolved, domain.StatusClosed, "", nil},
+		{
+			"undeclared transition", domain.StatusNew, domain.StatusClosed, users.RoleAdmin,
+			domain.ErrInvalidTransition,
+		},
+		{"undeclared status", domain.StatusNew, domain.StatusWaiting, users.RoleAdmin, domain.ErrInvalidStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := workflow.CanTransition(tt.from, tt.to, tt.role)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWorkflow_AvailableTransitions(t *testing.T) {
+	workflow := newVendorWorkflow(t)
+
+	assert.ElementsMatch(t,
+		[]domain.Status{"pending_vendor", domain.StatusResolved},
+		workflow.AvailableTransitions(domain.StatusInProgress, users.RoleAgent))
+	assert.Empty(t, workflow.AvailableTransitions(domain.StatusResolved, users.RoleAgent))
+	assert.Equal(t,
+		[]domain.Status{domain.StatusClosed},
+		workflow.AvailableTransitions(domain.StatusResolved, users.RoleAdmin))
+}
+
+func TestTicket_ChangeStatusInWorkflow(t *testing.T) {
+	workflow := newVendorWorkflow(t)
+	ticket := createTestTicket(t)
+
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, domain.StatusInProgress, users.RoleAgent))
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, "pending_vendor", users.RoleAgent))
+	assert.Equal(t, domain.Status("pending_vendor"), ticket.Status())
+	assert.Equal(t, domain.StatusWaiting, ticket.StatusCategory())
+	assert.False(t, ticket.IsResolved())
+
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, domain.StatusInProgress, users.RoleAgent))
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, domain.StatusResolved, users.RoleAgent))
+	assert.True(t, ticket.IsResolved())
+	assert.NotNil(t, ticket.ResolvedAt())
+
+	err := ticket.ChangeStatusInWorkflow(workflow, domain.StatusClosed, users.RoleAgent)
+	require.ErrorIs(t, err, domain.ErrTransitionNotAllowed)
+	assert.Equal(t, domain.StatusResolved, ticket.Status())
+	assert.Nil(t, ticket.ClosedAt())
+
+	require.NoError(t, ticket.ChangeStatusInWorkflow(workflow, domain.StatusClosed, users.RoleAdmin))
+	assert.True(t, ticket.IsClosed())
+	assert.NotNil(t, ticket.ClosedAt())
+}
+
+func TestTicket_ChangeStatusInWorkflow_NilUsesDefault(t *testing.T) {
+	ticket := createTestTicket(t)
+
+	err := ticket.ChangeStatusInWorkflow(nil, domain.StatusResolved, users.RoleAdmin)
+	require.ErrorIs(t, err, domain.ErrInvalidTransition)
+
+	require.NoError(t, ticket.ChangeStatusInWorkflow(nil, domain.StatusInProgress, users.RoleAdmin))
+	assert.Equal(t, domain.StatusInProgress, ticket.StatusCategory())
+}
+
+func TestParseWorkflowStatus(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected domain.Status
+		hasError bool
+	}{
+		{"new", domain.StatusNew, false},
+		{" Pending_Vendor ", "pending_vendor", false},
+		{"level2", "level2", false},
+		{"2nd_line", "", true},
+		{"pending-vendor", "", true},
+		{strings.Repeat("a", 51), "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := domain.ParseWorkflowStatus(tt.input)
+			if tt.hasError {
+				require.ErrorIs(t, err, domain.ErrInvalidStatus)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
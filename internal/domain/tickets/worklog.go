@@ -0,0 +1,193 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrWorkLogNotFound = errors.New("work log entry not found")
+
+const (
+	MaxWorkLogMinutes = 24 * 60 // Одна запись не может превышать сутки
+	MaxWorkLogs       = 1000    // Ограничение количества записей одной заявки
+	WorkLogDateLayout = time.DateOnly
+)
+
+// WorkLogEntry содержит изменяемые поля записи о затраченном времени
+type WorkLogEntry struct {
+	AgentID  uuid.UUID `json:"agent_id"`
+	Date     time.Time `json:"date"`    // День работы; хранится как полночь UTC
+	Minutes  int       `json:"minutes"` // Затраченное время в минутах
+	Billable bool      `json:"billable"`
+	Note     string    `json:"note,omitempty"`
+}
+
+// WorkLog представляет запись о времени, затраченном агентом на заявку
+type WorkLog struct {
+	WorkLogEntry
+
+	ID        uuid.UUID  `json:"id"`
+	CreatedBy uuid.UUID  `json:"created_by"` // Пользователь, внесший запись; администратор может вносить время за агента
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TimeSpent содержит итоги затраченного на заявку времени
+type TimeSpent struct {
+	Minutes         int64 // Все записи
+	BillableMinutes int64 // Записи, выставляемые клиенту
+}
+
+// Add учитывает в итогах еще одну запись
+func (s *TimeSpent) Add(entry WorkLogEntry) {
+	s.Minutes += int64(entry.Minutes)
+	if entry.Billable {
+		s.BillableMinutes += int64(entry.Minutes)
+	}
+}
+
+func (t *Ticket) WorkLogs() []WorkLog { return slices.Clone(t.workLogs) }
+
+// RestoreWorkLogs sets the ticket work logs (for data restoration)
+func (t *Ticket) RestoreWorkLogs(workLogs []WorkLog) { t.workLogs = workLogs }
+
+// WorkLog возвращает запись о затраченном времени по идентификатору
+func (t *Ticket) WorkLog(workLogID uuid.UUID) (WorkLog, error) {
+	for _, workLog := range t.workLogs {
+		if workLog.ID == workLogID {
+			return workLog, nil
+		}
+	}
+	return WorkLog{}, fmt.Errorf(formatError, ErrWorkLogNotFound, workLogID)
+}
+
+// TimeSpent возвращает итоги затраченного на заявку времени
+func (t *Ticket) TimeSpent() TimeSpent {
+	var total TimeSpent
+	for _, workLog := range t.workLogs {
+		total.Add(workLog.WorkLogEntry)
+	}
+	return total
+}
+
+// AddWorkLog добавляет запись о затраченном времени от имени текущего пользователя.
+// События учета времени видны только агентам.
+func (t *Ticket) AddWorkLog(entry WorkLogEntry) (WorkLog, error) {
+	entry, err := validateWorkLogEntry(entry)
+	if err != nil {
+		return WorkLog{}, err
+	}
+	if len(t.workLogs) >= MaxWorkLogs {
+		return WorkLog{}, fmt.Errorf("%w: too many work log entries (max %d)", ErrTicketValidation, MaxWorkLogs)
+	}
+
+	createdBy := entry.AgentID
+	if t.actorID != nil {
+		createdBy = *t.actorID
+	}
+	workLog := WorkLog{
+		WorkLogEntry: entry,
+		ID:           uuid.New(),
+		CreatedBy:    createdBy,
+		CreatedAt:    time.Now(),
+	}
+
+	t.workLogs = append(t.workLogs, workLog)
+	t.recordEventBy(t.actorID, EventWorkLogAdded, "", strconv.Itoa(entry.Minutes), true)
+	t.updatedAt = time.Now()
+	return workLog, nil
+}
+
+// UpdateWorkLog заменяет поля записи о затраченном времени
+func (t *Ticket) UpdateWorkLog(workLogID uuid.UUID, entry WorkLogEntry) error {
+	entry, err := validateWorkLogEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	for i := range t.workLogs {
+		workLog := &t.workLogs[i]
+		if workLog.ID != workLogID {
+			continue
+		}
+		if workLog.WorkLogEntry == entry {
+			return nil
+		}
+
+		now := time.Now()
+		oldMinutes := workLog.Minutes
+		workLog.WorkLogEntry = entry
+		workLog.UpdatedAt = &now
+		t.recordEventBy(t.actorID, EventWorkLogUpdated, strconv.Itoa(oldMinutes), strconv.Itoa(entry.Minutes), true)
+		t.updatedAt = now
+		return nil
+	}
+
+	return fmt.Errorf(formatError, ErrWorkLogNotFound, workLogID)
+}
+
+// RemoveWorkLog удаляет запись о затраченном времени
+func (t *Ticket) RemoveWorkLog(workLogID uuid.UUID) error {
+	for i, workLog := range t.workLogs {
+		if workLog.ID != workLogID {
+			continue
+		}
+
+		t.workLogs = append(t.workLogs[:i], t.workLogs[i+1:]...)
+		t.recordEventBy(t.actorID, EventWorkLogDeleted, strconv.Itoa(workLog.Minutes), "", true)
+		t.updatedAt = time.Now()
+		return nil
+	}
+
+	return fmt.Errorf(formatError, ErrWorkLogNotFound, workLogID)
+}
+
+// WorkDate приводит момент времени к дню работы - полуночи UTC той же календарной даты
+func WorkDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func validateWorkLogEntry(entry WorkLogEntry) (WorkLogEntry, error) {
+	if err := validateUUID(entry.AgentID, "agent_id"); err != nil {
+		return WorkLogEntry{}, err
+	}
+	if entry.Minutes <= 0 || entry.Minutes > MaxWorkLogMinutes {
+		return WorkLogEntry{}, fmt.Errorf("%w: minutes must be between 1 and %d",
+			ErrTicketValidation, MaxWorkLogMinutes)
+	}
+	if entry.Date.IsZero() {
+		return WorkLogEntry{}, fmt.Errorf("%w: date is required", ErrTicketValidation)
+	}
+	// Календарная дата агента может опережать UTC не более чем на сутки
+	entry.Date = WorkDate(entry.Date)
+	if entry.Date.After(time.Now().UTC().AddDate(0, 0, 1)) {
+		return WorkLogEntry{}, fmt.Errorf("%w: date %s is in the future",
+			ErrTicketValidation, entry.Date.Format(WorkLogDateLayout))
+	}
+
+	entry.Note = strings.TrimSpace(entry.Note)
+	if len(entry.Note) > MaxCommentLength {
+		return WorkLogEntry{}, fmt.Errorf("%w: note too long (max %d characters)", ErrTicketValidation, MaxCommentLength)
+	}
+	return entry, nil
+}
+
+// WorkLogStats содержит сводку затраченного времени группы записей
+type WorkLogStats struct {
+	GroupID *uuid.UUID // Агент, категория или организация; nil - заявки без категории
+	Entries int64      // Количество записей
+	TimeSpent
+}
+
+// Merge добавляет к сводке записи другой группы
+func (s *WorkLogStats) Merge(other WorkLogStats) {
+	s.Entries += other.Entries
+	s.Minutes += other.Minutes
+	s.BillableMinutes += other.BillableMinutes
+}
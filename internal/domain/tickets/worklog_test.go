@@ -0,0 +1,86 @@
+package tickets_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+func TestTicket_WorkLogs(t *testing.T) {
+	ticket := createTestTicket(t)
+	adminID, agentID := uuid.New(), uuid.New()
+	ticket.ActAs(adminID)
+	workedAt := time.Date(2024, time.March, 4, 23, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+
+	workLog, err := ticket.AddWorkLog(domain.WorkLogEntry{
+		AgentID:  agentID,
+		Date:     workedAt,
+		Minutes:  90,
+		Billable: true,
+		Note:     "  Replaced the toner  ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), workLog.Date)
+	assert.Equal(t, "Replaced the toner", workLog.Note)
+	assert.Equal(t, adminID, workLog.CreatedBy)
+
+	_, err = ticket.AddWorkLog(domain.WorkLogEntry{AgentID: agentID, Date: workedAt, Minutes: 30})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimeSpent{Minutes: 120, BillableMinutes: 90}, ticket.TimeSpent())
+
+	require.NoError(t, ticket.UpdateWorkLog(workLog.ID, domain.WorkLogEntry{
+		AgentID: agentID, Date: workedAt, Minutes: 60, Billable: false,
+	}))
+	updated, err := ticket.WorkLog(workLog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 60, updated.Minutes)
+	assert.NotNil(t, updated.UpdatedAt)
+	assert.Equal(t, domain.TimeSpent{Minutes: 90}, ticket.TimeSpent())
+
+	require.NoError(t, ticket.RemoveWorkLog(workLog.ID))
+	require.ErrorIs(t, ticket.RemoveWorkLog(workLog.ID), domain.ErrWorkLogNotFound)
+	_, err = ticket.WorkLog(workLog.ID)
+	require.ErrorIs(t, err, domain.ErrWorkLogNotFound)
+	assert.Len(t, ticket.WorkLogs(), 1)
+
+	var types []domain.EventType
+	for _, event := range ticket.PendingEvents() {
+		types = append(types, event.Type)
+		if event.Type == domain.EventWorkLogAdded {
+			assert.True(t, event.IsInternal)
+		}
+	}
+	assert.Contains(t, types, domain.EventWorkLogAdded)
+	assert.Contains(t, types, domain.EventWorkLogUpdated)
+	assert.Contains(t, types, domain.EventWorkLogDeleted)
+}
+
+func TestTicket_WorkLogValidation(t *testing.T) {
+	ticket := createTestTicket(t)
+	valid := domain.WorkLogEntry{AgentID: uuid.New(), Date: time.Now(), Minutes: 15}
+
+	cases := map[string]func(*domain.WorkLogEntry){
+		"missing agent":     func(e *domain.WorkLogEntry) { e.AgentID = uuid.Nil },
+		"zero minutes":      func(e *domain.WorkLogEntry) { e.Minutes = 0 },
+		"more than a day":   func(e *domain.WorkLogEntry) { e.Minutes = domain.MaxWorkLogMinutes + 1 },
+		"missing date":      func(e *domain.WorkLogEntry) { e.Date = time.Time{} },
+		"date in future":    func(e *domain.WorkLogEntry) { e.Date = time.Now().AddDate(0, 0, 3) },
+		"note is too long":  func(e *domain.WorkLogEntry) { e.Note = strings.Repeat("a", domain.MaxCommentLength+1) },
+		"negative duration": func(e *domain.WorkLogEntry) { e.Minutes = -5 },
+	}
+	for name, modify := range cases {
+		entry := valid
+		modify(&entry)
+		_, err := ticket.AddWorkLog(entry)
+		require.ErrorIs(t, err, domain.ErrTicketValidation, name)
+	}
+	assert.Empty(t, ticket.WorkLogs())
+
+	require.ErrorIs(t, ticket.UpdateWorkLog(uuid.New(), valid), domain.ErrWorkLogNotFound)
+}
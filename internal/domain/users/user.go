@@ -14,6 +14,8 @@ var (
 	ErrInvalidUser      = errors.New("invalid user")
 	ErrUserValidation   = errors.New("validation error")
 	ErrUserAlreadyExist = errors.New("user already exist")
+	ErrVersionConflict  = errors.New("user was modified by another request")
+	ErrConcurrentUpdate = errors.New("user is being changed by concurrent requests, try again")
 )
 
 const MinPasswordLength = 6
@@ -28,6 +30,7 @@ type User struct {
 	isActive       bool
 	createdAt      time.Time
 	updatedAt      time.Time
+	version        int64 // Номер сохраненной версии; 0 - пользователь еще не сохранен
 }
 
 func NewUser(id uuid.UUID, name, email string, passwordHash []byte) (*User, error) {
@@ -105,6 +108,15 @@ func (u *User) UpdatedAt() time.Time {
 	return u.updatedAt
 }
 
+func (u *User) Version() int64 {
+	return u.version
+}
+
+// RestoreVersion устанавливает номер сохраненной версии (для восстановления данных и после записи)
+func (u *User) RestoreVersion(version int64) {
+	u.version = version
+}
+
 func (u *User) SendToEmail(_ string) error {
 	return errors.New("not implemented")
 }
@@ -0,0 +1,115 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+
+	"simpleservicedesk/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// Visibility определяет, с кем владелец поделился представлением
+type Visibility string
+
+const (
+	VisibilityPrivate      Visibility = "private"      // Только владелец
+	VisibilityOrganization Visibility = "organization" // Пользователи организации
+	VisibilityRole         Visibility = "role"         // Пользователи с ролью
+)
+
+// AllVisibilities возвращает все варианты видимости представления
+func AllVisibilities() []Visibility {
+	return []Visibility{VisibilityPrivate, VisibilityOrganization, VisibilityRole}
+}
+
+// String возвращает строковое представление видимости
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// IsValid проверяет, является ли видимость допустимой
+func (v Visibility) IsValid() bool {
+	return slices.Contains(AllVisibilities(), v)
+}
+
+// Sharing определяет, кому доступно представление.
+// Администраторы видят все общие представления, чтобы управлять ими.
+type Sharing struct {
+	Visibility     Visibility
+	OrganizationID *uuid.UUID // Для VisibilityOrganization
+	Role           users.Role // Для VisibilityRole
+	OwnerID        uuid.UUID  // Пользователь, создавший представление
+}
+
+// Viewer описывает пользователя, которому показываются представления
+type Viewer struct {
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Role           users.Role
+}
+
+// PrivateSharing создает видимость личного представления
+func PrivateSharing(ownerID uuid.UUID) Sharing {
+	return Sharing{Visibility: VisibilityPrivate, OwnerID: ownerID}
+}
+
+// OrganizationSharing создает видимость представления для пользователей организации
+func OrganizationSharing(organizationID, ownerID uuid.UUID) Sharing {
+	return Sharing{Visibility: VisibilityOrganization, OrganizationID: &organizationID, OwnerID: ownerID}
+}
+
+// RoleSharing создает видимость представления для пользователей с ролью
+func RoleSharing(role users.Role, ownerID uuid.UUID) Sharing {
+	return Sharing{Visibility: VisibilityRole, Role: role, OwnerID: ownerID}
+}
+
+// IsPrivate проверяет, является ли представление личным
+func (s Sharing) IsPrivate() bool {
+	return s.Visibility == VisibilityPrivate
+}
+
+// IsVisibleTo проверяет, может ли пользователь видеть и запускать представление
+func (s Sharing) IsVisibleTo(viewer Viewer) bool {
+	if s.OwnerID == viewer.UserID {
+		return true
+	}
+	switch s.Visibility {
+	case VisibilityOrganization:
+		return viewer.Role == users.RoleAdmin ||
+			viewer.OrganizationID != nil && *viewer.OrganizationID == *s.OrganizationID
+	case VisibilityRole:
+		return viewer.Role == users.RoleAdmin || viewer.Role == s.Role
+	case VisibilityPrivate:
+	}
+	return false
+}
+
+// CanManage проверяет, может ли пользователь изменять и удалять представление:
+// личное - только владелец, общее - владелец или администратор
+func (s Sharing) CanManage(viewer Viewer) bool {
+	return s.OwnerID == viewer.UserID || !s.IsPrivate() && viewer.Role == users.RoleAdmin
+}
+
+func (s Sharing) validate() error {
+	if s.OwnerID == uuid.Nil {
+		return fmt.Errorf("%w: owner is required", ErrViewValidation)
+	}
+	switch s.Visibility {
+	case VisibilityPrivate:
+		if s.OrganizationID != nil || s.Role != "" {
+			return fmt.Errorf("%w: private views are not shared with an organization or role", ErrViewValidation)
+		}
+	case VisibilityOrganization:
+		if s.OrganizationID == nil || *s.OrganizationID == uuid.Nil || s.Role != "" {
+			return fmt.Errorf("%w: views shared with an organization require only its ID", ErrViewValidation)
+		}
+	case VisibilityRole:
+		if !s.Role.IsValid() || s.OrganizationID != nil {
+			return fmt.Errorf("%w: views shared with a role require only a valid role", ErrViewValidation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown visibility %q", ErrViewValidation, s.Visibility)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"simpleservicedesk/internal/domain/users"
+	domain "simpleservicedesk/internal/domain/views"
+)
+
+func TestSharing(t *testing.T) {
+	ownerID := uuid.New()
+	orgID := uuid.New()
+	owner := domain.Viewer{UserID: ownerID, Role: users.RoleAgent}
+	colleague := domain.Viewer{UserID: uuid.New(), OrganizationID: &orgID, Role: users.RoleAgent}
+	customer := domain.Viewer{UserID: uuid.New(), OrganizationID: &orgID, Role: users.RoleCustomer}
+	admin := domain.Viewer{UserID: uuid.New(), Role: users.RoleAdmin}
+
+	private := domain.PrivateSharing(ownerID)
+	assert.True(t, private.IsPrivate())
+	assert.True(t, private.IsVisibleTo(owner))
+	assert.False(t, private.IsVisibleTo(colleague))
+	assert.False(t, private.IsVisibleTo(admin))
+	assert.True(t, private.CanManage(owner))
+	assert.False(t, private.CanManage(admin))
+
+	organization := domain.OrganizationSharing(orgID, ownerID)
+	assert.True(t, organization.IsVisibleTo(colleague))
+	assert.True(t, organization.IsVisibleTo(customer))
+	assert.True(t, organization.IsVisibleTo(admin))
+	assert.False(t, organization.IsVisibleTo(domain.Viewer{UserID: uuid.New(), Role: users.RoleAgent}))
+	assert.False(t, organization.CanManage(colleague))
+	assert.True(t, organization.CanManage(admin))
+
+	role := domain.RoleSharing(users.RoleAgent, ownerID)
+	assert.False(t, role.IsPrivate())
+	assert.True(t, role.IsVisibleTo(colleague))
+	assert.False(t, role.IsVisibleTo(customer))
+	assert.True(t, role.IsVisibleTo(admin))
+	assert.True(t, role.CanManage(owner))
+}
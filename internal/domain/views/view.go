@@ -0,0 +1,156 @@
+package views
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrViewNotFound   = errors.New("view not found")
+	ErrViewValidation = errors.New("view validation error")
+)
+
+const (
+	MaxNameLength = 100
+	MaxFilterSize = 8192 // Ограничение размера сериализованного фильтра в байтах
+)
+
+// Column представляет колонку списка заявок
+type Column string
+
+const (
+	ColumnID           Column = "id"
+	ColumnTitle        Column = "title"
+	ColumnStatus       Column = "status"
+	ColumnPriority     Column = "priority"
+	ColumnCategory     Column = "category"
+	ColumnAssignee     Column = "assignee"
+	ColumnAuthor       Column = "author"
+	ColumnOrganization Column = "organization"
+	ColumnTags         Column = "tags"
+	ColumnSLA          Column = "sla"
+	ColumnCreatedAt    Column = "created_at"
+	ColumnUpdatedAt    Column = "updated_at"
+)
+
+// AllColumns возвращает все колонки списка заявок
+func AllColumns() []Column {
+	return []Column{
+		ColumnID, ColumnTitle, ColumnStatus, ColumnPriority, ColumnCategory, ColumnAssignee,
+		ColumnAuthor, ColumnOrganization, ColumnTags, ColumnSLA, ColumnCreatedAt, ColumnUpdatedAt,
+	}
+}
+
+// DefaultColumns возвращает колонки представления, для которого они не выбраны
+func DefaultColumns() []Column {
+	return []Column{ColumnTitle, ColumnStatus, ColumnPriority, ColumnAssignee, ColumnUpdatedAt}
+}
+
+// String возвращает строковое представление колонки
+func (c Column) String() string {
+	return string(c)
+}
+
+// IsValid проверяет, является ли колонка допустимой
+func (c Column) IsValid() bool {
+	return slices.Contains(AllColumns(), c)
+}
+
+// View представляет сохраненное представление списка заявок: фильтр, сортировку и колонки
+type View struct {
+	id               uuid.UUID
+	name             string
+	filter           []byte // Сериализованный в JSON фильтр заявок вместе с сортировкой
+	assignedToViewer bool   // Показывать заявки, назначенные пользователю, запустившему представление
+	columns          []Column
+	sharing          Sharing
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+// NewView создает представление с указанным ID
+func NewView(
+	id uuid.UUID,
+	name string,
+	filter []byte,
+	assignedToViewer bool,
+	columns []Column,
+	sharing Sharing,
+) (*View, error) {
+	if err := sharing.validate(); err != nil {
+		return nil, err
+	}
+
+	view := &View{id: id, sharing: sharing}
+	if err := view.Update(name, filter, assignedToViewer, columns); err != nil {
+		return nil, err
+	}
+	view.createdAt = view.updatedAt
+	return view, nil
+}
+
+// CreateView создает представление с автоматически сгенерированным ID
+func CreateView(name string, filter []byte, assignedToViewer bool, columns []Column, sharing Sharing) (*View, error) {
+	return NewView(uuid.New(), name, filter, assignedToViewer, columns, sharing)
+}
+
+func (v *View) ID() uuid.UUID          { return v.id }
+func (v *View) Name() string           { return v.name }
+func (v *View) Filter() []byte         { return slices.Clone(v.filter) }
+func (v *View) AssignedToViewer() bool { return v.assignedToViewer }
+func (v *View) Columns() []Column      { return slices.Clone(v.columns) }
+func (v *View) Sharing() Sharing       { return v.sharing }
+func (v *View) CreatedAt() time.Time   { return v.createdAt }
+func (v *View) UpdatedAt() time.Time   { return v.updatedAt }
+
+// RestoreTimestamps sets the creation and modification times (for data restoration)
+func (v *View) RestoreTimestamps(createdAt, updatedAt time.Time) {
+	v.createdAt = createdAt
+	v.updatedAt = updatedAt
+}
+
+// Update изменяет название, фильтр и колонки представления; видимость не меняется.
+// Без выбранных колонок используются DefaultColumns.
+func (v *View) Update(name string, filter []byte, assignedToViewer bool, columns []Column) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrViewValidation)
+	}
+	if len(name) > MaxNameLength {
+		return fmt.Errorf("%w: name must be no more than %d characters long", ErrViewValidation, MaxNameLength)
+	}
+
+	if len(filter) > MaxFilterSize {
+		return fmt.Errorf("%w: filter must be no more than %d bytes", ErrViewValidation, MaxFilterSize)
+	}
+	if !json.Valid(filter) {
+		return fmt.Errorf("%w: filter must be a JSON document", ErrViewValidation)
+	}
+
+	if len(columns) == 0 {
+		columns = DefaultColumns()
+	}
+	validated := make([]Column, 0, len(columns))
+	for _, column := range columns {
+		if !column.IsValid() {
+			return fmt.Errorf("%w: unknown column %q", ErrViewValidation, column)
+		}
+		if slices.Contains(validated, column) {
+			return fmt.Errorf("%w: duplicate column %q", ErrViewValidation, column)
+		}
+		validated = append(validated, column)
+	}
+
+	v.name = name
+	v.filter = slices.Clone(filter)
+	v.assignedToViewer = assignedToViewer
+	v.columns = validated
+	v.updatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,71 @@
+package views_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"simpleservicedesk/internal/domain/users"
+	domain "simpleservicedesk/internal/domain/views"
+)
+
+func TestNewView(t *testing.T) {
+	ownerID := uuid.New()
+	filter := []byte(`{"priority":"critical","sort_by":"updated_at"}`)
+
+	view, err := domain.CreateView(" My critical tickets ", filter, true,
+		[]domain.Column{domain.ColumnTitle, domain.ColumnSLA}, domain.PrivateSharing(ownerID))
+	require.NoError(t, err)
+	assert.Equal(t, "My critical tickets", view.Name())
+	assert.JSONEq(t, string(filter), string(view.Filter()))
+	assert.True(t, view.AssignedToViewer())
+	assert.Equal(t, []domain.Column{domain.ColumnTitle, domain.ColumnSLA}, view.Columns())
+	assert.Equal(t, view.CreatedAt(), view.UpdatedAt())
+
+	view, err = domain.CreateView("Unassigned", []byte(`{}`), false, nil, domain.RoleSharing(users.RoleAgent, ownerID))
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultColumns(), view.Columns())
+
+	orgID := uuid.New()
+	invalid := []struct {
+		name    string
+		filter  string
+		columns []domain.Column
+		sharing domain.Sharing
+	}{
+		{"", `{}`, nil, domain.PrivateSharing(ownerID)},
+		{strings.Repeat("a", domain.MaxNameLength+1), `{}`, nil, domain.PrivateSharing(ownerID)},
+		{"Name", `{"status":`, nil, domain.PrivateSharing(ownerID)},
+		{"Name", `{"q":"` + strings.Repeat("a", domain.MaxFilterSize) + `"}`, nil, domain.PrivateSharing(ownerID)},
+		{"Name", `{}`, []domain.Column{"color"}, domain.PrivateSharing(ownerID)},
+		{"Name", `{}`, []domain.Column{domain.ColumnTitle, domain.ColumnTitle}, domain.PrivateSharing(ownerID)},
+		{"Name", `{}`, nil, domain.PrivateSharing(uuid.Nil)},
+		{"Name", `{}`, nil, domain.OrganizationSharing(uuid.Nil, ownerID)},
+		{"Name", `{}`, nil, domain.RoleSharing("guest", ownerID)},
+		{"Name", `{}`, nil, domain.Sharing{Visibility: domain.VisibilityPrivate, OrganizationID: &orgID, OwnerID: ownerID}},
+		{"Name", `{}`, nil, domain.Sharing{Visibility: "team", OwnerID: ownerID}},
+	}
+	for _, tc := range invalid {
+		_, err = domain.CreateView(tc.name, []byte(tc.filter), false, tc.columns, tc.sharing)
+		require.ErrorIs(t, err, domain.ErrViewValidation, "%q %v", tc.name, tc.sharing)
+	}
+}
+
+func TestView_Update(t *testing.T) {
+	sharing := domain.OrganizationSharing(uuid.New(), uuid.New())
+	view, err := domain.CreateView("Open", []byte(`{}`), false, nil, sharing)
+	require.NoError(t, err)
+
+	require.NoError(t, view.Update("Open hardware", []byte(`{"category_id":"hardware"}`), true,
+		[]domain.Column{domain.ColumnID}))
+	assert.Equal(t, "Open hardware", view.Name())
+	assert.True(t, view.AssignedToViewer())
+	assert.Equal(t, []domain.Column{domain.ColumnID}, view.Columns())
+	assert.Equal(t, sharing, view.Sharing())
+
+	require.ErrorIs(t, view.Update("Open", []byte(`not json`), false, nil), domain.ErrViewValidation)
+	assert.Equal(t, "Open hardware", view.Name())
+}
@@ -0,0 +1,86 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const gridFSBucketName = "attachments"
+
+// GridFSStore implements BlobStore on top of MongoDB GridFS
+type GridFSStore struct {
+	bucket *gridfs.Bucket
+}
+
+// NewGridFSStore creates a GridFS blob store in the given database
+func NewGridFSStore(db *mongo.Database) (*GridFSStore, error) {
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(gridFSBucketName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gridfs bucket: %w", err)
+	}
+
+	return &GridFSStore{bucket: bucket}, nil
+}
+
+// Put writes the content under the key, replacing any existing blob
+func (s *GridFSStore) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	if err := s.Delete(ctx, key); err != nil && !errors.Is(err, domain.ErrAttachmentNotFound) {
+		return 0, err
+	}
+
+	stream, err := s.bucket.OpenUploadStreamWithID(key, key)
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := io.Copy(stream, content)
+	if err != nil {
+		_ = stream.Abort()
+		return 0, err
+	}
+	if err = stream.Close(); err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
+
+// Get opens the blob stored under the key for reading
+func (s *GridFSStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	stream, err := s.bucket.OpenDownloadStream(key)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// Delete removes the blob stored under the key together with its chunks
+func (s *GridFSStore) Delete(ctx context.Context, key string) error {
+	err := s.bucket.DeleteContext(ctx, key)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		return fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, key)
+	}
+	return err
+}
+
+// Clear removes all blobs (useful for testing)
+func (s *GridFSStore) Clear(ctx context.Context) error {
+	if _, err := s.bucket.GetFilesCollection().DeleteMany(ctx, bson.M{}); err != nil {
+		return err
+	}
+	_, err := s.bucket.GetChunksCollection().DeleteMany(ctx, bson.M{})
+	return err
+}
@@ -0,0 +1,110 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+)
+
+const (
+	localDirPermissions = 0o750
+	localTempPattern    = ".upload-*"
+)
+
+// LocalStore implements BlobStore on the local filesystem
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore creates a filesystem blob store rooted at the given directory
+func NewLocalStore(root string) (*LocalStore, error) {
+	if root == "" {
+		return nil, errors.New("local blob store root is required")
+	}
+	if err := os.MkdirAll(root, localDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create blob store root: %w", err)
+	}
+
+	return &LocalStore{root: root}, nil
+}
+
+// Put writes the content under the key, replacing any existing blob atomically
+func (s *LocalStore) Put(_ context.Context, key string, content io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, localDirPermissions); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(dir, localTempPattern)
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return 0, err
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, err
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
+
+// Get opens the blob stored under the key for reading
+func (s *LocalStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Delete removes the blob stored under the key
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, key)
+	}
+	return err
+}
+
+// path resolves the key inside the root and rejects keys escaping it
+func (s *LocalStore) path(key string) (string, error) {
+	relative := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(relative) {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(s.root, relative), nil
+}
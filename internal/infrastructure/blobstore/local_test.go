@@ -0,0 +1,69 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/infrastructure/blobstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "tickets/ticket-id/blob-id"
+
+	t.Run("put and get", func(t *testing.T) {
+		written, putErr := store.Put(ctx, key, strings.NewReader("hello attachment"))
+		require.NoError(t, putErr)
+		assert.Equal(t, int64(len("hello attachment")), written)
+
+		reader, getErr := store.Get(ctx, key)
+		require.NoError(t, getErr)
+		defer reader.Close()
+
+		content, readErr := io.ReadAll(reader)
+		require.NoError(t, readErr)
+		assert.Equal(t, "hello attachment", string(content))
+	})
+
+	t.Run("put replaces existing blob", func(t *testing.T) {
+		_, putErr := store.Put(ctx, key, strings.NewReader("new"))
+		require.NoError(t, putErr)
+
+		reader, getErr := store.Get(ctx, key)
+		require.NoError(t, getErr)
+		defer reader.Close()
+
+		content, readErr := io.ReadAll(reader)
+		require.NoError(t, readErr)
+		assert.Equal(t, "new", string(content))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, key))
+
+		_, getErr := store.Get(ctx, key)
+		require.ErrorIs(t, getErr, domain.ErrAttachmentNotFound)
+		require.ErrorIs(t, store.Delete(ctx, key), domain.ErrAttachmentNotFound)
+	})
+
+	t.Run("rejects keys outside root", func(t *testing.T) {
+		for _, invalidKey := range []string{"", "../escape", "/absolute/path"} {
+			_, putErr := store.Put(ctx, invalidKey, strings.NewReader("data"))
+			require.Error(t, putErr, invalidKey)
+		}
+	})
+}
+
+func TestNewLocalStore_RequiresRoot(t *testing.T) {
+	_, err := blobstore.NewLocalStore("")
+	require.Error(t, err)
+}
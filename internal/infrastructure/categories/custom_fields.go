@@ -0,0 +1,57 @@
+package categories
+
+import (
+	domain "simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+type mongoCustomField struct {
+	Key      string   `bson:"key"`
+	Label    string   `bson:"label"`
+	Type     string   `bson:"type"`
+	Required bool     `bson:"required"`
+	Options  []string `bson:"options,omitempty"`
+}
+
+// customFieldSchemaToMongo returns nil for categories without custom fields
+func customFieldSchemaToMongo(category *domain.Category) []mongoCustomField {
+	schema := category.CustomFieldSchema()
+	if schema == nil {
+		return nil
+	}
+
+	fields := make([]mongoCustomField, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		fields = append(fields, mongoCustomField{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     field.Type.String(),
+			Required: field.Required,
+			Options:  field.Options,
+		})
+	}
+	return fields
+}
+
+func restoreCustomFieldSchema(category *domain.Category, fields []mongoCustomField) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	definitions := make([]tickets.CustomFieldDefinition, 0, len(fields))
+	for _, field := range fields {
+		definitions = append(definitions, tickets.CustomFieldDefinition{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     tickets.CustomFieldType(field.Type),
+			Required: field.Required,
+			Options:  field.Options,
+		})
+	}
+
+	schema, err := tickets.NewCustomFieldSchema(definitions)
+	if err != nil {
+		return err
+	}
+	return category.SetCustomFieldSchema(schema)
+}
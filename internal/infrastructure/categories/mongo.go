@@ -8,6 +8,7 @@ import (
 
 	"simpleservicedesk/internal/application"
 	domain "simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/infrastructure/optimistic"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -25,8 +26,12 @@ type mongoCategory struct {
 	OrganizationID uuid.UUID          `bson:"organization_id"`
 	ParentID       *uuid.UUID         `bson:"parent_id,omitempty"`
 	IsActive       bool               `bson:"is_active"`
+	CustomFields   []mongoCustomField `bson:"custom_fields,omitempty"`
 	CreatedAt      time.Time          `bson:"created_at"`
 	UpdatedAt      time.Time          `bson:"updated_at"`
+	Version        int64              `bson:"version,omitempty"`
+	DeletedAt      *time.Time         `bson:"deleted_at,omitempty"`
+	DeletedBy      *uuid.UUID         `bson:"deleted_by,omitempty"`
 }
 
 type MongoRepo struct {
@@ -48,10 +53,11 @@ func (r *MongoRepo) CreateCategory(
 		return nil, err
 	}
 
-	// Check if category with same name exists in the same organization
+	// Check if category with same name exists in the same organization; trashed categories free their names
 	count, err := r.collection.CountDocuments(ctx, bson.M{
 		"name":            category.Name(),
 		"organization_id": category.OrganizationID(),
+		"deleted_at":      nil,
 	})
 	if err != nil {
 		return nil, err
@@ -64,6 +70,7 @@ func (r *MongoRepo) CreateCategory(
 	if category.HasParent() {
 		parentCount, parentErr := r.collection.CountDocuments(ctx, bson.M{
 			"category_id": *category.ParentID(),
+			"deleted_at":  nil,
 		})
 		if parentErr != nil {
 			return nil, parentErr
@@ -80,21 +87,25 @@ func (r *MongoRepo) CreateCategory(
 		OrganizationID: category.OrganizationID(),
 		ParentID:       category.ParentID(),
 		IsActive:       category.IsActive(),
+		CustomFields:   customFieldSchemaToMongo(category),
 		CreatedAt:      category.CreatedAt(),
 		UpdatedAt:      category.UpdatedAt(),
+		Version:        1,
 	}
 
 	_, err = r.collection.InsertOne(ctx, mc)
 	if err != nil {
 		return nil, err
 	}
+	category.RestoreVersion(mc.Version)
 
 	return category, nil
 }
 
+// GetCategory retrieves a category by ID; trashed categories are not found
 func (r *MongoRepo) GetCategory(ctx context.Context, categoryID uuid.UUID) (*domain.Category, error) {
 	var mc mongoCategory
-	err := r.collection.FindOne(ctx, bson.M{"category_id": categoryID}).Decode(&mc)
+	err := r.collection.FindOne(ctx, bson.M{"category_id": categoryID, "deleted_at": nil}).Decode(&mc)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrCategoryNotFound
 	}
@@ -117,17 +128,34 @@ func (r *MongoRepo) GetCategory(ctx context.Context, categoryID uuid.UUID) (*dom
 	if !mc.IsActive {
 		category.Deactivate()
 	}
+	if err = restoreCustomFieldSchema(category, mc.CustomFields); err != nil {
+		return nil, err
+	}
+	category.RestoreVersion(mc.Version)
 
 	return category, nil
 }
 
+// UpdateCategory updates an existing category in MongoDB.
+// The write applies only if the category still has the version it was loaded with; when a concurrent
+// write got there first, the category is reloaded and updateFn runs again on the fresh state.
 func (r *MongoRepo) UpdateCategory(
 	ctx context.Context,
 	categoryID uuid.UUID,
 	updateFn func(*domain.Category) (bool, error),
+) (*domain.Category, error) {
+	return optimistic.Retry(ctx, func(ctx context.Context) (*domain.Category, error) {
+		return r.updateCategory(ctx, categoryID, updateFn)
+	}, domain.ErrConcurrentUpdate)
+}
+
+func (r *MongoRepo) updateCategory(
+	ctx context.Context,
+	categoryID uuid.UUID,
+	updateFn func(*domain.Category) (bool, error),
 ) (*domain.Category, error) {
 	var mc mongoCategory
-	err := r.collection.FindOne(ctx, bson.M{"category_id": categoryID}).Decode(&mc)
+	err := r.collection.FindOne(ctx, bson.M{"category_id": categoryID, "deleted_at": nil}).Decode(&mc)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrCategoryNotFound
 	}
@@ -150,6 +178,10 @@ func (r *MongoRepo) UpdateCategory(
 	if !mc.IsActive {
 		category.Deactivate()
 	}
+	if err = restoreCustomFieldSchema(category, mc.CustomFields); err != nil {
+		return nil, err
+	}
+	category.RestoreVersion(mc.Version)
 
 	updated, err := updateFn(category)
 	if err != nil {
@@ -167,17 +199,28 @@ func (r *MongoRepo) UpdateCategory(
 	}
 
 	update := bson.M{"$set": bson.M{
-		"name":        category.Name(),
-		"description": category.Description(),
-		"parent_id":   category.ParentID(),
-		"is_active":   category.IsActive(),
-		"updated_at":  category.UpdatedAt(),
+		"name":          category.Name(),
+		"description":   category.Description(),
+		"parent_id":     category.ParentID(),
+		"is_active":     category.IsActive(),
+		"custom_fields": customFieldSchemaToMongo(category),
+		"updated_at":    category.UpdatedAt(),
+		"version":       mc.Version + 1,
 	}}
 
-	_, err = r.collection.UpdateOne(ctx, bson.M{"category_id": categoryID}, update)
+	filter := bson.M{"category_id": categoryID, "version": mc.Version, "deleted_at": nil}
+	if mc.Version == 0 {
+		// Categories stored before versioning have no version field
+		filter["version"] = bson.M{"$exists": false}
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, err
 	}
+	if result.MatchedCount == 0 {
+		return nil, optimistic.ErrStaleVersion
+	}
+	category.RestoreVersion(mc.Version + 1)
 
 	return category, nil
 }
@@ -186,7 +229,8 @@ func (r *MongoRepo) ListCategories(
 	ctx context.Context,
 	filter queries.CategoryFilter,
 ) ([]*domain.Category, error) {
-	query := bson.M{}
+	// Trashed categories are listed only through the trash
+	query := bson.M{"deleted_at": nil}
 
 	// Apply filters
 	if filter.OrganizationID != nil {
@@ -253,6 +297,10 @@ func (r *MongoRepo) ListCategories(
 		if !mc.IsActive {
 			category.Deactivate()
 		}
+		if categoryErr = restoreCustomFieldSchema(category, mc.CustomFields); categoryErr != nil {
+			return nil, categoryErr
+		}
+		category.RestoreVersion(mc.Version)
 
 		categories = append(categories, category)
 	}
@@ -294,21 +342,28 @@ func (r *MongoRepo) GetCategoryHierarchy(
 	return tree, nil
 }
 
-func (r *MongoRepo) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+// DeleteCategory moves a category to the trash.
+// Trashed categories are hidden from all other queries until they are restored or purged.
+func (r *MongoRepo) DeleteCategory(ctx context.Context, categoryID, deletedBy uuid.UUID) error {
 	// Check if category has children
-	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": categoryID})
+	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": categoryID, "deleted_at": nil})
 	if err != nil {
 		return err
 	}
 	if childCount > 0 {
-		return errors.New("cannot delete category with children")
+		return fmt.Errorf("%w: cannot delete category with children", domain.ErrCategoryHasChildren)
 	}
 
-	result, err := r.collection.DeleteOne(ctx, bson.M{"category_id": categoryID})
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"category_id": categoryID, "deleted_at": nil},
+		bson.M{
+			"$set": bson.M{"deleted_at": time.Now(), "deleted_by": deletedBy},
+			"$inc": bson.M{"version": 1},
+		})
 	if err != nil {
 		return err
 	}
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return domain.ErrCategoryNotFound
 	}
 
@@ -333,7 +388,7 @@ func (r *MongoRepo) checkCircularReference(ctx context.Context, categoryID, newP
 		}
 
 		var mc mongoCategory
-		err := r.collection.FindOne(ctx, bson.M{"category_id": currentID}).Decode(&mc)
+		err := r.collection.FindOne(ctx, bson.M{"category_id": currentID, "deleted_at": nil}).Decode(&mc)
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			break
 		}
@@ -352,7 +407,7 @@ func (r *MongoRepo) checkCircularReference(ctx context.Context, categoryID, newP
 
 // getChildCategories returns immediate children of a category
 func (r *MongoRepo) getChildCategories(ctx context.Context, parentID uuid.UUID) ([]*domain.Category, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{"parent_id": parentID})
+	cursor, err := r.collection.Find(ctx, bson.M{"parent_id": parentID, "deleted_at": nil})
 	if err != nil {
 		return nil, err
 	}
@@ -380,6 +435,10 @@ func (r *MongoRepo) getChildCategories(ctx context.Context, parentID uuid.UUID)
 		if !mc.IsActive {
 			category.Deactivate()
 		}
+		if categoryErr = restoreCustomFieldSchema(category, mc.CustomFields); categoryErr != nil {
+			return nil, categoryErr
+		}
+		category.RestoreVersion(mc.Version)
 
 		categories = append(categories, category)
 	}
@@ -16,6 +16,7 @@ import (
 
 	"simpleservicedesk/internal/application"
 	domain "simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/domain/tickets"
 	categoriesInfra "simpleservicedesk/internal/infrastructure/categories"
 	"simpleservicedesk/internal/queries"
 )
@@ -180,6 +181,40 @@ func (s *MongoRepoSuite) TestUpdateCategory() {
 	s.Equal(newDescription, fetchedCategory.Description())
 }
 
+func (s *MongoRepoSuite) TestCategoryCustomFieldSchema() {
+	ctx := context.Background()
+
+	category, err := s.repo.CreateCategory(ctx, func() (*domain.Category, error) {
+		return domain.CreateRootCategory("Hardware", "", s.orgID)
+	})
+	s.Require().NoError(err)
+
+	schema, err := tickets.NewCustomFieldSchema([]tickets.CustomFieldDefinition{
+		{Key: "asset_tag", Label: "Asset tag", Type: tickets.CustomFieldText, Required: true},
+		{Key: "os", Label: "Operating system", Type: tickets.CustomFieldEnum, Options: []string{"linux", "macos"}},
+	})
+	s.Require().NoError(err)
+	_, err = s.repo.UpdateCategory(ctx, category.ID(), func(c *domain.Category) (bool, error) {
+		return true, c.SetCustomFieldSchema(schema)
+	})
+	s.Require().NoError(err)
+
+	fetchedCategory, err := s.repo.GetCategory(ctx, category.ID())
+	s.Require().NoError(err)
+	s.Require().NotNil(fetchedCategory.CustomFieldSchema())
+	s.Equal(schema.Fields(), fetchedCategory.CustomFieldSchema().Fields())
+
+	_, err = s.repo.UpdateCategory(ctx, category.ID(), func(c *domain.Category) (bool, error) {
+		c.ResetCustomFieldSchema()
+		return true, nil
+	})
+	s.Require().NoError(err)
+
+	fetchedCategory, err = s.repo.GetCategory(ctx, category.ID())
+	s.Require().NoError(err)
+	s.Nil(fetchedCategory.CustomFieldSchema())
+}
+
 func (s *MongoRepoSuite) TestUpdateCategoryParent() {
 	ctx := context.Background()
 
@@ -382,7 +417,7 @@ func (s *MongoRepoSuite) TestDeleteCategory() {
 	s.Require().NoError(err)
 
 	// Delete category
-	err = s.repo.DeleteCategory(ctx, category.ID())
+	err = s.repo.DeleteCategory(ctx, category.ID(), uuid.New())
 	s.Require().NoError(err)
 
 	// Verify deletion
@@ -405,11 +440,44 @@ func (s *MongoRepoSuite) TestDeleteCategoryWithChildren() {
 	s.Require().NoError(err)
 
 	// Try to delete parent with children
-	err = s.repo.DeleteCategory(ctx, parent.ID())
+	err = s.repo.DeleteCategory(ctx, parent.ID(), uuid.New())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "cannot delete category with children")
 }
 
+func (s *MongoRepoSuite) TestRestoreAndPurgeCategory() {
+	ctx := context.Background()
+
+	parent, err := s.repo.CreateCategory(ctx, func() (*domain.Category, error) {
+		return domain.CreateRootCategory("Trash Parent", "Parent Category", s.orgID)
+	})
+	s.Require().NoError(err)
+	child, err := s.repo.CreateCategory(ctx, func() (*domain.Category, error) {
+		return domain.CreateSubCategory("Trash Child", "Child Category", s.orgID, parent.ID())
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.DeleteCategory(ctx, child.ID(), uuid.New()))
+	s.Require().NoError(s.repo.DeleteCategory(ctx, parent.ID(), uuid.New()))
+
+	trashed, err := s.repo.ListDeletedCategories(ctx, queries.TrashFilter{OrganizationID: &s.orgID})
+	s.Require().NoError(err)
+	s.Len(trashed, 2)
+
+	// The child cannot come back while its parent is in the trash
+	s.Require().ErrorIs(s.repo.RestoreCategory(ctx, child.ID()), domain.ErrParentInTrash)
+
+	// A trashed subcategory still blocks purging the parent
+	s.Require().ErrorIs(s.repo.PurgeCategory(ctx, parent.ID()), domain.ErrCategoryHasChildren)
+	s.Require().NoError(s.repo.PurgeCategory(ctx, child.ID()))
+	s.Require().NoError(s.repo.RestoreCategory(ctx, parent.ID()))
+
+	restored, err := s.repo.GetCategory(ctx, parent.ID())
+	s.Require().NoError(err)
+	s.False(restored.IsDeleted())
+	s.Require().ErrorIs(s.repo.PurgeCategory(ctx, parent.ID()), domain.ErrCategoryNotFound)
+}
+
 func (s *MongoRepoSuite) TestGetCategoryNotFound() {
 	ctx := context.Background()
 	nonExistentID := uuid.New()
@@ -434,7 +502,7 @@ func (s *MongoRepoSuite) TestDeleteCategoryNotFound() {
 	ctx := context.Background()
 	nonExistentID := uuid.New()
 
-	err := s.repo.DeleteCategory(ctx, nonExistentID)
+	err := s.repo.DeleteCategory(ctx, nonExistentID, uuid.New())
 	s.Require().Error(err)
 	s.ErrorIs(err, domain.ErrCategoryNotFound)
 }
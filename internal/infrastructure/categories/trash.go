@@ -0,0 +1,179 @@
+package categories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain "simpleservicedesk/internal/domain/categories"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ListDeletedCategories retrieves trashed categories matching the filter
+func (r *MongoRepo) ListDeletedCategories(
+	ctx context.Context,
+	filter queries.TrashFilter,
+) ([]*domain.Category, error) {
+	sortOrder := -1 // default: the most recently deleted first
+	if filter.SortOrder == "asc" {
+		sortOrder = 1
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, buildTrashQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var categories []*domain.Category
+	for cursor.Next(ctx) {
+		var mc mongoCategory
+		if decodeErr := cursor.Decode(&mc); decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		category, categoryErr := trashedCategoryToDomain(&mc)
+		if categoryErr != nil {
+			return nil, categoryErr
+		}
+		categories = append(categories, category)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+
+	return categories, nil
+}
+
+// CountDeletedCategories counts trashed categories matching the filter
+func (r *MongoRepo) CountDeletedCategories(ctx context.Context, filter queries.TrashFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, buildTrashQuery(filter))
+}
+
+// RestoreCategory moves a category out of the trash.
+// The parent must not be trashed and no active category of the organization may have taken the name.
+func (r *MongoRepo) RestoreCategory(ctx context.Context, categoryID uuid.UUID) error {
+	var mc mongoCategory
+	err := r.collection.FindOne(ctx, bson.M{"category_id": categoryID, "deleted_at": bson.M{"$ne": nil}}).Decode(&mc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if mc.ParentID != nil {
+		parentCount, parentErr := r.collection.CountDocuments(ctx, bson.M{
+			"category_id": *mc.ParentID,
+			"deleted_at":  nil,
+		})
+		if parentErr != nil {
+			return parentErr
+		}
+		if parentCount == 0 {
+			return domain.ErrParentInTrash
+		}
+	}
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"name":            mc.Name,
+		"organization_id": mc.OrganizationID,
+		"deleted_at":      nil,
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrCategoryAlreadyExist
+	}
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"category_id": categoryID, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{
+			"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+			"$inc":   bson.M{"version": 1},
+		})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// PurgeCategory permanently removes a trashed category.
+// A category with subcategories, trashed or not, cannot be purged.
+func (r *MongoRepo) PurgeCategory(ctx context.Context, categoryID uuid.UUID) error {
+	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": categoryID})
+	if err != nil {
+		return err
+	}
+	if childCount > 0 {
+		return fmt.Errorf("%w: purge the subcategories first", domain.ErrCategoryHasChildren)
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"category_id": categoryID, "deleted_at": bson.M{"$ne": nil}})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func buildTrashQuery(filter queries.TrashFilter) bson.M {
+	deletedQuery := bson.M{"$ne": nil}
+	if filter.DeletedBefore != nil {
+		deletedQuery["$lt"] = *filter.DeletedBefore
+	}
+
+	query := bson.M{"deleted_at": deletedQuery}
+	if filter.OrganizationID != nil {
+		query["organization_id"] = *filter.OrganizationID
+	}
+	return query
+}
+
+// trashedCategoryToDomain restores a trashed category together with its deletion details
+func trashedCategoryToDomain(mc *mongoCategory) (*domain.Category, error) {
+	category, err := domain.NewCategory(
+		mc.CategoryID,
+		mc.Name,
+		mc.Description,
+		mc.OrganizationID,
+		mc.ParentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !mc.IsActive {
+		category.Deactivate()
+	}
+	if err = restoreCustomFieldSchema(category, mc.CustomFields); err != nil {
+		return nil, err
+	}
+	category.RestoreVersion(mc.Version)
+	if mc.DeletedAt != nil && mc.DeletedBy != nil {
+		category.MarkDeleted(*mc.DeletedBy, *mc.DeletedAt)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,57 @@
+package leases
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoRepo stores one document per lease name in the leases collection,
+// so that only one server replica runs a background job at a time
+type MongoRepo struct {
+	collection *mongo.Collection
+}
+
+// NewMongoRepo creates a new MongoDB repository for distributed leases
+func NewMongoRepo(db *mongo.Database) *MongoRepo {
+	return &MongoRepo{
+		collection: db.Collection("leases"),
+	}
+}
+
+// AcquireLease takes or renews the named lease for the holder until ttl elapses.
+// It returns false when another holder owns a lease that has not expired yet.
+func (r *MongoRepo) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"holder": holder},
+			bson.M{"expires_at": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"holder":     holder,
+		"renewed_at": now,
+		"expires_at": now.Add(ttl),
+	}}
+
+	// A live lease of another holder does not match the filter, so the upsert collides on _id
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseLease gives up the named lease if it is still owned by the holder
+func (r *MongoRepo) ReleaseLease(ctx context.Context, name, holder string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": name, "holder": holder})
+	return err
+}
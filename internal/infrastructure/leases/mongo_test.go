@@ -0,0 +1,120 @@
+package leases_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	leasesInfra "simpleservicedesk/internal/infrastructure/leases"
+)
+
+type MongoRepoSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+	db        *mongo.Database
+	repo      *leasesInfra.MongoRepo
+}
+
+func (s *MongoRepoSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "mongo:latest",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(10 * time.Second),
+	}
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	s.Require().NoError(err)
+	s.container = mongoContainer
+
+	host, err := mongoContainer.Host(ctx)
+	s.Require().NoError(err)
+	port, err := mongoContainer.MappedPort(ctx, "27017")
+	s.Require().NoError(err)
+
+	uri := fmt.Sprintf("mongodb://%s", net.JoinHostPort(host, port.Port()))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	s.Require().NoError(err)
+
+	s.db = client.Database("testdb")
+	s.repo = leasesInfra.NewMongoRepo(s.db)
+}
+
+func (s *MongoRepoSuite) TearDownSuite() {
+	ctx := context.Background()
+	err := s.db.Client().Disconnect(ctx)
+	s.Require().NoError(err)
+	err = s.container.Terminate(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) SetupTest() {
+	err := s.db.Collection("leases").Drop(context.Background())
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) TestAcquireLease() {
+	ctx := context.Background()
+
+	acquired, err := s.repo.AcquireLease(ctx, "job", "replica-1", time.Minute)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	acquired, err = s.repo.AcquireLease(ctx, "job", "replica-2", time.Minute)
+	s.Require().NoError(err)
+	s.False(acquired, "live lease of another replica cannot be taken")
+
+	acquired, err = s.repo.AcquireLease(ctx, "job", "replica-1", time.Minute)
+	s.Require().NoError(err)
+	s.True(acquired, "holder renews its own lease")
+
+	acquired, err = s.repo.AcquireLease(ctx, "other-job", "replica-2", time.Minute)
+	s.Require().NoError(err)
+	s.True(acquired, "leases of different jobs are independent")
+}
+
+func (s *MongoRepoSuite) TestAcquireExpiredLease() {
+	ctx := context.Background()
+
+	acquired, err := s.repo.AcquireLease(ctx, "job", "replica-1", time.Millisecond)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	time.Sleep(10 * time.Millisecond)
+
+	acquired, err = s.repo.AcquireLease(ctx, "job", "replica-2", time.Minute)
+	s.Require().NoError(err)
+	s.True(acquired, "expired lease is taken over")
+}
+
+func (s *MongoRepoSuite) TestReleaseLease() {
+	ctx := context.Background()
+
+	_, err := s.repo.AcquireLease(ctx, "job", "replica-1", time.Minute)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.ReleaseLease(ctx, "job", "replica-2"))
+	acquired, err := s.repo.AcquireLease(ctx, "job", "replica-2", time.Minute)
+	s.Require().NoError(err)
+	s.False(acquired, "only the holder can release its lease")
+
+	s.Require().NoError(s.repo.ReleaseLease(ctx, "job", "replica-1"))
+	acquired, err = s.repo.AcquireLease(ctx, "job", "replica-2", time.Minute)
+	s.Require().NoError(err)
+	s.True(acquired)
+}
+
+func TestMongoRepoSuite(t *testing.T) {
+	suite.Run(t, new(MongoRepoSuite))
+}
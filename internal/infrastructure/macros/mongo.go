@@ -0,0 +1,323 @@
+package macros
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/macros"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoCannedResponse struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	CannedResponseID uuid.UUID          `bson:"canned_response_id"`
+	Name             string             `bson:"name"`
+	Content          string             `bson:"content"`
+	OrganizationID   *uuid.UUID         `bson:"organization_id"`
+	OwnerID          uuid.UUID          `bson:"owner_id"`
+	CreatedAt        time.Time          `bson:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+}
+
+type mongoMacro struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	MacroID        uuid.UUID          `bson:"macro_id"`
+	Name           string             `bson:"name"`
+	Description    string             `bson:"description"`
+	Actions        []mongoAction      `bson:"actions"`
+	OrganizationID *uuid.UUID         `bson:"organization_id"`
+	OwnerID        uuid.UUID          `bson:"owner_id"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+type mongoAction struct {
+	Type     string `bson:"type"`
+	Value    string `bson:"value,omitempty"`
+	Internal bool   `bson:"internal,omitempty"`
+}
+
+// MongoRepo stores canned responses and macros in separate collections
+type MongoRepo struct {
+	cannedResponses *mongo.Collection
+	macros          *mongo.Collection
+}
+
+func NewMongoRepo(db *mongo.Database) *MongoRepo {
+	return &MongoRepo{
+		cannedResponses: db.Collection("canned_responses"),
+		macros:          db.Collection("macros"),
+	}
+}
+
+func (r *MongoRepo) CreateCannedResponse(
+	ctx context.Context,
+	createFn func() (*domain.CannedResponse, error),
+) (*domain.CannedResponse, error) {
+	response, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.cannedResponses.InsertOne(ctx, cannedResponseToMongo(response)); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (r *MongoRepo) GetCannedResponse(ctx context.Context, id uuid.UUID) (*domain.CannedResponse, error) {
+	var mr mongoCannedResponse
+	err := r.cannedResponses.FindOne(ctx, bson.M{"canned_response_id": id}).Decode(&mr)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrCannedResponseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mongoToCannedResponse(mr)
+}
+
+func (r *MongoRepo) UpdateCannedResponse(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.CannedResponse) (bool, error),
+) (*domain.CannedResponse, error) {
+	response, err := r.GetCannedResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateFn(response)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return response, nil
+	}
+
+	update := bson.M{"$set": bson.M{
+		"name":       response.Name(),
+		"content":    response.Content(),
+		"updated_at": response.UpdatedAt(),
+	}}
+	if _, err = r.cannedResponses.UpdateOne(ctx, bson.M{"canned_response_id": id}, update); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (r *MongoRepo) ListCannedResponses(
+	ctx context.Context,
+	filter queries.MacroFilter,
+) ([]*domain.CannedResponse, error) {
+	cursor, err := r.cannedResponses.Find(ctx, visibilityQuery(filter), options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var responses []*domain.CannedResponse
+	for cursor.Next(ctx) {
+		var mr mongoCannedResponse
+		if decodeErr := cursor.Decode(&mr); decodeErr != nil {
+			return nil, decodeErr
+		}
+		response, responseErr := mongoToCannedResponse(mr)
+		if responseErr != nil {
+			return nil, responseErr
+		}
+		responses = append(responses, response)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+	return responses, nil
+}
+
+func (r *MongoRepo) DeleteCannedResponse(ctx context.Context, id uuid.UUID) error {
+	result, err := r.cannedResponses.DeleteOne(ctx, bson.M{"canned_response_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrCannedResponseNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepo) CreateMacro(ctx context.Context, createFn func() (*domain.Macro, error)) (*domain.Macro, error) {
+	macro, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.macros.InsertOne(ctx, macroToMongo(macro)); err != nil {
+		return nil, err
+	}
+	return macro, nil
+}
+
+func (r *MongoRepo) GetMacro(ctx context.Context, id uuid.UUID) (*domain.Macro, error) {
+	var mm mongoMacro
+	err := r.macros.FindOne(ctx, bson.M{"macro_id": id}).Decode(&mm)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrMacroNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mongoToMacro(mm)
+}
+
+func (r *MongoRepo) UpdateMacro(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.Macro) (bool, error),
+) (*domain.Macro, error) {
+	macro, err := r.GetMacro(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateFn(macro)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return macro, nil
+	}
+
+	mm := macroToMongo(macro)
+	update := bson.M{"$set": bson.M{
+		"name":        mm.Name,
+		"description": mm.Description,
+		"actions":     mm.Actions,
+		"updated_at":  mm.UpdatedAt,
+	}}
+	if _, err = r.macros.UpdateOne(ctx, bson.M{"macro_id": id}, update); err != nil {
+		return nil, err
+	}
+	return macro, nil
+}
+
+func (r *MongoRepo) ListMacros(ctx context.Context, filter queries.MacroFilter) ([]*domain.Macro, error) {
+	cursor, err := r.macros.Find(ctx, visibilityQuery(filter), options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var macros []*domain.Macro
+	for cursor.Next(ctx) {
+		var mm mongoMacro
+		if decodeErr := cursor.Decode(&mm); decodeErr != nil {
+			return nil, decodeErr
+		}
+		macro, macroErr := mongoToMacro(mm)
+		if macroErr != nil {
+			return nil, macroErr
+		}
+		macros = append(macros, macro)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+	return macros, nil
+}
+
+func (r *MongoRepo) DeleteMacro(ctx context.Context, id uuid.UUID) error {
+	result, err := r.macros.DeleteOne(ctx, bson.M{"macro_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrMacroNotFound
+	}
+	return nil
+}
+
+// visibilityQuery matches the personal items of the owner and the items shared with organizations
+func visibilityQuery(filter queries.MacroFilter) bson.M {
+	shared := bson.M{"organization_id": bson.M{"$ne": nil}}
+	if filter.OrganizationID != nil {
+		shared = bson.M{"organization_id": *filter.OrganizationID}
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{"organization_id": nil, "owner_id": filter.OwnerID},
+		shared,
+	}}
+}
+
+func cannedResponseToMongo(response *domain.CannedResponse) mongoCannedResponse {
+	scope := response.Scope()
+	return mongoCannedResponse{
+		CannedResponseID: response.ID(),
+		Name:             response.Name(),
+		Content:          response.Content(),
+		OrganizationID:   scope.OrganizationID,
+		OwnerID:          scope.OwnerID,
+		CreatedAt:        response.CreatedAt(),
+		UpdatedAt:        response.UpdatedAt(),
+	}
+}
+
+func mongoToCannedResponse(mr mongoCannedResponse) (*domain.CannedResponse, error) {
+	scope := domain.Scope{OrganizationID: mr.OrganizationID, OwnerID: mr.OwnerID}
+	response, err := domain.NewCannedResponse(mr.CannedResponseID, mr.Name, mr.Content, scope)
+	if err != nil {
+		return nil, err
+	}
+	response.RestoreTimestamps(mr.CreatedAt, mr.UpdatedAt)
+	return response, nil
+}
+
+func macroToMongo(macro *domain.Macro) mongoMacro {
+	actions := make([]mongoAction, 0, len(macro.Actions()))
+	for _, action := range macro.Actions() {
+		actions = append(actions, mongoAction{
+			Type:     action.Type.String(),
+			Value:    action.Value,
+			Internal: action.Internal,
+		})
+	}
+
+	scope := macro.Scope()
+	return mongoMacro{
+		MacroID:        macro.ID(),
+		Name:           macro.Name(),
+		Description:    macro.Description(),
+		Actions:        actions,
+		OrganizationID: scope.OrganizationID,
+		OwnerID:        scope.OwnerID,
+		CreatedAt:      macro.CreatedAt(),
+		UpdatedAt:      macro.UpdatedAt(),
+	}
+}
+
+func mongoToMacro(mm mongoMacro) (*domain.Macro, error) {
+	actions := make([]domain.Action, 0, len(mm.Actions))
+	for _, action := range mm.Actions {
+		actions = append(actions, domain.Action{
+			Type:     domain.ActionType(action.Type),
+			Value:    action.Value,
+			Internal: action.Internal,
+		})
+	}
+
+	scope := domain.Scope{OrganizationID: mm.OrganizationID, OwnerID: mm.OwnerID}
+	macro, err := domain.NewMacro(mm.MacroID, mm.Name, mm.Description, actions, scope)
+	if err != nil {
+		return nil, err
+	}
+	macro.RestoreTimestamps(mm.CreatedAt, mm.UpdatedAt)
+	return macro, nil
+}
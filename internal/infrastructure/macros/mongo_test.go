@@ -0,0 +1,166 @@
+package macros_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	domain "simpleservicedesk/internal/domain/macros"
+	macrosInfra "simpleservicedesk/internal/infrastructure/macros"
+	"simpleservicedesk/internal/queries"
+)
+
+type MongoRepoSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+	db        *mongo.Database
+	repo      *macrosInfra.MongoRepo
+}
+
+func (s *MongoRepoSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "mongo:latest",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(10 * time.Second),
+	}
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	s.Require().NoError(err)
+	s.container = mongoContainer
+
+	host, err := mongoContainer.Host(ctx)
+	s.Require().NoError(err)
+	port, err := mongoContainer.MappedPort(ctx, "27017")
+	s.Require().NoError(err)
+
+	uri := fmt.Sprintf("mongodb://%s", net.JoinHostPort(host, port.Port()))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	s.Require().NoError(err)
+
+	s.db = client.Database("testdb")
+	s.repo = macrosInfra.NewMongoRepo(s.db)
+}
+
+func (s *MongoRepoSuite) TearDownSuite() {
+	ctx := context.Background()
+	err := s.db.Client().Disconnect(ctx)
+	s.Require().NoError(err)
+	err = s.container.Terminate(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) SetupTest() {
+	ctx := context.Background()
+	s.Require().NoError(s.db.Collection("canned_responses").Drop(ctx))
+	s.Require().NoError(s.db.Collection("macros").Drop(ctx))
+}
+
+func (s *MongoRepoSuite) TestCannedResponseCRUD() {
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	created, err := s.repo.CreateCannedResponse(ctx, func() (*domain.CannedResponse, error) {
+		return domain.CreateCannedResponse("Greeting", "Hello {{ author.name }}", domain.PersonalScope(ownerID))
+	})
+	s.Require().NoError(err)
+
+	loaded, err := s.repo.GetCannedResponse(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal("Hello {{ author.name }}", loaded.Content())
+	s.True(loaded.Scope().IsPersonal())
+	s.Equal(ownerID, loaded.Scope().OwnerID)
+
+	updated, err := s.repo.UpdateCannedResponse(ctx, created.ID(), func(response *domain.CannedResponse) (bool, error) {
+		return true, response.Update("Greeting", "Hi {{ author.name }}")
+	})
+	s.Require().NoError(err)
+	s.Equal("Hi {{ author.name }}", updated.Content())
+
+	s.Require().NoError(s.repo.DeleteCannedResponse(ctx, created.ID()))
+	_, err = s.repo.GetCannedResponse(ctx, created.ID())
+	s.ErrorIs(err, domain.ErrCannedResponseNotFound)
+	s.ErrorIs(s.repo.DeleteCannedResponse(ctx, created.ID()), domain.ErrCannedResponseNotFound)
+}
+
+func (s *MongoRepoSuite) TestListCannedResponsesVisibility() {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	orgID := uuid.New()
+	for name, scope := range map[string]domain.Scope{
+		"Own":          domain.PersonalScope(ownerID),
+		"Foreign":      domain.PersonalScope(uuid.New()),
+		"Shared":       domain.OrganizationScope(orgID, uuid.New()),
+		"Other shared": domain.OrganizationScope(uuid.New(), uuid.New()),
+	} {
+		_, err := s.repo.CreateCannedResponse(ctx, func() (*domain.CannedResponse, error) {
+			return domain.CreateCannedResponse(name, "Content", scope)
+		})
+		s.Require().NoError(err)
+	}
+
+	names := func(filter queries.MacroFilter) []string {
+		responses, err := s.repo.ListCannedResponses(ctx, filter)
+		s.Require().NoError(err)
+		result := make([]string, 0, len(responses))
+		for _, response := range responses {
+			result = append(result, response.Name())
+		}
+		return result
+	}
+	s.Equal([]string{"Other shared", "Own", "Shared"}, names(queries.MacroFilter{OwnerID: ownerID}))
+	s.Equal([]string{"Own", "Shared"}, names(queries.MacroFilter{OwnerID: ownerID, OrganizationID: &orgID}))
+}
+
+func (s *MongoRepoSuite) TestMacroCRUD() {
+	ctx := context.Background()
+	orgID := uuid.New()
+	actions := []domain.Action{
+		{Type: domain.ActionComment, Value: "Resolved, {{ author.name }}", Internal: true},
+		{Type: domain.ActionStatus, Value: "resolved"},
+		{Type: domain.ActionAssign},
+	}
+
+	created, err := s.repo.CreateMacro(ctx, func() (*domain.Macro, error) {
+		return domain.CreateMacro("Resolve", "Resolves the ticket", actions, domain.OrganizationScope(orgID, uuid.New()))
+	})
+	s.Require().NoError(err)
+
+	loaded, err := s.repo.GetMacro(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal(actions, loaded.Actions())
+	s.Equal(orgID, *loaded.Scope().OrganizationID)
+
+	list, err := s.repo.ListMacros(ctx, queries.MacroFilter{OwnerID: uuid.New(), OrganizationID: &orgID})
+	s.Require().NoError(err)
+	s.Len(list, 1)
+
+	_, err = s.repo.UpdateMacro(ctx, created.ID(), func(macro *domain.Macro) (bool, error) {
+		return true, macro.Update("Close", "", []domain.Action{{Type: domain.ActionStatus, Value: "closed"}})
+	})
+	s.Require().NoError(err)
+	loaded, err = s.repo.GetMacro(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal("Close", loaded.Name())
+	s.Len(loaded.Actions(), 1)
+
+	s.Require().NoError(s.repo.DeleteMacro(ctx, created.ID()))
+	_, err = s.repo.GetMacro(ctx, created.ID())
+	s.ErrorIs(err, domain.ErrMacroNotFound)
+}
+
+func TestMongoRepoSuite(t *testing.T) {
+	suite.Run(t, new(MongoRepoSuite))
+}
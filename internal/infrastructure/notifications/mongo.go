@@ -0,0 +1,175 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/notifications"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoNotification struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	NotificationID uuid.UUID          `bson:"notification_id"`
+	UserID         uuid.UUID          `bson:"user_id"`
+	Type           string             `bson:"type"`
+	ActorID        uuid.UUID          `bson:"actor_id"`
+	TicketID       uuid.UUID          `bson:"ticket_id"`
+	CommentID      *uuid.UUID         `bson:"comment_id,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	ReadAt         *time.Time         `bson:"read_at"`
+}
+
+// MongoRepo stores in-app notifications of users
+type MongoRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepo(db *mongo.Database) *MongoRepo {
+	collection := db.Collection("notifications")
+
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "notification_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "read_at", Value: 1}, {Key: "created_at", Value: -1}}},
+	}
+	_, _ = collection.Indexes().CreateMany(context.Background(), indexes)
+
+	return &MongoRepo{collection: collection}
+}
+
+func (r *MongoRepo) CreateNotification(
+	ctx context.Context,
+	createFn func() (*domain.Notification, error),
+) (*domain.Notification, error) {
+	notification, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.collection.InsertOne(ctx, notificationToMongo(notification)); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (r *MongoRepo) GetNotification(ctx context.Context, id uuid.UUID) (*domain.Notification, error) {
+	var mn mongoNotification
+	err := r.collection.FindOne(ctx, bson.M{"notification_id": id}).Decode(&mn)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrNotificationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mongoToNotification(mn)
+}
+
+// UpdateNotification applies updateFn to the notification; only its read state can change
+func (r *MongoRepo) UpdateNotification(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.Notification) (bool, error),
+) (*domain.Notification, error) {
+	notification, err := r.GetNotification(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateFn(notification)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return notification, nil
+	}
+
+	update := bson.M{"$set": bson.M{"read_at": notification.ReadAt()}}
+	if _, err = r.collection.UpdateOne(ctx, bson.M{"notification_id": id}, update); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (r *MongoRepo) ListNotifications(
+	ctx context.Context,
+	filter queries.NotificationFilter,
+) ([]*domain.Notification, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, buildNotificationQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []*domain.Notification
+	for cursor.Next(ctx) {
+		var mn mongoNotification
+		if decodeErr := cursor.Decode(&mn); decodeErr != nil {
+			return nil, decodeErr
+		}
+		notification, notificationErr := mongoToNotification(mn)
+		if notificationErr != nil {
+			return nil, notificationErr
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+	return notifications, nil
+}
+
+func (r *MongoRepo) CountNotifications(ctx context.Context, filter queries.NotificationFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, buildNotificationQuery(filter))
+}
+
+func buildNotificationQuery(filter queries.NotificationFilter) bson.M {
+	query := bson.M{"user_id": filter.UserID}
+	if filter.Unread != nil {
+		if *filter.Unread {
+			query["read_at"] = nil
+		} else {
+			query["read_at"] = bson.M{"$ne": nil}
+		}
+	}
+	return query
+}
+
+func notificationToMongo(notification *domain.Notification) mongoNotification {
+	return mongoNotification{
+		NotificationID: notification.ID(),
+		UserID:         notification.UserID(),
+		Type:           notification.Type().String(),
+		ActorID:        notification.ActorID(),
+		TicketID:       notification.TicketID(),
+		CommentID:      notification.CommentID(),
+		CreatedAt:      notification.CreatedAt(),
+		ReadAt:         notification.ReadAt(),
+	}
+}
+
+func mongoToNotification(mn mongoNotification) (*domain.Notification, error) {
+	notification, err := domain.NewNotification(
+		mn.NotificationID, mn.UserID, domain.Type(mn.Type), mn.ActorID, mn.TicketID, mn.CommentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	notification.RestoreState(mn.CreatedAt, mn.ReadAt)
+	return notification, nil
+}
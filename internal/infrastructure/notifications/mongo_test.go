@@ -0,0 +1,130 @@
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	domain "simpleservicedesk/internal/domain/notifications"
+	notificationsInfra "simpleservicedesk/internal/infrastructure/notifications"
+	"simpleservicedesk/internal/queries"
+)
+
+type MongoRepoSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+	db        *mongo.Database
+	repo      *notificationsInfra.MongoRepo
+}
+
+func (s *MongoRepoSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "mongo:latest",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(10 * time.Second),
+	}
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	s.Require().NoError(err)
+	s.container = mongoContainer
+
+	host, err := mongoContainer.Host(ctx)
+	s.Require().NoError(err)
+	port, err := mongoContainer.MappedPort(ctx, "27017")
+	s.Require().NoError(err)
+
+	uri := fmt.Sprintf("mongodb://%s", net.JoinHostPort(host, port.Port()))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	s.Require().NoError(err)
+
+	s.db = client.Database("testdb")
+	s.repo = notificationsInfra.NewMongoRepo(s.db)
+}
+
+func (s *MongoRepoSuite) TearDownSuite() {
+	ctx := context.Background()
+	err := s.db.Client().Disconnect(ctx)
+	s.Require().NoError(err)
+	err = s.container.Terminate(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) SetupTest() {
+	s.Require().NoError(s.db.Collection("notifications").Drop(context.Background()))
+}
+
+func (s *MongoRepoSuite) createMention(userID uuid.UUID) *domain.Notification {
+	notification, err := s.repo.CreateNotification(context.Background(), func() (*domain.Notification, error) {
+		return domain.NewMentionNotification(userID, uuid.New(), uuid.New(), uuid.New())
+	})
+	s.Require().NoError(err)
+	return notification
+}
+
+func (s *MongoRepoSuite) TestNotificationLifecycle() {
+	ctx := context.Background()
+	created := s.createMention(uuid.New())
+
+	loaded, err := s.repo.GetNotification(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal(domain.TypeMention, loaded.Type())
+	s.Equal(created.UserID(), loaded.UserID())
+	s.Equal(created.CommentID(), loaded.CommentID())
+	s.False(loaded.IsRead())
+
+	_, err = s.repo.UpdateNotification(ctx, created.ID(), func(notification *domain.Notification) (bool, error) {
+		return notification.MarkRead(), nil
+	})
+	s.Require().NoError(err)
+	loaded, err = s.repo.GetNotification(ctx, created.ID())
+	s.Require().NoError(err)
+	s.True(loaded.IsRead())
+
+	_, err = s.repo.GetNotification(ctx, uuid.New())
+	s.ErrorIs(err, domain.ErrNotificationNotFound)
+}
+
+func (s *MongoRepoSuite) TestListNotifications() {
+	ctx := context.Background()
+	userID := uuid.New()
+	first := s.createMention(userID)
+	second := s.createMention(userID)
+	s.createMention(uuid.New())
+	_, err := s.repo.UpdateNotification(ctx, first.ID(), func(notification *domain.Notification) (bool, error) {
+		return notification.MarkRead(), nil
+	})
+	s.Require().NoError(err)
+
+	list, err := s.repo.ListNotifications(ctx, queries.NotificationFilter{UserID: userID})
+	s.Require().NoError(err)
+	s.Require().Len(list, 2)
+	s.Equal(second.ID(), list[0].ID())
+
+	unread := true
+	filter := queries.NotificationFilter{UserID: userID, Unread: &unread}
+	list, err = s.repo.ListNotifications(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(second.ID(), list[0].ID())
+
+	count, err := s.repo.CountNotifications(ctx, filter)
+	s.Require().NoError(err)
+	s.Equal(int64(1), count)
+}
+
+func TestMongoRepoSuite(t *testing.T) {
+	suite.Run(t, new(MongoRepoSuite))
+}
@@ -0,0 +1,30 @@
+// Package optimistic retries MongoDB updates guarded by a document version
+package optimistic
+
+import (
+	"context"
+	"errors"
+)
+
+// MaxAttempts bounds how many times an update is retried after losing a race with a concurrent write
+const MaxAttempts = 3
+
+// ErrStaleVersion reports that the document changed between loading and writing it
+var ErrStaleVersion = errors.New("stale document version")
+
+// Retry runs update until it stops failing with ErrStaleVersion.
+// Each attempt reloads the document, so updateFn of the repository runs again on the fresh state.
+// When every attempt loses the race, exhausted is returned.
+func Retry[T any](ctx context.Context, update func(ctx context.Context) (T, error), exhausted error) (T, error) {
+	var zero T
+	for range MaxAttempts {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		result, err := update(ctx)
+		if !errors.Is(err, ErrStaleVersion) {
+			return result, err
+		}
+	}
+	return zero, exhausted
+}
@@ -0,0 +1,52 @@
+package optimistic_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"simpleservicedesk/internal/infrastructure/optimistic"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errExhausted = errors.New("exhausted")
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Stale attempts are retried", func(t *testing.T) {
+		attempts := 0
+		result, err := optimistic.Retry(ctx, func(context.Context) (int, error) {
+			attempts++
+			if attempts < optimistic.MaxAttempts {
+				return 0, optimistic.ErrStaleVersion
+			}
+			return attempts, nil
+		}, errExhausted)
+		require.NoError(t, err)
+		assert.Equal(t, optimistic.MaxAttempts, result)
+	})
+
+	t.Run("Other errors are returned at once", func(t *testing.T) {
+		attempts := 0
+		failure := errors.New("write failed")
+		_, err := optimistic.Retry(ctx, func(context.Context) (int, error) {
+			attempts++
+			return 0, failure
+		}, errExhausted)
+		require.ErrorIs(t, err, failure)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Losing every race reports exhaustion", func(t *testing.T) {
+		attempts := 0
+		_, err := optimistic.Retry(ctx, func(context.Context) (int, error) {
+			attempts++
+			return 0, optimistic.ErrStaleVersion
+		}, errExhausted)
+		require.ErrorIs(t, err, errExhausted)
+		assert.Equal(t, optimistic.MaxAttempts, attempts)
+	})
+}
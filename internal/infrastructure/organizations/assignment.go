@@ -0,0 +1,51 @@
+package organizations
+
+import (
+	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+type mongoAssignmentConfig struct {
+	Strategy      string                    `bson:"strategy"`
+	AgentIDs      []uuid.UUID               `bson:"agent_ids,omitempty"`
+	CategoryRules []mongoCategoryAssignment `bson:"category_rules,omitempty"`
+}
+
+type mongoCategoryAssignment struct {
+	CategoryID uuid.UUID   `bson:"category_id"`
+	AgentIDs   []uuid.UUID `bson:"agent_ids"`
+}
+
+// assignmentConfigToMongo returns nil for organizations that assign tickets manually
+func assignmentConfigToMongo(organization *domain.Organization) *mongoAssignmentConfig {
+	config := organization.AssignmentConfig()
+	if config == nil {
+		return nil
+	}
+
+	ma := &mongoAssignmentConfig{
+		Strategy: config.Strategy().String(),
+		AgentIDs: config.AgentIDs(),
+	}
+	for _, rule := range config.CategoryRules() {
+		ma.CategoryRules = append(ma.CategoryRules, mongoCategoryAssignment{
+			CategoryID: rule.CategoryID,
+			AgentIDs:   rule.AgentIDs,
+		})
+	}
+	return ma
+}
+
+func mongoToAssignmentConfig(ma *mongoAssignmentConfig) (*tickets.AssignmentConfig, error) {
+	rules := make([]tickets.CategoryAssignment, 0, len(ma.CategoryRules))
+	for _, rule := range ma.CategoryRules {
+		rules = append(rules, tickets.CategoryAssignment{
+			CategoryID: rule.CategoryID,
+			AgentIDs:   rule.AgentIDs,
+		})
+	}
+
+	return tickets.NewAssignmentConfig(tickets.AssignmentStrategy(ma.Strategy), ma.AgentIDs, rules)
+}
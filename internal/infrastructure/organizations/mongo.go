@@ -8,6 +8,9 @@ import (
 
 	"simpleservicedesk/internal/application"
 	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/infrastructure/optimistic"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -18,15 +21,41 @@ import (
 )
 
 type mongoOrganization struct {
-	ID        primitive.ObjectID          `bson:"_id,omitempty"`
-	OrgID     uuid.UUID                   `bson:"organization_id"`
-	Name      string                      `bson:"name"`
-	Domain    string                      `bson:"domain"`
-	ParentID  *uuid.UUID                  `bson:"parent_id,omitempty"`
-	IsActive  bool                        `bson:"is_active"`
-	Settings  domain.OrganizationSettings `bson:"settings"`
-	CreatedAt time.Time                   `bson:"created_at"`
-	UpdatedAt time.Time                   `bson:"updated_at"`
+	ID                  primitive.ObjectID          `bson:"_id,omitempty"`
+	OrgID               uuid.UUID                   `bson:"organization_id"`
+	Name                string                      `bson:"name"`
+	Domain              string                      `bson:"domain"`
+	ParentID            *uuid.UUID                  `bson:"parent_id,omitempty"`
+	IsActive            bool                        `bson:"is_active"`
+	Settings            domain.OrganizationSettings `bson:"settings"`
+	Workflow            *mongoWorkflow              `bson:"workflow,omitempty"`
+	SLA                 *mongoSLAConfig             `bson:"sla,omitempty"`
+	Assignment          *mongoAssignmentConfig      `bson:"assignment,omitempty"`
+	PriorityMatrix      []mongoPriorityMatrixCell   `bson:"priority_matrix,omitempty"`
+	AutoAssignmentTurns int64                       `bson:"auto_assignment_turns,omitempty"`
+	Tags                []mongoTag                  `bson:"tags,omitempty"`
+	CreatedAt           time.Time                   `bson:"created_at"`
+	UpdatedAt           time.Time                   `bson:"updated_at"`
+	Version             int64                       `bson:"version,omitempty"`
+	DeletedAt           *time.Time                  `bson:"deleted_at,omitempty"`
+	DeletedBy           *uuid.UUID                  `bson:"deleted_by,omitempty"`
+}
+
+type mongoWorkflow struct {
+	Statuses    []mongoWorkflowStatus     `bson:"statuses"`
+	Transitions []mongoWorkflowTransition `bson:"transitions"`
+}
+
+type mongoWorkflowStatus struct {
+	Key      string `bson:"key"`
+	Name     string `bson:"name"`
+	Category string `bson:"category"`
+}
+
+type mongoWorkflowTransition struct {
+	From         string   `bson:"from"`
+	To           string   `bson:"to"`
+	AllowedRoles []string `bson:"allowed_roles,omitempty"`
 }
 
 type MongoRepo struct {
@@ -48,9 +77,10 @@ func (r *MongoRepo) CreateOrganization(
 		return nil, err
 	}
 
-	// Check if organization with same name exists
+	// Check if organization with same name exists; trashed organizations free their names
 	count, err := r.collection.CountDocuments(ctx, bson.M{
-		"name": organization.Name(),
+		"name":       organization.Name(),
+		"deleted_at": nil,
 	})
 	if err != nil {
 		return nil, err
@@ -63,6 +93,7 @@ func (r *MongoRepo) CreateOrganization(
 	if organization.HasParent() {
 		parentCount, parentErr := r.collection.CountDocuments(ctx, bson.M{
 			"organization_id": *organization.ParentID(),
+			"deleted_at":      nil,
 		})
 		if parentErr != nil {
 			return nil, parentErr
@@ -73,27 +104,36 @@ func (r *MongoRepo) CreateOrganization(
 	}
 
 	mo := mongoOrganization{
-		OrgID:     organization.ID(),
-		Name:      organization.Name(),
-		Domain:    organization.Domain(),
-		ParentID:  organization.ParentID(),
-		IsActive:  organization.IsActive(),
-		Settings:  organization.Settings(),
-		CreatedAt: organization.CreatedAt(),
-		UpdatedAt: organization.UpdatedAt(),
+		OrgID:               organization.ID(),
+		Name:                organization.Name(),
+		Domain:              organization.Domain(),
+		ParentID:            organization.ParentID(),
+		IsActive:            organization.IsActive(),
+		Settings:            organization.Settings(),
+		Workflow:            workflowToMongo(organization),
+		SLA:                 slaConfigToMongo(organization),
+		Assignment:          assignmentConfigToMongo(organization),
+		PriorityMatrix:      priorityMatrixToMongo(organization),
+		AutoAssignmentTurns: organization.AutoAssignmentTurns(),
+		Tags:                tagsToMongo(organization.Tags()),
+		CreatedAt:           organization.CreatedAt(),
+		UpdatedAt:           organization.UpdatedAt(),
+		Version:             1,
 	}
 
 	_, err = r.collection.InsertOne(ctx, mo)
 	if err != nil {
 		return nil, err
 	}
+	organization.RestoreVersion(mo.Version)
 
 	return organization, nil
 }
 
+// GetOrganization retrieves an organization by ID; trashed organizations are not found
 func (r *MongoRepo) GetOrganization(ctx context.Context, orgID uuid.UUID) (*domain.Organization, error) {
 	var mo mongoOrganization
-	err := r.collection.FindOne(ctx, bson.M{"organization_id": orgID}).Decode(&mo)
+	err := r.collection.FindOne(ctx, bson.M{"organization_id": orgID, "deleted_at": nil}).Decode(&mo)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrOrganizationNotFound
 	}
@@ -101,53 +141,65 @@ func (r *MongoRepo) GetOrganization(ctx context.Context, orgID uuid.UUID) (*doma
 		return nil, err
 	}
 
-	organization, err := domain.NewOrganization(
-		mo.OrgID,
-		mo.Name,
-		mo.Domain,
-		mo.ParentID,
-	)
+	organization, err := mongoToDomain(mo)
 	if err != nil {
 		return nil, err
 	}
 
-	// Restore settings and activation state
-	organization.UpdateSettings(mo.Settings)
-	if !mo.IsActive {
-		organization.Deactivate()
-	}
-
 	return organization, nil
 }
 
+// UpdateOrganization updates an existing organization in MongoDB.
+// The write applies only if the organization still has the version it was loaded with; when a concurrent
+// write got there first, the organization is reloaded and updateFn runs again on the fresh state.
 func (r *MongoRepo) UpdateOrganization(
 	ctx context.Context,
 	orgID uuid.UUID,
 	updateFn func(*domain.Organization) (bool, error),
 ) (*domain.Organization, error) {
+	return optimistic.Retry(ctx, func(ctx context.Context) (*domain.Organization, error) {
+		return r.updateOrganization(ctx, orgID, updateFn)
+	}, domain.ErrConcurrentUpdate)
+}
+
+// TakeAutoAssignmentTurn claims the next round-robin turn of the organization and returns it.
+// The counter is incremented atomically, so concurrent assignments always get distinct turns;
+// it leaves the version alone, so ticket creation never conflicts with organization updates.
+func (r *MongoRepo) TakeAutoAssignmentTurn(ctx context.Context, orgID uuid.UUID) (int64, error) {
 	var mo mongoOrganization
-	err := r.collection.FindOne(ctx, bson.M{"organization_id": orgID}).Decode(&mo)
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"organization_id": orgID, "deleted_at": nil},
+		bson.M{"$inc": bson.M{"auto_assignment_turns": 1}},
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{"auto_assignment_turns": 1}),
+	).Decode(&mo)
 	if errors.Is(err, mongo.ErrNoDocuments) {
-		return nil, domain.ErrOrganizationNotFound
+		return 0, domain.ErrOrganizationNotFound
 	}
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
+	return mo.AutoAssignmentTurns - 1, nil
+}
 
-	organization, err := domain.NewOrganization(
-		mo.OrgID,
-		mo.Name,
-		mo.Domain,
-		mo.ParentID,
-	)
+func (r *MongoRepo) updateOrganization(
+	ctx context.Context,
+	orgID uuid.UUID,
+	updateFn func(*domain.Organization) (bool, error),
+) (*domain.Organization, error) {
+	var mo mongoOrganization
+	err := r.collection.FindOne(ctx, bson.M{"organization_id": orgID, "deleted_at": nil}).Decode(&mo)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrOrganizationNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Restore settings and activation state
-	organization.UpdateSettings(mo.Settings)
-	if !mo.IsActive {
-		organization.Deactivate()
+	organization, err := mongoToDomain(mo)
+	if err != nil {
+		return nil, err
 	}
 
 	updated, err := updateFn(organization)
@@ -164,24 +216,59 @@ func (r *MongoRepo) UpdateOrganization(
 			return nil, circularErr
 		}
 	}
+	if prefix := organization.Settings().TicketKeyPrefix; prefix != "" && prefix != mo.Settings.TicketKeyPrefix {
+		if prefixErr := r.checkKeyPrefixAvailable(ctx, orgID, prefix); prefixErr != nil {
+			return nil, prefixErr
+		}
+	}
 
 	update := bson.M{"$set": bson.M{
-		"name":       organization.Name(),
-		"domain":     organization.Domain(),
-		"parent_id":  organization.ParentID(),
-		"is_active":  organization.IsActive(),
-		"settings":   organization.Settings(),
-		"updated_at": organization.UpdatedAt(),
+		"name":            organization.Name(),
+		"domain":          organization.Domain(),
+		"parent_id":       organization.ParentID(),
+		"is_active":       organization.IsActive(),
+		"settings":        organization.Settings(),
+		"workflow":        workflowToMongo(organization),
+		"sla":             slaConfigToMongo(organization),
+		"assignment":      assignmentConfigToMongo(organization),
+		"priority_matrix": priorityMatrixToMongo(organization),
+		"tags":            tagsToMongo(organization.Tags()),
+		"updated_at":      organization.UpdatedAt(),
+		"version":         mo.Version + 1,
 	}}
 
-	_, err = r.collection.UpdateOne(ctx, bson.M{"organization_id": orgID}, update)
+	filter := bson.M{"organization_id": orgID, "version": mo.Version, "deleted_at": nil}
+	if mo.Version == 0 {
+		// Organizations stored before versioning have no version field
+		filter["version"] = bson.M{"$exists": false}
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, err
 	}
+	if result.MatchedCount == 0 {
+		return nil, optimistic.ErrStaleVersion
+	}
+	organization.RestoreVersion(mo.Version + 1)
 
 	return organization, nil
 }
 
+// checkKeyPrefixAvailable makes sure no other organization, trashed ones included, issues keys with the prefix
+func (r *MongoRepo) checkKeyPrefixAvailable(ctx context.Context, orgID uuid.UUID, prefix string) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"settings.ticketkeyprefix": prefix,
+		"organization_id":          bson.M{"$ne": orgID},
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrKeyPrefixTaken
+	}
+	return nil
+}
+
 func (r *MongoRepo) ListOrganizations(
 	ctx context.Context,
 	filter queries.OrganizationFilter,
@@ -221,22 +308,11 @@ func (r *MongoRepo) ListOrganizations(
 			return nil, decodeErr
 		}
 
-		organization, orgErr := domain.NewOrganization(
-			mo.OrgID,
-			mo.Name,
-			mo.Domain,
-			mo.ParentID,
-		)
+		organization, orgErr := mongoToDomain(mo)
 		if orgErr != nil {
 			return nil, orgErr
 		}
 
-		// Restore settings and activation state
-		organization.UpdateSettings(mo.Settings)
-		if !mo.IsActive {
-			organization.Deactivate()
-		}
-
 		organizations = append(organizations, organization)
 	}
 
@@ -253,7 +329,8 @@ func (r *MongoRepo) CountOrganizations(ctx context.Context, filter queries.Organ
 }
 
 func buildOrganizationQuery(filter queries.OrganizationFilter) bson.M {
-	query := bson.M{}
+	// Trashed organizations are listed only through the trash
+	query := bson.M{"deleted_at": nil}
 
 	if filter.ParentID != nil {
 		query["parent_id"] = *filter.ParentID
@@ -304,21 +381,28 @@ func (r *MongoRepo) GetOrganizationHierarchy(
 	return tree, nil
 }
 
-func (r *MongoRepo) DeleteOrganization(ctx context.Context, orgID uuid.UUID) error {
+// DeleteOrganization moves an organization to the trash.
+// Trashed organizations are hidden from all other queries until they are restored or purged.
+func (r *MongoRepo) DeleteOrganization(ctx context.Context, orgID, deletedBy uuid.UUID) error {
 	// Check if organization has children
-	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": orgID})
+	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": orgID, "deleted_at": nil})
 	if err != nil {
 		return err
 	}
 	if childCount > 0 {
-		return errors.New("cannot delete organization with children")
+		return fmt.Errorf("%w: cannot delete organization with children", domain.ErrOrganizationHasChildren)
 	}
 
-	result, err := r.collection.DeleteOne(ctx, bson.M{"organization_id": orgID})
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"organization_id": orgID, "deleted_at": nil},
+		bson.M{
+			"$set": bson.M{"deleted_at": time.Now(), "deleted_by": deletedBy},
+			"$inc": bson.M{"version": 1},
+		})
 	if err != nil {
 		return err
 	}
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return domain.ErrOrganizationNotFound
 	}
 
@@ -343,7 +427,7 @@ func (r *MongoRepo) checkCircularReference(ctx context.Context, orgID, newParent
 		}
 
 		var mo mongoOrganization
-		err := r.collection.FindOne(ctx, bson.M{"organization_id": currentID}).Decode(&mo)
+		err := r.collection.FindOne(ctx, bson.M{"organization_id": currentID, "deleted_at": nil}).Decode(&mo)
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			break
 		}
@@ -362,7 +446,7 @@ func (r *MongoRepo) checkCircularReference(ctx context.Context, orgID, newParent
 
 // getChildOrganizations returns immediate children of an organization
 func (r *MongoRepo) getChildOrganizations(ctx context.Context, parentID uuid.UUID) ([]*domain.Organization, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{"parent_id": parentID})
+	cursor, err := r.collection.Find(ctx, bson.M{"parent_id": parentID, "deleted_at": nil})
 	if err != nil {
 		return nil, err
 	}
@@ -375,22 +459,11 @@ func (r *MongoRepo) getChildOrganizations(ctx context.Context, parentID uuid.UUI
 			return nil, decodeErr
 		}
 
-		organization, orgErr := domain.NewOrganization(
-			mo.OrgID,
-			mo.Name,
-			mo.Domain,
-			mo.ParentID,
-		)
+		organization, orgErr := mongoToDomain(mo)
 		if orgErr != nil {
 			return nil, orgErr
 		}
 
-		// Restore settings and activation state
-		organization.UpdateSettings(mo.Settings)
-		if !mo.IsActive {
-			organization.Deactivate()
-		}
-
 		organizations = append(organizations, organization)
 	}
 
@@ -400,3 +473,127 @@ func (r *MongoRepo) getChildOrganizations(ctx context.Context, parentID uuid.UUI
 
 	return organizations, nil
 }
+
+// mongoToDomain restores an organization aggregate from its stored document
+func mongoToDomain(mo mongoOrganization) (*domain.Organization, error) {
+	organization, err := domain.NewOrganization(
+		mo.OrgID,
+		mo.Name,
+		mo.Domain,
+		mo.ParentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore settings and activation state
+	organization.UpdateSettings(mo.Settings)
+	if !mo.IsActive {
+		organization.Deactivate()
+	}
+
+	if mo.Workflow != nil {
+		workflow, workflowErr := mongoToWorkflow(mo.Workflow)
+		if workflowErr != nil {
+			return nil, workflowErr
+		}
+		if workflowErr = organization.SetWorkflow(workflow); workflowErr != nil {
+			return nil, workflowErr
+		}
+	}
+
+	if mo.SLA != nil {
+		config, slaErr := mongoToSLAConfig(mo.SLA)
+		if slaErr != nil {
+			return nil, slaErr
+		}
+		if slaErr = organization.SetSLAConfig(config); slaErr != nil {
+			return nil, slaErr
+		}
+	}
+
+	if mo.Assignment != nil {
+		config, assignmentErr := mongoToAssignmentConfig(mo.Assignment)
+		if assignmentErr != nil {
+			return nil, assignmentErr
+		}
+		if assignmentErr = organization.SetAssignmentConfig(config); assignmentErr != nil {
+			return nil, assignmentErr
+		}
+	}
+	if len(mo.PriorityMatrix) > 0 {
+		matrix, matrixErr := mongoToPriorityMatrix(mo.PriorityMatrix)
+		if matrixErr != nil {
+			return nil, matrixErr
+		}
+		if matrixErr = organization.SetPriorityMatrix(matrix); matrixErr != nil {
+			return nil, matrixErr
+		}
+	}
+	organization.RestoreAutoAssignmentTurns(mo.AutoAssignmentTurns)
+	organization.RestoreTags(mongoToTags(mo.Tags))
+	organization.RestoreVersion(mo.Version)
+	if mo.DeletedAt != nil && mo.DeletedBy != nil {
+		organization.MarkDeleted(*mo.DeletedBy, *mo.DeletedAt)
+	}
+
+	return organization, nil
+}
+
+// workflowToMongo returns nil for organizations that use the default workflow
+func workflowToMongo(organization *domain.Organization) *mongoWorkflow {
+	if !organization.HasCustomWorkflow() {
+		return nil
+	}
+
+	workflow := organization.Workflow()
+	mw := &mongoWorkflow{
+		Statuses:    make([]mongoWorkflowStatus, 0, len(workflow.Statuses())),
+		Transitions: make([]mongoWorkflowTransition, 0, len(workflow.Transitions())),
+	}
+	for _, status := range workflow.Statuses() {
+		mw.Statuses = append(mw.Statuses, mongoWorkflowStatus{
+			Key:      status.Key.String(),
+			Name:     status.Name,
+			Category: status.Category.String(),
+		})
+	}
+	for _, transition := range workflow.Transitions() {
+		roles := make([]string, 0, len(transition.AllowedRoles))
+		for _, role := range transition.AllowedRoles {
+			roles = append(roles, string(role))
+		}
+		mw.Transitions = append(mw.Transitions, mongoWorkflowTransition{
+			From:         transition.From.String(),
+			To:           transition.To.String(),
+			AllowedRoles: roles,
+		})
+	}
+	return mw
+}
+
+func mongoToWorkflow(mw *mongoWorkflow) (*tickets.Workflow, error) {
+	statuses := make([]tickets.WorkflowStatus, 0, len(mw.Statuses))
+	for _, status := range mw.Statuses {
+		statuses = append(statuses, tickets.WorkflowStatus{
+			Key:      tickets.Status(status.Key),
+			Name:     status.Name,
+			Category: tickets.Status(status.Category),
+		})
+	}
+
+	transitions := make([]tickets.WorkflowTransition, 0, len(mw.Transitions))
+	for _, transition := range mw.Transitions {
+		roles := make([]users.Role, 0, len(transition.AllowedRoles))
+		for _, role := range transition.AllowedRoles {
+			roles = append(roles, users.Role(role))
+		}
+		transitions = append(transitions, tickets.WorkflowTransition{
+			From:         tickets.Status(transition.From),
+			To:           tickets.Status(transition.To),
+			AllowedRoles: roles,
+		})
+	}
+
+	return tickets.NewWorkflow(statuses, transitions)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	"simpleservicedesk/internal/application"
 	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
 	organizationsInfra "simpleservicedesk/internal/infrastructure/organizations"
 	"simpleservicedesk/internal/queries"
 )
@@ -176,6 +178,173 @@ func (s *MongoRepoSuite) TestUpdateOrganization() {
 	s.Equal(newDomain, fetchedOrg.Domain())
 }
 
+func (s *MongoRepoSuite) TestUpdateOrganizationAssignment() {
+	ctx := context.Background()
+
+	org, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Assignment Org", "assignment.com")
+	})
+	s.Require().NoError(err)
+
+	agentID, categoryID := uuid.New(), uuid.New()
+	config, err := tickets.NewAssignmentConfig(tickets.AssignmentCategory, []uuid.UUID{agentID},
+		[]tickets.CategoryAssignment{{CategoryID: categoryID, AgentIDs: []uuid.UUID{agentID}}})
+	s.Require().NoError(err)
+
+	updatedOrg, err := s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		return true, o.SetAssignmentConfig(config)
+	})
+	s.Require().NoError(err)
+
+	// Concurrent assignments must never share a turn
+	const assignments = 8
+	turns := make(chan int64, assignments)
+	var wg sync.WaitGroup
+	for range assignments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			turn, turnErr := s.repo.TakeAutoAssignmentTurn(ctx, org.ID())
+			s.NoError(turnErr)
+			turns <- turn
+		}()
+	}
+	wg.Wait()
+	close(turns)
+	taken := make(map[int64]bool, assignments)
+	for turn := range turns {
+		taken[turn] = true
+	}
+	s.Len(taken, assignments)
+	_, err = s.repo.TakeAutoAssignmentTurn(ctx, uuid.New())
+	s.Require().ErrorIs(err, domain.ErrOrganizationNotFound)
+
+	fetchedOrg, err := s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.Require().NotNil(fetchedOrg.AssignmentConfig())
+	s.Equal(tickets.AssignmentCategory, fetchedOrg.AssignmentConfig().Strategy())
+	s.Equal([]uuid.UUID{agentID}, fetchedOrg.AssignmentConfig().AgentPool(&categoryID))
+	s.Equal(int64(assignments), fetchedOrg.AutoAssignmentTurns())
+	s.Equal(updatedOrg.Version(), fetchedOrg.Version(), "round-robin turns do not bump the version")
+
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		o.ResetAssignmentConfig()
+		return true, nil
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err = s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.Nil(fetchedOrg.AssignmentConfig())
+}
+
+func (s *MongoRepoSuite) TestUpdateOrganizationPriorityMatrix() {
+	ctx := context.Background()
+
+	org, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Matrix Org", "matrix.com")
+	})
+	s.Require().NoError(err)
+
+	cells := tickets.DefaultPriorityMatrix().Cells()
+	cells[0].Priority = tickets.PriorityHigh
+	matrix, err := tickets.NewPriorityMatrix(cells)
+	s.Require().NoError(err)
+
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		return true, o.SetPriorityMatrix(matrix)
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err := s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.True(fetchedOrg.HasCustomPriorityMatrix())
+	s.Equal(cells, fetchedOrg.PriorityMatrix().Cells())
+
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		o.ResetPriorityMatrix()
+		return true, nil
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err = s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.False(fetchedOrg.HasCustomPriorityMatrix())
+}
+
+func (s *MongoRepoSuite) TestUpdateOrganizationSettings() {
+	ctx := context.Background()
+
+	org, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Settings Org", "settings.com")
+	})
+	s.Require().NoError(err)
+
+	settings := org.Settings()
+	settings.AutoCloseAfter = 48 * time.Hour
+	settings.MaxFileSize = 1024
+	settings.TicketKeyPrefix = "SET"
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		o.UpdateSettings(settings)
+		return true, nil
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err := s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.Equal(settings, fetchedOrg.Settings())
+
+	s.Run("Ticket key prefix of another organization", func() {
+		other, createErr := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+			return domain.CreateRootOrganization("Other Settings Org", "other-settings.com")
+		})
+		s.Require().NoError(createErr)
+
+		_, err = s.repo.UpdateOrganization(ctx, other.ID(), func(o *domain.Organization) (bool, error) {
+			o.UpdateSettings(settings)
+			return true, nil
+		})
+		s.Require().ErrorIs(err, domain.ErrKeyPrefixTaken)
+
+		// The organization keeps its own prefix when other settings change
+		settings.MaxFileSize = 2048
+		_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+			o.UpdateSettings(settings)
+			return true, nil
+		})
+		s.Require().NoError(err)
+	})
+}
+
+func (s *MongoRepoSuite) TestUpdateOrganizationTags() {
+	ctx := context.Background()
+
+	org, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Tags Org", "tags.com")
+	})
+	s.Require().NoError(err)
+
+	vip, err := tickets.NewTag("vip", "#FFD700", "Key accounts")
+	s.Require().NoError(err)
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		return true, o.AddTag(vip)
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err := s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.Equal([]tickets.Tag{vip}, fetchedOrg.Tags())
+
+	_, err = s.repo.UpdateOrganization(ctx, org.ID(), func(o *domain.Organization) (bool, error) {
+		return true, o.RemoveTag("vip")
+	})
+	s.Require().NoError(err)
+
+	fetchedOrg, err = s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+	s.Empty(fetchedOrg.Tags())
+}
+
 func (s *MongoRepoSuite) TestUpdateOrganizationParent() {
 	ctx := context.Background()
 
@@ -383,7 +552,7 @@ func (s *MongoRepoSuite) TestDeleteOrganization() {
 	s.Require().NoError(err)
 
 	// Delete organization
-	err = s.repo.DeleteOrganization(ctx, org.ID())
+	err = s.repo.DeleteOrganization(ctx, org.ID(), uuid.New())
 	s.Require().NoError(err)
 
 	// Verify deletion
@@ -406,11 +575,38 @@ func (s *MongoRepoSuite) TestDeleteOrganizationWithChildren() {
 	s.Require().NoError(err)
 
 	// Try to delete parent with children
-	err = s.repo.DeleteOrganization(ctx, parent.ID())
+	err = s.repo.DeleteOrganization(ctx, parent.ID(), uuid.New())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "cannot delete organization with children")
 }
 
+func (s *MongoRepoSuite) TestRestoreAndPurgeOrganization() {
+	ctx := context.Background()
+
+	org, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Trash Test", "trash.com")
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.DeleteOrganization(ctx, org.ID(), uuid.New()))
+
+	// The name is free again while the organization is in the trash
+	twin, err := s.repo.CreateOrganization(ctx, func() (*domain.Organization, error) {
+		return domain.CreateRootOrganization("Trash Test", "trash.org")
+	})
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.repo.RestoreOrganization(ctx, org.ID()), domain.ErrOrganizationAlreadyExist)
+
+	s.Require().NoError(s.repo.DeleteOrganization(ctx, twin.ID(), uuid.New()))
+	s.Require().NoError(s.repo.RestoreOrganization(ctx, org.ID()))
+	s.Require().NoError(s.repo.PurgeOrganization(ctx, twin.ID()))
+
+	count, err := s.repo.CountDeletedOrganizations(ctx, queries.TrashFilter{})
+	s.Require().NoError(err)
+	s.Zero(count)
+	_, err = s.repo.GetOrganization(ctx, org.ID())
+	s.Require().NoError(err)
+}
+
 func (s *MongoRepoSuite) TestGetOrganizationNotFound() {
 	ctx := context.Background()
 	nonExistentID := uuid.New()
@@ -435,7 +631,7 @@ func (s *MongoRepoSuite) TestDeleteOrganizationNotFound() {
 	ctx := context.Background()
 	nonExistentID := uuid.New()
 
-	err := s.repo.DeleteOrganization(ctx, nonExistentID)
+	err := s.repo.DeleteOrganization(ctx, nonExistentID, uuid.New())
 	s.Require().Error(err)
 	s.ErrorIs(err, domain.ErrOrganizationNotFound)
 }
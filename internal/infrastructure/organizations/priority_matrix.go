@@ -0,0 +1,43 @@
+package organizations
+
+import (
+	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+type mongoPriorityMatrixCell struct {
+	Impact   string `bson:"impact"`
+	Urgency  string `bson:"urgency"`
+	Priority string `bson:"priority"`
+}
+
+// priorityMatrixToMongo returns nil for organizations that use the default priority matrix
+func priorityMatrixToMongo(organization *domain.Organization) []mongoPriorityMatrixCell {
+	if !organization.HasCustomPriorityMatrix() {
+		return nil
+	}
+
+	cells := organization.PriorityMatrix().Cells()
+	result := make([]mongoPriorityMatrixCell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, mongoPriorityMatrixCell{
+			Impact:   cell.Impact.String(),
+			Urgency:  cell.Urgency.String(),
+			Priority: cell.Priority.String(),
+		})
+	}
+	return result
+}
+
+func mongoToPriorityMatrix(cells []mongoPriorityMatrixCell) (*tickets.PriorityMatrix, error) {
+	result := make([]tickets.PriorityMatrixCell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, tickets.PriorityMatrixCell{
+			Impact:   tickets.Impact(cell.Impact),
+			Urgency:  tickets.Urgency(cell.Urgency),
+			Priority: tickets.Priority(cell.Priority),
+		})
+	}
+
+	return tickets.NewPriorityMatrix(result)
+}
@@ -0,0 +1,155 @@
+package organizations
+
+import (
+	"time"
+
+	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+type mongoSLAConfig struct {
+	Calendar    *mongoCalendar        `bson:"calendar,omitempty"`
+	Policies    []mongoSLAPolicy      `bson:"policies"`
+	Escalations []mongoEscalationRule `bson:"escalations,omitempty"`
+}
+
+type mongoSLAPolicy struct {
+	ID                   uuid.UUID  `bson:"id"`
+	Name                 string     `bson:"name"`
+	CategoryID           *uuid.UUID `bson:"category_id,omitempty"`
+	Priority             string     `bson:"priority,omitempty"`
+	FirstResponseMinutes int64      `bson:"first_response_minutes"`
+	ResolutionMinutes    int64      `bson:"resolution_minutes"`
+	AroundTheClock       bool       `bson:"around_the_clock"`
+}
+
+type mongoEscalationRule struct {
+	ID            uuid.UUID  `bson:"id"`
+	Name          string     `bson:"name"`
+	Target        string     `bson:"target"`
+	BeforeMinutes int64      `bson:"before_minutes"`
+	RaisePriority bool       `bson:"raise_priority"`
+	AssignTo      *uuid.UUID `bson:"assign_to,omitempty"`
+	Comment       string     `bson:"comment,omitempty"`
+}
+
+type mongoCalendar struct {
+	Timezone     string              `bson:"timezone"`
+	WorkingHours []mongoWorkingHours `bson:"working_hours"`
+	Holidays     []string            `bson:"holidays,omitempty"`
+}
+
+type mongoWorkingHours struct {
+	Weekday      int   `bson:"weekday"`
+	StartMinutes int64 `bson:"start_minutes"`
+	EndMinutes   int64 `bson:"end_minutes"`
+}
+
+// slaConfigToMongo returns nil for organizations that use the default SLA targets
+func slaConfigToMongo(organization *domain.Organization) *mongoSLAConfig {
+	config := organization.SLAConfig()
+	if config == nil {
+		return nil
+	}
+
+	ms := &mongoSLAConfig{
+		Calendar: calendarToMongo(config.Calendar()),
+		Policies: make([]mongoSLAPolicy, 0, len(config.Policies())),
+	}
+	for _, policy := range config.Policies() {
+		ms.Policies = append(ms.Policies, mongoSLAPolicy{
+			ID:                   policy.ID,
+			Name:                 policy.Name,
+			CategoryID:           policy.CategoryID,
+			Priority:             policy.Priority.String(),
+			FirstResponseMinutes: int64(policy.FirstResponse / time.Minute),
+			ResolutionMinutes:    int64(policy.Resolution / time.Minute),
+			AroundTheClock:       policy.AroundTheClock,
+		})
+	}
+	for _, rule := range config.EscalationRules() {
+		ms.Escalations = append(ms.Escalations, mongoEscalationRule{
+			ID:            rule.ID,
+			Name:          rule.Name,
+			Target:        string(rule.Target),
+			BeforeMinutes: int64(rule.Before / time.Minute),
+			RaisePriority: rule.RaisePriority,
+			AssignTo:      rule.AssignTo,
+			Comment:       rule.Comment,
+		})
+	}
+	return ms
+}
+
+func mongoToSLAConfig(ms *mongoSLAConfig) (*tickets.SLAConfig, error) {
+	var calendar *tickets.BusinessCalendar
+	if ms.Calendar != nil {
+		var err error
+		if calendar, err = mongoToCalendar(ms.Calendar); err != nil {
+			return nil, err
+		}
+	}
+
+	policies := make([]tickets.SLAPolicy, 0, len(ms.Policies))
+	for _, policy := range ms.Policies {
+		policies = append(policies, tickets.SLAPolicy{
+			ID:             policy.ID,
+			Name:           policy.Name,
+			CategoryID:     policy.CategoryID,
+			Priority:       tickets.Priority(policy.Priority),
+			FirstResponse:  time.Duration(policy.FirstResponseMinutes) * time.Minute,
+			Resolution:     time.Duration(policy.ResolutionMinutes) * time.Minute,
+			AroundTheClock: policy.AroundTheClock,
+		})
+	}
+
+	escalations := make([]tickets.EscalationRule, 0, len(ms.Escalations))
+	for _, rule := range ms.Escalations {
+		escalations = append(escalations, tickets.EscalationRule{
+			ID:            rule.ID,
+			Name:          rule.Name,
+			Target:        tickets.SLATarget(rule.Target),
+			Before:        time.Duration(rule.BeforeMinutes) * time.Minute,
+			RaisePriority: rule.RaisePriority,
+			AssignTo:      rule.AssignTo,
+			Comment:       rule.Comment,
+		})
+	}
+
+	return tickets.NewSLAConfig(calendar, policies, escalations)
+}
+
+func calendarToMongo(calendar *tickets.BusinessCalendar) *mongoCalendar {
+	if calendar == nil {
+		return nil
+	}
+
+	mc := &mongoCalendar{
+		Timezone:     calendar.Timezone(),
+		WorkingHours: make([]mongoWorkingHours, 0, len(calendar.WorkingHours())),
+		Holidays:     calendar.Holidays(),
+	}
+	for _, wh := range calendar.WorkingHours() {
+		mc.WorkingHours = append(mc.WorkingHours, mongoWorkingHours{
+			Weekday:      int(wh.Weekday),
+			StartMinutes: int64(wh.Start / time.Minute),
+			EndMinutes:   int64(wh.End / time.Minute),
+		})
+	}
+	return mc
+}
+
+func mongoToCalendar(mc *mongoCalendar) (*tickets.BusinessCalendar, error) {
+	hours := make([]tickets.WorkingHours, 0, len(mc.WorkingHours))
+	for _, wh := range mc.WorkingHours {
+		hours = append(hours, tickets.WorkingHours{
+			Weekday: time.Weekday(wh.Weekday),
+			Start:   time.Duration(wh.StartMinutes) * time.Minute,
+			End:     time.Duration(wh.EndMinutes) * time.Minute,
+		})
+	}
+
+	return tickets.NewBusinessCalendar(mc.Timezone, hours, mc.Holidays)
+}
@@ -0,0 +1,41 @@
+package organizations
+
+import (
+	"simpleservicedesk/internal/domain/tickets"
+)
+
+type mongoTag struct {
+	Name        string `bson:"name"`
+	Color       string `bson:"color"`
+	Description string `bson:"description,omitempty"`
+}
+
+func tagsToMongo(tags []tickets.Tag) []mongoTag {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]mongoTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, mongoTag{
+			Name:        tag.Name,
+			Color:       tag.Color,
+			Description: tag.Description,
+		})
+	}
+	return result
+}
+
+func mongoToTags(mts []mongoTag) []tickets.Tag {
+	if len(mts) == 0 {
+		return nil
+	}
+	result := make([]tickets.Tag, 0, len(mts))
+	for _, mt := range mts {
+		result = append(result, tickets.Tag{
+			Name:        mt.Name,
+			Color:       mt.Color,
+			Description: mt.Description,
+		})
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain "simpleservicedesk/internal/domain/organizations"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ListDeletedOrganizations retrieves trashed organizations matching the filter
+func (r *MongoRepo) ListDeletedOrganizations(
+	ctx context.Context,
+	filter queries.TrashFilter,
+) ([]*domain.Organization, error) {
+	sortOrder := -1 // default: the most recently deleted first
+	if filter.SortOrder == "asc" {
+		sortOrder = 1
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, buildTrashQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var organizations []*domain.Organization
+	for cursor.Next(ctx) {
+		var mo mongoOrganization
+		if decodeErr := cursor.Decode(&mo); decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		organization, orgErr := mongoToDomain(mo)
+		if orgErr != nil {
+			return nil, orgErr
+		}
+		organizations = append(organizations, organization)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+
+	return organizations, nil
+}
+
+// CountDeletedOrganizations counts trashed organizations matching the filter
+func (r *MongoRepo) CountDeletedOrganizations(ctx context.Context, filter queries.TrashFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, buildTrashQuery(filter))
+}
+
+// RestoreOrganization moves an organization out of the trash.
+// The parent must not be trashed and no active organization may have taken the name.
+func (r *MongoRepo) RestoreOrganization(ctx context.Context, orgID uuid.UUID) error {
+	var mo mongoOrganization
+	err := r.collection.FindOne(ctx, bson.M{"organization_id": orgID, "deleted_at": bson.M{"$ne": nil}}).Decode(&mo)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if mo.ParentID != nil {
+		parentCount, parentErr := r.collection.CountDocuments(ctx, bson.M{
+			"organization_id": *mo.ParentID,
+			"deleted_at":      nil,
+		})
+		if parentErr != nil {
+			return parentErr
+		}
+		if parentCount == 0 {
+			return domain.ErrParentInTrash
+		}
+	}
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"name": mo.Name, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrOrganizationAlreadyExist
+	}
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"organization_id": orgID, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{
+			"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+			"$inc":   bson.M{"version": 1},
+		})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrOrganizationNotFound
+	}
+
+	return nil
+}
+
+// PurgeOrganization permanently removes a trashed organization.
+// An organization with child organizations, trashed or not, cannot be purged.
+func (r *MongoRepo) PurgeOrganization(ctx context.Context, orgID uuid.UUID) error {
+	childCount, err := r.collection.CountDocuments(ctx, bson.M{"parent_id": orgID})
+	if err != nil {
+		return err
+	}
+	if childCount > 0 {
+		return fmt.Errorf("%w: purge the child organizations first", domain.ErrOrganizationHasChildren)
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"organization_id": orgID, "deleted_at": bson.M{"$ne": nil}})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrOrganizationNotFound
+	}
+
+	return nil
+}
+
+// buildTrashQuery ignores the organization filter: it narrows tickets and categories only
+func buildTrashQuery(filter queries.TrashFilter) bson.M {
+	deletedQuery := bson.M{"$ne": nil}
+	if filter.DeletedBefore != nil {
+		deletedQuery["$lt"] = *filter.DeletedBefore
+	}
+	return bson.M{"deleted_at": deletedQuery}
+}
@@ -0,0 +1,267 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/infrastructure/optimistic"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTemplate struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	TemplateID     uuid.UUID          `bson:"template_id"`
+	Name           string             `bson:"name"`
+	Title          string             `bson:"title"`
+	Description    string             `bson:"description"`
+	Priority       string             `bson:"priority"`
+	OrganizationID uuid.UUID          `bson:"organization_id"`
+	CategoryID     *uuid.UUID         `bson:"category_id,omitempty"`
+	AssigneeID     *uuid.UUID         `bson:"assignee_id,omitempty"`
+	Schedule       mongoSchedule      `bson:"schedule"`
+	IsActive       bool               `bson:"is_active"`
+	NextRunAt      *time.Time         `bson:"next_run_at"`
+	LastRunAt      *time.Time         `bson:"last_run_at,omitempty"`
+	LastTicketID   *uuid.UUID         `bson:"last_ticket_id,omitempty"`
+	CreatedBy      uuid.UUID          `bson:"created_by"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+	Version        int64              `bson:"version"`
+}
+
+type mongoSchedule struct {
+	Type       string    `bson:"type"`
+	Expression string    `bson:"expression"`
+	Timezone   string    `bson:"timezone"`
+	StartAt    time.Time `bson:"start_at"`
+}
+
+// MongoRepo stores recurring ticket templates together with their run state
+type MongoRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepo(db *mongo.Database) *MongoRepo {
+	collection := db.Collection("recurring_templates")
+
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "template_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "organization_id", Value: 1}}},
+		{Keys: bson.D{{Key: "is_active", Value: 1}, {Key: "next_run_at", Value: 1}}},
+	}
+	_, _ = collection.Indexes().CreateMany(context.Background(), indexes)
+
+	return &MongoRepo{collection: collection}
+}
+
+func (r *MongoRepo) CreateTemplate(
+	ctx context.Context,
+	createFn func() (*domain.Template, error),
+) (*domain.Template, error) {
+	template, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+
+	mt := templateToMongo(template)
+	mt.Version = 1
+	if _, err = r.collection.InsertOne(ctx, mt); err != nil {
+		return nil, err
+	}
+	template.RestoreVersion(mt.Version)
+	return template, nil
+}
+
+func (r *MongoRepo) GetTemplate(ctx context.Context, id uuid.UUID) (*domain.Template, error) {
+	var mt mongoTemplate
+	err := r.collection.FindOne(ctx, bson.M{"template_id": id}).Decode(&mt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mongoToTemplate(mt)
+}
+
+// UpdateTemplate updates a template in MongoDB.
+// The write applies only if the template still has the version it was loaded with; when a concurrent
+// write got there first, the template is reloaded and updateFn runs again on the fresh state.
+func (r *MongoRepo) UpdateTemplate(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.Template) (bool, error),
+) (*domain.Template, error) {
+	return optimistic.Retry(ctx, func(ctx context.Context) (*domain.Template, error) {
+		return r.updateTemplate(ctx, id, updateFn)
+	}, domain.ErrConcurrentUpdate)
+}
+
+func (r *MongoRepo) updateTemplate(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.Template) (bool, error),
+) (*domain.Template, error) {
+	template, err := r.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	version := template.Version()
+
+	updated, err := updateFn(template)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return template, nil
+	}
+
+	mt := templateToMongo(template)
+	update := bson.M{"$set": bson.M{
+		"name":            mt.Name,
+		"title":           mt.Title,
+		"description":     mt.Description,
+		"priority":        mt.Priority,
+		"organization_id": mt.OrganizationID,
+		"category_id":     mt.CategoryID,
+		"assignee_id":     mt.AssigneeID,
+		"schedule":        mt.Schedule,
+		"is_active":       mt.IsActive,
+		"next_run_at":     mt.NextRunAt,
+		"last_run_at":     mt.LastRunAt,
+		"last_ticket_id":  mt.LastTicketID,
+		"updated_at":      mt.UpdatedAt,
+		"version":         version + 1,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"template_id": id, "version": version}, update)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, optimistic.ErrStaleVersion
+	}
+	template.RestoreVersion(version + 1)
+	return template, nil
+}
+
+// ListTemplates returns templates ordered by name, or by next run when only due templates are requested
+func (r *MongoRepo) ListTemplates(
+	ctx context.Context,
+	filter queries.RecurringTemplateFilter,
+) ([]*domain.Template, error) {
+	query := bson.M{}
+	if filter.OrganizationID != nil {
+		query["organization_id"] = *filter.OrganizationID
+	}
+	if filter.IsActive != nil {
+		query["is_active"] = *filter.IsActive
+	}
+	sort := bson.D{{Key: "name", Value: 1}}
+	if filter.DueBefore != nil {
+		query["is_active"] = true
+		query["next_run_at"] = bson.M{"$lte": *filter.DueBefore}
+		sort = bson.D{{Key: "next_run_at", Value: 1}}
+	}
+
+	cursor, err := r.collection.Find(ctx, query, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var templates []*domain.Template
+	for cursor.Next(ctx) {
+		var mt mongoTemplate
+		if decodeErr := cursor.Decode(&mt); decodeErr != nil {
+			return nil, decodeErr
+		}
+		template, templateErr := mongoToTemplate(mt)
+		if templateErr != nil {
+			return nil, templateErr
+		}
+		templates = append(templates, template)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+	return templates, nil
+}
+
+func (r *MongoRepo) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"template_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrTemplateNotFound
+	}
+	return nil
+}
+
+func templateToMongo(template *domain.Template) mongoTemplate {
+	draft := template.Draft()
+	schedule := template.Schedule()
+	return mongoTemplate{
+		TemplateID:     template.ID(),
+		Name:           template.Name(),
+		Title:          draft.Title,
+		Description:    draft.Description,
+		Priority:       draft.Priority.String(),
+		OrganizationID: draft.OrganizationID,
+		CategoryID:     draft.CategoryID,
+		AssigneeID:     draft.AssigneeID,
+		Schedule: mongoSchedule{
+			Type:       schedule.Type().String(),
+			Expression: schedule.Expression(),
+			Timezone:   schedule.Timezone(),
+			StartAt:    schedule.StartAt(),
+		},
+		IsActive:     template.IsActive(),
+		NextRunAt:    template.NextRunAt(),
+		LastRunAt:    template.LastRunAt(),
+		LastTicketID: template.LastTicketID(),
+		CreatedBy:    template.CreatedBy(),
+		CreatedAt:    template.CreatedAt(),
+		UpdatedAt:    template.UpdatedAt(),
+		Version:      template.Version(),
+	}
+}
+
+func mongoToTemplate(mt mongoTemplate) (*domain.Template, error) {
+	schedule, err := domain.NewSchedule(
+		domain.ScheduleType(mt.Schedule.Type),
+		mt.Schedule.Expression,
+		mt.Schedule.Timezone,
+		mt.Schedule.StartAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := domain.TicketDraft{
+		Title:          mt.Title,
+		Description:    mt.Description,
+		Priority:       tickets.Priority(mt.Priority),
+		OrganizationID: mt.OrganizationID,
+		CategoryID:     mt.CategoryID,
+		AssigneeID:     mt.AssigneeID,
+	}
+	template, err := domain.NewTemplate(mt.TemplateID, mt.Name, draft, schedule, mt.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	template.RestoreRunState(mt.IsActive, mt.NextRunAt, mt.LastRunAt, mt.LastTicketID)
+	template.RestoreTimestamps(mt.CreatedAt, mt.UpdatedAt)
+	template.RestoreVersion(mt.Version)
+	return template, nil
+}
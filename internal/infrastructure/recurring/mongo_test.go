@@ -0,0 +1,168 @@
+package recurring_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	domain "simpleservicedesk/internal/domain/recurring"
+	"simpleservicedesk/internal/domain/tickets"
+	recurringInfra "simpleservicedesk/internal/infrastructure/recurring"
+	"simpleservicedesk/internal/queries"
+)
+
+type MongoRepoSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+	db        *mongo.Database
+	repo      *recurringInfra.MongoRepo
+}
+
+func (s *MongoRepoSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "mongo:latest",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(10 * time.Second),
+	}
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	s.Require().NoError(err)
+	s.container = mongoContainer
+
+	host, err := mongoContainer.Host(ctx)
+	s.Require().NoError(err)
+	port, err := mongoContainer.MappedPort(ctx, "27017")
+	s.Require().NoError(err)
+
+	uri := fmt.Sprintf("mongodb://%s", net.JoinHostPort(host, port.Port()))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	s.Require().NoError(err)
+
+	s.db = client.Database("testdb")
+	s.repo = recurringInfra.NewMongoRepo(s.db)
+}
+
+func (s *MongoRepoSuite) TearDownSuite() {
+	ctx := context.Background()
+	err := s.db.Client().Disconnect(ctx)
+	s.Require().NoError(err)
+	err = s.container.Terminate(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) SetupTest() {
+	s.Require().NoError(s.db.Collection("recurring_templates").Drop(context.Background()))
+}
+
+func (s *MongoRepoSuite) createTemplate(name, expression string, orgID uuid.UUID) *domain.Template {
+	schedule, err := domain.NewSchedule(domain.ScheduleCron, expression, "Europe/Berlin", time.Now())
+	s.Require().NoError(err)
+	assigneeID := uuid.New()
+	template, err := s.repo.CreateTemplate(context.Background(), func() (*domain.Template, error) {
+		return domain.CreateTemplate(name, domain.TicketDraft{
+			Title:          "Check backups",
+			Description:    "Verify last night's backups",
+			Priority:       tickets.PriorityNormal,
+			OrganizationID: orgID,
+			AssigneeID:     &assigneeID,
+		}, schedule, uuid.New())
+	})
+	s.Require().NoError(err)
+	return template
+}
+
+func (s *MongoRepoSuite) TestTemplateCRUD() {
+	ctx := context.Background()
+	created := s.createTemplate("Backups", "0 9 * * MON-FRI", uuid.New())
+	s.Equal(int64(1), created.Version())
+
+	loaded, err := s.repo.GetTemplate(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal("Backups", loaded.Name())
+	s.Equal(created.Draft(), loaded.Draft())
+	s.True(created.Schedule().Equal(loaded.Schedule()))
+	s.True(loaded.IsActive())
+	s.WithinDuration(*created.NextRunAt(), *loaded.NextRunAt(), time.Millisecond)
+
+	updated, err := s.repo.UpdateTemplate(ctx, created.ID(), func(template *domain.Template) (bool, error) {
+		template.Deactivate()
+		return true, nil
+	})
+	s.Require().NoError(err)
+	s.Equal(int64(2), updated.Version())
+
+	loaded, err = s.repo.GetTemplate(ctx, created.ID())
+	s.Require().NoError(err)
+	s.False(loaded.IsActive())
+	s.Nil(loaded.NextRunAt())
+
+	s.Require().NoError(s.repo.DeleteTemplate(ctx, created.ID()))
+	_, err = s.repo.GetTemplate(ctx, created.ID())
+	s.ErrorIs(err, domain.ErrTemplateNotFound)
+	s.ErrorIs(s.repo.DeleteTemplate(ctx, created.ID()), domain.ErrTemplateNotFound)
+}
+
+func (s *MongoRepoSuite) TestRecordRunIsAppliedOnce() {
+	ctx := context.Background()
+	created := s.createTemplate("Hourly", "0 * * * *", uuid.New())
+	scheduledAt := *created.NextRunAt()
+
+	// Two replicas record the same run; the second one sees the run already recorded
+	recorded := 0
+	for range 2 {
+		_, err := s.repo.UpdateTemplate(ctx, created.ID(), func(template *domain.Template) (bool, error) {
+			ok := template.RecordRun(scheduledAt, template.OccurrenceTicketID(scheduledAt), scheduledAt)
+			if ok {
+				recorded++
+			}
+			return ok, nil
+		})
+		s.Require().NoError(err)
+	}
+	s.Equal(1, recorded)
+
+	loaded, err := s.repo.GetTemplate(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal(created.OccurrenceTicketID(scheduledAt), *loaded.LastTicketID())
+	s.WithinDuration(scheduledAt.Add(time.Hour), *loaded.NextRunAt(), time.Millisecond)
+}
+
+func (s *MongoRepoSuite) TestListTemplates() {
+	ctx := context.Background()
+	orgID := uuid.New()
+	s.createTemplate("Leap day", "0 9 29 2 *", orgID)
+	s.createTemplate("Hourly", "0 * * * *", orgID)
+	s.createTemplate("Other org", "0 * * * *", uuid.New())
+
+	names := func(filter queries.RecurringTemplateFilter) []string {
+		templates, err := s.repo.ListTemplates(ctx, filter)
+		s.Require().NoError(err)
+		result := make([]string, 0, len(templates))
+		for _, template := range templates {
+			result = append(result, template.Name())
+		}
+		return result
+	}
+	s.Equal([]string{"Hourly", "Leap day"}, names(queries.RecurringTemplateFilter{OrganizationID: &orgID}))
+	s.Len(names(queries.RecurringTemplateFilter{}), 3)
+
+	soon := time.Now().Add(time.Hour)
+	s.ElementsMatch([]string{"Hourly", "Other org"}, names(queries.RecurringTemplateFilter{DueBefore: &soon}))
+}
+
+func TestMongoRepoSuite(t *testing.T) {
+	suite.Run(t, new(MongoRepoSuite))
+}
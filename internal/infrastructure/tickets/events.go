@@ -0,0 +1,137 @@
+package tickets
+
+import (
+	"context"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoTicketEvent represents the MongoDB document structure for ticket history events
+type mongoTicketEvent struct {
+	EventID    uuid.UUID  `bson:"event_id"`
+	TicketID   uuid.UUID  `bson:"ticket_id"`
+	Type       string     `bson:"type"`
+	ActorID    *uuid.UUID `bson:"actor_id,omitempty"`
+	OldValue   string     `bson:"old_value,omitempty"`
+	NewValue   string     `bson:"new_value,omitempty"`
+	IsInternal bool       `bson:"is_internal"`
+	CreatedAt  time.Time  `bson:"created_at"`
+}
+
+func newEventsCollection(db *mongo.Database) *mongo.Collection {
+	collection := db.Collection("ticket_events")
+
+	ctx := context.Background()
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "event_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "ticket_id", Value: 1}, {Key: "created_at", Value: 1}}},
+	}
+
+	_, _ = collection.Indexes().CreateMany(ctx, indexes)
+
+	return collection
+}
+
+// eventDocuments converts the pending history events of a ticket for storage
+func eventDocuments(events []domain.Event) []mongoTicketEvent {
+	docs := make([]mongoTicketEvent, 0, len(events))
+	for _, event := range events {
+		docs = append(docs, mongoTicketEvent{
+			EventID:    event.ID,
+			TicketID:   event.TicketID,
+			Type:       string(event.Type),
+			ActorID:    event.ActorID,
+			OldValue:   event.OldValue,
+			NewValue:   event.NewValue,
+			IsInternal: event.IsInternal,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+	return docs
+}
+
+// saveEvents stores history events; events already stored under the same ID are left as they are
+func (r *MongoRepo) saveEvents(ctx context.Context, events []mongoTicketEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(events))
+	for _, event := range events {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"event_id": event.EventID}).
+			SetReplacement(event).
+			SetUpsert(true))
+	}
+	_, err := r.events.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// ListTicketEvents retrieves the history of a ticket in chronological order
+func (r *MongoRepo) ListTicketEvents(ctx context.Context, filter queries.TicketEventFilter) ([]domain.Event, error) {
+	opts := options.Find()
+
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	sortOrder := 1 // default: chronological
+	if filter.SortOrder == "desc" {
+		sortOrder = -1
+	}
+	opts.SetSort(bson.D{{Key: "created_at", Value: sortOrder}})
+
+	cursor, err := r.events.Find(ctx, r.buildEventFilterQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []domain.Event
+	for cursor.Next(ctx) {
+		var mongoDoc mongoTicketEvent
+		if err = cursor.Decode(&mongoDoc); err != nil {
+			return nil, err
+		}
+
+		events = append(events, domain.Event{
+			ID:         mongoDoc.EventID,
+			TicketID:   mongoDoc.TicketID,
+			Type:       domain.EventType(mongoDoc.Type),
+			ActorID:    mongoDoc.ActorID,
+			OldValue:   mongoDoc.OldValue,
+			NewValue:   mongoDoc.NewValue,
+			IsInternal: mongoDoc.IsInternal,
+			CreatedAt:  mongoDoc.CreatedAt,
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// CountTicketEvents returns the number of history events matching the filter
+func (r *MongoRepo) CountTicketEvents(ctx context.Context, filter queries.TicketEventFilter) (int64, error) {
+	return r.events.CountDocuments(ctx, r.buildEventFilterQuery(filter))
+}
+
+func (r *MongoRepo) buildEventFilterQuery(filter queries.TicketEventFilter) bson.M {
+	query := bson.M{"ticket_id": filter.TicketID}
+	if !filter.IncludeInternal {
+		query["is_internal"] = false
+	}
+	return query
+}
@@ -0,0 +1,52 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoTicketCounter holds the last ticket number issued with a key prefix
+type mongoTicketCounter struct {
+	Prefix string `bson:"_id"`
+	Seq    int64  `bson:"seq"`
+}
+
+func newCountersCollection(db *mongo.Database) *mongo.Collection {
+	return db.Collection("ticket_counters")
+}
+
+// NextTicketNumber atomically issues the next ticket number for the key prefix, starting with 1.
+// Counters are kept per prefix rather than per organization, so a prefix that changes hands never
+// issues a key twice. Numbers taken by tickets that failed to save are not reused.
+func (r *MongoRepo) NextTicketNumber(ctx context.Context, prefix string) (int64, error) {
+	var counter mongoTicketCounter
+	err := r.counters.FindOneAndUpdate(ctx,
+		bson.M{"_id": prefix},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return 0, err
+	}
+	return counter.Seq, nil
+}
+
+// GetTicketByKey retrieves a ticket by its key such as ACME-1042; trashed tickets are not found
+func (r *MongoRepo) GetTicketByKey(ctx context.Context, key string) (*domain.Ticket, error) {
+	var mongoDoc mongoTicket
+	err := r.collection.FindOne(ctx, bson.M{"key": key, "deleted_at": nil}).Decode(&mongoDoc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrTicketNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mongoToDomain(&mongoDoc)
+}
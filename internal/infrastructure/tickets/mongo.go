@@ -3,9 +3,11 @@ package tickets
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/infrastructure/optimistic"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -17,32 +19,65 @@ import (
 
 // mongoTicket represents the MongoDB document structure for tickets
 type mongoTicket struct {
-	ID             primitive.ObjectID `bson:"_id,omitempty"`
-	TicketID       uuid.UUID          `bson:"ticket_id"`
-	Title          string             `bson:"title"`
-	Description    string             `bson:"description"`
-	Status         string             `bson:"status"`
-	Priority       string             `bson:"priority"`
-	OrganizationID uuid.UUID          `bson:"organization_id"`
-	CategoryID     *uuid.UUID         `bson:"category_id,omitempty"`
-	AuthorID       uuid.UUID          `bson:"author_id"`
-	AssigneeID     *uuid.UUID         `bson:"assignee_id,omitempty"`
-	Comments       []mongoComment     `bson:"comments"`
-	Attachments    []mongoAttachment  `bson:"attachments"`
-	CreatedAt      time.Time          `bson:"created_at"`
-	UpdatedAt      time.Time          `bson:"updated_at"`
-	ResolvedAt     *time.Time         `bson:"resolved_at,omitempty"`
-	ClosedAt       *time.Time         `bson:"closed_at,omitempty"`
+	ID                 primitive.ObjectID `bson:"_id,omitempty"`
+	TicketID           uuid.UUID          `bson:"ticket_id"`
+	Key                string             `bson:"key,omitempty"`
+	Title              string             `bson:"title"`
+	Description        string             `bson:"description"`
+	Status             string             `bson:"status"`
+	StatusCategory     string             `bson:"status_category,omitempty"`
+	Priority           string             `bson:"priority"`
+	Impact             string             `bson:"impact,omitempty"`
+	Urgency            string             `bson:"urgency,omitempty"`
+	PriorityOverridden bool               `bson:"priority_overridden,omitempty"`
+	OrganizationID     uuid.UUID          `bson:"organization_id"`
+	CategoryID         *uuid.UUID         `bson:"category_id,omitempty"`
+	AuthorID           uuid.UUID          `bson:"author_id"`
+	AssigneeID         *uuid.UUID         `bson:"assignee_id,omitempty"`
+	Comments           []mongoComment     `bson:"comments"`
+	Attachments        []mongoAttachment  `bson:"attachments"`
+	CreatedAt          time.Time          `bson:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at"`
+	ResolvedAt         *time.Time         `bson:"resolved_at,omitempty"`
+	ClosedAt           *time.Time         `bson:"closed_at,omitempty"`
+	SLA                *mongoTicketSLA    `bson:"sla,omitempty"`
+	SLAPauses          []mongoSLAPause    `bson:"sla_pauses,omitempty"`
+	RespondedAt        *time.Time         `bson:"first_responded_at,omitempty"`
+	Escalations        []mongoEscalation  `bson:"escalations,omitempty"`
+	AssignmentStrategy string             `bson:"assignment_strategy,omitempty"`
+	MergedIntoID       *uuid.UUID         `bson:"merged_into_id,omitempty"`
+	SplitFromID        *uuid.UUID         `bson:"split_from_id,omitempty"`
+	Relations          []mongoRelation    `bson:"relations,omitempty"`
+	Watchers           []mongoWatcher     `bson:"watchers,omitempty"`
+	Tags               []string           `bson:"tags,omitempty"`
+	CustomFields       map[string]any     `bson:"custom_fields,omitempty"`
+	Survey             *mongoSurvey       `bson:"survey,omitempty"`
+	WorkLogs           []mongoWorkLog     `bson:"worklogs,omitempty"`
+	Version            int64              `bson:"version,omitempty"`
+	SyncPending        bool               `bson:"sync_pending,omitempty"`   // Derived writes are not confirmed yet
+	PendingEvents      []mongoTicketEvent `bson:"pending_events,omitempty"` // History events not stored yet
+	DeletedAt          *time.Time         `bson:"deleted_at,omitempty"`
+	DeletedBy          *uuid.UUID         `bson:"deleted_by,omitempty"`
 }
 
 // mongoComment represents the MongoDB subdocument structure for comments
 type mongoComment struct {
-	ID         uuid.UUID `bson:"id"`
-	TicketID   uuid.UUID `bson:"ticket_id"`
-	AuthorID   uuid.UUID `bson:"author_id"`
-	Content    string    `bson:"content"`
-	IsInternal bool      `bson:"is_internal"`
-	CreatedAt  time.Time `bson:"created_at"`
+	ID         uuid.UUID              `bson:"id"`
+	TicketID   uuid.UUID              `bson:"ticket_id"`
+	AuthorID   uuid.UUID              `bson:"author_id"`
+	Content    string                 `bson:"content"`
+	IsInternal bool                   `bson:"is_internal"`
+	Mentions   []uuid.UUID            `bson:"mentions,omitempty"`
+	CreatedAt  time.Time              `bson:"created_at"`
+	UpdatedAt  *time.Time             `bson:"updated_at,omitempty"`
+	Revisions  []mongoCommentRevision `bson:"revisions,omitempty"`
+}
+
+// mongoCommentRevision represents a previous version of an edited comment
+type mongoCommentRevision struct {
+	Content  string    `bson:"content"`
+	EditedBy uuid.UUID `bson:"edited_by"`
+	EditedAt time.Time `bson:"edited_at"`
 }
 
 // mongoAttachment represents the MongoDB subdocument structure for attachments
@@ -60,6 +95,9 @@ type mongoAttachment struct {
 // MongoRepo implements TicketRepository for MongoDB
 type MongoRepo struct {
 	collection *mongo.Collection
+	events     *mongo.Collection
+	search     *mongo.Collection
+	counters   *mongo.Collection
 }
 
 // NewMongoRepo creates a new MongoDB repository for tickets
@@ -70,20 +108,41 @@ func NewMongoRepo(db *mongo.Database) *MongoRepo {
 	ctx := context.Background()
 	indexes := []mongo.IndexModel{
 		{Keys: bson.D{{Key: "ticket_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{
+			// Tickets of organizations without a key prefix have no key
+			Keys: bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"key": bson.M{"$type": "string"}}),
+		},
 		{Keys: bson.D{{Key: "status", Value: 1}}},
+		{Keys: bson.D{{Key: "status_category", Value: 1}}},
 		{Keys: bson.D{{Key: "priority", Value: 1}}},
 		{Keys: bson.D{{Key: "assignee_id", Value: 1}}},
 		{Keys: bson.D{{Key: "author_id", Value: 1}}},
 		{Keys: bson.D{{Key: "organization_id", Value: 1}}},
 		{Keys: bson.D{{Key: "category_id", Value: 1}}},
+		{Keys: bson.D{{Key: "relations.ticket_id", Value: 1}}},
+		{Keys: bson.D{{Key: "watchers.user_id", Value: 1}}},
+		{Keys: bson.D{{Key: "organization_id", Value: 1}, {Key: "tags", Value: 1}}},
+		{Keys: bson.D{{Key: "custom_fields.$**", Value: 1}}},
 		{Keys: bson.D{{Key: "created_at", Value: -1}}},
 		{Keys: bson.D{{Key: "updated_at", Value: -1}}},
+		{Keys: bson.D{{Key: "deleted_at", Value: 1}}},
+		{Keys: bson.D{{Key: "survey.rated_at", Value: 1}}},
+		{Keys: bson.D{{Key: "worklogs.date", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "sync_pending", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"sync_pending": true}),
+		},
 	}
 
 	_, _ = collection.Indexes().CreateMany(ctx, indexes)
 
 	return &MongoRepo{
 		collection: collection,
+		events:     newEventsCollection(db),
+		search:     newSearchCollection(db),
+		counters:   newCountersCollection(db),
 	}
 }
 
@@ -94,19 +153,27 @@ func (r *MongoRepo) CreateTicket(ctx context.Context, createFn func() (*domain.T
 		return nil, err
 	}
 
+	ticket.RestoreVersion(1)
 	mongoDoc := r.domainToMongo(ticket)
+	mongoDoc.SyncPending = true
+	mongoDoc.PendingEvents = eventDocuments(ticket.PendingEvents())
 	_, err = r.collection.InsertOne(ctx, mongoDoc)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, domain.ErrTicketExists
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	ticket.ClearPendingEvents()
+	r.syncTicket(ctx, ticket, mongoDoc.PendingEvents)
 	return ticket, nil
 }
 
-// GetTicket retrieves a ticket by ID from MongoDB
+// GetTicket retrieves a ticket by ID from MongoDB; trashed tickets are not found
 func (r *MongoRepo) GetTicket(ctx context.Context, ticketID uuid.UUID) (*domain.Ticket, error) {
 	var mongoDoc mongoTicket
-	err := r.collection.FindOne(ctx, bson.M{"ticket_id": ticketID}).Decode(&mongoDoc)
+	err := r.collection.FindOne(ctx, bson.M{"ticket_id": ticketID, "deleted_at": nil}).Decode(&mongoDoc)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrTicketNotFound
 	}
@@ -117,14 +184,26 @@ func (r *MongoRepo) GetTicket(ctx context.Context, ticketID uuid.UUID) (*domain.
 	return r.mongoToDomain(&mongoDoc)
 }
 
-// UpdateTicket updates an existing ticket in MongoDB
+// UpdateTicket updates an existing ticket in MongoDB.
+// The write applies only if the ticket still has the version it was loaded with; when a concurrent
+// write got there first, the ticket is reloaded and updateFn runs again on the fresh state.
 func (r *MongoRepo) UpdateTicket(
 	ctx context.Context,
 	ticketID uuid.UUID,
 	updateFn func(*domain.Ticket) (bool, error),
+) (*domain.Ticket, error) {
+	return optimistic.Retry(ctx, func(ctx context.Context) (*domain.Ticket, error) {
+		return r.updateTicket(ctx, ticketID, updateFn)
+	}, domain.ErrConcurrentUpdate)
+}
+
+func (r *MongoRepo) updateTicket(
+	ctx context.Context,
+	ticketID uuid.UUID,
+	updateFn func(*domain.Ticket) (bool, error),
 ) (*domain.Ticket, error) {
 	var mongoDoc mongoTicket
-	err := r.collection.FindOne(ctx, bson.M{"ticket_id": ticketID}).Decode(&mongoDoc)
+	err := r.collection.FindOne(ctx, bson.M{"ticket_id": ticketID, "deleted_at": nil}).Decode(&mongoDoc)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrTicketNotFound
 	}
@@ -147,48 +226,70 @@ func (r *MongoRepo) UpdateTicket(
 
 	updatedDoc := r.domainToMongo(ticket)
 	update := bson.M{"$set": bson.M{
-		"title":       updatedDoc.Title,
-		"description": updatedDoc.Description,
-		"status":      updatedDoc.Status,
-		"priority":    updatedDoc.Priority,
-		"category_id": updatedDoc.CategoryID,
-		"assignee_id": updatedDoc.AssigneeID,
-		"comments":    updatedDoc.Comments,
-		"attachments": updatedDoc.Attachments,
-		"updated_at":  updatedDoc.UpdatedAt,
-		"resolved_at": updatedDoc.ResolvedAt,
-		"closed_at":   updatedDoc.ClosedAt,
+		"title":               updatedDoc.Title,
+		"description":         updatedDoc.Description,
+		"status":              updatedDoc.Status,
+		"status_category":     updatedDoc.StatusCategory,
+		"priority":            updatedDoc.Priority,
+		"impact":              updatedDoc.Impact,
+		"urgency":             updatedDoc.Urgency,
+		"priority_overridden": updatedDoc.PriorityOverridden,
+		"category_id":         updatedDoc.CategoryID,
+		"assignee_id":         updatedDoc.AssigneeID,
+		"comments":            updatedDoc.Comments,
+		"attachments":         updatedDoc.Attachments,
+		"updated_at":          updatedDoc.UpdatedAt,
+		"resolved_at":         updatedDoc.ResolvedAt,
+		"closed_at":           updatedDoc.ClosedAt,
+		"sla":                 updatedDoc.SLA,
+		"sla_pauses":          updatedDoc.SLAPauses,
+		"first_responded_at":  updatedDoc.RespondedAt,
+		"escalations":         updatedDoc.Escalations,
+		"assignment_strategy": updatedDoc.AssignmentStrategy,
+		"merged_into_id":      updatedDoc.MergedIntoID,
+		"split_from_id":       updatedDoc.SplitFromID,
+		"relations":           updatedDoc.Relations,
+		"watchers":            updatedDoc.Watchers,
+		"tags":                updatedDoc.Tags,
+		"custom_fields":       updatedDoc.CustomFields,
+		"survey":              updatedDoc.Survey,
+		"worklogs":            updatedDoc.WorkLogs,
+		"version":             mongoDoc.Version + 1,
+		"sync_pending":        true,
 	}}
+	events := eventDocuments(ticket.PendingEvents())
+	if len(events) > 0 {
+		update["$push"] = bson.M{"pending_events": bson.M{"$each": events}}
+	}
 
-	_, err = r.collection.UpdateOne(ctx, bson.M{"ticket_id": ticketID}, update)
+	filter := bson.M{"ticket_id": ticketID, "version": mongoDoc.Version, "deleted_at": nil}
+	if mongoDoc.Version == 0 {
+		// Tickets stored before versioning have no version field
+		filter["version"] = bson.M{"$exists": false}
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, err
 	}
+	if result.MatchedCount == 0 {
+		return nil, optimistic.ErrStaleVersion
+	}
+	ticket.RestoreVersion(mongoDoc.Version + 1)
 
+	ticket.ClearPendingEvents()
+	r.syncTicket(ctx, ticket, events)
 	return ticket, nil
 }
 
 // ListTickets retrieves tickets based on filter criteria
 func (r *MongoRepo) ListTickets(ctx context.Context, filter queries.TicketFilter) ([]*domain.Ticket, error) {
-	query := r.buildFilterQuery(filter)
-
-	opts := options.Find()
-
-	// Set limit and offset
-	if filter.Limit > 0 {
-		opts.SetLimit(int64(filter.Limit))
-	}
-	if filter.Offset > 0 {
-		opts.SetSkip(int64(filter.Offset))
+	var cursor *mongo.Cursor
+	var err error
+	if filter.Search != nil {
+		cursor, err = r.findSearchResults(ctx, filter)
+	} else {
+		cursor, err = r.findTickets(ctx, filter)
 	}
-
-	// Set sorting
-	sort := r.buildSortOptions(filter)
-	if len(sort) > 0 {
-		opts.SetSort(sort)
-	}
-
-	cursor, err := r.collection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -221,13 +322,43 @@ func (r *MongoRepo) ListTickets(ctx context.Context, filter queries.TicketFilter
 	}
 
 	// If sorting by priority, we need to re-sort by weight since MongoDB sorted alphabetically
-	if filter.SortBy == "priority" {
+	if filter.SortBy == "priority" && filter.Search == nil {
 		r.sortTicketsByPriority(tickets, filter.SortOrder == "asc")
 	}
 
 	return tickets, nil
 }
 
+// CountTickets counts tickets matching the filter criteria.
+// The overdue filter is not applied because it is evaluated in memory by ListTickets.
+func (r *MongoRepo) CountTickets(ctx context.Context, filter queries.TicketFilter) (int64, error) {
+	if filter.Search != nil {
+		return r.countSearchResults(ctx, filter)
+	}
+	return r.collection.CountDocuments(ctx, r.buildFilterQuery(filter))
+}
+
+// findTickets returns a cursor over the tickets matching the filter in the requested order
+func (r *MongoRepo) findTickets(ctx context.Context, filter queries.TicketFilter) (*mongo.Cursor, error) {
+	opts := options.Find()
+
+	// Set limit and offset
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	// Set sorting
+	sort := r.buildSortOptions(filter)
+	if len(sort) > 0 {
+		opts.SetSort(sort)
+	}
+
+	return r.collection.Find(ctx, r.buildFilterQuery(filter), opts)
+}
+
 // sortTicketsByPriority sorts tickets by priority weight
 func (r *MongoRepo) sortTicketsByPriority(tickets []*domain.Ticket, ascending bool) {
 	for i := range len(tickets) - 1 {
@@ -246,13 +377,19 @@ func (r *MongoRepo) sortTicketsByPriority(tickets []*domain.Ticket, ascending bo
 	}
 }
 
-// DeleteTicket removes a ticket from MongoDB
-func (r *MongoRepo) DeleteTicket(ctx context.Context, ticketID uuid.UUID) error {
-	result, err := r.collection.DeleteOne(ctx, bson.M{"ticket_id": ticketID})
+// DeleteTicket moves a ticket to the trash.
+// Trashed tickets are hidden from all other queries until they are restored or purged.
+func (r *MongoRepo) DeleteTicket(ctx context.Context, ticketID, deletedBy uuid.UUID) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"ticket_id": ticketID, "deleted_at": nil},
+		bson.M{
+			"$set": bson.M{"deleted_at": time.Now(), "deleted_by": deletedBy},
+			"$inc": bson.M{"version": 1},
+		})
 	if err != nil {
 		return err
 	}
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return domain.ErrTicketNotFound
 	}
 	return nil
@@ -263,13 +400,25 @@ func (r *MongoRepo) DeleteTicket(ctx context.Context, ticketID uuid.UUID) error
 func (r *MongoRepo) domainToMongo(ticket *domain.Ticket) *mongoTicket {
 	var comments []mongoComment
 	for _, comment := range ticket.Comments() {
+		var revisions []mongoCommentRevision
+		for _, revision := range comment.Revisions {
+			revisions = append(revisions, mongoCommentRevision{
+				Content:  revision.Content,
+				EditedBy: revision.EditedBy,
+				EditedAt: revision.EditedAt,
+			})
+		}
+
 		comments = append(comments, mongoComment{
 			ID:         comment.ID,
 			TicketID:   comment.TicketID,
 			AuthorID:   comment.AuthorID,
 			Content:    comment.Content,
 			IsInternal: comment.IsInternal,
+			Mentions:   comment.Mentions,
 			CreatedAt:  comment.CreatedAt,
+			UpdatedAt:  comment.UpdatedAt,
+			Revisions:  revisions,
 		})
 	}
 
@@ -288,21 +437,42 @@ func (r *MongoRepo) domainToMongo(ticket *domain.Ticket) *mongoTicket {
 	}
 
 	return &mongoTicket{
-		TicketID:       ticket.ID(),
-		Title:          ticket.Title(),
-		Description:    ticket.Description(),
-		Status:         string(ticket.Status()),
-		Priority:       string(ticket.Priority()),
-		OrganizationID: ticket.OrganizationID(),
-		CategoryID:     ticket.CategoryID(),
-		AuthorID:       ticket.AuthorID(),
-		AssigneeID:     ticket.AssigneeID(),
-		Comments:       comments,
-		Attachments:    attachments,
-		CreatedAt:      ticket.CreatedAt(),
-		UpdatedAt:      ticket.UpdatedAt(),
-		ResolvedAt:     ticket.ResolvedAt(),
-		ClosedAt:       ticket.ClosedAt(),
+		TicketID:           ticket.ID(),
+		Key:                ticket.Key(),
+		Title:              ticket.Title(),
+		Description:        ticket.Description(),
+		Status:             string(ticket.Status()),
+		StatusCategory:     string(ticket.StatusCategory()),
+		Priority:           string(ticket.Priority()),
+		Impact:             ticket.Impact().String(),
+		Urgency:            ticket.Urgency().String(),
+		PriorityOverridden: ticket.IsPriorityOverridden(),
+		OrganizationID:     ticket.OrganizationID(),
+		CategoryID:         ticket.CategoryID(),
+		AuthorID:           ticket.AuthorID(),
+		AssigneeID:         ticket.AssigneeID(),
+		Comments:           comments,
+		Attachments:        attachments,
+		CreatedAt:          ticket.CreatedAt(),
+		UpdatedAt:          ticket.UpdatedAt(),
+		ResolvedAt:         ticket.ResolvedAt(),
+		ClosedAt:           ticket.ClosedAt(),
+		SLA:                ticketSLAToMongo(ticket),
+		SLAPauses:          slaPausesToMongo(ticket.SLAPauses()),
+		RespondedAt:        ticket.FirstRespondedAt(),
+		Escalations:        escalationsToMongo(ticket.Escalations()),
+		AssignmentStrategy: ticket.AssignmentStrategy().String(),
+		MergedIntoID:       ticket.MergedIntoID(),
+		SplitFromID:        ticket.SplitFromID(),
+		Relations:          relationsToMongo(ticket.Relations()),
+		Watchers:           watchersToMongo(ticket.Watchers()),
+		Tags:               ticket.Tags(),
+		CustomFields:       ticket.CustomFields(),
+		Survey:             surveyToMongo(ticket.Survey()),
+		WorkLogs:           workLogsToMongo(ticket.WorkLogs()),
+		Version:            ticket.Version(),
+		DeletedAt:          ticket.DeletedAt(),
+		DeletedBy:          ticket.DeletedBy(),
 	}
 }
 
@@ -325,18 +495,21 @@ func (r *MongoRepo) mongoToDomain(mongoDoc *mongoTicket) (*domain.Ticket, error)
 		return nil, err
 	}
 
-	// Set the timestamps from the database
-	ticket.SetCreatedAt(mongoDoc.CreatedAt)
-	ticket.SetUpdatedAt(mongoDoc.UpdatedAt)
-	ticket.SetResolvedAt(mongoDoc.ResolvedAt)
-	ticket.SetClosedAt(mongoDoc.ClosedAt)
-
-	// Restore the status without resetting timestamps
-	status, err := domain.ParseStatus(mongoDoc.Status)
+	// Restore the status without resetting timestamps.
+	// Documents written before organization workflows have no category: the status is built-in.
+	status, err := domain.ParseWorkflowStatus(mongoDoc.Status)
 	if err != nil {
 		return nil, err
 	}
-	ticket.SetStatus(status)
+	categoryValue := mongoDoc.StatusCategory
+	if categoryValue == "" {
+		categoryValue = mongoDoc.Status
+	}
+	category, err := domain.ParseStatus(categoryValue)
+	if err != nil {
+		return nil, err
+	}
+	ticket.RestoreStatus(status, category)
 
 	// Restore assignee if exists
 	if mongoDoc.AssigneeID != nil {
@@ -345,40 +518,115 @@ func (r *MongoRepo) mongoToDomain(mongoDoc *mongoTicket) (*domain.Ticket, error)
 		}
 	}
 
-	// Restore comments
-	for _, mongoComment := range mongoDoc.Comments {
-		if err = ticket.AddComment(mongoComment.AuthorID, mongoComment.Content, mongoComment.IsInternal); err != nil {
-			return nil, err
+	// Restore comments and attachments with their stored identities
+	ticket.RestoreComments(commentsToDomain(mongoDoc.Comments))
+	ticket.RestoreAttachments(attachmentsToDomain(mongoDoc.Attachments))
+
+	// Restore the applied SLA snapshot and waiting periods
+	if mongoDoc.SLA != nil {
+		sla, slaErr := mongoToTicketSLA(mongoDoc.SLA)
+		if slaErr != nil {
+			return nil, slaErr
 		}
-	}
+		ticket.RestoreSLA(sla)
+	}
+	ticket.RestoreSLAPauses(mongoToSLAPauses(mongoDoc.SLAPauses))
+	ticket.SetFirstRespondedAt(mongoDoc.RespondedAt)
+	ticket.RestoreEscalations(mongoToEscalations(mongoDoc.Escalations))
+	ticket.SetAssignmentStrategy(domain.AssignmentStrategy(mongoDoc.AssignmentStrategy))
+	ticket.RestoreClassification(domain.Impact(mongoDoc.Impact), domain.Urgency(mongoDoc.Urgency),
+		mongoDoc.PriorityOverridden)
+	ticket.SetMergedIntoID(mongoDoc.MergedIntoID)
+	ticket.SetSplitFromID(mongoDoc.SplitFromID)
+	ticket.RestoreRelations(mongoToRelations(mongoDoc.Relations))
+	ticket.RestoreWatchers(mongoToWatchers(mongoDoc.Watchers))
+	ticket.RestoreKey(mongoDoc.Key)
+	ticket.RestoreTags(mongoDoc.Tags)
+	ticket.RestoreCustomFields(mongoDoc.CustomFields)
+	ticket.RestoreSurvey(mongoToSurvey(mongoDoc.Survey))
+	ticket.RestoreWorkLogs(mongoToWorkLogs(mongoDoc.WorkLogs))
+	ticket.RestoreVersion(mongoDoc.Version)
+	if mongoDoc.DeletedAt != nil && mongoDoc.DeletedBy != nil {
+		ticket.MarkDeleted(*mongoDoc.DeletedBy, *mongoDoc.DeletedAt)
+	}
+
+	// Set the timestamps from the database after all mutations that touch them
+	ticket.SetCreatedAt(mongoDoc.CreatedAt)
+	ticket.SetUpdatedAt(mongoDoc.UpdatedAt)
+	ticket.SetResolvedAt(mongoDoc.ResolvedAt)
+	ticket.SetClosedAt(mongoDoc.ClosedAt)
 
-	// Restore attachments
-	for _, mongoAttachment := range mongoDoc.Attachments {
-		if err = ticket.AddAttachment(
-			mongoAttachment.FileName,
-			mongoAttachment.FileSize,
-			mongoAttachment.MimeType,
-			mongoAttachment.FilePath,
-			mongoAttachment.UploadedBy,
-		); err != nil {
-			return nil, err
+	// Restoring the state must not produce history events
+	ticket.ClearPendingEvents()
+
+	return ticket, nil
+}
+
+func commentsToDomain(mongoComments []mongoComment) []domain.Comment {
+	comments := make([]domain.Comment, 0, len(mongoComments))
+	for _, mc := range mongoComments {
+		var revisions []domain.CommentRevision
+		for _, revision := range mc.Revisions {
+			revisions = append(revisions, domain.CommentRevision{
+				Content:  revision.Content,
+				EditedBy: revision.EditedBy,
+				EditedAt: revision.EditedAt,
+			})
 		}
+
+		comments = append(comments, domain.Comment{
+			ID:         mc.ID,
+			TicketID:   mc.TicketID,
+			AuthorID:   mc.AuthorID,
+			Content:    mc.Content,
+			IsInternal: mc.IsInternal,
+			Mentions:   mc.Mentions,
+			CreatedAt:  mc.CreatedAt,
+			UpdatedAt:  mc.UpdatedAt,
+			Revisions:  revisions,
+		})
 	}
+	return comments
+}
 
-	return ticket, nil
+func attachmentsToDomain(mongoAttachments []mongoAttachment) []domain.Attachment {
+	attachments := make([]domain.Attachment, 0, len(mongoAttachments))
+	for _, ma := range mongoAttachments {
+		attachments = append(attachments, domain.Attachment{
+			ID:         ma.ID,
+			TicketID:   ma.TicketID,
+			FileName:   ma.FileName,
+			FileSize:   ma.FileSize,
+			MimeType:   ma.MimeType,
+			FilePath:   ma.FilePath,
+			UploadedBy: ma.UploadedBy,
+			CreatedAt:  ma.CreatedAt,
+		})
+	}
+	return attachments
 }
 
 func (r *MongoRepo) buildFilterQuery(filter queries.TicketFilter) bson.M {
-	query := bson.M{}
+	// Trashed tickets are listed only through the trash
+	query := bson.M{"deleted_at": nil}
 
 	if filter.Status != nil {
 		query["status"] = string(*filter.Status)
 	}
+	if len(filter.StatusCategories) > 0 {
+		// Tickets stored before workflows were introduced have no status_category: their status is the category
+		query["$or"] = bson.A{
+			bson.M{"status_category": bson.M{"$in": filter.StatusCategories}},
+			bson.M{"status_category": bson.M{"$exists": false}, "status": bson.M{"$in": filter.StatusCategories}},
+		}
+	}
 	if filter.Priority != nil {
 		query["priority"] = string(*filter.Priority)
 	}
 	if filter.AssigneeID != nil {
 		query["assignee_id"] = *filter.AssigneeID
+	} else if filter.Unassigned {
+		query["assignee_id"] = nil
 	}
 	if filter.AuthorID != nil {
 		query["author_id"] = *filter.AuthorID
@@ -386,6 +634,25 @@ func (r *MongoRepo) buildFilterQuery(filter queries.TicketFilter) bson.M {
 	if filter.OrganizationID != nil {
 		query["organization_id"] = *filter.OrganizationID
 	}
+	if filter.Keys != nil {
+		query["key"] = bson.M{"$in": filter.Keys}
+	}
+	if filter.WatcherID != nil {
+		query["watchers.user_id"] = *filter.WatcherID
+	}
+	if len(filter.Tags) > 0 || len(filter.TagsAny) > 0 {
+		tagsQuery := bson.M{}
+		if len(filter.Tags) > 0 {
+			tagsQuery["$all"] = filter.Tags
+		}
+		if len(filter.TagsAny) > 0 {
+			tagsQuery["$in"] = filter.TagsAny
+		}
+		query["tags"] = tagsQuery
+	}
+	for key, value := range filter.CustomFields {
+		query["custom_fields."+key] = customFieldFilterValue(value)
+	}
 	if len(filter.CategoryIDs) > 0 {
 		query["category_id"] = bson.M{"$in": filter.CategoryIDs}
 	} else if filter.CategoryID != nil {
@@ -418,6 +685,15 @@ func (r *MongoRepo) buildFilterQuery(filter queries.TicketFilter) bson.M {
 	return query
 }
 
+// customFieldFilterValue matches number fields by their numeric value and all other fields by the string
+func customFieldFilterValue(value string) any {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value
+	}
+	return bson.M{"$in": bson.A{value, number}}
+}
+
 func (r *MongoRepo) buildSortOptions(filter queries.TicketFilter) bson.D {
 	var sort bson.D
 
@@ -439,7 +715,13 @@ func (r *MongoRepo) buildSortOptions(filter queries.TicketFilter) bson.D {
 	return sort
 }
 
-// Clear removes all tickets from MongoDB (useful for testing)
+// Clear removes all tickets, their history and search documents from MongoDB (useful for testing)
 func (r *MongoRepo) Clear(ctx context.Context) error {
+	if err := r.events.Drop(ctx); err != nil {
+		return err
+	}
+	if err := r.search.Drop(ctx); err != nil {
+		return err
+	}
 	return r.collection.Drop(ctx)
 }
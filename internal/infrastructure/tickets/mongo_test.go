@@ -13,11 +13,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func setupMongoTest(t *testing.T) (*tickets.MongoRepo, func()) {
+	db, cleanup := setupMongoTestDatabase(t)
+	return tickets.NewMongoRepo(db), cleanup
+}
+
+func setupMongoTestDatabase(t *testing.T) (*mongo.Database, func()) {
 	ctx := context.Background()
 
 	// Start MongoDB container
@@ -36,16 +42,13 @@ func setupMongoTest(t *testing.T) (*tickets.MongoRepo, func()) {
 	err = client.Ping(ctx, nil)
 	require.NoError(t, err)
 
-	// Create test database
-	db := client.Database("test_tickets")
-	repo := tickets.NewMongoRepo(db)
-
 	cleanup := func() {
 		client.Disconnect(ctx)
 		mongoContainer.Terminate(ctx)
 	}
 
-	return repo, cleanup
+	// Create test database
+	return client.Database("test_tickets"), cleanup
 }
 
 func createTestTicket(t *testing.T) *domain.Ticket {
@@ -83,6 +86,19 @@ func TestMongoRepo_CreateTicket(t *testing.T) {
 		assert.Equal(t, originalTicket.Priority(), createdTicket.Priority())
 	})
 
+	t.Run("duplicate ID", func(t *testing.T) {
+		originalTicket := createTestTicket(t)
+		_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return originalTicket, nil
+		})
+		require.NoError(t, err)
+
+		_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return domain.NewTicket(originalTicket.ID(), "Again", "", domain.PriorityLow, uuid.New(), uuid.New(), nil)
+		})
+		require.ErrorIs(t, err, domain.ErrTicketExists)
+	})
+
 	t.Run("createFn returns error", func(t *testing.T) {
 		expectedErr := assert.AnError
 
@@ -206,6 +222,62 @@ func TestMongoRepo_UpdateTicket(t *testing.T) {
 	})
 }
 
+func TestMongoRepo_UpdateTicket_Version(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ticket := createTestTicket(t)
+	created, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), created.Version())
+
+	t.Run("each write increments the version", func(t *testing.T) {
+		updated, updateErr := repo.UpdateTicket(ctx, ticket.ID(), func(ticket *domain.Ticket) (bool, error) {
+			return true, ticket.UpdateTitle("Versioned title")
+		})
+		require.NoError(t, updateErr)
+		assert.Equal(t, int64(2), updated.Version())
+
+		retrieved, getErr := repo.GetTicket(ctx, ticket.ID())
+		require.NoError(t, getErr)
+		assert.Equal(t, int64(2), retrieved.Version())
+	})
+
+	t.Run("a concurrent write makes the update run again on the fresh ticket", func(t *testing.T) {
+		attempts := 0
+		updated, updateErr := repo.UpdateTicket(ctx, ticket.ID(), func(ticket *domain.Ticket) (bool, error) {
+			attempts++
+			if attempts == 1 {
+				_, concurrentErr := repo.UpdateTicket(ctx, ticket.ID(), func(concurrent *domain.Ticket) (bool, error) {
+					return true, concurrent.UpdateDescription("Changed concurrently")
+				})
+				require.NoError(t, concurrentErr)
+			}
+			return true, ticket.UpdateTitle("Retried title")
+		})
+		require.NoError(t, updateErr)
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, int64(4), updated.Version())
+		assert.Equal(t, "Changed concurrently", updated.Description())
+		assert.Equal(t, "Retried title", updated.Title())
+	})
+
+	t.Run("losing every race reports a concurrent update", func(t *testing.T) {
+		updated, updateErr := repo.UpdateTicket(ctx, ticket.ID(), func(ticket *domain.Ticket) (bool, error) {
+			_, concurrentErr := repo.UpdateTicket(ctx, ticket.ID(), func(concurrent *domain.Ticket) (bool, error) {
+				return true, concurrent.UpdateDescription("Always first " + uuid.NewString())
+			})
+			require.NoError(t, concurrentErr)
+			return true, nil
+		})
+		assert.Nil(t, updated)
+		require.ErrorIs(t, updateErr, domain.ErrConcurrentUpdate)
+	})
+}
+
 func TestMongoRepo_DeleteTicket(t *testing.T) {
 	repo, cleanup := setupMongoTest(t)
 	defer cleanup()
@@ -221,7 +293,7 @@ func TestMongoRepo_DeleteTicket(t *testing.T) {
 		require.NoError(t, err)
 
 		// Delete the ticket
-		err = repo.DeleteTicket(ctx, originalTicket.ID())
+		err = repo.DeleteTicket(ctx, originalTicket.ID(), uuid.New())
 		require.NoError(t, err)
 
 		// Verify the ticket is deleted
@@ -233,11 +305,66 @@ func TestMongoRepo_DeleteTicket(t *testing.T) {
 	t.Run("non-existing ticket", func(t *testing.T) {
 		nonExistingID := uuid.New()
 
-		err := repo.DeleteTicket(ctx, nonExistingID)
+		err := repo.DeleteTicket(ctx, nonExistingID, uuid.New())
 		assert.Equal(t, domain.ErrTicketNotFound, err)
 	})
 }
 
+func TestMongoRepo_Trash(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	deletedBy := uuid.New()
+	ticket := createTestTicket(t)
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteTicket(ctx, ticket.ID(), deletedBy))
+
+	t.Run("deleted tickets are listed only in the trash", func(t *testing.T) {
+		count, countErr := repo.CountTickets(ctx, queries.TicketFilter{})
+		require.NoError(t, countErr)
+		assert.Zero(t, count)
+
+		trashed, listErr := repo.ListDeletedTickets(ctx, queries.TrashFilter{})
+		require.NoError(t, listErr)
+		require.Len(t, trashed, 1)
+		assert.Equal(t, ticket.ID(), trashed[0].ID())
+		assert.Equal(t, &deletedBy, trashed[0].DeletedBy())
+		require.NotNil(t, trashed[0].DeletedAt())
+
+		deletedBefore := trashed[0].DeletedAt().Add(-time.Minute)
+		count, countErr = repo.CountDeletedTickets(ctx, queries.TrashFilter{DeletedBefore: &deletedBefore})
+		require.NoError(t, countErr)
+		assert.Zero(t, count)
+	})
+
+	t.Run("restore brings the ticket back", func(t *testing.T) {
+		require.NoError(t, repo.RestoreTicket(ctx, ticket.ID()))
+		restored, getErr := repo.GetTicket(ctx, ticket.ID())
+		require.NoError(t, getErr)
+		assert.False(t, restored.IsDeleted())
+
+		assert.Equal(t, domain.ErrTicketNotFound, repo.RestoreTicket(ctx, ticket.ID()))
+	})
+
+	t.Run("only trashed tickets can be purged", func(t *testing.T) {
+		_, purgeErr := repo.PurgeTicket(ctx, ticket.ID())
+		require.ErrorIs(t, purgeErr, domain.ErrTicketNotFound)
+
+		require.NoError(t, repo.DeleteTicket(ctx, ticket.ID(), deletedBy))
+		purged, purgeErr := repo.PurgeTicket(ctx, ticket.ID())
+		require.NoError(t, purgeErr)
+		assert.Equal(t, ticket.ID(), purged.ID())
+
+		count, countErr := repo.CountDeletedTickets(ctx, queries.TrashFilter{})
+		require.NoError(t, countErr)
+		assert.Zero(t, count)
+	})
+}
+
 func TestMongoRepo_ListTickets(t *testing.T) {
 	repo, cleanup := setupMongoTest(t)
 	defer cleanup()
@@ -374,3 +501,569 @@ func TestMongoRepo_ListTickets(t *testing.T) {
 		assert.Len(t, result, 3) // All sliceTickets should be within this range
 	})
 }
+
+func TestMongoRepo_CountTickets(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	agentID := uuid.New()
+
+	for _, status := range []domain.Status{domain.StatusNew, domain.StatusInProgress, domain.StatusResolved} {
+		ticket := createTestTicket(t)
+		require.NoError(t, ticket.AutoAssign(agentID, domain.AssignmentLeastOpen))
+		if status != domain.StatusNew {
+			require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+		}
+		if status == domain.StatusResolved {
+			require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+		}
+		_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{
+		AssigneeID:       &agentID,
+		StatusCategories: []domain.Status{domain.StatusNew, domain.StatusInProgress, domain.StatusWaiting},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	assigned, err := repo.ListTickets(ctx, queries.TicketFilter{AssigneeID: &agentID})
+	require.NoError(t, err)
+	require.Len(t, assigned, 3)
+	assert.Equal(t, domain.AssignmentLeastOpen, assigned[0].AssignmentStrategy())
+}
+
+func TestMongoRepo_MergeAndSplitReferences(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	source := createTestTicket(t)
+	require.NoError(t, source.AddComment(source.AuthorID(), "Duplicate report", false))
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return source, nil
+	})
+	require.NoError(t, err)
+
+	split := createTestTicket(t)
+	require.NoError(t, split.AdoptSplitComments(source.ID(), nil))
+	_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return split, nil
+	})
+	require.NoError(t, err)
+
+	target, err := domain.NewTicket(uuid.New(), "Target", "Merge target", domain.PriorityNormal,
+		source.OrganizationID(), uuid.New(), nil)
+	require.NoError(t, err)
+	require.NoError(t, target.AbsorbTicket(source))
+	targetID := target.ID()
+	_, err = repo.UpdateTicket(ctx, source.ID(), func(ticket *domain.Ticket) (bool, error) {
+		return true, ticket.MergeInto(target, nil)
+	})
+	require.NoError(t, err)
+
+	merged, err := repo.GetTicket(ctx, source.ID())
+	require.NoError(t, err)
+	require.NotNil(t, merged.MergedIntoID())
+	assert.Equal(t, targetID, *merged.MergedIntoID())
+	assert.Empty(t, merged.Comments())
+	assert.Equal(t, domain.StatusClosed, merged.Status())
+
+	retrievedSplit, err := repo.GetTicket(ctx, split.ID())
+	require.NoError(t, err)
+	require.NotNil(t, retrievedSplit.SplitFromID())
+	assert.Equal(t, source.ID(), *retrievedSplit.SplitFromID())
+	assert.Nil(t, retrievedSplit.MergedIntoID())
+}
+
+func TestMongoRepo_Relations(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	ticket := createTestTicket(t)
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+
+	blockerID := uuid.New()
+	actorID := uuid.New()
+	_, err = repo.UpdateTicket(ctx, ticket.ID(), func(ticket *domain.Ticket) (bool, error) {
+		ticket.ActAs(actorID)
+		return true, ticket.AddRelation(domain.RelationBlockedBy, blockerID)
+	})
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetTicket(ctx, ticket.ID())
+	require.NoError(t, err)
+	require.Len(t, retrieved.Relations(), 1)
+	relation := retrieved.Relations()[0]
+	assert.Equal(t, domain.RelationBlockedBy, relation.Type)
+	assert.Equal(t, blockerID, relation.TicketID)
+	require.NotNil(t, relation.CreatedBy)
+	assert.Equal(t, actorID, *relation.CreatedBy)
+
+	_, err = repo.UpdateTicket(ctx, ticket.ID(), func(ticket *domain.Ticket) (bool, error) {
+		return true, ticket.RemoveRelation(domain.RelationBlockedBy, blockerID)
+	})
+	require.NoError(t, err)
+
+	retrieved, err = repo.GetTicket(ctx, ticket.ID())
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.Relations())
+}
+
+func TestMongoRepo_ListTickets_WatcherFilter(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	watcherID := uuid.New()
+
+	watched := createTestTicket(t)
+	require.NoError(t, watched.AddWatcher(watcherID))
+	for _, ticket := range []*domain.Ticket{watched, createTestTicket(t)} {
+		_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	result, err := repo.ListTickets(ctx, queries.TicketFilter{WatcherID: &watcherID})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, watched.ID(), result[0].ID())
+	assert.True(t, result[0].IsWatcher(watcherID))
+}
+
+func TestMongoRepo_ListTickets_TagFilter(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	vip := createTestTicket(t)
+	require.NoError(t, vip.AddTag("vip"))
+	vipSecurity := createTestTicket(t)
+	require.NoError(t, vipSecurity.AddTag("vip"))
+	require.NoError(t, vipSecurity.AddTag("security"))
+	for _, ticket := range []*domain.Ticket{vip, vipSecurity, createTestTicket(t)} {
+		_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	result, err := repo.ListTickets(ctx, queries.TicketFilter{Tags: []string{"vip", "security"}})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, vipSecurity.ID(), result[0].ID())
+	assert.Equal(t, []string{"vip", "security"}, result[0].Tags())
+
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{TagsAny: []string{"security", "vip"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestMongoRepo_ListTickets_CustomFieldFilter(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	schema, err := domain.NewCustomFieldSchema([]domain.CustomFieldDefinition{
+		{Key: "asset_tag", Label: "Asset tag", Type: domain.CustomFieldText},
+		{Key: "cost", Label: "Cost", Type: domain.CustomFieldNumber},
+	})
+	require.NoError(t, err)
+	laptop := createTestTicket(t)
+	require.NoError(t, laptop.SetCustomFields(schema, domain.CustomFields{"asset_tag": "LT-1042", "cost": 1299.5}))
+	monitor := createTestTicket(t)
+	require.NoError(t, monitor.SetCustomFields(schema, domain.CustomFields{"asset_tag": "MN-7", "cost": 300}))
+	for _, ticket := range []*domain.Ticket{laptop, monitor, createTestTicket(t)} {
+		_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	result, err := repo.ListTickets(ctx, queries.TicketFilter{CustomFields: map[string]string{"asset_tag": "LT-1042"}})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, laptop.ID(), result[0].ID())
+	assert.Equal(t, domain.CustomFields{"asset_tag": "LT-1042", "cost": 1299.5}, result[0].CustomFields())
+
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{CustomFields: map[string]string{"cost": "300"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestMongoRepo_ListTickets_Search(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	inTitle, err := domain.NewTicket(uuid.New(), "Printer jams", "Paper gets stuck", domain.PriorityNormal,
+		uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	inComment := createTestTicket(t)
+	require.NoError(t, inComment.AddComment(uuid.New(), "The printer driver was reinstalled", false))
+	internalOnly := createTestTicket(t)
+	require.NoError(t, internalOnly.AddComment(uuid.New(), "Printer vendor contract expired", true))
+	for _, ticket := range []*domain.Ticket{inTitle, inComment, internalOnly, createTestTicket(t)} {
+		_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	query, err := domain.ParseSearchQuery("printers")
+	require.NoError(t, err)
+
+	result, err := repo.ListTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, inTitle.ID(), result[0].ID())
+	assert.Equal(t, inComment.ID(), result[1].ID())
+
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{Search: &query, SearchInternal: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = repo.UpdateTicket(ctx, inTitle.ID(), func(ticket *domain.Ticket) (bool, error) {
+		return true, ticket.UpdateTitle("Scanner does not start")
+	})
+	require.NoError(t, err)
+	orgID := inComment.OrganizationID()
+	result, err = repo.ListTickets(ctx, queries.TicketFilter{Search: &query, OrganizationID: &orgID})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, inComment.ID(), result[0].ID())
+	count, err = repo.CountTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestMongoRepo_BackfillSearchDocuments(t *testing.T) {
+	db, cleanup := setupMongoTestDatabase(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := tickets.NewMongoRepo(db)
+
+	// Tickets stored before full-text search have no search documents
+	legacy, err := domain.NewTicket(uuid.New(), "Printer jams", "Paper gets stuck", domain.PriorityNormal,
+		uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return legacy, nil
+	})
+	require.NoError(t, err)
+	_, err = db.Collection("ticket_search").DeleteMany(ctx, bson.M{})
+	require.NoError(t, err)
+
+	query, err := domain.ParseSearchQuery("printer")
+	require.NoError(t, err)
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+
+	require.NoError(t, repo.BackfillSearchDocuments(ctx))
+	count, err = repo.CountTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// The applied migration is not run again
+	_, err = db.Collection("ticket_search").DeleteMany(ctx, bson.M{})
+	require.NoError(t, err)
+	require.NoError(t, repo.BackfillSearchDocuments(ctx))
+	count, err = repo.CountTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestMongoRepo_SyncPendingTickets(t *testing.T) {
+	db, cleanup := setupMongoTestDatabase(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := tickets.NewMongoRepo(db)
+
+	ticket, err := domain.NewTicket(uuid.New(), "Printer jams", "Paper gets stuck", domain.PriorityNormal,
+		uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+	_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+	synced, err := db.Collection("tickets").CountDocuments(ctx, bson.M{"sync_pending": true})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), synced, "a successful sync clears the marker")
+
+	// A write whose search documents and events were lost after the ticket committed
+	events, err := repo.ListTicketEvents(ctx, queries.TicketEventFilter{TicketID: ticket.ID(), IncludeInternal: true})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	lost := bson.A{}
+	for _, event := range events {
+		lost = append(lost, bson.M{"event_id": event.ID, "ticket_id": event.TicketID, "type": string(event.Type),
+			"is_internal": event.IsInternal, "created_at": event.CreatedAt})
+	}
+	_, err = db.Collection("tickets").UpdateOne(ctx, bson.M{"ticket_id": ticket.ID()},
+		bson.M{"$set": bson.M{"sync_pending": true, "pending_events": lost}})
+	require.NoError(t, err)
+	_, err = db.Collection("ticket_search").DeleteMany(ctx, bson.M{})
+	require.NoError(t, err)
+	_, err = db.Collection("ticket_events").DeleteMany(ctx, bson.M{})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SyncPendingTickets(ctx, time.Now()))
+	require.NoError(t, repo.SyncPendingTickets(ctx, time.Now()), "repairing twice is harmless")
+
+	query, err := domain.ParseSearchQuery("printer")
+	require.NoError(t, err)
+	count, err := repo.CountTickets(ctx, queries.TicketFilter{Search: &query})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	repaired, err := repo.ListTicketEvents(ctx, queries.TicketEventFilter{TicketID: ticket.ID(), IncludeInternal: true})
+	require.NoError(t, err)
+	assert.Len(t, repaired, len(events))
+
+	synced, err = db.Collection("tickets").CountDocuments(ctx, bson.M{"sync_pending": true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), synced)
+}
+
+func TestMongoRepo_AggregateSatisfaction(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	agentID := uuid.New()
+	var orgID uuid.UUID
+	for _, rating := range []int{5, 4, 1} {
+		ticket := createTestTicket(t)
+		orgID = ticket.OrganizationID()
+		require.NoError(t, ticket.AssignTo(agentID))
+		require.NoError(t, ticket.ChangeStatus(domain.StatusInProgress))
+		require.NoError(t, ticket.ChangeStatus(domain.StatusResolved))
+		require.NoError(t, ticket.SubmitSurvey(ticket.Survey().Token, rating, "Thanks"))
+		_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	pending := createTestTicket(t)
+	require.NoError(t, pending.ChangeStatus(domain.StatusInProgress))
+	require.NoError(t, pending.ChangeStatus(domain.StatusResolved))
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return pending, nil
+	})
+	require.NoError(t, err)
+
+	t.Run("survey round-trips through the repository", func(t *testing.T) {
+		stored, getErr := repo.GetTicket(ctx, pending.ID())
+		require.NoError(t, getErr)
+		require.NotNil(t, stored.Survey())
+		assert.Equal(t, pending.Survey().Token, stored.Survey().Token)
+		assert.False(t, stored.Survey().IsSubmitted())
+	})
+
+	t.Run("only submitted ratings are aggregated", func(t *testing.T) {
+		stats, aggErr := repo.AggregateSatisfaction(ctx, queries.SatisfactionFilter{GroupBy: queries.SatisfactionByAgent})
+		require.NoError(t, aggErr)
+		require.Len(t, stats, 1)
+		assert.Equal(t, &agentID, stats[0].GroupID)
+		assert.Equal(t, int64(3), stats[0].Responses)
+		assert.Equal(t, int64(10), stats[0].RatingSum)
+		assert.Equal(t, int64(2), stats[0].Satisfied)
+	})
+
+	t.Run("filters narrow the ratings", func(t *testing.T) {
+		stats, aggErr := repo.AggregateSatisfaction(ctx, queries.SatisfactionFilter{
+			GroupBy:        queries.SatisfactionByOrganization,
+			OrganizationID: &orgID,
+		})
+		require.NoError(t, aggErr)
+		require.Len(t, stats, 1)
+		assert.Equal(t, &orgID, stats[0].GroupID)
+
+		future := time.Now().Add(time.Hour)
+		stats, aggErr = repo.AggregateSatisfaction(ctx, queries.SatisfactionFilter{
+			GroupBy:    queries.SatisfactionByCategory,
+			RatedAfter: &future,
+		})
+		require.NoError(t, aggErr)
+		assert.Empty(t, stats)
+	})
+}
+
+func TestMongoRepo_TicketKeys(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, expected := range []int64{1, 2, 3} {
+		number, err := repo.NextTicketNumber(ctx, "ACME")
+		require.NoError(t, err)
+		assert.Equal(t, expected, number)
+	}
+	number, err := repo.NextTicketNumber(ctx, "IT")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), number)
+
+	keyed := createTestTicket(t)
+	require.NoError(t, keyed.AssignKey("ACME-3"))
+	for _, ticket := range []*domain.Ticket{keyed, createTestTicket(t), createTestTicket(t)} {
+		_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+			return ticket, nil
+		})
+		require.NoError(t, err)
+	}
+
+	retrieved, err := repo.GetTicketByKey(ctx, "ACME-3")
+	require.NoError(t, err)
+	assert.Equal(t, keyed.ID(), retrieved.ID())
+	assert.Equal(t, "ACME-3", retrieved.Key())
+
+	_, err = repo.GetTicketByKey(ctx, "ACME-4")
+	require.ErrorIs(t, err, domain.ErrTicketNotFound)
+
+	result, err := repo.ListTickets(ctx, queries.TicketFilter{Keys: []string{"ACME-3", "IT-1"}})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, keyed.ID(), result[0].ID())
+
+	duplicate := createTestTicket(t)
+	require.NoError(t, duplicate.AssignKey("ACME-3"))
+	_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return duplicate, nil
+	})
+	require.ErrorIs(t, err, domain.ErrTicketExists)
+}
+
+func TestMongoRepo_AggregateWorkLogs(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	agentID := uuid.New()
+	monday := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	ticket := createTestTicket(t)
+	for _, entry := range []domain.WorkLogEntry{
+		{AgentID: agentID, Date: monday, Minutes: 60, Billable: true, Note: "Setup"},
+		{AgentID: agentID, Date: monday.AddDate(0, 0, 1), Minutes: 30},
+		{AgentID: agentID, Date: monday.AddDate(0, 0, 14), Minutes: 120, Billable: true},
+	} {
+		_, err := ticket.AddWorkLog(entry)
+		require.NoError(t, err)
+	}
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+
+	t.Run("work logs round-trip through the repository", func(t *testing.T) {
+		stored, getErr := repo.GetTicket(ctx, ticket.ID())
+		require.NoError(t, getErr)
+		require.Len(t, stored.WorkLogs(), 3)
+		assert.Equal(t, ticket.WorkLogs()[0].WorkLogEntry, stored.WorkLogs()[0].WorkLogEntry)
+		assert.Equal(t, domain.TimeSpent{Minutes: 210, BillableMinutes: 180}, stored.TimeSpent())
+	})
+
+	t.Run("only entries of the range are aggregated", func(t *testing.T) {
+		stats, aggErr := repo.AggregateWorkLogs(ctx, queries.WorkLogFilter{
+			GroupBy: queries.WorkLogByAgent,
+			From:    monday,
+			To:      monday.AddDate(0, 0, 6),
+		})
+		require.NoError(t, aggErr)
+		require.Len(t, stats, 1)
+		assert.Equal(t, &agentID, stats[0].GroupID)
+		assert.Equal(t, int64(2), stats[0].Entries)
+		assert.Equal(t, int64(90), stats[0].Minutes)
+		assert.Equal(t, int64(60), stats[0].BillableMinutes)
+	})
+
+	t.Run("filters narrow the entries", func(t *testing.T) {
+		billable := false
+		orgID := ticket.OrganizationID()
+		stats, aggErr := repo.AggregateWorkLogs(ctx, queries.WorkLogFilter{
+			GroupBy:        queries.WorkLogByOrganization,
+			From:           monday,
+			To:             monday.AddDate(0, 1, 0),
+			OrganizationID: &orgID,
+			Billable:       &billable,
+		})
+		require.NoError(t, aggErr)
+		require.Len(t, stats, 1)
+		assert.Equal(t, &orgID, stats[0].GroupID)
+		assert.Equal(t, int64(30), stats[0].Minutes)
+
+		require.NoError(t, repo.DeleteTicket(ctx, ticket.ID(), uuid.New()))
+		stats, aggErr = repo.AggregateWorkLogs(ctx, queries.WorkLogFilter{
+			GroupBy: queries.WorkLogByCategory,
+			From:    monday,
+			To:      monday.AddDate(0, 1, 0),
+		})
+		require.NoError(t, aggErr)
+		assert.Empty(t, stats)
+	})
+}
+
+func TestMongoRepo_CommentMentions(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ticket := createTestTicket(t)
+	mentionedID := uuid.New()
+	comment, err := ticket.AddMentioningComment(uuid.New(), "@alice please have a look", true,
+		domain.MentionDirectory{"alice": {ID: mentionedID, IsAgent: true}})
+	require.NoError(t, err)
+
+	_, err = repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetTicket(ctx, ticket.ID())
+	require.NoError(t, err)
+	saved, err := retrieved.Comment(comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{mentionedID}, saved.Mentions)
+}
+
+func TestMongoRepo_PriorityClassification(t *testing.T) {
+	repo, cleanup := setupMongoTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ticket := createTestTicket(t)
+	require.NoError(t, ticket.Classify(domain.DefaultPriorityMatrix(), domain.ImpactHigh, domain.UrgencyLow))
+	require.NoError(t, ticket.OverridePriority(domain.PriorityCritical))
+
+	_, err := repo.CreateTicket(ctx, func() (*domain.Ticket, error) {
+		return ticket, nil
+	})
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetTicket(ctx, ticket.ID())
+	require.NoError(t, err)
+	assert.Equal(t, domain.ImpactHigh, retrieved.Impact())
+	assert.Equal(t, domain.UrgencyLow, retrieved.Urgency())
+	assert.Equal(t, domain.PriorityCritical, retrieved.Priority())
+	assert.True(t, retrieved.IsPriorityOverridden())
+}
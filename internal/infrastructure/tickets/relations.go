@@ -0,0 +1,43 @@
+package tickets
+
+import (
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// mongoRelation represents a link from the ticket to another ticket
+type mongoRelation struct {
+	Type      string     `bson:"type"`
+	TicketID  uuid.UUID  `bson:"ticket_id"`
+	CreatedBy *uuid.UUID `bson:"created_by,omitempty"`
+	CreatedAt time.Time  `bson:"created_at"`
+}
+
+func relationsToMongo(relations []domain.Relation) []mongoRelation {
+	result := make([]mongoRelation, 0, len(relations))
+	for _, relation := range relations {
+		result = append(result, mongoRelation{
+			Type:      relation.Type.String(),
+			TicketID:  relation.TicketID,
+			CreatedBy: relation.CreatedBy,
+			CreatedAt: relation.CreatedAt,
+		})
+	}
+	return result
+}
+
+func mongoToRelations(relations []mongoRelation) []domain.Relation {
+	result := make([]domain.Relation, 0, len(relations))
+	for _, relation := range relations {
+		result = append(result, domain.Relation{
+			Type:      domain.RelationType(relation.Type),
+			TicketID:  relation.TicketID,
+			CreatedBy: relation.CreatedBy,
+			CreatedAt: relation.CreatedAt,
+		})
+	}
+	return result
+}
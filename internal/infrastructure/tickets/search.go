@@ -0,0 +1,199 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoSearchDocument represents the searchable text of a ticket.
+// Every ticket has a public document with the title, description and public comments
+// and, when it has internal comments, an internal document with them.
+// A collection has a single text index, so the split lets customer searches skip internal comments.
+type mongoSearchDocument struct {
+	TicketID    uuid.UUID `bson:"ticket_id"`
+	Internal    bool      `bson:"internal"`
+	Title       string    `bson:"title,omitempty"`
+	Description string    `bson:"description,omitempty"`
+	Comments    []string  `bson:"comments,omitempty"`
+}
+
+func newSearchCollection(db *mongo.Database) *mongo.Collection {
+	collection := db.Collection("ticket_search")
+
+	ctx := context.Background()
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "ticket_id", Value: 1}, {Key: "internal", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "description", Value: "text"}, {Key: "comments", Value: "text"}},
+			Options: options.Index().SetWeights(bson.M{
+				"title":       domain.SearchWeightTitle,
+				"description": domain.SearchWeightDescription,
+				"comments":    domain.SearchWeightComment,
+			}),
+		},
+	}
+
+	_, _ = collection.Indexes().CreateMany(ctx, indexes)
+
+	return collection
+}
+
+// saveSearchDocuments replaces the searchable text of the ticket
+func (r *MongoRepo) saveSearchDocuments(ctx context.Context, ticket *domain.Ticket) error {
+	public := mongoSearchDocument{TicketID: ticket.ID(), Title: ticket.Title(), Description: ticket.Description()}
+	internal := mongoSearchDocument{TicketID: ticket.ID(), Internal: true}
+	for _, comment := range ticket.Comments() {
+		if comment.IsInternal {
+			internal.Comments = append(internal.Comments, comment.Content)
+		} else {
+			public.Comments = append(public.Comments, comment.Content)
+		}
+	}
+
+	models := []mongo.WriteModel{
+		mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"ticket_id": ticket.ID(), "internal": false}).
+			SetReplacement(public).
+			SetUpsert(true),
+	}
+	if len(internal.Comments) > 0 {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"ticket_id": ticket.ID(), "internal": true}).
+			SetReplacement(internal).
+			SetUpsert(true))
+	} else {
+		models = append(models, mongo.NewDeleteOneModel().SetFilter(bson.M{"ticket_id": ticket.ID(), "internal": true}))
+	}
+
+	_, err := r.search.BulkWrite(ctx, models)
+	return err
+}
+
+// searchBackfillMigration names the one-off migration that indexes tickets stored before full-text search
+const searchBackfillMigration = "ticket_search_backfill"
+
+// BackfillSearchDocuments creates the search documents of tickets stored before full-text search was introduced.
+// The scan runs once per database: after it succeeds the migration is recorded and later calls return at once.
+// Indexing is idempotent, so replicas starting together may both run it.
+func (r *MongoRepo) BackfillSearchDocuments(ctx context.Context) error {
+	migrations := r.collection.Database().Collection("migrations")
+	err := migrations.FindOne(ctx, bson.M{"_id": searchBackfillMigration}).Err()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	if err = r.indexUnsearchableTickets(ctx); err != nil {
+		return err
+	}
+	_, err = migrations.UpdateOne(ctx,
+		bson.M{"_id": searchBackfillMigration},
+		bson.M{"$set": bson.M{"applied_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// indexUnsearchableTickets creates the search documents of tickets that have none
+func (r *MongoRepo) indexUnsearchableTickets(ctx context.Context) error {
+	cursor, err := r.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from":         r.search.Name(),
+			"localField":   "ticket_id",
+			"foreignField": "ticket_id",
+			"as":           "search",
+		}}},
+		{{Key: "$match", Value: bson.M{"search": bson.M{"$size": 0}}}},
+		{{Key: "$unset", Value: "search"}},
+	})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var mongoDoc mongoTicket
+		if err = cursor.Decode(&mongoDoc); err != nil {
+			return err
+		}
+		ticket, domainErr := r.mongoToDomain(&mongoDoc)
+		if domainErr != nil {
+			return domainErr
+		}
+		if err = r.saveSearchDocuments(ctx, ticket); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// searchPipeline finds the tickets matching the search query and the rest of the filter.
+// A ticket's score is the sum of the text scores of its search documents.
+func (r *MongoRepo) searchPipeline(filter queries.TicketFilter) mongo.Pipeline {
+	match := bson.M{"$text": bson.M{"$search": filter.Search.String()}}
+	if !filter.SearchInternal {
+		match["internal"] = false
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$set", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$ticket_id", "score": bson.M{"$sum": "$score"}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         r.collection.Name(),
+			"localField":   "_id",
+			"foreignField": "ticket_id",
+			"as":           "ticket",
+		}}},
+		{{Key: "$unwind", Value: "$ticket"}},
+		{{Key: "$replaceWith", Value: bson.M{"$mergeObjects": bson.A{"$ticket", bson.M{"search_score": "$score"}}}}},
+		{{Key: "$match", Value: r.buildFilterQuery(filter)}},
+	}
+}
+
+// findSearchResults returns a cursor over the tickets matching the search query, the most relevant first
+func (r *MongoRepo) findSearchResults(ctx context.Context, filter queries.TicketFilter) (*mongo.Cursor, error) {
+	pipeline := append(r.searchPipeline(filter),
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "search_score", Value: -1}, {Key: "created_at", Value: -1}}}})
+	if filter.Offset > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(filter.Offset)}})
+	}
+	if filter.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: int64(filter.Limit)}})
+	}
+	return r.search.Aggregate(ctx, pipeline)
+}
+
+// countSearchResults counts the tickets matching the search query
+func (r *MongoRepo) countSearchResults(ctx context.Context, filter queries.TicketFilter) (int64, error) {
+	pipeline := append(r.searchPipeline(filter), bson.D{{Key: "$count", Value: "total"}})
+	cursor, err := r.search.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Total int64 `bson:"total"`
+	}
+	if cursor.Next(ctx) {
+		if err = cursor.Decode(&result); err != nil {
+			return 0, err
+		}
+	}
+	return result.Total, cursor.Err()
+}
@@ -0,0 +1,141 @@
+package tickets
+
+import (
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// mongoTicketSLA stores the SLA snapshot applied to a ticket
+type mongoTicketSLA struct {
+	PolicyID             *uuid.UUID     `bson:"policy_id,omitempty"`
+	PolicyName           string         `bson:"policy_name,omitempty"`
+	FirstResponseMinutes int64          `bson:"first_response_minutes"`
+	ResolutionMinutes    int64          `bson:"resolution_minutes"`
+	Calendar             *mongoCalendar `bson:"calendar,omitempty"`
+}
+
+type mongoSLAPause struct {
+	StartedAt time.Time  `bson:"started_at"`
+	EndedAt   *time.Time `bson:"ended_at,omitempty"`
+}
+
+type mongoEscalation struct {
+	RuleID      uuid.UUID `bson:"rule_id"`
+	RuleName    string    `bson:"rule_name"`
+	Target      string    `bson:"target"`
+	EscalatedAt time.Time `bson:"escalated_at"`
+}
+
+type mongoCalendar struct {
+	Timezone     string              `bson:"timezone"`
+	WorkingHours []mongoWorkingHours `bson:"working_hours"`
+	Holidays     []string            `bson:"holidays,omitempty"`
+}
+
+type mongoWorkingHours struct {
+	Weekday      int   `bson:"weekday"`
+	StartMinutes int64 `bson:"start_minutes"`
+	EndMinutes   int64 `bson:"end_minutes"`
+}
+
+// ticketSLAToMongo returns nil for tickets that use the default priority targets
+func ticketSLAToMongo(ticket *domain.Ticket) *mongoTicketSLA {
+	sla := ticket.AppliedSLA()
+	if sla == nil {
+		return nil
+	}
+
+	ms := &mongoTicketSLA{
+		PolicyID:             sla.PolicyID,
+		PolicyName:           sla.PolicyName,
+		FirstResponseMinutes: int64(sla.FirstResponseTarget / time.Minute),
+		ResolutionMinutes:    int64(sla.ResolutionTarget / time.Minute),
+	}
+	if calendar := sla.Calendar; calendar != nil {
+		ms.Calendar = &mongoCalendar{
+			Timezone: calendar.Timezone(),
+			Holidays: calendar.Holidays(),
+		}
+		for _, wh := range calendar.WorkingHours() {
+			ms.Calendar.WorkingHours = append(ms.Calendar.WorkingHours, mongoWorkingHours{
+				Weekday:      int(wh.Weekday),
+				StartMinutes: int64(wh.Start / time.Minute),
+				EndMinutes:   int64(wh.End / time.Minute),
+			})
+		}
+	}
+	return ms
+}
+
+func mongoToTicketSLA(ms *mongoTicketSLA) (domain.TicketSLA, error) {
+	sla := domain.TicketSLA{
+		PolicyID:            ms.PolicyID,
+		PolicyName:          ms.PolicyName,
+		FirstResponseTarget: time.Duration(ms.FirstResponseMinutes) * time.Minute,
+		ResolutionTarget:    time.Duration(ms.ResolutionMinutes) * time.Minute,
+	}
+	if ms.Calendar == nil {
+		return sla, nil
+	}
+
+	hours := make([]domain.WorkingHours, 0, len(ms.Calendar.WorkingHours))
+	for _, wh := range ms.Calendar.WorkingHours {
+		hours = append(hours, domain.WorkingHours{
+			Weekday: time.Weekday(wh.Weekday),
+			Start:   time.Duration(wh.StartMinutes) * time.Minute,
+			End:     time.Duration(wh.EndMinutes) * time.Minute,
+		})
+	}
+
+	calendar, err := domain.NewBusinessCalendar(ms.Calendar.Timezone, hours, ms.Calendar.Holidays)
+	if err != nil {
+		return domain.TicketSLA{}, err
+	}
+	sla.Calendar = calendar
+	return sla, nil
+}
+
+func slaPausesToMongo(pauses []domain.SLAPause) []mongoSLAPause {
+	result := make([]mongoSLAPause, 0, len(pauses))
+	for _, pause := range pauses {
+		result = append(result, mongoSLAPause{StartedAt: pause.StartedAt, EndedAt: pause.EndedAt})
+	}
+	return result
+}
+
+func mongoToSLAPauses(pauses []mongoSLAPause) []domain.SLAPause {
+	result := make([]domain.SLAPause, 0, len(pauses))
+	for _, pause := range pauses {
+		result = append(result, domain.SLAPause{StartedAt: pause.StartedAt, EndedAt: pause.EndedAt})
+	}
+	return result
+}
+
+func escalationsToMongo(escalations []domain.Escalation) []mongoEscalation {
+	result := make([]mongoEscalation, 0, len(escalations))
+	for _, escalation := range escalations {
+		result = append(result, mongoEscalation{
+			RuleID:      escalation.RuleID,
+			RuleName:    escalation.RuleName,
+			Target:      string(escalation.Target),
+			EscalatedAt: escalation.EscalatedAt,
+		})
+	}
+	return result
+}
+
+func mongoToEscalations(escalations []mongoEscalation) []domain.Escalation {
+	result := make([]domain.Escalation, 0, len(escalations))
+	for _, escalation := range escalations {
+		result = append(result, domain.Escalation{
+			RuleID:      escalation.RuleID,
+			RuleName:    escalation.RuleName,
+			Target:      domain.SLATarget(escalation.Target),
+			EscalatedAt: escalation.EscalatedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package tickets
+
+import (
+	"context"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoSurvey represents the satisfaction survey of a resolved ticket
+type mongoSurvey struct {
+	Token       string     `bson:"token,omitempty"`
+	AgentID     *uuid.UUID `bson:"agent_id,omitempty"`
+	RequestedAt time.Time  `bson:"requested_at"`
+	Rating      int        `bson:"rating,omitempty"`
+	Comment     string     `bson:"comment,omitempty"`
+	RatedAt     *time.Time `bson:"rated_at,omitempty"`
+}
+
+// mongoSatisfactionGroup is a row of the satisfaction aggregation
+type mongoSatisfactionGroup struct {
+	GroupID   *uuid.UUID `bson:"_id"`
+	Responses int64      `bson:"responses"`
+	RatingSum int64      `bson:"rating_sum"`
+	Satisfied int64      `bson:"satisfied"`
+}
+
+// satisfactionGroupFields maps report dimensions to the ticket fields they group by
+var satisfactionGroupFields = map[queries.SatisfactionGroup]string{
+	queries.SatisfactionByAgent:        "$survey.agent_id",
+	queries.SatisfactionByCategory:     "$category_id",
+	queries.SatisfactionByOrganization: "$organization_id",
+}
+
+// AggregateSatisfaction summarizes submitted satisfaction ratings per group, the groups with the most ratings first
+func (r *MongoRepo) AggregateSatisfaction(
+	ctx context.Context,
+	filter queries.SatisfactionFilter,
+) ([]domain.SatisfactionStats, error) {
+	ratedAt := bson.M{"$ne": nil}
+	if filter.RatedAfter != nil {
+		ratedAt["$gte"] = *filter.RatedAfter
+	}
+	if filter.RatedBefore != nil {
+		ratedAt["$lt"] = *filter.RatedBefore
+	}
+	match := bson.M{"survey.rated_at": ratedAt, "deleted_at": nil}
+	if filter.OrganizationID != nil {
+		match["organization_id"] = *filter.OrganizationID
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        satisfactionGroupFields[filter.GroupBy],
+			"responses":  bson.M{"$sum": 1},
+			"rating_sum": bson.M{"$sum": "$survey.rating"},
+			"satisfied": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$gte": bson.A{"$survey.rating", domain.SatisfiedRating}}, 1, 0},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "responses", Value: -1}, {Key: "_id", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []mongoSatisfactionGroup
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.SatisfactionStats, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, domain.SatisfactionStats{
+			GroupID:   group.GroupID,
+			Responses: group.Responses,
+			RatingSum: group.RatingSum,
+			Satisfied: group.Satisfied,
+		})
+	}
+	return result, nil
+}
+
+func surveyToMongo(survey *domain.Survey) *mongoSurvey {
+	if survey == nil {
+		return nil
+	}
+	return &mongoSurvey{
+		Token:       survey.Token,
+		AgentID:     survey.AgentID,
+		RequestedAt: survey.RequestedAt,
+		Rating:      survey.Rating,
+		Comment:     survey.Comment,
+		RatedAt:     survey.RatedAt,
+	}
+}
+
+func mongoToSurvey(survey *mongoSurvey) *domain.Survey {
+	if survey == nil {
+		return nil
+	}
+	return &domain.Survey{
+		Token:       survey.Token,
+		AgentID:     survey.AgentID,
+		RequestedAt: survey.RequestedAt,
+		Rating:      survey.Rating,
+		Comment:     survey.Comment,
+		RatedAt:     survey.RatedAt,
+	}
+}
@@ -0,0 +1,85 @@
+package tickets
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SyncJobName identifies the job that repairs ticket search documents and history, and its lease
+const SyncJobName = "ticket-sync"
+
+// Search documents and history events are derived from a ticket and written after it. The ticket write
+// itself marks the ticket with sync_pending and queues its events in pending_events, so nothing is lost
+// when a derived write fails after the ticket committed: SyncPendingTickets repeats it later.
+
+// syncTicket writes the search documents and history events of a saved ticket.
+// The ticket is already saved, so a failure is only logged and left to SyncPendingTickets.
+func (r *MongoRepo) syncTicket(ctx context.Context, ticket *domain.Ticket, events []mongoTicketEvent) {
+	if err := r.syncDerived(ctx, ticket, events); err != nil {
+		slog.WarnContext(ctx, "failed to sync ticket search and history, left for repair",
+			"ticket_id", ticket.ID(), "error", err)
+	}
+}
+
+// syncDerived upserts the search documents and history events of the ticket and clears its sync marker.
+// Both writes are keyed by ticket and event ID, so repeating an interrupted sync is safe.
+func (r *MongoRepo) syncDerived(ctx context.Context, ticket *domain.Ticket, events []mongoTicketEvent) error {
+	if err := r.saveSearchDocuments(ctx, ticket); err != nil {
+		return err
+	}
+	if err := r.saveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	// Only the synced events are dropped: a concurrent write may have queued more
+	if len(events) > 0 {
+		eventIDs := make([]uuid.UUID, 0, len(events))
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.EventID)
+		}
+		_, err := r.collection.UpdateOne(ctx,
+			bson.M{"ticket_id": ticket.ID()},
+			bson.M{"$pull": bson.M{"pending_events": bson.M{"event_id": bson.M{"$in": eventIDs}}}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The marker stays while a later write is unsynced or events of another write are still queued
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"ticket_id": ticket.ID(), "version": ticket.Version(), "pending_events.0": bson.M{"$exists": false}},
+		bson.M{"$unset": bson.M{"sync_pending": "", "pending_events": ""}},
+	)
+	return err
+}
+
+// SyncPendingTickets repairs the search documents and history of tickets whose derived writes failed
+func (r *MongoRepo) SyncPendingTickets(ctx context.Context, _ time.Time) error {
+	cursor, err := r.collection.Find(ctx, bson.M{"sync_pending": true})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var mongoDoc mongoTicket
+		if err = cursor.Decode(&mongoDoc); err != nil {
+			return err
+		}
+		ticket, domainErr := r.mongoToDomain(&mongoDoc)
+		if domainErr != nil {
+			return domainErr
+		}
+		if err = r.syncDerived(ctx, ticket, mongoDoc.PendingEvents); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,113 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ListDeletedTickets retrieves trashed tickets matching the filter
+func (r *MongoRepo) ListDeletedTickets(ctx context.Context, filter queries.TrashFilter) ([]*domain.Ticket, error) {
+	opts := options.Find().SetSort(buildTrashSort(filter))
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, buildTrashQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tickets []*domain.Ticket
+	for cursor.Next(ctx) {
+		var mongoDoc mongoTicket
+		if err = cursor.Decode(&mongoDoc); err != nil {
+			return nil, err
+		}
+
+		ticket, domainErr := r.mongoToDomain(&mongoDoc)
+		if domainErr != nil {
+			return nil, domainErr
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets, cursor.Err()
+}
+
+// CountDeletedTickets counts trashed tickets matching the filter
+func (r *MongoRepo) CountDeletedTickets(ctx context.Context, filter queries.TrashFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, buildTrashQuery(filter))
+}
+
+// RestoreTicket moves a ticket out of the trash
+func (r *MongoRepo) RestoreTicket(ctx context.Context, ticketID uuid.UUID) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"ticket_id": ticketID, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{
+			"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+			"$inc":   bson.M{"version": 1},
+		})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrTicketNotFound
+	}
+	return nil
+}
+
+// PurgeTicket permanently removes a trashed ticket together with its history and search documents.
+// The purged ticket is returned so that the caller can clean up its attachments and relations.
+func (r *MongoRepo) PurgeTicket(ctx context.Context, ticketID uuid.UUID) (*domain.Ticket, error) {
+	filter := bson.M{"ticket_id": ticketID, "deleted_at": bson.M{"$ne": nil}}
+
+	var mongoDoc mongoTicket
+	err := r.collection.FindOneAndDelete(ctx, filter).Decode(&mongoDoc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrTicketNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.search.DeleteMany(ctx, bson.M{"ticket_id": ticketID}); err != nil {
+		return nil, err
+	}
+	if _, err = r.events.DeleteMany(ctx, bson.M{"ticket_id": ticketID}); err != nil {
+		return nil, err
+	}
+
+	return r.mongoToDomain(&mongoDoc)
+}
+
+func buildTrashQuery(filter queries.TrashFilter) bson.M {
+	deletedQuery := bson.M{"$ne": nil}
+	if filter.DeletedBefore != nil {
+		deletedQuery["$lt"] = *filter.DeletedBefore
+	}
+
+	query := bson.M{"deleted_at": deletedQuery}
+	if filter.OrganizationID != nil {
+		query["organization_id"] = *filter.OrganizationID
+	}
+	return query
+}
+
+func buildTrashSort(filter queries.TrashFilter) bson.D {
+	sortOrder := -1 // default: the most recently deleted first
+	if filter.SortOrder == "asc" {
+		sortOrder = 1
+	}
+	return bson.D{{Key: "deleted_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}}
+}
@@ -0,0 +1,40 @@
+package tickets
+
+import (
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+
+	"github.com/google/uuid"
+)
+
+// mongoWatcher represents a user subscribed to the ticket
+type mongoWatcher struct {
+	UserID  uuid.UUID  `bson:"user_id"`
+	AddedBy *uuid.UUID `bson:"added_by,omitempty"`
+	AddedAt time.Time  `bson:"added_at"`
+}
+
+func watchersToMongo(watchers []domain.Watcher) []mongoWatcher {
+	result := make([]mongoWatcher, 0, len(watchers))
+	for _, watcher := range watchers {
+		result = append(result, mongoWatcher{
+			UserID:  watcher.UserID,
+			AddedBy: watcher.AddedBy,
+			AddedAt: watcher.AddedAt,
+		})
+	}
+	return result
+}
+
+func mongoToWatchers(watchers []mongoWatcher) []domain.Watcher {
+	result := make([]domain.Watcher, 0, len(watchers))
+	for _, watcher := range watchers {
+		result = append(result, domain.Watcher{
+			UserID:  watcher.UserID,
+			AddedBy: watcher.AddedBy,
+			AddedAt: watcher.AddedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package tickets
+
+import (
+	"context"
+	"time"
+
+	domain "simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoWorkLog represents time an agent spent on the ticket
+type mongoWorkLog struct {
+	ID        uuid.UUID  `bson:"id"`
+	AgentID   uuid.UUID  `bson:"agent_id"`
+	Date      time.Time  `bson:"date"`
+	Minutes   int        `bson:"minutes"`
+	Billable  bool       `bson:"billable"`
+	Note      string     `bson:"note,omitempty"`
+	CreatedBy uuid.UUID  `bson:"created_by"`
+	CreatedAt time.Time  `bson:"created_at"`
+	UpdatedAt *time.Time `bson:"updated_at,omitempty"`
+}
+
+// mongoWorkLogGroup is a row of the time tracking aggregation
+type mongoWorkLogGroup struct {
+	GroupID         *uuid.UUID `bson:"_id"`
+	Entries         int64      `bson:"entries"`
+	Minutes         int64      `bson:"minutes"`
+	BillableMinutes int64      `bson:"billable_minutes"`
+}
+
+// workLogGroupFields maps report dimensions to the fields work log entries are grouped by
+var workLogGroupFields = map[queries.WorkLogGroup]string{
+	queries.WorkLogByAgent:        "$worklogs.agent_id",
+	queries.WorkLogByCategory:     "$category_id",
+	queries.WorkLogByOrganization: "$organization_id",
+}
+
+// AggregateWorkLogs summarizes time logged on work dates of the range per group, the groups with the most time first
+func (r *MongoRepo) AggregateWorkLogs(
+	ctx context.Context,
+	filter queries.WorkLogFilter,
+) ([]domain.WorkLogStats, error) {
+	entryMatch := bson.M{"date": bson.M{"$gte": filter.From, "$lte": filter.To}}
+	if filter.Billable != nil {
+		entryMatch["billable"] = *filter.Billable
+	}
+	match := bson.M{"worklogs": bson.M{"$elemMatch": entryMatch}, "deleted_at": nil}
+	if filter.OrganizationID != nil {
+		match["organization_id"] = *filter.OrganizationID
+	}
+	unwoundMatch := bson.M{}
+	for field, condition := range entryMatch {
+		unwoundMatch["worklogs."+field] = condition
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$unwind", Value: "$worklogs"}},
+		{{Key: "$match", Value: unwoundMatch}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     workLogGroupFields[filter.GroupBy],
+			"entries": bson.M{"$sum": 1},
+			"minutes": bson.M{"$sum": "$worklogs.minutes"},
+			"billable_minutes": bson.M{"$sum": bson.M{
+				"$cond": bson.A{"$worklogs.billable", "$worklogs.minutes", 0},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "minutes", Value: -1}, {Key: "_id", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []mongoWorkLogGroup
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WorkLogStats, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, domain.WorkLogStats{
+			GroupID: group.GroupID,
+			Entries: group.Entries,
+			TimeSpent: domain.TimeSpent{
+				Minutes:         group.Minutes,
+				BillableMinutes: group.BillableMinutes,
+			},
+		})
+	}
+	return result, nil
+}
+
+func workLogsToMongo(workLogs []domain.WorkLog) []mongoWorkLog {
+	result := make([]mongoWorkLog, 0, len(workLogs))
+	for _, workLog := range workLogs {
+		result = append(result, mongoWorkLog{
+			ID:        workLog.ID,
+			AgentID:   workLog.AgentID,
+			Date:      workLog.Date,
+			Minutes:   workLog.Minutes,
+			Billable:  workLog.Billable,
+			Note:      workLog.Note,
+			CreatedBy: workLog.CreatedBy,
+			CreatedAt: workLog.CreatedAt,
+			UpdatedAt: workLog.UpdatedAt,
+		})
+	}
+	return result
+}
+
+func mongoToWorkLogs(workLogs []mongoWorkLog) []domain.WorkLog {
+	result := make([]domain.WorkLog, 0, len(workLogs))
+	for _, workLog := range workLogs {
+		result = append(result, domain.WorkLog{
+			WorkLogEntry: domain.WorkLogEntry{
+				AgentID:  workLog.AgentID,
+				Date:     workLog.Date.UTC(),
+				Minutes:  workLog.Minutes,
+				Billable: workLog.Billable,
+				Note:     workLog.Note,
+			},
+			ID:        workLog.ID,
+			CreatedBy: workLog.CreatedBy,
+			CreatedAt: workLog.CreatedAt,
+			UpdatedAt: workLog.UpdatedAt,
+		})
+	}
+	return result
+}
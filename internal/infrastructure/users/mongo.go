@@ -7,6 +7,7 @@ import (
 	"time"
 
 	domain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/infrastructure/optimistic"
 	"simpleservicedesk/internal/queries"
 
 	"github.com/google/uuid"
@@ -27,6 +28,7 @@ type mongoUser struct {
 	IsActive       bool               `bson:"is_active"`
 	CreatedAt      time.Time          `bson:"created_at"`
 	UpdatedAt      time.Time          `bson:"updated_at"`
+	Version        int64              `bson:"version,omitempty"`
 }
 
 type MongoRepo struct {
@@ -80,6 +82,7 @@ func (r *MongoRepo) CreateUser(
 		IsActive:       u.IsActive(),
 		CreatedAt:      u.CreatedAt(),
 		UpdatedAt:      u.UpdatedAt(),
+		Version:        1,
 	}
 	_, err = r.collection.InsertOne(ctx, mu)
 	if err != nil {
@@ -88,6 +91,7 @@ func (r *MongoRepo) CreateUser(
 		}
 		return nil, err
 	}
+	u.RestoreVersion(mu.Version)
 
 	return u, nil
 }
@@ -114,10 +118,22 @@ func (r *MongoRepo) GetUser(ctx context.Context, userID uuid.UUID) (*domain.User
 	if err != nil {
 		return nil, err
 	}
+	user.RestoreVersion(mu.Version)
 	return user, nil
 }
 
+// UpdateUser updates an existing user in MongoDB.
+// The write applies only if the user still has the version it was loaded with; when a concurrent
+// write got there first, the user is reloaded and updateFn runs again on the fresh state.
 func (r *MongoRepo) UpdateUser(ctx context.Context,
+	userID uuid.UUID,
+	updateFn func(*domain.User) (bool, error)) (*domain.User, error) {
+	return optimistic.Retry(ctx, func(ctx context.Context) (*domain.User, error) {
+		return r.updateUser(ctx, userID, updateFn)
+	}, domain.ErrConcurrentUpdate)
+}
+
+func (r *MongoRepo) updateUser(ctx context.Context,
 	userID uuid.UUID,
 	updateFn func(*domain.User) (bool, error)) (*domain.User, error) {
 	var mu mongoUser
@@ -141,6 +157,7 @@ func (r *MongoRepo) UpdateUser(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	entity.RestoreVersion(mu.Version)
 
 	updated, err := updateFn(entity)
 	if err != nil {
@@ -171,14 +188,24 @@ func (r *MongoRepo) UpdateUser(ctx context.Context,
 		"organization_id": entity.OrganizationID(),
 		"is_active":       entity.IsActive(),
 		"updated_at":      entity.UpdatedAt(),
+		"version":         mu.Version + 1,
 	}}
-	_, err = r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update)
+	filter := bson.M{"user_id": userID, "version": mu.Version}
+	if mu.Version == 0 {
+		// Users stored before versioning have no version field
+		filter["version"] = bson.M{"$exists": false}
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
 			return nil, domain.ErrUserAlreadyExist
 		}
 		return nil, err
 	}
+	if result.MatchedCount == 0 {
+		return nil, optimistic.ErrStaleVersion
+	}
+	entity.RestoreVersion(mu.Version + 1)
 	return entity, nil
 }
 
@@ -235,6 +262,7 @@ func (r *MongoRepo) ListUsers(ctx context.Context, filter queries.UserFilter) ([
 		if userErr != nil {
 			return nil, userErr
 		}
+		user.RestoreVersion(mu.Version)
 
 		users = append(users, user)
 	}
@@ -125,6 +125,38 @@ func (s *MongoRepoSuite) TestUpdateUser() {
 	s.Equal(newEmail, fetchedUser.Email())
 }
 
+func (s *MongoRepoSuite) TestUpdateUser_Version() {
+	ctx := context.Background()
+	email := "version@example.com"
+	passwordHash := []byte("hashedpassword")
+
+	user, err := s.repo.CreateUser(ctx, email, passwordHash, func() (*domain.User, error) {
+		return domain.CreateUser("Version User", email, passwordHash)
+	})
+	s.Require().NoError(err)
+	s.Equal(int64(1), user.Version())
+
+	attempts := 0
+	updatedUser, err := s.repo.UpdateUser(ctx, user.ID(), func(u *domain.User) (bool, error) {
+		attempts++
+		if attempts == 1 {
+			_, concurrentErr := s.repo.UpdateUser(ctx, user.ID(), func(concurrent *domain.User) (bool, error) {
+				return true, concurrent.ChangeName("Concurrent Name")
+			})
+			s.Require().NoError(concurrentErr)
+		}
+		return true, u.ChangeEmail("version.updated@example.com")
+	})
+	s.Require().NoError(err)
+	s.Equal(2, attempts)
+	s.Equal(int64(3), updatedUser.Version())
+	s.Equal("Concurrent Name", updatedUser.Name())
+
+	fetchedUser, err := s.repo.GetUser(ctx, user.ID())
+	s.Require().NoError(err)
+	s.Equal(int64(3), fetchedUser.Version())
+}
+
 func (s *MongoRepoSuite) TestGetUser_NotFound() {
 	_, err := s.repo.GetUser(context.Background(), uuid.New())
 	s.Require().Error(err)
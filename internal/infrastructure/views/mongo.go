@@ -0,0 +1,195 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"simpleservicedesk/internal/domain/users"
+	domain "simpleservicedesk/internal/domain/views"
+	"simpleservicedesk/internal/queries"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoView struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	ViewID           uuid.UUID          `bson:"view_id"`
+	Name             string             `bson:"name"`
+	Filter           string             `bson:"filter"` // Serialized ticket filter as stored by the application
+	AssignedToViewer bool               `bson:"assigned_to_viewer"`
+	Columns          []string           `bson:"columns"`
+	Visibility       string             `bson:"visibility"`
+	OrganizationID   *uuid.UUID         `bson:"organization_id"`
+	Role             string             `bson:"role,omitempty"`
+	OwnerID          uuid.UUID          `bson:"owner_id"`
+	CreatedAt        time.Time          `bson:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+}
+
+// MongoRepo stores saved ticket views; their filters are kept as serialized JSON
+type MongoRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepo(db *mongo.Database) *MongoRepo {
+	return &MongoRepo{collection: db.Collection("views")}
+}
+
+func (r *MongoRepo) CreateView(ctx context.Context, createFn func() (*domain.View, error)) (*domain.View, error) {
+	view, err := createFn()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.collection.InsertOne(ctx, viewToMongo(view)); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (r *MongoRepo) GetView(ctx context.Context, id uuid.UUID) (*domain.View, error) {
+	var mv mongoView
+	err := r.collection.FindOne(ctx, bson.M{"view_id": id}).Decode(&mv)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrViewNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mongoToView(mv)
+}
+
+func (r *MongoRepo) UpdateView(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(*domain.View) (bool, error),
+) (*domain.View, error) {
+	view, err := r.GetView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateFn(view)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return view, nil
+	}
+
+	mv := viewToMongo(view)
+	update := bson.M{"$set": bson.M{
+		"name":               mv.Name,
+		"filter":             mv.Filter,
+		"assigned_to_viewer": mv.AssignedToViewer,
+		"columns":            mv.Columns,
+		"updated_at":         mv.UpdatedAt,
+	}}
+	if _, err = r.collection.UpdateOne(ctx, bson.M{"view_id": id}, update); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (r *MongoRepo) ListViews(ctx context.Context, filter queries.ViewFilter) ([]*domain.View, error) {
+	cursor, err := r.collection.Find(ctx, visibilityQuery(filter.Viewer), options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var views []*domain.View
+	for cursor.Next(ctx) {
+		var mv mongoView
+		if decodeErr := cursor.Decode(&mv); decodeErr != nil {
+			return nil, decodeErr
+		}
+		view, viewErr := mongoToView(mv)
+		if viewErr != nil {
+			return nil, viewErr
+		}
+		views = append(views, view)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, cursorErr
+	}
+	return views, nil
+}
+
+func (r *MongoRepo) DeleteView(ctx context.Context, id uuid.UUID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"view_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrViewNotFound
+	}
+	return nil
+}
+
+// visibilityQuery mirrors Sharing.IsVisibleTo: the viewer's own views, the views shared with their
+// organization or role and, for admins, every shared view
+func visibilityQuery(viewer domain.Viewer) bson.M {
+	conditions := bson.A{
+		bson.M{"owner_id": viewer.UserID},
+		bson.M{"visibility": domain.VisibilityRole.String(), "role": viewer.Role.String()},
+	}
+	if viewer.OrganizationID != nil {
+		conditions = append(conditions, bson.M{
+			"visibility":      domain.VisibilityOrganization.String(),
+			"organization_id": *viewer.OrganizationID,
+		})
+	}
+	if viewer.Role == users.RoleAdmin {
+		conditions = append(conditions, bson.M{"visibility": bson.M{"$ne": domain.VisibilityPrivate.String()}})
+	}
+	return bson.M{"$or": conditions}
+}
+
+func viewToMongo(view *domain.View) mongoView {
+	columns := make([]string, 0, len(view.Columns()))
+	for _, column := range view.Columns() {
+		columns = append(columns, column.String())
+	}
+
+	sharing := view.Sharing()
+	return mongoView{
+		ViewID:           view.ID(),
+		Name:             view.Name(),
+		Filter:           string(view.Filter()),
+		AssignedToViewer: view.AssignedToViewer(),
+		Columns:          columns,
+		Visibility:       sharing.Visibility.String(),
+		OrganizationID:   sharing.OrganizationID,
+		Role:             sharing.Role.String(),
+		OwnerID:          sharing.OwnerID,
+		CreatedAt:        view.CreatedAt(),
+		UpdatedAt:        view.UpdatedAt(),
+	}
+}
+
+func mongoToView(mv mongoView) (*domain.View, error) {
+	columns := make([]domain.Column, 0, len(mv.Columns))
+	for _, column := range mv.Columns {
+		columns = append(columns, domain.Column(column))
+	}
+
+	sharing := domain.Sharing{
+		Visibility:     domain.Visibility(mv.Visibility),
+		OrganizationID: mv.OrganizationID,
+		Role:           users.Role(mv.Role),
+		OwnerID:        mv.OwnerID,
+	}
+	view, err := domain.NewView(mv.ViewID, mv.Name, []byte(mv.Filter), mv.AssignedToViewer, columns, sharing)
+	if err != nil {
+		return nil, err
+	}
+	view.RestoreTimestamps(mv.CreatedAt, mv.UpdatedAt)
+	return view, nil
+}
@@ -0,0 +1,140 @@
+package views_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"simpleservicedesk/internal/domain/users"
+	domain "simpleservicedesk/internal/domain/views"
+	viewsInfra "simpleservicedesk/internal/infrastructure/views"
+	"simpleservicedesk/internal/queries"
+)
+
+type MongoRepoSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+	db        *mongo.Database
+	repo      *viewsInfra.MongoRepo
+}
+
+func (s *MongoRepoSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "mongo:latest",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(10 * time.Second),
+	}
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	s.Require().NoError(err)
+	s.container = mongoContainer
+
+	host, err := mongoContainer.Host(ctx)
+	s.Require().NoError(err)
+	port, err := mongoContainer.MappedPort(ctx, "27017")
+	s.Require().NoError(err)
+
+	uri := fmt.Sprintf("mongodb://%s", net.JoinHostPort(host, port.Port()))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	s.Require().NoError(err)
+
+	s.db = client.Database("testdb")
+	s.repo = viewsInfra.NewMongoRepo(s.db)
+}
+
+func (s *MongoRepoSuite) TearDownSuite() {
+	ctx := context.Background()
+	err := s.db.Client().Disconnect(ctx)
+	s.Require().NoError(err)
+	err = s.container.Terminate(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepoSuite) SetupTest() {
+	s.Require().NoError(s.db.Collection("views").Drop(context.Background()))
+}
+
+func (s *MongoRepoSuite) TestViewCRUD() {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	filter := []byte(`{"status":"new","sort_by":"priority","sort_order":"asc"}`)
+
+	created, err := s.repo.CreateView(ctx, func() (*domain.View, error) {
+		return domain.CreateView("New tickets", filter, true,
+			[]domain.Column{domain.ColumnTitle, domain.ColumnSLA}, domain.PrivateSharing(ownerID))
+	})
+	s.Require().NoError(err)
+
+	loaded, err := s.repo.GetView(ctx, created.ID())
+	s.Require().NoError(err)
+	s.JSONEq(string(filter), string(loaded.Filter()))
+	s.True(loaded.AssignedToViewer())
+	s.Equal([]domain.Column{domain.ColumnTitle, domain.ColumnSLA}, loaded.Columns())
+	s.Equal(domain.PrivateSharing(ownerID), loaded.Sharing())
+
+	_, err = s.repo.UpdateView(ctx, created.ID(), func(view *domain.View) (bool, error) {
+		return true, view.Update("Open tickets", []byte(`{"status_categories":["new","in_progress"]}`), false, nil)
+	})
+	s.Require().NoError(err)
+	loaded, err = s.repo.GetView(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal("Open tickets", loaded.Name())
+	s.False(loaded.AssignedToViewer())
+	s.Equal(domain.DefaultColumns(), loaded.Columns())
+
+	s.Require().NoError(s.repo.DeleteView(ctx, created.ID()))
+	_, err = s.repo.GetView(ctx, created.ID())
+	s.ErrorIs(err, domain.ErrViewNotFound)
+	s.ErrorIs(s.repo.DeleteView(ctx, created.ID()), domain.ErrViewNotFound)
+}
+
+func (s *MongoRepoSuite) TestListViewsVisibility() {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	orgID := uuid.New()
+	for name, sharing := range map[string]domain.Sharing{
+		"Own":          domain.PrivateSharing(ownerID),
+		"Foreign":      domain.PrivateSharing(uuid.New()),
+		"Organization": domain.OrganizationSharing(orgID, uuid.New()),
+		"Other org":    domain.OrganizationSharing(uuid.New(), uuid.New()),
+		"Agents":       domain.RoleSharing(users.RoleAgent, uuid.New()),
+		"Customers":    domain.RoleSharing(users.RoleCustomer, uuid.New()),
+	} {
+		_, err := s.repo.CreateView(ctx, func() (*domain.View, error) {
+			return domain.CreateView(name, []byte(`{}`), false, nil, sharing)
+		})
+		s.Require().NoError(err)
+	}
+
+	names := func(viewer domain.Viewer) []string {
+		views, err := s.repo.ListViews(ctx, queries.ViewFilter{Viewer: viewer})
+		s.Require().NoError(err)
+		result := make([]string, 0, len(views))
+		for _, view := range views {
+			result = append(result, view.Name())
+		}
+		return result
+	}
+	s.Equal([]string{"Agents", "Organization", "Own"},
+		names(domain.Viewer{UserID: ownerID, OrganizationID: &orgID, Role: users.RoleAgent}))
+	s.Equal([]string{"Customers"}, names(domain.Viewer{UserID: uuid.New(), Role: users.RoleCustomer}))
+	s.Equal([]string{"Agents", "Customers", "Organization", "Other org"},
+		names(domain.Viewer{UserID: uuid.New(), Role: users.RoleAdmin}))
+}
+
+func TestMongoRepoSuite(t *testing.T) {
+	suite.Run(t, new(MongoRepoSuite))
+}
@@ -1,7 +1,11 @@
 package queries
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
 
 	"simpleservicedesk/generated/openapi"
 	"simpleservicedesk/internal/domain/tickets"
@@ -61,10 +65,209 @@ func FromOpenAPITicketParams(params openapi.GetTicketsParams) (TicketFilter, err
 	filter.AuthorID = params.AuthorId
 	filter.OrganizationID = params.OrganizationId
 	filter.CategoryID = params.CategoryId
+	filter.WatcherID = params.WatcherId
+
+	// Tags are matched by their normalized names
+	var err error
+	if filter.Tags, err = parseTagNames(params.Tags); err != nil {
+		return filter, fmt.Errorf("invalid tags: %w", err)
+	}
+	if filter.TagsAny, err = parseTagNames(params.TagsAny); err != nil {
+		return filter, fmt.Errorf("invalid tags_any: %w", err)
+	}
+	if filter.CustomFields, err = parseCustomFieldFilter(params.CustomFields); err != nil {
+		return filter, fmt.Errorf("invalid custom_fields: %w", err)
+	}
+
+	if filter.Keys, err = parseTicketKeys(params.Key); err != nil {
+		return filter, fmt.Errorf("invalid key: %w", err)
+	}
+
+	if params.Q != nil {
+		// A ticket key in place of search words finds the ticket with that key
+		if key, isKey := tickets.ParseTicketKey(*params.Q); isKey {
+			filter.Keys = intersectTicketKeys(filter.Keys, key)
+			return filter, nil
+		}
+		search, searchErr := tickets.ParseSearchQuery(*params.Q)
+		if searchErr != nil {
+			return filter, fmt.Errorf("invalid q: %w", searchErr)
+		}
+		filter.Search = &search
+	}
 
 	return filter, nil
 }
 
+// FromOpenAPIBulkTicketFilter converts the filter of a bulk ticket operation to TicketFilter.
+// The filter selects tickets like the query parameters of the ticket list; the caller sets the limit.
+func FromOpenAPIBulkTicketFilter(filter openapi.BulkTicketFilter) (TicketFilter, error) {
+	return FromOpenAPITicketParams(openapi.GetTicketsParams{
+		Status:         filter.Status,
+		Priority:       filter.Priority,
+		CategoryId:     filter.CategoryId,
+		AssigneeId:     filter.AssigneeId,
+		OrganizationId: filter.OrganizationId,
+		AuthorId:       filter.AuthorId,
+		WatcherId:      filter.WatcherId,
+		Tags:           filter.Tags,
+		TagsAny:        filter.TagsAny,
+		CustomFields:   filter.CustomFields,
+		Key:            filter.Keys,
+	})
+}
+
+// FromOpenAPITicketViewFilter converts the filter and sort order of a saved view to TicketFilter.
+// The stored filter keeps only the sort settings of the base filter; pagination is set when the view runs.
+// AssignedToMe is resolved for the user running the view, so it is not part of the result.
+func FromOpenAPITicketViewFilter(
+	viewFilter openapi.TicketViewFilter,
+	sortBy *openapi.TicketViewSortBy,
+	sortOrder *openapi.TicketViewSortOrder,
+) (TicketFilter, error) {
+	filter, err := FromOpenAPITicketParams(openapi.GetTicketsParams{
+		Status:         viewFilter.Status,
+		Priority:       viewFilter.Priority,
+		CategoryId:     viewFilter.CategoryId,
+		AssigneeId:     viewFilter.AssigneeId,
+		OrganizationId: viewFilter.OrganizationId,
+		AuthorId:       viewFilter.AuthorId,
+		WatcherId:      viewFilter.WatcherId,
+		Tags:           viewFilter.Tags,
+		TagsAny:        viewFilter.TagsAny,
+		CustomFields:   viewFilter.CustomFields,
+		Q:              viewFilter.Q,
+	})
+	if err != nil {
+		return filter, err
+	}
+
+	if viewFilter.StatusCategories != nil {
+		for _, category := range *viewFilter.StatusCategories {
+			status, parseErr := tickets.ParseStatus(string(category))
+			if parseErr != nil {
+				return filter, fmt.Errorf("invalid status_categories: %w", parseErr)
+			}
+			filter.StatusCategories = append(filter.StatusCategories, status)
+		}
+	}
+	filter.Unassigned = viewFilter.Unassigned != nil && *viewFilter.Unassigned
+	if viewFilter.AssignedToMe != nil && *viewFilter.AssignedToMe && (filter.AssigneeID != nil || filter.Unassigned) {
+		return filter, errors.New("assigned_to_me cannot be combined with assignee_id or unassigned")
+	}
+
+	filter.BaseFilter = BaseFilter{SortBy: "created_at", SortOrder: "desc"}
+	if sortBy != nil {
+		filter.SortBy = string(*sortBy)
+	}
+	if sortOrder != nil {
+		filter.SortOrder = string(*sortOrder)
+	}
+	return filter, filter.Validate()
+}
+
+// ToOpenAPITicketViewFilter converts the stored filter of a saved view back to its OpenAPI form
+func ToOpenAPITicketViewFilter(filter TicketFilter, assignedToMe bool) openapi.TicketViewFilter {
+	viewFilter := openapi.TicketViewFilter{
+		CategoryId:     filter.CategoryID,
+		AssigneeId:     filter.AssigneeID,
+		OrganizationId: filter.OrganizationID,
+		AuthorId:       filter.AuthorID,
+		WatcherId:      filter.WatcherID,
+	}
+	if filter.Status != nil {
+		status := openapi.TicketStatus(filter.Status.String())
+		viewFilter.Status = &status
+	}
+	if filter.Priority != nil {
+		priority := openapi.TicketPriority(filter.Priority.String())
+		viewFilter.Priority = &priority
+	}
+	if len(filter.StatusCategories) > 0 {
+		categories := make([]openapi.TicketStatusCategory, 0, len(filter.StatusCategories))
+		for _, status := range filter.StatusCategories {
+			categories = append(categories, openapi.TicketStatusCategory(status.String()))
+		}
+		viewFilter.StatusCategories = &categories
+	}
+	if len(filter.Tags) > 0 {
+		viewFilter.Tags = &filter.Tags
+	}
+	if len(filter.TagsAny) > 0 {
+		viewFilter.TagsAny = &filter.TagsAny
+	}
+	if len(filter.CustomFields) > 0 {
+		viewFilter.CustomFields = &filter.CustomFields
+	}
+	if filter.Search != nil {
+		q := filter.Search.String()
+		viewFilter.Q = &q
+	}
+	if filter.Unassigned {
+		viewFilter.Unassigned = &filter.Unassigned
+	}
+	if assignedToMe {
+		viewFilter.AssignedToMe = &assignedToMe
+	}
+	return viewFilter
+}
+
+// parseCustomFieldFilter validates the keys, which become part of the storage query
+func parseCustomFieldFilter(values *map[string]string) (map[string]string, error) {
+	var fields map[string]string
+	if values == nil {
+		return fields, nil
+	}
+	fields = make(map[string]string, len(*values))
+	for key, value := range *values {
+		key, err := tickets.ParseCustomFieldKey(key)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+func parseTagNames(values *[]string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	names := make([]string, 0, len(*values))
+	for _, value := range *values {
+		name, err := tickets.ParseTagName(value)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// parseTicketKeys normalizes ticket keys; nil means no key filter
+func parseTicketKeys(values *[]string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(*values))
+	for _, value := range *values {
+		key, ok := tickets.ParseTicketKey(value)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", tickets.ErrInvalidTicketKey, value)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// intersectTicketKeys narrows the key filter down to a single key; the result is empty when the key is not in it
+func intersectTicketKeys(keys []string, key string) []string {
+	if keys != nil && !slices.Contains(keys, key) {
+		return []string{}
+	}
+	return []string{key}
+}
+
 // FromOpenAPICategoryParams converts OpenAPI parameters to CategoryFilter
 func FromOpenAPICategoryParams(params openapi.GetCategoriesParams) (CategoryFilter, error) {
 	filter := CategoryFilter{
@@ -130,6 +333,101 @@ func FromOpenAPIUserParams(params openapi.GetUsersParams) (UserFilter, error) {
 	return filter, nil
 }
 
+// FromOpenAPITicketHistoryParams converts OpenAPI parameters to TicketEventFilter
+func FromOpenAPITicketHistoryParams(
+	ticketID uuid.UUID,
+	params openapi.GetTicketsIDHistoryParams,
+) (TicketEventFilter, error) {
+	if params.Page != nil && *params.Page < 1 {
+		return TicketEventFilter{}, fmt.Errorf("page must be positive, got: %d", *params.Page)
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			return TicketEventFilter{}, fmt.Errorf("limit must be positive, got: %d", *params.Limit)
+		}
+		if *params.Limit > maxAllowedLimit {
+			return TicketEventFilter{}, fmt.Errorf(
+				"limit too large, maximum: %d, got: %d", maxAllowedLimit, *params.Limit)
+		}
+	}
+
+	return TicketEventFilter{
+		BaseFilter: BaseFilter{
+			Limit:     getIntValue(params.Limit),
+			Offset:    calculateOffset(params.Page, params.Limit),
+			SortBy:    "created_at",
+			SortOrder: sortOrderAsc, // History reads chronologically
+		},
+		TicketID: ticketID,
+	}, nil
+}
+
+// FromOpenAPITrashParams converts OpenAPI parameters to TrashFilter, the most recently deleted items first
+func FromOpenAPITrashParams(params openapi.GetTrashParams) (TrashFilter, error) {
+	filter := TrashFilter{
+		BaseFilter: BaseFilter{
+			Limit:     getIntValue(params.Limit),
+			Offset:    calculateOffset(params.Page, params.Limit),
+			SortBy:    "deleted_at",
+			SortOrder: sortOrderDesc,
+		},
+		OrganizationID: params.OrganizationId,
+	}
+	return filter, filter.Validate()
+}
+
+// FromOpenAPISatisfactionParams converts OpenAPI parameters to SatisfactionFilter
+func FromOpenAPISatisfactionParams(params openapi.GetReportsSatisfactionParams) (SatisfactionFilter, error) {
+	filter := SatisfactionFilter{
+		GroupBy:        SatisfactionGroup(params.GroupBy),
+		OrganizationID: params.OrganizationId,
+		RatedAfter:     params.From,
+		RatedBefore:    params.To,
+	}
+	return filter, filter.Validate()
+}
+
+// FromOpenAPITimeReportParams converts OpenAPI parameters to WorkLogFilter; dates are taken as work days in UTC
+func FromOpenAPITimeReportParams(params openapi.GetReportsTimeParams) (WorkLogFilter, error) {
+	filter := WorkLogFilter{
+		GroupBy:        WorkLogGroup(params.GroupBy),
+		From:           tickets.WorkDate(params.From.Time),
+		To:             tickets.WorkDate(params.To.Time),
+		OrganizationID: params.OrganizationId,
+		Billable:       params.Billable,
+	}
+	return filter, filter.Validate()
+}
+
+// FromOpenAPINotificationParams converts OpenAPI parameters to NotificationFilter for the requesting user
+func FromOpenAPINotificationParams(
+	userID uuid.UUID,
+	params openapi.GetNotificationsParams,
+) (NotificationFilter, error) {
+	filter := NotificationFilter{
+		BaseFilter: BaseFilter{
+			Limit:  getIntValue(params.Limit),
+			Offset: calculateOffset(params.Page, params.Limit),
+		},
+		UserID: userID,
+		Unread: params.Unread,
+	}
+	return filter, filter.Validate()
+}
+
+// FromOpenAPICannedResponseParams converts OpenAPI parameters to MacroFilter for the requesting user
+func FromOpenAPICannedResponseParams(ownerID uuid.UUID, params openapi.GetCannedResponsesParams) (MacroFilter, error) {
+	filter := MacroFilter{OwnerID: ownerID, OrganizationID: params.OrganizationId}
+	return filter, filter.Validate()
+}
+
+// FromOpenAPIMacroParams converts OpenAPI parameters to MacroFilter for the requesting user
+func FromOpenAPIMacroParams(ownerID uuid.UUID, params openapi.GetMacrosParams) (MacroFilter, error) {
+	filter := MacroFilter{OwnerID: ownerID, OrganizationID: params.OrganizationId}
+	return filter, filter.Validate()
+}
+
 // Helper functions for safe pointer dereferencing and type conversions
 
 func getIntValue(ptr *int) int {
@@ -4,18 +4,21 @@ package queries_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"simpleservicedesk/generated/openapi"
+	"simpleservicedesk/internal/domain/tickets"
 	"simpleservicedesk/internal/queries"
 )
 
 func TestFromOpenAPITicketParams(t *testing.T) {
 	t.Run("successful conversion with all params", func(t *testing.T) {
-		status := openapi.New
+		status := openapi.TicketStatus("new")
 		priority := openapi.High
 		page := 2
 		limit := 50
@@ -69,6 +72,148 @@ func TestFromOpenAPITicketParams(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid priority")
 	})
+
+	t.Run("tags are normalized", func(t *testing.T) {
+		tags := []string{"VIP", " security "}
+		tagsAny := []string{"hardware-recall"}
+		params := openapi.GetTicketsParams{
+			Tags:    &tags,
+			TagsAny: &tagsAny,
+		}
+
+		filter, err := queries.FromOpenAPITicketParams(params)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vip", "security"}, filter.Tags)
+		assert.Equal(t, []string{"hardware-recall"}, filter.TagsAny)
+	})
+
+	t.Run("custom field keys are validated", func(t *testing.T) {
+		fields := map[string]string{"Asset_Tag": "LT-1042"}
+		filter, err := queries.FromOpenAPITicketParams(openapi.GetTicketsParams{CustomFields: &fields})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"asset_tag": "LT-1042"}, filter.CustomFields)
+
+		fields = map[string]string{"asset.tag": "LT-1042"}
+		_, err = queries.FromOpenAPITicketParams(openapi.GetTicketsParams{CustomFields: &fields})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid custom_fields")
+	})
+
+	t.Run("invalid tag returns error", func(t *testing.T) {
+		tags := []string{"no spaces"}
+		params := openapi.GetTicketsParams{
+			Tags: &tags,
+		}
+
+		_, err := queries.FromOpenAPITicketParams(params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid tags")
+	})
+
+	t.Run("search query is parsed", func(t *testing.T) {
+		q := " printer jam "
+		filter, err := queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Q: &q})
+		require.NoError(t, err)
+		require.NotNil(t, filter.Search)
+		assert.Equal(t, "printer jam", filter.Search.String())
+		assert.False(t, filter.SearchInternal)
+
+		q = "-printer"
+		_, err = queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Q: &q})
+		require.ErrorIs(t, err, tickets.ErrInvalidSearch)
+	})
+
+	t.Run("ticket keys are normalized", func(t *testing.T) {
+		keys := []string{"acme-1042", " IT-7 "}
+		filter, err := queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Key: &keys})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ACME-1042", "IT-7"}, filter.Keys)
+
+		keys = []string{"ACME"}
+		_, err = queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Key: &keys})
+		require.ErrorIs(t, err, tickets.ErrInvalidTicketKey)
+	})
+
+	t.Run("search for a ticket key matches the key", func(t *testing.T) {
+		q := "acme-1042"
+		filter, err := queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Q: &q})
+		require.NoError(t, err)
+		assert.Nil(t, filter.Search)
+		assert.Equal(t, []string{"ACME-1042"}, filter.Keys)
+
+		keys := []string{"IT-7"}
+		filter, err = queries.FromOpenAPITicketParams(openapi.GetTicketsParams{Q: &q, Key: &keys})
+		require.NoError(t, err)
+		assert.NotNil(t, filter.Keys)
+		assert.Empty(t, filter.Keys)
+	})
+}
+
+func TestFromOpenAPIBulkTicketFilter(t *testing.T) {
+	t.Run("successful conversion", func(t *testing.T) {
+		orgID := uuid.New()
+		status := openapi.TicketStatus("in_progress")
+		tags := []string{"VIP"}
+
+		filter, err := queries.FromOpenAPIBulkTicketFilter(openapi.BulkTicketFilter{
+			OrganizationId: &orgID,
+			Status:         &status,
+			Tags:           &tags,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &orgID, filter.OrganizationID)
+		assert.Equal(t, tickets.StatusInProgress, *filter.Status)
+		assert.Equal(t, []string{"vip"}, filter.Tags)
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		priority := openapi.TicketPriority("highest")
+		_, err := queries.FromOpenAPIBulkTicketFilter(openapi.BulkTicketFilter{Priority: &priority})
+		require.Error(t, err)
+	})
+}
+
+func TestFromOpenAPITicketViewFilter(t *testing.T) {
+	t.Run("keeps only sort settings of the base filter", func(t *testing.T) {
+		categories := []openapi.TicketStatusCategory{openapi.New, openapi.InProgress}
+		unassigned := true
+		q := "printer"
+		sortBy := openapi.ViewSortByPriority
+
+		filter, err := queries.FromOpenAPITicketViewFilter(openapi.TicketViewFilter{
+			StatusCategories: &categories,
+			Unassigned:       &unassigned,
+			Q:                &q,
+		}, &sortBy, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []tickets.Status{tickets.StatusNew, tickets.StatusInProgress}, filter.StatusCategories)
+		assert.True(t, filter.Unassigned)
+		assert.Equal(t, queries.BaseFilter{SortBy: "priority", SortOrder: "desc"}, filter.BaseFilter)
+
+		restored := queries.ToOpenAPITicketViewFilter(filter, false)
+		assert.Equal(t, &categories, restored.StatusCategories)
+		assert.Equal(t, &q, restored.Q)
+		assert.Nil(t, restored.AssignedToMe)
+	})
+
+	t.Run("assigned to me conflicts with an explicit assignee", func(t *testing.T) {
+		assigneeID := uuid.New()
+		assignedToMe := true
+		_, err := queries.FromOpenAPITicketViewFilter(openapi.TicketViewFilter{
+			AssigneeId:   &assigneeID,
+			AssignedToMe: &assignedToMe,
+		}, nil, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		sortBy := openapi.TicketViewSortBy("sla")
+		_, err := queries.FromOpenAPITicketViewFilter(openapi.TicketViewFilter{}, &sortBy, nil)
+		require.Error(t, err)
+	})
 }
 
 func TestFromOpenAPICategoryParams(t *testing.T) {
@@ -96,6 +241,122 @@ func TestFromOpenAPICategoryParams(t *testing.T) {
 	})
 }
 
+func TestFromOpenAPIMacroParams(t *testing.T) {
+	t.Run("successful conversion", func(t *testing.T) {
+		ownerID := uuid.New()
+		orgID := uuid.New()
+
+		filter, err := queries.FromOpenAPIMacroParams(ownerID, openapi.GetMacrosParams{OrganizationId: &orgID})
+
+		require.NoError(t, err)
+		assert.Equal(t, ownerID, filter.OwnerID)
+		assert.Equal(t, &orgID, filter.OrganizationID)
+	})
+
+	t.Run("owner is required", func(t *testing.T) {
+		_, err := queries.FromOpenAPICannedResponseParams(uuid.Nil, openapi.GetCannedResponsesParams{})
+		require.Error(t, err)
+	})
+}
+
+func TestFromOpenAPITrashParams(t *testing.T) {
+	orgID := uuid.New()
+	page := 3
+	limit := 10
+
+	filter, err := queries.FromOpenAPITrashParams(openapi.GetTrashParams{
+		Type:           openapi.TrashItemTicket,
+		OrganizationId: &orgID,
+		Page:           &page,
+		Limit:          &limit,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &orgID, filter.OrganizationID)
+	assert.Equal(t, 10, filter.Limit)
+	assert.Equal(t, 20, filter.Offset)
+	assert.Equal(t, "deleted_at", filter.SortBy)
+	assert.Equal(t, "desc", filter.SortOrder)
+	assert.Nil(t, filter.DeletedBefore)
+}
+
+func TestFromOpenAPINotificationParams(t *testing.T) {
+	userID := uuid.New()
+	unread := true
+	page := 2
+	limit := 5
+
+	filter, err := queries.FromOpenAPINotificationParams(userID, openapi.GetNotificationsParams{
+		Unread: &unread,
+		Page:   &page,
+		Limit:  &limit,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, filter.UserID)
+	assert.Equal(t, &unread, filter.Unread)
+	assert.Equal(t, 5, filter.Limit)
+	assert.Equal(t, 5, filter.Offset)
+
+	_, err = queries.FromOpenAPINotificationParams(uuid.Nil, openapi.GetNotificationsParams{})
+	require.Error(t, err)
+}
+
+func TestFromOpenAPISatisfactionParams(t *testing.T) {
+	orgID := uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	filter, err := queries.FromOpenAPISatisfactionParams(openapi.GetReportsSatisfactionParams{
+		GroupBy:        openapi.SatisfactionByCategory,
+		OrganizationId: &orgID,
+		From:           &from,
+		To:             &to,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, queries.SatisfactionByCategory, filter.GroupBy)
+	assert.Equal(t, &orgID, filter.OrganizationID)
+	assert.Equal(t, &from, filter.RatedAfter)
+	assert.Equal(t, &to, filter.RatedBefore)
+
+	_, err = queries.FromOpenAPISatisfactionParams(openapi.GetReportsSatisfactionParams{
+		GroupBy: openapi.SatisfactionByAgent,
+		From:    &to,
+		To:      &from,
+	})
+	require.Error(t, err)
+}
+
+func TestFromOpenAPITimeReportParams(t *testing.T) {
+	orgID := uuid.New()
+	billable := true
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 30)
+
+	filter, err := queries.FromOpenAPITimeReportParams(openapi.GetReportsTimeParams{
+		GroupBy:        openapi.TimeByAgent,
+		From:           openapi_types.Date{Time: from},
+		To:             openapi_types.Date{Time: to},
+		OrganizationId: &orgID,
+		Billable:       &billable,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, queries.WorkLogByAgent, filter.GroupBy)
+	assert.Equal(t, from, filter.From)
+	assert.Equal(t, to, filter.To)
+	assert.Equal(t, &orgID, filter.OrganizationID)
+	assert.Equal(t, &billable, filter.Billable)
+
+	_, err = queries.FromOpenAPITimeReportParams(openapi.GetReportsTimeParams{
+		GroupBy: openapi.TimeByCategory,
+		From:    openapi_types.Date{Time: to},
+		To:      openapi_types.Date{Time: from},
+	})
+	require.Error(t, err)
+}
+
 func TestFromOpenAPIOrganizationParams(t *testing.T) {
 	t.Run("successful conversion", func(t *testing.T) {
 		name := "Test Org"
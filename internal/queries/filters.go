@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 
 	"simpleservicedesk/internal/domain/tickets"
+	"simpleservicedesk/internal/domain/views"
 )
 
 // BaseFilter contains common pagination and sorting fields
@@ -29,14 +30,25 @@ type TicketFilter struct {
 	BaseFilter
 	TimeRangeFilter
 
-	Status         *tickets.Status   `json:"status,omitempty"`
-	Priority       *tickets.Priority `json:"priority,omitempty"`
-	AssigneeID     *uuid.UUID        `json:"assignee_id,omitempty"`
-	AuthorID       *uuid.UUID        `json:"author_id,omitempty"`
-	OrganizationID *uuid.UUID        `json:"organization_id,omitempty"`
-	CategoryID     *uuid.UUID        `json:"category_id,omitempty"`
-	CategoryIDs    []uuid.UUID       `json:"category_ids,omitempty"`
-	IsOverdue      *bool             `json:"is_overdue,omitempty"`
+	Status           *tickets.Status   `json:"status,omitempty"`
+	StatusCategories []tickets.Status  `json:"status_categories,omitempty"`
+	Priority         *tickets.Priority `json:"priority,omitempty"`
+	AssigneeID       *uuid.UUID        `json:"assignee_id,omitempty"`
+	AuthorID         *uuid.UUID        `json:"author_id,omitempty"`
+	OrganizationID   *uuid.UUID        `json:"organization_id,omitempty"`
+	CategoryID       *uuid.UUID        `json:"category_id,omitempty"`
+	CategoryIDs      []uuid.UUID       `json:"category_ids,omitempty"`
+	Keys             []string          `json:"keys,omitempty"` // Tickets with one of the keys; nil - any ticket
+	WatcherID        *uuid.UUID        `json:"watcher_id,omitempty"`
+	Tags             []string          `json:"tags,omitempty"`     // Tickets tagged with all of the tags
+	TagsAny          []string          `json:"tags_any,omitempty"` // Tickets tagged with at least one of the tags
+	CustomFields     map[string]string `json:"custom_fields,omitempty"`
+	Unassigned       bool              `json:"unassigned,omitempty"` // Tickets without an assignee
+	IsOverdue        *bool             `json:"is_overdue,omitempty"`
+
+	// Full-text search; matching tickets are ordered by relevance
+	Search         *tickets.SearchQuery `json:"search,omitempty"`
+	SearchInternal bool                 `json:"search_internal,omitempty"` // Internal comments are searched too
 }
 
 // CategoryFilter - SINGLE source of truth for category filtering
@@ -71,3 +83,83 @@ type UserFilter struct {
 	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 	IsActive       *bool      `json:"is_active,omitempty"`
 }
+
+// TicketEventFilter - SINGLE source of truth for ticket history filtering
+type TicketEventFilter struct {
+	BaseFilter
+
+	TicketID        uuid.UUID `json:"ticket_id"`
+	IncludeInternal bool      `json:"include_internal,omitempty"`
+}
+
+// ViewFilter - SINGLE source of truth for saved ticket view filtering.
+// Lists the views the viewer can run: their own, those shared with their organization or role,
+// and for admins all shared views.
+type ViewFilter struct {
+	Viewer views.Viewer `json:"viewer"`
+}
+
+// TrashFilter - SINGLE source of truth for filtering trashed tickets, categories and organizations
+type TrashFilter struct {
+	BaseFilter
+
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"` // Tickets and categories of the organization
+	DeletedBefore  *time.Time `json:"deleted_before,omitempty"`  // Items trashed before this time
+}
+
+// SatisfactionGroup selects the dimension CSAT ratings are grouped by
+type SatisfactionGroup string
+
+const (
+	SatisfactionByAgent        SatisfactionGroup = "agent"
+	SatisfactionByCategory     SatisfactionGroup = "category"
+	SatisfactionByOrganization SatisfactionGroup = "organization"
+)
+
+// SatisfactionFilter - SINGLE source of truth for CSAT report filtering
+type SatisfactionFilter struct {
+	GroupBy        SatisfactionGroup `json:"group_by"`
+	OrganizationID *uuid.UUID        `json:"organization_id,omitempty"`
+	RatedAfter     *time.Time        `json:"rated_after,omitempty"`  // Ratings submitted at or after this time
+	RatedBefore    *time.Time        `json:"rated_before,omitempty"` // Ratings submitted before this time
+}
+
+// WorkLogGroup selects the dimension logged time is grouped by
+type WorkLogGroup string
+
+const (
+	WorkLogByAgent        WorkLogGroup = "agent"
+	WorkLogByCategory     WorkLogGroup = "category"
+	WorkLogByOrganization WorkLogGroup = "organization"
+)
+
+// WorkLogFilter - SINGLE source of truth for time tracking report filtering
+type WorkLogFilter struct {
+	GroupBy        WorkLogGroup `json:"group_by"`
+	From           time.Time    `json:"from"` // First work date of the range
+	To             time.Time    `json:"to"`   // Last work date of the range, inclusive
+	OrganizationID *uuid.UUID   `json:"organization_id,omitempty"`
+	Billable       *bool        `json:"billable,omitempty"` // nil - billable and non-billable entries
+}
+
+// NotificationFilter - SINGLE source of truth for listing in-app notifications of a user, the newest first
+type NotificationFilter struct {
+	BaseFilter
+
+	UserID uuid.UUID `json:"user_id"`
+	Unread *bool     `json:"unread,omitempty"` // nil - read and unread notifications
+}
+
+// MacroFilter - SINGLE source of truth for canned response and macro filtering.
+// Personal items of the owner are always included together with items shared with organizations.
+type MacroFilter struct {
+	OwnerID        uuid.UUID  `json:"owner_id"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"` // Only items shared with this organization
+}
+
+// RecurringTemplateFilter - SINGLE source of truth for recurring ticket template filtering
+type RecurringTemplateFilter struct {
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	IsActive       *bool      `json:"is_active,omitempty"`
+	DueBefore      *time.Time `json:"due_before,omitempty"` // Active templates whose next run is at or before this time
+}
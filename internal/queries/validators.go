@@ -6,6 +6,8 @@ import (
 	"slices"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
@@ -32,6 +34,13 @@ func (f TicketFilter) Validate() error {
 
 	// Ticket-specific validation rules
 	// Status and Priority are validated during parsing, so no additional checks needed
+	validSortFields := []string{"created_at", "updated_at", "status", "priority", "title"}
+	if f.SortBy != "" && !contains(validSortFields, f.SortBy) {
+		return fmt.Errorf("invalid ticket sort field: %s", f.SortBy)
+	}
+	if f.Unassigned && f.AssigneeID != nil {
+		return errors.New("unassigned cannot be combined with assignee_id")
+	}
 
 	return nil
 }
@@ -93,6 +102,87 @@ func (f UserFilter) Validate() error {
 	return nil
 }
 
+// Validate checks TicketEventFilter for business rule compliance
+func (f TicketEventFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return fmt.Errorf("base filter validation: %w", err)
+	}
+
+	if f.TicketID == uuid.Nil {
+		return errors.New("ticket id is required")
+	}
+
+	return nil
+}
+
+// Validate checks TrashFilter for business rule compliance
+func (f TrashFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return fmt.Errorf("base filter validation: %w", err)
+	}
+
+	if f.SortBy != "" && f.SortBy != "deleted_at" {
+		return fmt.Errorf("invalid trash sort field: %s", f.SortBy)
+	}
+
+	return nil
+}
+
+// Validate checks SatisfactionFilter for business rule compliance
+func (f SatisfactionFilter) Validate() error {
+	switch f.GroupBy {
+	case SatisfactionByAgent, SatisfactionByCategory, SatisfactionByOrganization:
+	default:
+		return fmt.Errorf("invalid satisfaction grouping: %q", f.GroupBy)
+	}
+
+	if f.RatedAfter != nil && f.RatedBefore != nil && !f.RatedAfter.Before(*f.RatedBefore) {
+		return errors.New("from must be before to")
+	}
+
+	return nil
+}
+
+// Validate checks WorkLogFilter for business rule compliance
+func (f WorkLogFilter) Validate() error {
+	switch f.GroupBy {
+	case WorkLogByAgent, WorkLogByCategory, WorkLogByOrganization:
+	default:
+		return fmt.Errorf("invalid time report grouping: %q", f.GroupBy)
+	}
+
+	if f.From.IsZero() || f.To.IsZero() {
+		return errors.New("from and to are required")
+	}
+	if f.To.Before(f.From) {
+		return errors.New("from must not be after to")
+	}
+
+	return nil
+}
+
+// Validate checks NotificationFilter for business rule compliance
+func (f NotificationFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return fmt.Errorf("base filter validation: %w", err)
+	}
+
+	if f.UserID == uuid.Nil {
+		return errors.New("user id is required")
+	}
+
+	return nil
+}
+
+// Validate checks MacroFilter for business rule compliance
+func (f MacroFilter) Validate() error {
+	if f.OwnerID == uuid.Nil {
+		return errors.New("owner id is required")
+	}
+
+	return nil
+}
+
 // Validate checks BaseFilter for common validation rules
 func (f BaseFilter) Validate() error {
 	if f.Limit < 0 {
@@ -109,7 +199,7 @@ func (f BaseFilter) Validate() error {
 	if f.SortBy != "" {
 		validSortFields := []string{
 			"created_at", "updated_at", "status", "priority",
-			"name", "title", "email", "domain", "id",
+			"name", "title", "email", "domain", "id", "deleted_at",
 		}
 		if !contains(validSortFields, f.SortBy) {
 			return fmt.Errorf("invalid sort field: %s", f.SortBy)
@@ -236,3 +326,19 @@ func (f UserFilter) ValidateAndSetDefaults() (UserFilter, error) {
 
 	return f, f.Validate()
 }
+
+// ValidateAndSetDefaults validates the filter and sets sensible defaults
+func (f TicketEventFilter) ValidateAndSetDefaults() (TicketEventFilter, error) {
+	// Set defaults
+	if f.Limit == 0 {
+		f.Limit = 20
+	}
+	if f.SortBy == "" {
+		f.SortBy = "created_at"
+	}
+	if f.SortOrder == "" {
+		f.SortOrder = sortOrderAsc
+	}
+
+	return f, f.Validate()
+}
@@ -176,6 +176,19 @@ func TestTicketFilterValidateAndSetDefaults(t *testing.T) {
 	})
 }
 
+func TestTicketFilterValidate(t *testing.T) {
+	t.Run("unknown sort field", func(t *testing.T) {
+		filter := queries.TicketFilter{BaseFilter: queries.BaseFilter{SortBy: "assignee"}}
+		require.Error(t, filter.Validate())
+	})
+
+	t.Run("unassigned with an assignee", func(t *testing.T) {
+		assigneeID := uuid.New()
+		filter := queries.TicketFilter{Unassigned: true, AssigneeID: &assigneeID}
+		require.Error(t, filter.Validate())
+	})
+}
+
 func TestUserFilterValidate(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -242,6 +255,51 @@ func TestUserFilterValidate(t *testing.T) {
 	}
 }
 
+func TestTrashFilterValidate(t *testing.T) {
+	require.NoError(t, queries.TrashFilter{BaseFilter: queries.BaseFilter{SortBy: "deleted_at"}}.Validate())
+
+	err := queries.TrashFilter{BaseFilter: queries.BaseFilter{SortBy: "name"}}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid trash sort field")
+
+	require.Error(t, queries.TrashFilter{BaseFilter: queries.BaseFilter{Limit: -1}}.Validate())
+}
+
+func TestNotificationFilterValidate(t *testing.T) {
+	require.NoError(t, queries.NotificationFilter{UserID: uuid.New()}.Validate())
+	require.Error(t, queries.NotificationFilter{}.Validate())
+	require.Error(t, queries.NotificationFilter{
+		BaseFilter: queries.BaseFilter{Limit: -1},
+		UserID:     uuid.New(),
+	}.Validate())
+}
+
+func TestSatisfactionFilterValidate(t *testing.T) {
+	require.NoError(t, queries.SatisfactionFilter{GroupBy: queries.SatisfactionByOrganization}.Validate())
+
+	err := queries.SatisfactionFilter{GroupBy: "priority"}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid satisfaction grouping")
+
+	now := time.Now()
+	err = queries.SatisfactionFilter{GroupBy: queries.SatisfactionByAgent, RatedAfter: &now, RatedBefore: &now}.Validate()
+	require.Error(t, err)
+}
+
+func TestWorkLogFilterValidate(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, queries.WorkLogFilter{GroupBy: queries.WorkLogByAgent, From: day, To: day}.Validate())
+
+	err := queries.WorkLogFilter{GroupBy: "priority", From: day, To: day}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid time report grouping")
+
+	require.Error(t, queries.WorkLogFilter{GroupBy: queries.WorkLogByCategory}.Validate())
+	require.Error(t, queries.WorkLogFilter{
+		GroupBy: queries.WorkLogByOrganization, From: day, To: day.AddDate(0, 0, -1),
+	}.Validate())
+}
+
 func TestCategoryFilterValidate(t *testing.T) {
 	tests := []struct {
 		name        string
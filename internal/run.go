@@ -12,12 +12,23 @@ import (
 	"time"
 
 	"simpleservicedesk/internal/application"
+	"simpleservicedesk/internal/application/autoclose"
+	"simpleservicedesk/internal/application/escalation"
+	"simpleservicedesk/internal/application/recurring"
+	"simpleservicedesk/internal/application/scheduler"
+	"simpleservicedesk/internal/application/trash"
 	userdomain "simpleservicedesk/internal/domain/users"
+	blobstoreInfra "simpleservicedesk/internal/infrastructure/blobstore"
 	categoriesInfra "simpleservicedesk/internal/infrastructure/categories"
 	healthInfra "simpleservicedesk/internal/infrastructure/health"
+	leasesInfra "simpleservicedesk/internal/infrastructure/leases"
+	macrosInfra "simpleservicedesk/internal/infrastructure/macros"
+	notificationsInfra "simpleservicedesk/internal/infrastructure/notifications"
 	organizationsInfra "simpleservicedesk/internal/infrastructure/organizations"
+	recurringInfra "simpleservicedesk/internal/infrastructure/recurring"
 	ticketsInfra "simpleservicedesk/internal/infrastructure/tickets"
 	usersInfra "simpleservicedesk/internal/infrastructure/users"
+	viewsInfra "simpleservicedesk/internal/infrastructure/views"
 	"simpleservicedesk/internal/queries"
 	"simpleservicedesk/pkg/environment"
 
@@ -78,8 +89,19 @@ func startServer(
 	ticketRepo := ticketsInfra.NewMongoRepo(db)
 	organizationRepo := organizationsInfra.NewMongoRepo(db)
 	categoryRepo := categoriesInfra.NewMongoRepo(db)
+	macroRepo := macrosInfra.NewMongoRepo(db)
+	viewRepo := viewsInfra.NewMongoRepo(db)
+	recurringRepo := recurringInfra.NewMongoRepo(db)
+	notificationRepo := notificationsInfra.NewMongoRepo(db)
+	blobStore, err := newBlobStore(cfg.Storage, db)
+	if err != nil {
+		return err
+	}
+	if err = ticketRepo.BackfillSearchDocuments(ctx); err != nil {
+		return fmt.Errorf("failed to backfill ticket search documents: %w", err)
+	}
 	pinger := healthInfra.NewMongoPinger(mongoClient)
-	if err := ensureBootstrapAdminUser(ctx, userRepo, cfg.Server.Environment, cfg.Auth); err != nil {
+	if err = ensureBootstrapAdminUser(ctx, userRepo, cfg.Server.Environment, cfg.Auth); err != nil {
 		return err
 	}
 
@@ -88,11 +110,17 @@ func startServer(
 		ticketRepo,
 		organizationRepo,
 		categoryRepo,
+		macroRepo,
+		viewRepo,
+		recurringRepo,
+		notificationRepo,
+		blobStore,
 		pinger,
 		cfg.Auth.JWTSigningKey,
 		cfg.Auth.JWTExpiration,
 		cfg.Server.CORSAllowedOrigins,
 		cfg.Server.RateLimitRPS,
+		cfg.Jobs.TrashRetention,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to set up http server: %w", err)
@@ -125,9 +153,82 @@ func startServer(
 		return nil
 	})
 
+	startBackgroundJobs(ctx, g, cfg.Jobs, db, ticketRepo, organizationRepo, categoryRepo, recurringRepo, blobStore)
+
 	return nil
 }
 
+func startBackgroundJobs(
+	ctx context.Context,
+	g *errgroup.Group,
+	cfg Jobs,
+	db *mongo.Database,
+	ticketRepo *ticketsInfra.MongoRepo,
+	organizationRepo *organizationsInfra.MongoRepo,
+	categoryRepo *categoriesInfra.MongoRepo,
+	recurringRepo *recurringInfra.MongoRepo,
+	blobStore application.BlobStore,
+) {
+	// Every replica runs the scheduler; the lease holder ID tells replicas apart
+	jobs := scheduler.New(leasesInfra.NewMongoRepo(db), uuid.NewString())
+	monitor := escalation.NewMonitor(ticketRepo, organizationRepo)
+	janitor := trash.NewJanitor(ticketRepo, categoryRepo, organizationRepo, blobStore, cfg.TrashRetention)
+	closer := autoclose.NewCloser(ticketRepo, organizationRepo)
+	runner := recurring.NewRunner(recurringRepo, ticketRepo, organizationRepo)
+
+	g.Go(func() error {
+		return jobs.Run(ctx, scheduler.Job{
+			Name:     escalation.JobName,
+			Interval: cfg.SLAEscalationInterval,
+			Run:      monitor.Check,
+		})
+	})
+	g.Go(func() error {
+		return jobs.Run(ctx, scheduler.Job{
+			Name:     trash.JobName,
+			Interval: cfg.TrashPurgeInterval,
+			Run:      janitor.PurgeExpired,
+		})
+	})
+	g.Go(func() error {
+		return jobs.Run(ctx, scheduler.Job{
+			Name:     autoclose.JobName,
+			Interval: cfg.AutoCloseInterval,
+			Run:      closer.CloseStale,
+		})
+	})
+	g.Go(func() error {
+		return jobs.Run(ctx, scheduler.Job{
+			Name:     recurring.JobName,
+			Interval: cfg.RecurringTicketsInterval,
+			Run:      runner.CreateDue,
+		})
+	})
+	g.Go(func() error {
+		return jobs.Run(ctx, scheduler.Job{
+			Name:     ticketsInfra.SyncJobName,
+			Interval: cfg.TicketSyncInterval,
+			Run:      ticketRepo.SyncPendingTickets,
+		})
+	})
+}
+
+func newBlobStore(cfg Storage, db *mongo.Database) (application.BlobStore, error) {
+	if cfg.Backend == StorageBackendLocal {
+		store, err := blobstoreInfra.NewLocalStore(cfg.LocalPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up local blob storage: %w", err)
+		}
+		return store, nil
+	}
+
+	store, err := blobstoreInfra.NewGridFSStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up gridfs blob storage: %w", err)
+	}
+	return store, nil
+}
+
 func ensureBootstrapAdminUser(
 	ctx context.Context,
 	userRepo *usersInfra.MongoRepo,
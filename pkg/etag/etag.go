@@ -0,0 +1,62 @@
+package etag
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
+// Format returns the strong entity tag of a resource version
+func Format(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// Set writes the entity tag of a resource version to the ETag response header
+func Set(c echo.Context, version int64) {
+	c.Response().Header().Set(HeaderETag, Format(version))
+}
+
+// Precondition is a parsed If-Match header
+type Precondition struct {
+	any  bool
+	tags []string
+}
+
+// ParseIfMatch parses the If-Match header.
+// A missing header and "*" match any version, otherwise the header lists the accepted entity tags.
+func ParseIfMatch(header *string) (Precondition, error) {
+	if header == nil || strings.TrimSpace(*header) == "" || strings.TrimSpace(*header) == "*" {
+		return Precondition{any: true}, nil
+	}
+
+	var precondition Precondition
+	for tag := range strings.SplitSeq(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		opaque := strings.TrimPrefix(tag, "W/")
+		if len(opaque) < 2 || !strings.HasPrefix(opaque, `"`) || !strings.HasSuffix(opaque, `"`) ||
+			strings.Contains(opaque[1:len(opaque)-1], `"`) {
+			return Precondition{}, ErrInvalidIfMatch
+		}
+		precondition.tags = append(precondition.tags, tag)
+	}
+	return precondition, nil
+}
+
+// Matches reports whether the precondition holds for the resource version.
+// If-Match uses the strong comparison, so weak tags never match.
+func (p Precondition) Matches(version int64) bool {
+	if p.any {
+		return true
+	}
+	return slices.Contains(p.tags, Format(version))
+}
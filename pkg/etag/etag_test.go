@@ -0,0 +1,43 @@
+package etag_test
+
+import (
+	"testing"
+
+	"simpleservicedesk/pkg/etag"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	header := func(value string) *string { return &value }
+
+	t.Run("missing header and wildcard match any version", func(t *testing.T) {
+		for _, value := range []*string{nil, header(""), header(" * ")} {
+			precondition, err := etag.ParseIfMatch(value)
+			require.NoError(t, err)
+			assert.True(t, precondition.Matches(7))
+		}
+	})
+
+	t.Run("listed tags match their versions only", func(t *testing.T) {
+		precondition, err := etag.ParseIfMatch(header(`"3", ` + etag.Format(5)))
+		require.NoError(t, err)
+		assert.True(t, precondition.Matches(3))
+		assert.True(t, precondition.Matches(5))
+		assert.False(t, precondition.Matches(4))
+	})
+
+	t.Run("weak tags never match", func(t *testing.T) {
+		precondition, err := etag.ParseIfMatch(header(`W/"3"`))
+		require.NoError(t, err)
+		assert.False(t, precondition.Matches(3))
+	})
+
+	t.Run("malformed tags are rejected", func(t *testing.T) {
+		for _, value := range []string{"3", `"3`, `"3", `, `"a"b"`, `W/3`} {
+			_, err := etag.ParseIfMatch(header(value))
+			require.ErrorIs(t, err, etag.ErrInvalidIfMatch, value)
+		}
+	})
+}
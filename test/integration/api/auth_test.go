@@ -118,7 +118,7 @@ func (s *AuthAPITestSuite) TestRoleProtectedEndpoints() {
 	targetUserID := uuid.New()
 	targetTicketID := uuid.New()
 
-	statusBody, err := json.Marshal(openapi.UpdateTicketStatusRequest{Status: openapi.InProgress})
+	statusBody, err := json.Marshal(openapi.UpdateTicketStatusRequest{Status: openapi.TicketStatus("in_progress")})
 	s.Require().NoError(err)
 	roleBody, err := json.Marshal(openapi.UpdateUserRoleRequest{Role: openapi.Agent})
 	s.Require().NoError(err)
@@ -169,7 +169,7 @@ func (s *E2ETestSuite) TestTicketLifecycleWorkflow() {
 	s.Require().NoError(err)
 	s.Require().NotNil(createdTicket.Id)
 	s.Require().NotNil(createdTicket.Status)
-	s.Equal(openapi.New, *createdTicket.Status)
+	s.Equal(openapi.TicketStatus("new"), *createdTicket.Status)
 	s.Nil(createdTicket.ResolvedAt)
 	s.Nil(createdTicket.ClosedAt)
 
@@ -184,38 +184,38 @@ func (s *E2ETestSuite) TestTicketLifecycleWorkflow() {
 	s.Require().NotNil(assignedTicket.AssigneeId)
 	s.Equal(assignee.ID, *assignedTicket.AssigneeId)
 
-	invalidTransitionResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.Resolved, agentToken)
+	invalidTransitionResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.TicketStatus("resolved"), agentToken)
 	s.Require().Equal(http.StatusBadRequest, invalidTransitionResp.Code, "response: %s", invalidTransitionResp.Body.String())
 	s.Contains(strings.ToLower(invalidTransitionResp.Body.String()), "invalid status transition")
 
-	inProgressResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.InProgress, agentToken)
+	inProgressResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.TicketStatus("in_progress"), agentToken)
 	s.Require().Equal(http.StatusOK, inProgressResp.Code, "response: %s", inProgressResp.Body.String())
 	var inProgressTicket openapi.GetTicketResponse
 	err = json.Unmarshal(inProgressResp.Body.Bytes(), &inProgressTicket)
 	s.Require().NoError(err)
 	s.Require().NotNil(inProgressTicket.Status)
-	s.Equal(openapi.InProgress, *inProgressTicket.Status)
+	s.Equal(openapi.TicketStatus("in_progress"), *inProgressTicket.Status)
 	s.Nil(inProgressTicket.ResolvedAt)
 	s.Nil(inProgressTicket.ClosedAt)
 
-	resolvedResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.Resolved, agentToken)
+	resolvedResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.TicketStatus("resolved"), agentToken)
 	s.Require().Equal(http.StatusOK, resolvedResp.Code, "response: %s", resolvedResp.Body.String())
 	var resolvedTicket openapi.GetTicketResponse
 	err = json.Unmarshal(resolvedResp.Body.Bytes(), &resolvedTicket)
 	s.Require().NoError(err)
 	s.Require().NotNil(resolvedTicket.Status)
-	s.Equal(openapi.Resolved, *resolvedTicket.Status)
+	s.Equal(openapi.TicketStatus("resolved"), *resolvedTicket.Status)
 	s.Require().NotNil(resolvedTicket.ResolvedAt)
 	s.Nil(resolvedTicket.ClosedAt)
 	resolvedAt := resolvedTicket.ResolvedAt.UTC()
 
-	closeResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.Closed, s.DefaultAdminToken())
+	closeResp := s.mustUpdateTicketStatusWithToken(*createdTicket.Id, openapi.TicketStatus("closed"), s.DefaultAdminToken())
 	s.Require().Equal(http.StatusOK, closeResp.Code, "response: %s", closeResp.Body.String())
 	var closedTicket openapi.GetTicketResponse
 	err = json.Unmarshal(closeResp.Body.Bytes(), &closedTicket)
 	s.Require().NoError(err)
 	s.Require().NotNil(closedTicket.Status)
-	s.Equal(openapi.Closed, *closedTicket.Status)
+	s.Equal(openapi.TicketStatus("closed"), *closedTicket.Status)
 	s.Require().NotNil(closedTicket.ResolvedAt)
 	s.Require().NotNil(closedTicket.ClosedAt)
 	closedResolvedAt := closedTicket.ResolvedAt.UTC()
@@ -291,7 +291,7 @@ func (s *E2ETestSuite) TestUserManagementWorkflow() {
 		s.Equal(customerB.ID, *ticket.AuthorId)
 	}
 
-	customerAOldRoleUpdateRec := s.mustUpdateTicketStatusWithToken(*customerBTicketForRoleChange.Id, openapi.InProgress, customerAToken)
+	customerAOldRoleUpdateRec := s.mustUpdateTicketStatusWithToken(*customerBTicketForRoleChange.Id, openapi.TicketStatus("in_progress"), customerAToken)
 	s.Require().Equal(http.StatusForbidden, customerAOldRoleUpdateRec.Code, "response: %s", customerAOldRoleUpdateRec.Body.String())
 
 	customerARoleReqBody, err := json.Marshal(openapi.UpdateUserRoleRequest{Role: openapi.Agent})
@@ -308,7 +308,7 @@ func (s *E2ETestSuite) TestUserManagementWorkflow() {
 
 	customerAOldTokenAfterRoleChangeRec := s.mustUpdateTicketStatusWithToken(
 		*customerBTicketForRoleChange.Id,
-		openapi.InProgress,
+		openapi.TicketStatus("in_progress"),
 		customerAToken,
 	)
 	s.Require().Equal(
@@ -322,15 +322,15 @@ func (s *E2ETestSuite) TestUserManagementWorkflow() {
 	s.Require().Equal(http.StatusOK, customerANewLoginRec.Code, "response: %s", customerANewLoginRec.Body.String())
 	s.Require().NotEmpty(customerANewToken)
 
-	customerANewRoleUpdateRec := s.mustUpdateTicketStatusWithToken(*customerBTicketForRoleChange.Id, openapi.InProgress, customerANewToken)
+	customerANewRoleUpdateRec := s.mustUpdateTicketStatusWithToken(*customerBTicketForRoleChange.Id, openapi.TicketStatus("in_progress"), customerANewToken)
 	s.Require().Equal(http.StatusOK, customerANewRoleUpdateRec.Code, "response: %s", customerANewRoleUpdateRec.Body.String())
 	var updatedByNewRole openapi.GetTicketResponse
 	err = json.Unmarshal(customerANewRoleUpdateRec.Body.Bytes(), &updatedByNewRole)
 	s.Require().NoError(err)
 	s.Require().NotNil(updatedByNewRole.Status)
-	s.Equal(openapi.InProgress, *updatedByNewRole.Status)
+	s.Equal(openapi.TicketStatus("in_progress"), *updatedByNewRole.Status)
 
-	agentUpdateRec := s.mustUpdateTicketStatusWithToken(*customerATicket.Id, openapi.InProgress, agentToken)
+	agentUpdateRec := s.mustUpdateTicketStatusWithToken(*customerATicket.Id, openapi.TicketStatus("in_progress"), agentToken)
 	s.Require().Equal(http.StatusOK, agentUpdateRec.Code, "response: %s", agentUpdateRec.Body.String())
 
 	agentGetsCustomerBTicketRec := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/tickets/%s", customerBTicketForVisibility.Id.String()), nil)
@@ -568,7 +568,7 @@ func (s *E2ETestSuite) TestErrorScenariosWorkflow() {
 	s.Require().NotEmpty(customerToken)
 
 	ticketResp := s.mustCreateTicketWithToken(customerToken, customer.UserID, "E2E error scenario ticket")
-	invalidTransitionRec := s.mustUpdateTicketStatusWithToken(*ticketResp.Id, openapi.Resolved, s.DefaultAdminToken())
+	invalidTransitionRec := s.mustUpdateTicketStatusWithToken(*ticketResp.Id, openapi.TicketStatus("resolved"), s.DefaultAdminToken())
 	s.Require().Equal(http.StatusBadRequest, invalidTransitionRec.Code, "response: %s", invalidTransitionRec.Body.String())
 	s.Contains(strings.ToLower(invalidTransitionRec.Body.String()), "invalid status transition")
 
@@ -102,7 +102,7 @@ func (s *TicketRepositoryIntegrationSuite) testBasicCRUD(repo application.Ticket
 		s.Equal(&assigneeID, retrievedAgain.AssigneeID())
 
 		// Test Delete
-		err = repo.DeleteTicket(ctx, ticket.ID())
+		err = repo.DeleteTicket(ctx, ticket.ID(), uuid.New())
 		s.Require().NoError(err)
 
 		// Verify deletion
@@ -339,7 +339,7 @@ func (s *TicketRepositoryIntegrationSuite) testErrorHandling(repo application.Ti
 		s.Equal(domain.ErrTicketNotFound, err)
 
 		// Test deleting non-existent ticket
-		err = repo.DeleteTicket(ctx, uuid.New())
+		err = repo.DeleteTicket(ctx, uuid.New(), uuid.New())
 		s.Equal(domain.ErrTicketNotFound, err)
 
 		// Test createFn returning error
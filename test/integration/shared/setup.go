@@ -10,11 +10,16 @@ import (
 
 	"simpleservicedesk/internal/application"
 	userdomain "simpleservicedesk/internal/domain/users"
+	"simpleservicedesk/internal/infrastructure/blobstore"
 	"simpleservicedesk/internal/infrastructure/categories"
 	healthInfra "simpleservicedesk/internal/infrastructure/health"
+	"simpleservicedesk/internal/infrastructure/macros"
+	"simpleservicedesk/internal/infrastructure/notifications"
 	"simpleservicedesk/internal/infrastructure/organizations"
+	"simpleservicedesk/internal/infrastructure/recurring"
 	"simpleservicedesk/internal/infrastructure/tickets"
 	userrepo "simpleservicedesk/internal/infrastructure/users"
+	"simpleservicedesk/internal/infrastructure/views"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
@@ -32,6 +37,11 @@ type IntegrationSuite struct {
 	TicketsRepo       application.TicketRepository
 	OrganizationsRepo application.OrganizationRepository
 	CategoriesRepo    application.CategoryRepository
+	MacrosRepo        application.MacroRepository
+	ViewsRepo         application.ViewRepository
+	RecurringRepo     application.RecurringTemplateRepository
+	NotificationsRepo application.NotificationRepository
+	BlobStore         application.BlobStore
 	MongoContainer    *mongodb.MongoDBContainer
 	MongoDB           *mongo.Database
 	MongoClient       *mongo.Client
@@ -39,6 +49,7 @@ type IntegrationSuite struct {
 }
 
 const testRateLimitRPS = 1000
+const testTrashRetention = 30 * 24 * time.Hour
 
 // MongoIntegrationSuite extends IntegrationSuite with MongoDB setup
 type MongoIntegrationSuite struct {
@@ -89,6 +100,13 @@ func (s *IntegrationSuite) SetupSuite() {
 	s.TicketsRepo = tickets.NewMongoRepo(s.MongoDB)
 	s.OrganizationsRepo = organizations.NewMongoRepo(s.MongoDB)
 	s.CategoriesRepo = categories.NewMongoRepo(s.MongoDB)
+	s.MacrosRepo = macros.NewMongoRepo(s.MongoDB)
+	s.ViewsRepo = views.NewMongoRepo(s.MongoDB)
+	s.RecurringRepo = recurring.NewMongoRepo(s.MongoDB)
+	s.NotificationsRepo = notifications.NewMongoRepo(s.MongoDB)
+	blobStore, err := blobstore.NewGridFSStore(s.MongoDB)
+	s.Require().NoError(err)
+	s.BlobStore = blobStore
 
 	// Initialize HTTP server with real repositories
 	server, err := application.SetupHTTPServer(
@@ -96,11 +114,17 @@ func (s *IntegrationSuite) SetupSuite() {
 		s.TicketsRepo,
 		s.OrganizationsRepo,
 		s.CategoriesRepo,
+		s.MacrosRepo,
+		s.ViewsRepo,
+		s.RecurringRepo,
+		s.NotificationsRepo,
+		s.BlobStore,
 		healthInfra.NewMongoPinger(s.MongoClient),
 		"integration-test-jwt-signing-key",
 		time.Hour,
 		[]string{"*"},
 		testRateLimitRPS,
+		testTrashRetention,
 	)
 	s.Require().NoError(err)
 	s.HTTPServer = server
@@ -124,11 +148,17 @@ func (s *IntegrationSuite) SetupTest() {
 		s.TicketsRepo,
 		s.OrganizationsRepo,
 		s.CategoriesRepo,
+		s.MacrosRepo,
+		s.ViewsRepo,
+		s.RecurringRepo,
+		s.NotificationsRepo,
+		s.BlobStore,
 		healthInfra.NewMongoPinger(s.MongoClient),
 		"integration-test-jwt-signing-key",
 		time.Hour,
 		[]string{"*"},
 		testRateLimitRPS,
+		testTrashRetention,
 	)
 	s.Require().NoError(err)
 	s.HTTPServer = server